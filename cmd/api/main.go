@@ -1,17 +1,35 @@
 package main
 
 import (
+	"context"
 	"log"
 
 	"github.com/gin-gonic/gin"
 	"github.com/opinedajr/micro-stakes-api/internal/di"
 	"github.com/opinedajr/micro-stakes-api/internal/shared/middleware"
+	"github.com/opinedajr/micro-stakes-api/internal/shared/validator"
 )
 
 func main() {
+	if err := validator.RegisterBindingValidators(); err != nil {
+		log.Fatal(err)
+	}
+
 	container := di.NewContainer()
 	r := gin.Default()
 
+	if err := container.CurrencyService().Load(context.Background()); err != nil {
+		log.Fatal(err)
+	}
+
+	go container.CurrencyRefresher().Run(context.Background())
+	go container.SessionSweeper().Run(context.Background())
+	go container.IdempotencyJanitor().Run(context.Background())
+	go container.RateFetcher().Run(context.Background())
+	go container.RakebackScheduler().Run(context.Background())
+
+	idempotent := middleware.Idempotency(container.IdempotencyRepository(), container.Config().Idempotency.TTL, container.Config().Idempotency.PendingLease, container.Logger())
+
 	r.GET("/health", container.HealthCheckHandler().Handle)
 
 	authRoutes := r.Group("/auth")
@@ -25,11 +43,105 @@ func main() {
 	bankrollRoutes := r.Group("/bankrolls")
 	bankrollRoutes.Use(middleware.AuthMiddleware(container.Config().Keycloak, container.AuthService(), container.Logger()))
 	{
-		bankrollRoutes.POST("", container.BankrollHandler().CreateBankroll)
+		bankrollRoutes.POST("", idempotent, container.BankrollHandler().CreateBankroll)
 		bankrollRoutes.GET("", container.BankrollHandler().ListBankrolls)
+		bankrollRoutes.POST("/transfers", idempotent, container.TransferHandler().CreateTransfer)
 		bankrollRoutes.GET("/:bankrollId", container.BankrollHandler().GetBankroll)
 		bankrollRoutes.PUT("/:bankrollId", container.BankrollHandler().UpdateBankroll)
-		bankrollRoutes.POST("/:bankrollId/reset", container.BankrollHandler().ResetBankroll)
+		bankrollRoutes.PATCH("/:bankrollId", container.BankrollHandler().PatchBankroll)
+		bankrollRoutes.DELETE("/:bankrollId", container.BankrollHandler().DeleteBankroll)
+		bankrollRoutes.POST("/:bankrollId/reset", idempotent, container.BankrollHandler().ResetBankroll)
+		bankrollRoutes.GET("/:bankrollId/periods", container.PeriodHandler().ListPeriods)
+		bankrollRoutes.POST("/:bankrollId/archive", container.BankrollHandler().ArchiveBankroll)
+		bankrollRoutes.POST("/:bankrollId/restore", container.BankrollHandler().RestoreBankroll)
+		bankrollRoutes.POST("/:bankrollId/transactions", idempotent, container.TransactionHandler().CreateTransaction)
+		bankrollRoutes.GET("/:bankrollId/transactions", container.TransactionHandler().ListTransactions)
+		bankrollRoutes.GET("/:bankrollId/history", container.HistoryHandler().GetBalanceHistory)
+		bankrollRoutes.GET("/:bankrollId/stats", container.StatsHandler().GetStats)
+		bankrollRoutes.POST("/:bankrollId/rules", container.RuleHandler().CreateRule)
+		bankrollRoutes.GET("/:bankrollId/rules", container.RuleHandler().ListRules)
+		bankrollRoutes.PUT("/:bankrollId/rules/:ruleId", container.RuleHandler().UpdateRule)
+		bankrollRoutes.DELETE("/:bankrollId/rules/:ruleId", container.RuleHandler().DeleteRule)
+		bankrollRoutes.GET("/:bankrollId/limits", container.LimitHandler().GetLimits)
+		bankrollRoutes.PUT("/:bankrollId/limits", container.LimitHandler().SetLimits)
+		bankrollRoutes.DELETE("/:bankrollId/limits", container.LimitHandler().DeleteLimits)
+		bankrollRoutes.GET("/:bankrollId/stake-advice", container.StakeHandler().GetAdvice)
+		bankrollRoutes.POST("/:bankrollId/risk-simulations", container.RiskHandler().Simulate)
+		bankrollRoutes.POST("/:bankrollId/goals", container.GoalHandler().CreateGoal)
+		bankrollRoutes.GET("/:bankrollId/goals", container.GoalHandler().ListGoals)
+		bankrollRoutes.GET("/:bankrollId/goals/:goalId", container.GoalHandler().GetGoal)
+		bankrollRoutes.DELETE("/:bankrollId/goals/:goalId", container.GoalHandler().DeleteGoal)
+		bankrollRoutes.GET("/:bankrollId/goal-milestones", container.GoalHandler().ListMilestones)
+		bankrollRoutes.POST("/:bankrollId/rakeback-deals", container.RakebackHandler().CreateDeal)
+		bankrollRoutes.GET("/:bankrollId/rakeback-deals", container.RakebackHandler().ListDeals)
+		bankrollRoutes.PUT("/:bankrollId/rakeback-deals/:dealId", container.RakebackHandler().UpdateDeal)
+		bankrollRoutes.DELETE("/:bankrollId/rakeback-deals/:dealId", container.RakebackHandler().DeleteDeal)
+		bankrollRoutes.GET("/:bankrollId/rakeback-payouts", container.RakebackHandler().ListPayouts)
+		bankrollRoutes.POST("/:bankrollId/bets", idempotent, container.BetHandler().RegisterBet)
+		bankrollRoutes.GET("/:bankrollId/bets", container.BetHandler().ListBets)
+		bankrollRoutes.POST("/:bankrollId/sessions", idempotent, container.SessionHandler().CreateSession)
+		bankrollRoutes.GET("/:bankrollId/sessions", container.SessionHandler().ListSessions)
+	}
+
+	betRoutes := r.Group("/bets")
+	betRoutes.Use(middleware.AuthMiddleware(container.Config().Keycloak, container.AuthService(), container.Logger()))
+	{
+		betRoutes.GET("/:betId", container.BetHandler().GetBet)
+		betRoutes.POST("/:betId/settle", idempotent, container.BetHandler().SettleBet)
+		betRoutes.PUT("/:betId/strategy", container.BetHandler().AssignStrategy)
+	}
+
+	sessionRoutes := r.Group("/sessions")
+	sessionRoutes.Use(middleware.AuthMiddleware(container.Config().Keycloak, container.AuthService(), container.Logger()))
+	{
+		sessionRoutes.POST("/start", idempotent, container.SessionHandler().StartSession)
+		sessionRoutes.GET("/:sessionId", container.SessionHandler().GetSession)
+		sessionRoutes.PUT("/:sessionId", container.SessionHandler().UpdateSession)
+		sessionRoutes.DELETE("/:sessionId", container.SessionHandler().DeleteSession)
+		sessionRoutes.POST("/:sessionId/rebuy", idempotent, container.SessionHandler().RebuySession)
+		sessionRoutes.POST("/:sessionId/stop", idempotent, container.SessionHandler().StopSession)
+	}
+
+	strategyRoutes := r.Group("/strategies")
+	strategyRoutes.Use(middleware.AuthMiddleware(container.Config().Keycloak, container.AuthService(), container.Logger()))
+	{
+		strategyRoutes.POST("", container.StrategyHandler().CreateStrategy)
+		strategyRoutes.GET("", container.StrategyHandler().ListStrategies)
+		strategyRoutes.GET("/:strategyId", container.StrategyHandler().GetStrategy)
+		strategyRoutes.PUT("/:strategyId", container.StrategyHandler().UpdateStrategy)
+		strategyRoutes.DELETE("/:strategyId", container.StrategyHandler().DeleteStrategy)
+		strategyRoutes.GET("/:strategyId/performance", container.StrategyHandler().GetPerformance)
+	}
+
+	stakeLadderRoutes := r.Group("/stake-ladders")
+	stakeLadderRoutes.Use(middleware.AuthMiddleware(container.Config().Keycloak, container.AuthService(), container.Logger()))
+	{
+		stakeLadderRoutes.GET("", container.StakeHandler().ListLadders)
+		stakeLadderRoutes.GET("/:gameType", container.StakeHandler().GetLadder)
+		stakeLadderRoutes.PUT("/:gameType", container.StakeHandler().SetLadder)
+		stakeLadderRoutes.DELETE("/:gameType", container.StakeHandler().ResetLadder)
+	}
+
+	dashboardRoutes := r.Group("/dashboard")
+	dashboardRoutes.Use(middleware.AuthMiddleware(container.Config().Keycloak, container.AuthService(), container.Logger()))
+	{
+		dashboardRoutes.GET("", container.DashboardHandler().GetDashboard)
+	}
+
+	fxRoutes := r.Group("/fx")
+	fxRoutes.Use(middleware.AuthMiddleware(container.Config().Keycloak, container.AuthService(), container.Logger()))
+	{
+		fxRoutes.GET("/rates", container.RateHandler().ListRates)
+		fxRoutes.GET("/convert", container.RateHandler().Convert)
+		fxRoutes.PUT("/rates", middleware.RequireRole(container.Config().Keycloak.AdminRole), container.RateHandler().UpsertRate)
+		fxRoutes.POST("/rates/import", middleware.RequireRole(container.Config().Keycloak.AdminRole), container.RateHandler().ImportRates)
+	}
+
+	currencyRoutes := r.Group("/currencies")
+	currencyRoutes.Use(middleware.AuthMiddleware(container.Config().Keycloak, container.AuthService(), container.Logger()))
+	{
+		currencyRoutes.GET("", container.CurrencyHandler().ListCurrencies)
+		currencyRoutes.PUT("/:code", middleware.RequireRole(container.Config().Keycloak.AdminRole), container.CurrencyHandler().UpsertCurrency)
 	}
 
 	log.Fatal(r.Run(":3003"))
@@ -175,6 +175,6 @@ func TestMain_HealthEndpoint(t *testing.T) {
 		w := httptest.NewRecorder()
 		r.ServeHTTP(w, req)
 
-		assert.JSONEq(t, `[{"service_name":"micro-stakes-api","status":"healthy","message":"Service is running"}]`, w.Body.String())
+		assert.JSONEq(t, `[{"service_name":"micro-stakes-api","status":"healthy","message":"Service is running"},{"service_name":"fx-rates","status":"healthy","message":"Exchange rates are up to date"}]`, w.Body.String())
 	})
 }
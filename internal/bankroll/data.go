@@ -1,38 +1,557 @@
 package bankroll
 
 import (
+	"encoding/json"
 	"time"
+
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
+	"github.com/opinedajr/micro-stakes-api/internal/shared/pagination"
 )
 
 type CreateBankrollInput struct {
-	Name                 string   `json:"name" binding:"required,min=1,max=100"`
-	Currency             Currency `json:"currency" binding:"required"`
-	InitialBalance       float64  `json:"initial_balance" binding:"required,gte=0"`
-	StartDate            string   `json:"start_date" binding:"required"`
-	CommissionPercentage float64  `json:"commission_percentage" binding:"required,gte=0,lte=100"`
+	Name                 string         `json:"name" binding:"required,min=1,max=100"`
+	Currency             Currency       `json:"currency" binding:"required"`
+	InitialBalance       domain.Decimal `json:"initial_balance" binding:"decimal_required,decimal_gte=0"`
+	StartDate            string         `json:"start_date" binding:"required"`
+	CommissionPercentage domain.Decimal `json:"commission_percentage" binding:"decimal_required,decimal_gte=0,decimal_lte=100"`
+	Timezone             string         `json:"timezone" binding:"max=64"`
 }
 
 type UpdateBankrollInput struct {
-	Name                 string   `json:"name" binding:"required,min=1,max=100"`
-	Currency             Currency `json:"currency" binding:"required"`
-	StartDate            string   `json:"start_date" binding:"required"`
-	CommissionPercentage float64  `json:"commission_percentage" binding:"required,gte=0,lte=100"`
+	Name                 string         `json:"name" binding:"required,min=1,max=100"`
+	Currency             Currency       `json:"currency" binding:"required"`
+	StartDate            string         `json:"start_date" binding:"required"`
+	CommissionPercentage domain.Decimal `json:"commission_percentage" binding:"decimal_required,decimal_gte=0,decimal_lte=100"`
+	Timezone             string         `json:"timezone" binding:"max=64"`
+	ExpectedVersion      uint           `json:"-"`
+}
+
+// PatchBankrollInput is an RFC 7396 merge patch. Nil fields were absent from
+// the document and keep their current value.
+type PatchBankrollInput struct {
+	Name                 *string
+	Currency             *Currency
+	StartDate            *string
+	CommissionPercentage *domain.Decimal
+	Timezone             *string
+	ExpectedVersion      uint
+}
+
+func (p PatchBankrollInput) IsEmpty() bool {
+	return p.Name == nil && p.Currency == nil && p.StartDate == nil && p.CommissionPercentage == nil && p.Timezone == nil
+}
+
+// ParseBankrollPatch decodes a merge-patch document. Balances can never be
+// patched, and since every editable field is mandatory a null (which would
+// remove the member) is rejected as well.
+func ParseBankrollPatch(data []byte) (PatchBankrollInput, error) {
+	var input PatchBankrollInput
+
+	var members map[string]json.RawMessage
+	if err := json.Unmarshal(data, &members); err != nil || members == nil {
+		return input, WrapError(ErrValidationFailed, "merge patch must be a JSON object")
+	}
+
+	for name, value := range members {
+		var target interface{}
+		switch name {
+		case "initial_balance", "current_balance", "in_play":
+			return input, ErrCannotModifyBalance
+		case "name":
+			target = &input.Name
+		case "currency":
+			target = &input.Currency
+		case "start_date":
+			target = &input.StartDate
+		case "commission_percentage":
+			target = &input.CommissionPercentage
+		case "timezone":
+			target = &input.Timezone
+		default:
+			return input, WrapError(ErrValidationFailed, "unknown field "+name)
+		}
+
+		if string(value) == "null" {
+			return input, WrapError(ErrValidationFailed, name+" cannot be removed")
+		}
+		if err := json.Unmarshal(value, target); err != nil {
+			return input, WrapError(ErrValidationFailed, "invalid value for "+name)
+		}
+	}
+
+	return input, nil
+}
+
+// ResetBankrollInput is optional; without a body the bankroll restarts at zero.
+type ResetBankrollInput struct {
+	Amount          domain.Decimal `json:"amount"`
+	ExpectedVersion uint           `json:"-"`
 }
 
 type BankrollOutput struct {
-	ID                   uint      `json:"id"`
-	Name                 string    `json:"name"`
-	Currency             Currency  `json:"currency"`
-	InitialBalance       float64   `json:"initial_balance"`
-	CurrentBalance       float64   `json:"current_balance"`
-	StartDate            string    `json:"start_date"`
-	CommissionPercentage float64   `json:"commission_percentage"`
-	CreatedAt            time.Time `json:"created_at"`
-	UpdatedAt            time.Time `json:"updated_at"`
+	ID                   uint           `json:"id"`
+	Name                 string         `json:"name"`
+	Currency             Currency       `json:"currency"`
+	InitialBalance       domain.Decimal `json:"initial_balance"`
+	CurrentBalance       domain.Decimal `json:"current_balance"`
+	InPlay               domain.Decimal `json:"in_play"`
+	StartDate            string         `json:"start_date"`
+	CommissionPercentage domain.Decimal `json:"commission_percentage"`
+	Timezone             string         `json:"timezone"`
+	Archived             bool           `json:"archived"`
+	ArchivedAt           *time.Time     `json:"archived_at,omitempty"`
+	Version              uint           `json:"version"`
+	// Lock is the stop-limit state. It is left out when the bankroll has just
+	// been created or the state could not be computed.
+	Lock      *LimitLockOutput `json:"lock,omitempty"`
+	CreatedAt time.Time        `json:"created_at"`
+	UpdatedAt time.Time        `json:"updated_at"`
 }
 
+type ListBankrollsInput struct {
+	Currency        Currency     `form:"currency"`
+	Name            string       `form:"name" binding:"max=100"`
+	CreatedAfter    string       `form:"created_after"`
+	IncludeArchived bool         `form:"include_archived"`
+	Sort            BankrollSort `form:"sort"`
+	Order           string       `form:"order" binding:"omitempty,oneof=asc desc"`
+	pagination.Params
+}
+
+type BankrollPageOutput = pagination.Page[*BankrollOutput]
+
 type ErrorOutput struct {
 	Error   string              `json:"error"`
 	Code    string              `json:"code"`
 	Details map[string][]string `json:"details,omitempty"`
 }
+
+type CreateTransactionInput struct {
+	Type        TransactionType `json:"type" binding:"required"`
+	Amount      domain.Decimal  `json:"amount" binding:"decimal_required"`
+	Description string          `json:"description" binding:"max=255"`
+	// ExpectedVersion is taken from the If-Match header, not the body.
+	ExpectedVersion uint `json:"-"`
+}
+
+type ListTransactionsInput struct {
+	pagination.Params
+}
+
+type TransactionOutput struct {
+	ID            uint            `json:"id"`
+	BankrollID    uint            `json:"bankroll_id"`
+	Type          TransactionType `json:"type"`
+	Amount        domain.Decimal  `json:"amount"`
+	BalanceAfter  domain.Decimal  `json:"balance_after"`
+	Description   string          `json:"description"`
+	TransferID    *uint           `json:"transfer_id,omitempty"`
+	ReferenceType string          `json:"reference_type,omitempty"`
+	ReferenceID   *uint           `json:"reference_id,omitempty"`
+	CreatedAt     time.Time       `json:"created_at"`
+}
+
+type TransactionPageOutput = pagination.Page[*TransactionOutput]
+
+type CreateTransferInput struct {
+	FromBankrollID uint           `json:"from_bankroll_id" binding:"required"`
+	ToBankrollID   uint           `json:"to_bankroll_id" binding:"required"`
+	Amount         domain.Decimal `json:"amount" binding:"decimal_required,decimal_gt=0"`
+	ExchangeRate   domain.Decimal `json:"exchange_rate" binding:"decimal_gte=0"`
+	Description    string         `json:"description" binding:"max=255"`
+}
+
+type TransferOutput struct {
+	ID              uint              `json:"id"`
+	FromBankrollID  uint              `json:"from_bankroll_id"`
+	ToBankrollID    uint              `json:"to_bankroll_id"`
+	Amount          domain.Decimal    `json:"amount"`
+	ConvertedAmount domain.Decimal    `json:"converted_amount"`
+	ExchangeRate    domain.Decimal    `json:"exchange_rate"`
+	Description     string            `json:"description"`
+	Debit           TransactionOutput `json:"debit"`
+	Credit          TransactionOutput `json:"credit"`
+	CreatedAt       time.Time         `json:"created_at"`
+}
+
+type BalanceHistoryInput struct {
+	From     string          `form:"from"`
+	To       string          `form:"to"`
+	Interval HistoryInterval `form:"interval"`
+}
+
+type BalancePointOutput struct {
+	Date    string         `json:"date"`
+	Balance domain.Decimal `json:"balance"`
+	Change  domain.Decimal `json:"change"`
+}
+
+type BalanceHistoryOutput struct {
+	BankrollID uint                 `json:"bankroll_id"`
+	Currency   Currency             `json:"currency"`
+	Interval   HistoryInterval      `json:"interval"`
+	From       string               `json:"from"`
+	To         string               `json:"to"`
+	Points     []BalancePointOutput `json:"points"`
+}
+
+type ListPeriodsInput struct {
+	pagination.Params
+}
+
+type PeriodOutput struct {
+	ID             uint           `json:"id"`
+	BankrollID     uint           `json:"bankroll_id"`
+	StartDate      string         `json:"start_date"`
+	EndDate        string         `json:"end_date"`
+	StartedAt      time.Time      `json:"started_at"`
+	EndedAt        time.Time      `json:"ended_at"`
+	InitialBalance domain.Decimal `json:"initial_balance"`
+	FinalBalance   domain.Decimal `json:"final_balance"`
+	Profit         domain.Decimal `json:"profit"`
+}
+
+type PeriodPageOutput = pagination.Page[*PeriodOutput]
+
+type RuleInput struct {
+	Type     RuleType       `json:"type" binding:"required"`
+	Value    domain.Decimal `json:"value" binding:"decimal_required"`
+	Severity RuleSeverity   `json:"severity"`
+	GameType string         `json:"game_type" binding:"max=10"`
+	Stakes   string         `json:"stakes" binding:"max=50"`
+}
+
+type ListRulesInput struct {
+	pagination.Params
+}
+
+type RuleOutput struct {
+	ID         uint           `json:"id"`
+	BankrollID uint           `json:"bankroll_id"`
+	Type       RuleType       `json:"type"`
+	Value      domain.Decimal `json:"value"`
+	Severity   RuleSeverity   `json:"severity"`
+	GameType   string         `json:"game_type,omitempty"`
+	Stakes     string         `json:"stakes,omitempty"`
+	CreatedAt  time.Time      `json:"created_at"`
+	UpdatedAt  time.Time      `json:"updated_at"`
+}
+
+type RulePageOutput = pagination.Page[*RuleOutput]
+
+// RuleBreachOutput describes a rule a session or bet would break: the hard
+// rule behind a RULE_VIOLATION error, or a soft rule returned as a warning.
+type RuleBreachOutput struct {
+	RuleID   uint           `json:"rule_id"`
+	Type     RuleType       `json:"type"`
+	Severity RuleSeverity   `json:"severity"`
+	Limit    domain.Decimal `json:"limit"`
+	Actual   domain.Decimal `json:"actual"`
+	Message  string         `json:"message"`
+}
+
+type LimitsInput struct {
+	DailyStopLoss  *domain.Decimal `json:"daily_stop_loss"`
+	DailyStopWin   *domain.Decimal `json:"daily_stop_win"`
+	WeeklyStopLoss *domain.Decimal `json:"weekly_stop_loss"`
+	WeeklyStopWin  *domain.Decimal `json:"weekly_stop_win"`
+}
+
+// LimitLockOutput tells whether a stop limit currently blocks new sessions
+// and bets, which one, and when the lock lifts.
+type LimitLockOutput struct {
+	Locked      bool            `json:"locked"`
+	Reason      LimitReason     `json:"reason,omitempty"`
+	Threshold   *domain.Decimal `json:"threshold,omitempty"`
+	Result      *domain.Decimal `json:"result,omitempty"`
+	LockedUntil *time.Time      `json:"locked_until,omitempty"`
+}
+
+type LimitsOutput struct {
+	BankrollID     uint            `json:"bankroll_id"`
+	Timezone       string          `json:"timezone"`
+	DailyStopLoss  *domain.Decimal `json:"daily_stop_loss,omitempty"`
+	DailyStopWin   *domain.Decimal `json:"daily_stop_win,omitempty"`
+	WeeklyStopLoss *domain.Decimal `json:"weekly_stop_loss,omitempty"`
+	WeeklyStopWin  *domain.Decimal `json:"weekly_stop_win,omitempty"`
+	DailyResult    domain.Decimal  `json:"daily_result"`
+	WeeklyResult   domain.Decimal  `json:"weekly_result"`
+	Lock           LimitLockOutput `json:"lock"`
+	UpdatedAt      time.Time       `json:"updated_at"`
+}
+
+type StakeLevelInput struct {
+	Stakes string         `json:"stakes" binding:"required,max=50"`
+	BuyIn  domain.Decimal `json:"buy_in" binding:"decimal_required"`
+}
+
+// StakeLadderInput replaces a ladder. Buy-ins are in Currency, USD when
+// omitted.
+type StakeLadderInput struct {
+	Currency Currency          `json:"currency" binding:"max=10"`
+	Levels   []StakeLevelInput `json:"levels" binding:"required,min=1,max=30,dive"`
+}
+
+type StakeLevelOutput struct {
+	Stakes string         `json:"stakes"`
+	BuyIn  domain.Decimal `json:"buy_in"`
+}
+
+// StakeLadderOutput is the ladder in effect for a game type. Custom is false
+// when the user has not replaced the default ladder.
+type StakeLadderOutput struct {
+	GameType string             `json:"game_type"`
+	Currency Currency           `json:"currency"`
+	Custom   bool               `json:"custom"`
+	Levels   []StakeLevelOutput `json:"levels"`
+}
+
+type StakeAdviceInput struct {
+	GameType string `form:"game_type" binding:"max=10"`
+}
+
+type StakeAdviceOutput struct {
+	BankrollID uint                    `json:"bankroll_id"`
+	Currency   Currency                `json:"currency"`
+	Balance    domain.Decimal          `json:"balance"`
+	Games      []GameStakeAdviceOutput `json:"games"`
+}
+
+// GameStakeAdviceOutput is the advice for one game type. BuyInsSource is
+// "rule" when RequiredBuyIns comes from the bankroll's min_buy_ins rule and
+// "default" otherwise. Recommended is the highest playable level. Level
+// buy-ins are in LadderCurrency, required balances in the bankroll's currency
+// at ExchangeRate.
+type GameStakeAdviceOutput struct {
+	GameType       string                   `json:"game_type"`
+	LadderCurrency Currency                 `json:"ladder_currency"`
+	ExchangeRate   domain.Decimal           `json:"exchange_rate"`
+	RequiredBuyIns domain.Decimal           `json:"required_buy_ins"`
+	BuyInsSource   string                   `json:"buy_ins_source"`
+	Recommended    string                   `json:"recommended,omitempty"`
+	Levels         []StakeLevelAdviceOutput `json:"levels"`
+	MoveUp         *MoveUpAdviceOutput      `json:"move_up,omitempty"`
+	MoveDown       *MoveDownAdviceOutput    `json:"move_down,omitempty"`
+}
+
+type StakeLevelAdviceOutput struct {
+	Stakes          string         `json:"stakes"`
+	BuyIn           domain.Decimal `json:"buy_in"`
+	RequiredBalance domain.Decimal `json:"required_balance"`
+	Playable        bool           `json:"playable"`
+}
+
+// MoveUpAdviceOutput describes the next level up: the balance that makes it
+// playable and the smaller one from which a shot at it is allowed.
+type MoveUpAdviceOutput struct {
+	Stakes          string         `json:"stakes"`
+	RequiredBalance domain.Decimal `json:"required_balance"`
+	ShotBalance     domain.Decimal `json:"shot_balance"`
+	ShotAllowed     bool           `json:"shot_allowed"`
+}
+
+// MoveDownAdviceOutput gives the balance below which the recommended level
+// must be left, and the level to drop to. Stakes is empty when the
+// recommended level is the lowest one.
+type MoveDownAdviceOutput struct {
+	Balance domain.Decimal `json:"balance"`
+	Stakes  string         `json:"stakes,omitempty"`
+}
+
+// RiskSimulationInput configures a Monte Carlo run. WinRate and StdDev are
+// per session or bet and must be given together; without them they are
+// estimated from the bankroll's history in Source. Seed makes the run
+// reproducible.
+type RiskSimulationInput struct {
+	Source  RiskSource      `json:"source"`
+	WinRate *domain.Decimal `json:"win_rate"`
+	StdDev  *domain.Decimal `json:"std_dev"`
+	Trials  int             `json:"trials" binding:"omitempty,min=1,max=10000"`
+	Horizon int             `json:"horizon" binding:"omitempty,min=1,max=5000"`
+	Seed    *int64          `json:"seed"`
+}
+
+type RiskCurveOutput struct {
+	Percentile int              `json:"percentile"`
+	Balances   []domain.Decimal `json:"balances"`
+}
+
+// RiskSimulationOutput reports the share of trials that went broke within
+// Horizon steps, the closed-form risk over an unlimited horizon, and the
+// balance percentiles at each of Steps. ExpectedDownswingLength is the mean,
+// in steps, of each trial's longest stretch below a previous peak.
+type RiskSimulationOutput struct {
+	BankrollID              uint              `json:"bankroll_id"`
+	Currency                Currency          `json:"currency"`
+	StartingBalance         domain.Decimal    `json:"starting_balance"`
+	Source                  RiskSource        `json:"source"`
+	SampleSize              int               `json:"sample_size,omitempty"`
+	WinRate                 domain.Decimal    `json:"win_rate"`
+	StdDev                  domain.Decimal    `json:"std_dev"`
+	Trials                  int               `json:"trials"`
+	Horizon                 int               `json:"horizon"`
+	Seed                    int64             `json:"seed"`
+	RiskOfRuin              float64           `json:"risk_of_ruin"`
+	AnalyticRiskOfRuin      float64           `json:"analytic_risk_of_ruin"`
+	ExpectedDownswingLength float64           `json:"expected_downswing_length"`
+	ExpectedMaxDrawdown     domain.Decimal    `json:"expected_max_drawdown"`
+	Steps                   []int             `json:"steps"`
+	Curves                  []RiskCurveOutput `json:"curves"`
+}
+
+// StatsOutput describes the bankroll's realized result curve. Drawdowns are
+// measured from the highest point of the curve, in money and as a share of
+// the balance held at that point. Downswings count sessions and bets, and
+// CurrentStreak is positive for wins and negative for losses. Recovery days
+// are null until a downswing has been recovered.
+type StatsOutput struct {
+	BankrollID              uint           `json:"bankroll_id"`
+	Currency                Currency       `json:"currency"`
+	Results                 int            `json:"results"`
+	NetResult               domain.Decimal `json:"net_result"`
+	PeakResult              domain.Decimal `json:"peak_result"`
+	MaxDrawdown             domain.Decimal `json:"max_drawdown"`
+	MaxDrawdownPercent      domain.Decimal `json:"max_drawdown_percent"`
+	CurrentDrawdown         domain.Decimal `json:"current_drawdown"`
+	CurrentDrawdownPercent  domain.Decimal `json:"current_drawdown_percent"`
+	CurrentDownswingResults int            `json:"current_downswing_results"`
+	CurrentDownswingDays    int            `json:"current_downswing_days"`
+	LongestDownswingResults int            `json:"longest_downswing_results"`
+	LongestDownswingDays    int            `json:"longest_downswing_days"`
+	CurrentStreak           int            `json:"current_streak"`
+	LongestWinningStreak    int            `json:"longest_winning_streak"`
+	LongestLosingStreak     int            `json:"longest_losing_streak"`
+	LastRecoveryDays        *int           `json:"last_recovery_days"`
+	LongestRecoveryDays     *int           `json:"longest_recovery_days"`
+	UpdatedAt               time.Time      `json:"updated_at"`
+}
+
+// GoalInput sets a goal. Cash-out goals may give TargetPercent instead of
+// Target, as a share of the balance. Profit and cash-out goals may recur
+// weekly or monthly, starting over every period with a percent target taken
+// from the balance the period opens with. Deadline is a date.
+type GoalInput struct {
+	Name          string          `json:"name" binding:"required,max=100"`
+	Type          GoalType        `json:"type" binding:"required"`
+	Target        *domain.Decimal `json:"target"`
+	TargetPercent *domain.Decimal `json:"target_percent"`
+	Recurrence    GoalRecurrence  `json:"recurrence" binding:"max=10"`
+	Deadline      string          `json:"deadline"`
+}
+
+type ListGoalsInput struct {
+	pagination.Params
+}
+
+type ListMilestonesInput struct {
+	pagination.Params
+}
+
+// GoalOutput reports a goal's progress, within the current period for
+// recurring goals. ProgressPercent is measured from the balance the goal
+// started at for balance goals. The projection extends the
+// daily profit rate of the recent window and is null when that rate does not
+// move the goal forward.
+type GoalOutput struct {
+	ID                  uint            `json:"id"`
+	BankrollID          uint            `json:"bankroll_id"`
+	Name                string          `json:"name"`
+	Type                GoalType        `json:"type"`
+	Status              GoalStatus      `json:"status"`
+	Target              domain.Decimal  `json:"target"`
+	TargetPercent       *domain.Decimal `json:"target_percent,omitempty"`
+	Recurrence          GoalRecurrence  `json:"recurrence,omitempty"`
+	PeriodStart         *time.Time      `json:"period_start,omitempty"`
+	PeriodEnd           *time.Time      `json:"period_end,omitempty"`
+	Deadline            *string         `json:"deadline"`
+	StartBalance        domain.Decimal  `json:"start_balance"`
+	Progress            domain.Decimal  `json:"progress"`
+	ProgressPercent     domain.Decimal  `json:"progress_percent"`
+	Remaining           domain.Decimal  `json:"remaining"`
+	DailyProfitRate     domain.Decimal  `json:"daily_profit_rate"`
+	ProjectedCompletion *string         `json:"projected_completion"`
+	OnTrack             *bool           `json:"on_track,omitempty"`
+	ReachedAt           *time.Time      `json:"reached_at"`
+	CreatedAt           time.Time       `json:"created_at"`
+	UpdatedAt           time.Time       `json:"updated_at"`
+}
+
+type GoalMilestoneOutput struct {
+	ID            uint           `json:"id"`
+	GoalID        uint           `json:"goal_id"`
+	BankrollID    uint           `json:"bankroll_id"`
+	GoalName      string         `json:"goal_name"`
+	GoalType      GoalType       `json:"goal_type"`
+	Target        domain.Decimal `json:"target"`
+	Progress      domain.Decimal `json:"progress"`
+	TransactionID uint           `json:"transaction_id"`
+	ReachedAt     time.Time      `json:"reached_at"`
+}
+
+type GoalPageOutput = pagination.Page[*GoalOutput]
+
+type GoalMilestonePageOutput = pagination.Page[*GoalMilestoneOutput]
+
+// RakebackDealInput creates a deal. Percentage is the share of the rake or
+// commission paid back; Venue only applies to rake deals.
+type RakebackDealInput struct {
+	Name       string         `json:"name" binding:"required,max=100"`
+	Source     RakebackSource `json:"source" binding:"required"`
+	Percentage domain.Decimal `json:"percentage" binding:"decimal_required"`
+	Period     RakebackPeriod `json:"period" binding:"required"`
+	Venue      string         `json:"venue" binding:"max=100"`
+}
+
+// UpdateRakebackDealInput changes a deal's terms. Source and period are
+// fixed once the deal exists; a new percentage applies to every period not
+// paid out yet.
+type UpdateRakebackDealInput struct {
+	Name       string         `json:"name" binding:"required,max=100"`
+	Percentage domain.Decimal `json:"percentage" binding:"decimal_required"`
+	Venue      string         `json:"venue" binding:"max=100"`
+	Active     *bool          `json:"active"`
+}
+
+type ListRakebackDealsInput struct {
+	pagination.Params
+}
+
+type ListRakebackPayoutsInput struct {
+	pagination.Params
+}
+
+// RakebackDealOutput includes what the deal has accrued since its last
+// payout; it is credited at NextPayoutAt.
+type RakebackDealOutput struct {
+	ID              uint           `json:"id"`
+	BankrollID      uint           `json:"bankroll_id"`
+	Name            string         `json:"name"`
+	Source          RakebackSource `json:"source"`
+	Percentage      domain.Decimal `json:"percentage"`
+	Period          RakebackPeriod `json:"period"`
+	Venue           string         `json:"venue,omitempty"`
+	Active          bool           `json:"active"`
+	AccruedBasis    domain.Decimal `json:"accrued_basis"`
+	AccruedRakeback domain.Decimal `json:"accrued_rakeback"`
+	AccruingSince   time.Time      `json:"accruing_since"`
+	NextPayoutAt    *time.Time     `json:"next_payout_at"`
+	CreatedAt       time.Time      `json:"created_at"`
+	UpdatedAt       time.Time      `json:"updated_at"`
+}
+
+type RakebackPayoutOutput struct {
+	ID            uint           `json:"id"`
+	DealID        uint           `json:"deal_id"`
+	BankrollID    uint           `json:"bankroll_id"`
+	PeriodStart   time.Time      `json:"period_start"`
+	PeriodEnd     time.Time      `json:"period_end"`
+	Basis         domain.Decimal `json:"basis"`
+	Percentage    domain.Decimal `json:"percentage"`
+	Amount        domain.Decimal `json:"amount"`
+	TransactionID *uint          `json:"transaction_id"`
+	CreatedAt     time.Time      `json:"created_at"`
+}
+
+type RakebackDealPageOutput = pagination.Page[*RakebackDealOutput]
+
+type RakebackPayoutPageOutput = pagination.Page[*RakebackPayoutOutput]
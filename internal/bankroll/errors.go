@@ -15,6 +15,48 @@ var (
 	ErrNegativeBalance     = errors.New("balance cannot be negative")
 	ErrInvalidCommission   = errors.New("commission percentage must be between 0 and 100")
 	ErrCannotModifyBalance = errors.New("cannot modify initial or current balance on update")
+	ErrInvalidPrecision    = errors.New("amount exceeds currency precision")
+	ErrBankrollArchived    = errors.New("bankroll is archived")
+	ErrBankrollNotArchived = errors.New("bankroll is not archived or deleted")
+	ErrBankrollInPlay      = errors.New("bankroll has money in play")
+	ErrVersionConflict     = errors.New("bankroll was modified by another request")
+
+	ErrInsufficientFunds      = errors.New("insufficient funds in bankroll")
+	ErrInvalidTransactionType = errors.New("invalid transaction type")
+	ErrInvalidAmount          = errors.New("invalid transaction amount")
+
+	ErrSameBankrollTransfer = errors.New("cannot transfer to the same bankroll")
+	ErrInvalidExchangeRate  = errors.New("invalid exchange rate")
+	ErrExchangeRateNotFound = errors.New("exchange rate not found")
+
+	ErrInvalidInterval = errors.New("invalid history interval")
+
+	ErrRuleNotFound        = errors.New("bankroll rule not found")
+	ErrInvalidRuleType     = errors.New("invalid rule type")
+	ErrInvalidRuleSeverity = errors.New("invalid rule severity")
+	ErrInvalidRuleValue    = errors.New("invalid rule value")
+	ErrRuleViolation       = errors.New("bankroll rule violated")
+
+	ErrLimitsNotFound  = errors.New("bankroll limits not found")
+	ErrInvalidLimit    = errors.New("invalid stop limit")
+	ErrInvalidTimezone = errors.New("invalid timezone")
+	ErrLimitReached    = errors.New("bankroll stop limit reached")
+
+	ErrInvalidGameType    = errors.New("invalid game type")
+	ErrInvalidStakeLadder = errors.New("invalid stake ladder")
+
+	ErrInvalidRiskParams    = errors.New("invalid risk simulation parameters")
+	ErrInsufficientRiskData = errors.New("not enough results to estimate risk")
+
+	ErrStatsNotFound = errors.New("bankroll stats not found")
+
+	ErrGoalNotFound    = errors.New("bankroll goal not found")
+	ErrInvalidGoalType = errors.New("invalid goal type")
+	ErrInvalidGoal     = errors.New("invalid goal")
+
+	ErrRakebackDealNotFound = errors.New("rakeback deal not found")
+	ErrInvalidRakebackDeal  = errors.New("invalid rakeback deal")
+	ErrRakebackAlreadyPaid  = errors.New("rakeback period already paid")
 )
 
 func WrapError(err error, message string) error {
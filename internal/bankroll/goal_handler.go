@@ -0,0 +1,181 @@
+package bankroll
+
+import (
+	"net/http"
+	"strconv"
+
+	"log/slog"
+
+	"github.com/gin-gonic/gin"
+)
+
+type GoalHandler struct {
+	service GoalService
+	logger  *slog.Logger
+}
+
+func NewGoalHandler(service GoalService, logger *slog.Logger) *GoalHandler {
+	return &GoalHandler{
+		service: service,
+		logger:  logger,
+	}
+}
+
+func (h *GoalHandler) CreateGoal(c *gin.Context) {
+	var input GoalInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		h.logger.Error("invalid request body", "error", err)
+		c.JSON(http.StatusBadRequest, ErrorOutput{
+			Error:   "Invalid request body",
+			Code:    "VALIDATION_ERROR",
+			Details: nil,
+		})
+		return
+	}
+
+	userID, err := getUserID(c)
+	if err != nil {
+		handleError(c, h.logger, err)
+		return
+	}
+
+	bankrollIDStr := c.Param("bankrollId")
+	bankrollID, err := strconv.ParseUint(bankrollIDStr, 10, 32)
+	if err != nil {
+		handleError(c, h.logger, ErrUnauthorized)
+		return
+	}
+
+	output, err := h.service.CreateGoal(c.Request.Context(), userID, uint(bankrollID), input)
+	if err != nil {
+		handleError(c, h.logger, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, output)
+}
+
+func (h *GoalHandler) ListGoals(c *gin.Context) {
+	var input ListGoalsInput
+	if err := c.ShouldBindQuery(&input); err != nil {
+		h.logger.Error("invalid query parameters", "error", err)
+		c.JSON(http.StatusBadRequest, ErrorOutput{
+			Error:   "Invalid query parameters",
+			Code:    "VALIDATION_ERROR",
+			Details: nil,
+		})
+		return
+	}
+
+	userID, err := getUserID(c)
+	if err != nil {
+		handleError(c, h.logger, err)
+		return
+	}
+
+	bankrollIDStr := c.Param("bankrollId")
+	bankrollID, err := strconv.ParseUint(bankrollIDStr, 10, 32)
+	if err != nil {
+		handleError(c, h.logger, ErrUnauthorized)
+		return
+	}
+
+	output, err := h.service.ListGoals(c.Request.Context(), userID, uint(bankrollID), input)
+	if err != nil {
+		handleError(c, h.logger, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
+
+func (h *GoalHandler) GetGoal(c *gin.Context) {
+	userID, err := getUserID(c)
+	if err != nil {
+		handleError(c, h.logger, err)
+		return
+	}
+
+	bankrollID, goalID, err := goalParams(c)
+	if err != nil {
+		handleError(c, h.logger, err)
+		return
+	}
+
+	output, err := h.service.GetGoal(c.Request.Context(), userID, bankrollID, goalID)
+	if err != nil {
+		handleError(c, h.logger, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
+
+func (h *GoalHandler) DeleteGoal(c *gin.Context) {
+	userID, err := getUserID(c)
+	if err != nil {
+		handleError(c, h.logger, err)
+		return
+	}
+
+	bankrollID, goalID, err := goalParams(c)
+	if err != nil {
+		handleError(c, h.logger, err)
+		return
+	}
+
+	if err := h.service.DeleteGoal(c.Request.Context(), userID, bankrollID, goalID); err != nil {
+		handleError(c, h.logger, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// ListMilestones returns the goals the bankroll has reached, most recent
+// first.
+func (h *GoalHandler) ListMilestones(c *gin.Context) {
+	var input ListMilestonesInput
+	if err := c.ShouldBindQuery(&input); err != nil {
+		h.logger.Error("invalid query parameters", "error", err)
+		c.JSON(http.StatusBadRequest, ErrorOutput{
+			Error:   "Invalid query parameters",
+			Code:    "VALIDATION_ERROR",
+			Details: nil,
+		})
+		return
+	}
+
+	userID, err := getUserID(c)
+	if err != nil {
+		handleError(c, h.logger, err)
+		return
+	}
+
+	bankrollIDStr := c.Param("bankrollId")
+	bankrollID, err := strconv.ParseUint(bankrollIDStr, 10, 32)
+	if err != nil {
+		handleError(c, h.logger, ErrUnauthorized)
+		return
+	}
+
+	output, err := h.service.ListMilestones(c.Request.Context(), userID, uint(bankrollID), input)
+	if err != nil {
+		handleError(c, h.logger, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
+
+func goalParams(c *gin.Context) (uint, uint, error) {
+	bankrollID, err := strconv.ParseUint(c.Param("bankrollId"), 10, 32)
+	if err != nil {
+		return 0, 0, ErrUnauthorized
+	}
+	goalID, err := strconv.ParseUint(c.Param("goalId"), 10, 32)
+	if err != nil {
+		return 0, 0, ErrGoalNotFound
+	}
+	return uint(bankrollID), uint(goalID), nil
+}
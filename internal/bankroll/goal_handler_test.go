@@ -0,0 +1,155 @@
+package bankroll
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"log/slog"
+
+	"github.com/gin-gonic/gin"
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
+	"github.com/opinedajr/micro-stakes-api/internal/shared/pagination"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type MockGoalService struct {
+	mock.Mock
+}
+
+func (m *MockGoalService) CreateGoal(ctx context.Context, userID uint, bankrollID uint, input GoalInput) (*GoalOutput, error) {
+	args := m.Called(ctx, userID, bankrollID, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*GoalOutput), args.Error(1)
+}
+
+func (m *MockGoalService) ListGoals(ctx context.Context, userID uint, bankrollID uint, input ListGoalsInput) (*GoalPageOutput, error) {
+	args := m.Called(ctx, userID, bankrollID, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*GoalPageOutput), args.Error(1)
+}
+
+func (m *MockGoalService) GetGoal(ctx context.Context, userID uint, bankrollID uint, goalID uint) (*GoalOutput, error) {
+	args := m.Called(ctx, userID, bankrollID, goalID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*GoalOutput), args.Error(1)
+}
+
+func (m *MockGoalService) DeleteGoal(ctx context.Context, userID uint, bankrollID uint, goalID uint) error {
+	args := m.Called(ctx, userID, bankrollID, goalID)
+	return args.Error(0)
+}
+
+func (m *MockGoalService) ListMilestones(ctx context.Context, userID uint, bankrollID uint, input ListMilestonesInput) (*GoalMilestonePageOutput, error) {
+	args := m.Called(ctx, userID, bankrollID, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*GoalMilestonePageOutput), args.Error(1)
+}
+
+func TestCreateGoalHandler(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		mockService := new(MockGoalService)
+		handler := NewGoalHandler(mockService, slog.Default())
+
+		input := GoalInput{Name: "Ten grand", Type: GoalTypeBalance, Target: decimalPtr("10000"), Deadline: "2026-12-31"}
+		mockService.On("CreateGoal", mock.Anything, uint(1), uint(2), input).
+			Return(&GoalOutput{ID: 4, BankrollID: 2, Type: GoalTypeBalance, Status: GoalStatusActive, Target: *input.Target}, nil).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = newRuleRequest(t, http.MethodPost, "/bankrolls/2/goals", `{"name":"Ten grand","type":"balance","target":"10000","deadline":"2026-12-31"}`)
+		c.Params = gin.Params{{Key: "bankrollId", Value: "2"}}
+		c.Set("userID", "1")
+
+		handler.CreateGoal(c)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		var response GoalOutput
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, uint(4), response.ID)
+		assert.Equal(t, GoalStatusActive, response.Status)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("invalid goal", func(t *testing.T) {
+		mockService := new(MockGoalService)
+		handler := NewGoalHandler(mockService, slog.Default())
+
+		mockService.On("CreateGoal", mock.Anything, uint(1), uint(2), mock.AnythingOfType("bankroll.GoalInput")).
+			Return(nil, WrapError(ErrInvalidGoal, "target is required")).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = newRuleRequest(t, http.MethodPost, "/bankrolls/2/goals", `{"name":"Profit","type":"profit"}`)
+		c.Params = gin.Params{{Key: "bankrollId", Value: "2"}}
+		c.Set("userID", "1")
+
+		handler.CreateGoal(c)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		var response ErrorOutput
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, "INVALID_GOAL", response.Code)
+	})
+}
+
+func TestGetGoalHandler(t *testing.T) {
+	mockService := new(MockGoalService)
+	handler := NewGoalHandler(mockService, slog.Default())
+
+	mockService.On("GetGoal", mock.Anything, uint(1), uint(2), uint(9)).Return(nil, ErrGoalNotFound).Once()
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/bankrolls/2/goals/9", nil)
+	c.Params = gin.Params{{Key: "bankrollId", Value: "2"}, {Key: "goalId", Value: "9"}}
+	c.Set("userID", "1")
+
+	handler.GetGoal(c)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	var response ErrorOutput
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, "GOAL_NOT_FOUND", response.Code)
+}
+
+func TestListGoalMilestonesHandler(t *testing.T) {
+	mockService := new(MockGoalService)
+	handler := NewGoalHandler(mockService, slog.Default())
+
+	mockService.On("ListMilestones", mock.Anything, uint(1), uint(2), ListMilestonesInput{Params: pagination.Params{Limit: 1}}).Return(&GoalMilestonePageOutput{
+		Items: []*GoalMilestoneOutput{
+			{ID: 1, GoalID: 4, BankrollID: 2, GoalType: GoalTypeProfit, Target: domain.MustParseDecimal("50.00"), Progress: domain.MustParseDecimal("60.00")},
+		},
+		Total: 2,
+		Limit: 1,
+	}, nil).Once()
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/bankrolls/2/goal-milestones?limit=1", nil)
+	c.Params = gin.Params{{Key: "bankrollId", Value: "2"}}
+	c.Set("userID", "1")
+
+	handler.ListMilestones(c)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	var response GoalMilestonePageOutput
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	require.Len(t, response.Items, 1)
+	assert.Equal(t, int64(2), response.Total)
+	assert.Equal(t, uint(4), response.Items[0].GoalID)
+	assert.Equal(t, "60.00", response.Items[0].Progress.String())
+}
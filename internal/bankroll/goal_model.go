@@ -0,0 +1,206 @@
+package bankroll
+
+import (
+	"time"
+
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
+)
+
+type GoalType string
+
+const (
+	// GoalTypeBalance is reached when the bankroll balance gets to Target.
+	GoalTypeBalance GoalType = "balance"
+	// GoalTypeProfit is reached once play has won Target since the goal was
+	// set.
+	GoalTypeProfit GoalType = "profit"
+	// GoalTypeCashOut is reached once Target has been withdrawn since the
+	// goal was set.
+	GoalTypeCashOut GoalType = "cash_out"
+)
+
+func (t GoalType) IsValid() bool {
+	return t == GoalTypeBalance || t == GoalTypeProfit || t == GoalTypeCashOut
+}
+
+type GoalStatus string
+
+const (
+	GoalStatusActive  GoalStatus = "active"
+	GoalStatusReached GoalStatus = "reached"
+	GoalStatusMissed  GoalStatus = "missed"
+)
+
+// GoalRecurrence restarts a profit or cash-out goal every period.
+type GoalRecurrence string
+
+const (
+	GoalRecurrenceWeekly  GoalRecurrence = "weekly"
+	GoalRecurrenceMonthly GoalRecurrence = "monthly"
+)
+
+func (r GoalRecurrence) IsValid() bool {
+	return r == GoalRecurrenceWeekly || r == GoalRecurrenceMonthly
+}
+
+// Start returns the beginning of the period containing at, counted in loc.
+// Weeks start on Monday.
+func (r GoalRecurrence) Start(at time.Time, loc *time.Location) time.Time {
+	local := at.In(loc)
+	if r == GoalRecurrenceMonthly {
+		return time.Date(local.Year(), local.Month(), 1, 0, 0, 0, 0, loc)
+	}
+	start := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, loc)
+	return start.AddDate(0, 0, -((int(start.Weekday()) + 6) % 7))
+}
+
+// End returns the beginning of the period that follows the one starting at
+// start.
+func (r GoalRecurrence) End(start time.Time) time.Time {
+	if r == GoalRecurrenceMonthly {
+		return start.AddDate(0, 1, 0)
+	}
+	return start.AddDate(0, 0, 7)
+}
+
+// BankrollGoal is a target the user wants the bankroll to hit, optionally by
+// a deadline. Profit and cash-out goals accrue the matching ledger entries
+// posted after they were set; balance goals follow the current balance.
+// Recurring goals only accrue within the period starting at PeriodStart and
+// start over every period, with a TargetPercent target recomputed from the
+// balance the period opens with. Days are counted in the bankroll's time
+// zone.
+type BankrollGoal struct {
+	ID            uint            `gorm:"primaryKey;autoIncrement"`
+	BankrollID    uint            `gorm:"not null;index"`
+	UserID        uint            `gorm:"not null;index"`
+	Name          string          `gorm:"type:varchar(100);not null"`
+	Type          GoalType        `gorm:"type:varchar(20);not null"`
+	Target        domain.Decimal  `gorm:"type:decimal(27,8);not null"`
+	TargetPercent *domain.Decimal `gorm:"type:decimal(10,2)"`
+	Recurrence    GoalRecurrence  `gorm:"type:varchar(10);not null;default:''"`
+	PeriodStart   *time.Time
+	Deadline      *time.Time     `gorm:"type:date"`
+	StartBalance  domain.Decimal `gorm:"type:decimal(27,8);not null"`
+	Accrued       domain.Decimal `gorm:"type:decimal(27,8);not null"`
+	ReachedAt     *time.Time
+	CreatedAt     time.Time `gorm:"autoCreateTime"`
+	UpdatedAt     time.Time `gorm:"autoUpdateTime"`
+}
+
+func (BankrollGoal) TableName() string {
+	return "bankroll_goals"
+}
+
+// Progress returns how far the goal has come given the bankroll's balance.
+func (g *BankrollGoal) Progress(balance domain.Decimal) domain.Decimal {
+	if g.Type == GoalTypeBalance {
+		return balance
+	}
+	return g.Accrued
+}
+
+// Status reports whether the goal was reached, or missed because its
+// deadline day is over.
+func (g *BankrollGoal) Status(now time.Time, loc *time.Location) GoalStatus {
+	if g.ReachedAt != nil {
+		return GoalStatusReached
+	}
+	if !g.isOpen(now, loc) {
+		return GoalStatusMissed
+	}
+	return GoalStatusActive
+}
+
+// isOpen reports whether the goal can still be reached at the given moment.
+// The deadline day counts in full in loc.
+func (g *BankrollGoal) isOpen(at time.Time, loc *time.Location) bool {
+	if g.Deadline == nil {
+		return true
+	}
+	d := *g.Deadline
+	return at.Before(time.Date(d.Year(), d.Month(), d.Day()+1, 0, 0, 0, 0, loc))
+}
+
+// PeriodEnd returns when the current period of a recurring goal ends.
+func (g *BankrollGoal) PeriodEnd() *time.Time {
+	if g.Recurrence == "" || g.PeriodStart == nil {
+		return nil
+	}
+	end := g.Recurrence.End(*g.PeriodStart)
+	return &end
+}
+
+// roll starts a recurring goal over when at falls past its current period,
+// from balance as it stood when the new period opened. It reports whether
+// the goal moved to a new period.
+func (g *BankrollGoal) roll(at time.Time, loc *time.Location, balance domain.Decimal, currency Currency) bool {
+	end := g.PeriodEnd()
+	if end == nil || at.Before(*end) || !g.isOpen(at, loc) {
+		return false
+	}
+	start := g.Recurrence.Start(at, loc)
+	g.PeriodStart = &start
+	g.StartBalance = balance
+	g.Accrued = domain.Zero
+	g.ReachedAt = nil
+	if g.TargetPercent != nil {
+		g.Target = currency.Round(balance.Mul(*g.TargetPercent).Div(hundred))
+	}
+	return true
+}
+
+// accrue adds a ledger entry to a profit or cash-out goal and reports whether
+// it counted.
+func (g *BankrollGoal) accrue(transaction *Transaction) bool {
+	switch g.Type {
+	case GoalTypeProfit:
+		for _, playType := range PlayTransactionTypes {
+			if transaction.Type == playType {
+				g.Accrued = g.Accrued.Add(transaction.Amount)
+				return true
+			}
+		}
+	case GoalTypeCashOut:
+		if transaction.Type == TransactionTypeWithdrawal {
+			g.Accrued = g.Accrued.Sub(transaction.Amount)
+			return true
+		}
+	}
+	return false
+}
+
+// GoalListFilter selects a page of a bankroll's goals, oldest first. AfterID,
+// when set, replaces Offset with keyset paging.
+type GoalListFilter struct {
+	AfterID uint
+	Limit   int
+	Offset  int
+}
+
+// MilestoneListFilter selects a page of a bankroll's milestones, most
+// recently reached first. After, when set, replaces Offset with keyset paging.
+type MilestoneListFilter struct {
+	After  *BankrollCursor
+	Limit  int
+	Offset int
+}
+
+// GoalMilestone records the moment a goal was reached, along with the ledger
+// entry that got it there.
+type GoalMilestone struct {
+	ID            uint           `gorm:"primaryKey;autoIncrement"`
+	GoalID        uint           `gorm:"not null;index"`
+	BankrollID    uint           `gorm:"not null;index"`
+	UserID        uint           `gorm:"not null;index"`
+	GoalName      string         `gorm:"type:varchar(100);not null"`
+	GoalType      GoalType       `gorm:"type:varchar(20);not null"`
+	Target        domain.Decimal `gorm:"type:decimal(27,8);not null"`
+	Progress      domain.Decimal `gorm:"type:decimal(27,8);not null"`
+	TransactionID uint           `gorm:"not null"`
+	ReachedAt     time.Time      `gorm:"not null"`
+}
+
+func (GoalMilestone) TableName() string {
+	return "bankroll_goal_milestones"
+}
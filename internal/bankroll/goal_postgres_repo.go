@@ -0,0 +1,173 @@
+package bankroll
+
+import (
+	"context"
+	"time"
+
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
+	"github.com/opinedajr/micro-stakes-api/internal/shared/pagination"
+	"gorm.io/gorm"
+)
+
+type postgresGoalRepository struct {
+	db *gorm.DB
+}
+
+func NewPostgresGoalRepository(db *gorm.DB) GoalRepository {
+	return &postgresGoalRepository{
+		db: db,
+	}
+}
+
+func (r *postgresGoalRepository) Create(ctx context.Context, goal *BankrollGoal) error {
+	if err := r.db.WithContext(ctx).Create(goal).Error; err != nil {
+		return WrapError(ErrDatabaseError, err.Error())
+	}
+	return nil
+}
+
+func (r *postgresGoalRepository) FindByID(ctx context.Context, id uint, bankrollID uint, userID uint) (*BankrollGoal, error) {
+	var goal BankrollGoal
+	err := r.db.WithContext(ctx).
+		Where("id = ? AND bankroll_id = ? AND user_id = ?", id, bankrollID, userID).
+		First(&goal).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, ErrGoalNotFound
+		}
+		return nil, WrapError(ErrDatabaseError, err.Error())
+	}
+	return &goal, nil
+}
+
+// ListByBankrollID returns a page of the bankroll's goals together with the
+// total number of goals.
+func (r *postgresGoalRepository) ListByBankrollID(ctx context.Context, bankrollID uint, userID uint, filter GoalListFilter) ([]*BankrollGoal, int64, error) {
+	query := r.db.WithContext(ctx).Model(&BankrollGoal{}).
+		Where("bankroll_id = ? AND user_id = ?", bankrollID, userID)
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, WrapError(ErrDatabaseError, err.Error())
+	}
+
+	if filter.AfterID != 0 {
+		query = query.Where("id > ?", filter.AfterID)
+	} else if filter.Offset > 0 {
+		query = query.Offset(filter.Offset)
+	}
+
+	var goals []*BankrollGoal
+	if err := query.Order("id").Limit(filter.Limit).Find(&goals).Error; err != nil {
+		return nil, 0, WrapError(ErrDatabaseError, err.Error())
+	}
+	return goals, total, nil
+}
+
+func (r *postgresGoalRepository) Delete(ctx context.Context, id uint, bankrollID uint, userID uint) error {
+	result := r.db.WithContext(ctx).
+		Where("id = ? AND bankroll_id = ? AND user_id = ?", id, bankrollID, userID).
+		Delete(&BankrollGoal{})
+	if result.Error != nil {
+		return WrapError(ErrDatabaseError, result.Error.Error())
+	}
+	if result.RowsAffected == 0 {
+		return ErrGoalNotFound
+	}
+	return nil
+}
+
+// ListMilestones returns a page of the bankroll's milestones together with
+// the total number of milestones.
+func (r *postgresGoalRepository) ListMilestones(ctx context.Context, bankrollID uint, userID uint, filter MilestoneListFilter) ([]*GoalMilestone, int64, error) {
+	query := r.db.WithContext(ctx).Model(&GoalMilestone{}).
+		Where("bankroll_id = ? AND user_id = ?", bankrollID, userID)
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, WrapError(ErrDatabaseError, err.Error())
+	}
+
+	if filter.After != nil {
+		query = query.Where(pagination.KeysetCondition("reached_at", true),
+			filter.After.Value, filter.After.Value, filter.After.ID)
+	} else if filter.Offset > 0 {
+		query = query.Offset(filter.Offset)
+	}
+
+	var milestones []*GoalMilestone
+	err := query.Order(pagination.OrderClause("reached_at", true)).
+		Limit(filter.Limit).
+		Find(&milestones).Error
+	if err != nil {
+		return nil, 0, WrapError(ErrDatabaseError, err.Error())
+	}
+	return milestones, total, nil
+}
+
+func (r *postgresGoalRepository) RealizedResult(ctx context.Context, bankrollID uint, since time.Time) (domain.Decimal, error) {
+	return realizedResult(r.db.WithContext(ctx), bankrollID, since)
+}
+
+// reachGoals moves the bankroll's open goals by a ledger entry that has just
+// been applied, and records a milestone for every goal it completes. Recurring
+// goals first start over when the entry falls in a new period. It runs in the
+// entry's database transaction, so a milestone exists exactly when the entry
+// does.
+func reachGoals(tx *gorm.DB, bankroll *Bankroll, transaction *Transaction, userID uint) error {
+	var goals []*BankrollGoal
+	err := tx.Where("bankroll_id = ? AND (reached_at IS NULL OR recurrence <> '')", transaction.BankrollID).
+		Order("id").
+		Find(&goals).Error
+	if err != nil {
+		return WrapError(ErrDatabaseError, err.Error())
+	}
+	if len(goals) == 0 {
+		return nil
+	}
+	loc := bankroll.Location()
+
+	at := transaction.CreatedAt
+	before := transaction.BalanceAfter.Sub(transaction.Amount)
+	for _, goal := range goals {
+		updates := map[string]interface{}{}
+		if goal.roll(at, loc, before, bankroll.Currency) {
+			updates["period_start"] = goal.PeriodStart
+			updates["start_balance"] = goal.StartBalance
+			updates["target"] = goal.Target
+			updates["accrued"] = goal.Accrued
+			updates["reached_at"] = nil
+		}
+		if goal.ReachedAt == nil && goal.isOpen(at, loc) {
+			if goal.accrue(transaction) {
+				updates["accrued"] = goal.Accrued
+			}
+			progress := goal.Progress(transaction.BalanceAfter)
+			if goal.Target.IsPositive() && progress.GreaterThanOrEqual(goal.Target) {
+				updates["reached_at"] = at
+				milestone := &GoalMilestone{
+					GoalID:        goal.ID,
+					BankrollID:    goal.BankrollID,
+					UserID:        userID,
+					GoalName:      goal.Name,
+					GoalType:      goal.Type,
+					Target:        goal.Target,
+					Progress:      progress,
+					TransactionID: transaction.ID,
+					ReachedAt:     at,
+				}
+				if err := tx.Create(milestone).Error; err != nil {
+					return WrapError(ErrDatabaseError, err.Error())
+				}
+			}
+		}
+		if len(updates) == 0 {
+			continue
+		}
+		updates["updated_at"] = time.Now()
+		if err := tx.Model(goal).Updates(updates).Error; err != nil {
+			return WrapError(ErrDatabaseError, err.Error())
+		}
+	}
+	return nil
+}
@@ -0,0 +1,201 @@
+package bankroll
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestGoal(t *testing.T, repo GoalRepository, bankroll *Bankroll, goalType GoalType, target string) *BankrollGoal {
+	goal := &BankrollGoal{
+		BankrollID:   bankroll.ID,
+		UserID:       bankroll.UserID,
+		Name:         string(goalType),
+		Type:         goalType,
+		Target:       domain.MustParseDecimal(target),
+		StartBalance: bankroll.CurrentBalance,
+		Accrued:      domain.Zero,
+	}
+	require.NoError(t, repo.Create(context.Background(), goal))
+	return goal
+}
+
+func TestApplyTransaction_ReachesGoals(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewPostgresGoalRepository(db)
+	ctx := context.Background()
+	bankroll := createTestBankroll(t, db, 1, "1000.00")
+
+	balanceGoal := createTestGoal(t, repo, bankroll, GoalTypeBalance, "1150.00")
+	profitGoal := createTestGoal(t, repo, bankroll, GoalTypeProfit, "50.00")
+	cashOutGoal := createTestGoal(t, repo, bankroll, GoalTypeCashOut, "100.00")
+	expired := createTestGoal(t, repo, bankroll, GoalTypeBalance, "1050.00")
+	deadline := time.Date(2026, 1, 31, 0, 0, 0, 0, time.UTC)
+	require.NoError(t, db.Model(expired).Update("deadline", deadline).Error)
+
+	betID := uint(3)
+	postHistoryEntry(t, db, bankroll, &Transaction{
+		Type: TransactionTypeBetSettlement, Amount: domain.MustParseDecimal("60.00"),
+		ReferenceType: "bet", ReferenceID: &betID,
+	})
+	postHistoryEntry(t, db, bankroll, &Transaction{Type: TransactionTypeDeposit, Amount: domain.MustParseDecimal("100.00")})
+	postHistoryEntry(t, db, bankroll, &Transaction{Type: TransactionTypeWithdrawal, Amount: domain.MustParseDecimal("-40.00")})
+
+	milestones, _, err := repo.ListMilestones(ctx, bankroll.ID, bankroll.UserID, MilestoneListFilter{Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, milestones, 2)
+	assert.Equal(t, balanceGoal.ID, milestones[0].GoalID)
+	assert.Equal(t, 1160.0, milestones[0].Progress.Float64())
+	assert.Equal(t, profitGoal.ID, milestones[1].GoalID)
+	assert.Equal(t, 60.0, milestones[1].Progress.Float64())
+
+	found, err := repo.FindByID(ctx, cashOutGoal.ID, bankroll.ID, bankroll.UserID)
+	require.NoError(t, err)
+	assert.Nil(t, found.ReachedAt)
+	assert.Equal(t, 40.0, found.Accrued.Float64())
+
+	postHistoryEntry(t, db, bankroll, &Transaction{Type: TransactionTypeWithdrawal, Amount: domain.MustParseDecimal("-60.00")})
+
+	found, err = repo.FindByID(ctx, cashOutGoal.ID, bankroll.ID, bankroll.UserID)
+	require.NoError(t, err)
+	assert.NotNil(t, found.ReachedAt)
+
+	found, err = repo.FindByID(ctx, expired.ID, bankroll.ID, bankroll.UserID)
+	require.NoError(t, err)
+	assert.Nil(t, found.ReachedAt, "goals past their deadline are not reached")
+
+	milestones, _, err = repo.ListMilestones(ctx, bankroll.ID, bankroll.UserID, MilestoneListFilter{Limit: 10})
+	require.NoError(t, err)
+	assert.Len(t, milestones, 3, "a goal is only reached once")
+
+	page, total, err := repo.ListMilestones(ctx, bankroll.ID, bankroll.UserID, MilestoneListFilter{Limit: 2})
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), total)
+	require.Len(t, page, 2)
+	last := page[1]
+	rest, _, err := repo.ListMilestones(ctx, bankroll.ID, bankroll.UserID, MilestoneListFilter{
+		Limit: 2,
+		After: &BankrollCursor{Value: last.ReachedAt, ID: last.ID},
+	})
+	require.NoError(t, err)
+	require.Len(t, rest, 1)
+	assert.Equal(t, milestones[2].ID, rest[0].ID)
+}
+
+func TestApplyTransaction_AdjustmentsDoNotReachProfitGoals(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewPostgresGoalRepository(db)
+	ctx := context.Background()
+	bankroll := createTestBankroll(t, db, 1, "1000.00")
+	goal := createTestGoal(t, repo, bankroll, GoalTypeProfit, "50.00")
+
+	postHistoryEntry(t, db, bankroll, &Transaction{Type: TransactionTypeAdjustment, Amount: domain.MustParseDecimal("80.00")})
+
+	found, err := repo.FindByID(ctx, goal.ID, bankroll.ID, bankroll.UserID)
+	require.NoError(t, err)
+	assert.True(t, found.Accrued.IsZero(), "an adjustment is not profit from play")
+	assert.Nil(t, found.ReachedAt)
+
+	milestones, total, err := repo.ListMilestones(ctx, bankroll.ID, bankroll.UserID, MilestoneListFilter{Limit: 10})
+	require.NoError(t, err)
+	assert.Zero(t, total)
+	assert.Empty(t, milestones)
+}
+
+func TestApplyTransaction_StartsRecurringGoalsOver(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewPostgresGoalRepository(db)
+	ctx := context.Background()
+	bankroll := createTestBankroll(t, db, 1, "1000.00")
+	require.NoError(t, db.Model(bankroll).Update("timezone", "America/Sao_Paulo").Error)
+
+	periodStart := time.Date(2026, 2, 1, 3, 0, 0, 0, time.UTC)
+	goal := &BankrollGoal{
+		BankrollID:    bankroll.ID,
+		UserID:        bankroll.UserID,
+		Name:          "Monthly cash out",
+		Type:          GoalTypeCashOut,
+		Target:        domain.MustParseDecimal("200.00"),
+		TargetPercent: decimalPtr("20"),
+		Recurrence:    GoalRecurrenceMonthly,
+		PeriodStart:   &periodStart,
+		StartBalance:  bankroll.CurrentBalance,
+		Accrued:       domain.Zero,
+	}
+	require.NoError(t, repo.Create(ctx, goal))
+
+	withdraw := func(amount string, at time.Time) {
+		postHistoryEntry(t, db, bankroll, &Transaction{Type: TransactionTypeWithdrawal, Amount: domain.MustParseDecimal(amount), CreatedAt: at})
+	}
+	withdraw("-150.00", time.Date(2026, 2, 10, 12, 0, 0, 0, time.UTC))
+	withdraw("-50.00", time.Date(2026, 3, 1, 2, 0, 0, 0, time.UTC))
+
+	found, err := repo.FindByID(ctx, goal.ID, bankroll.ID, bankroll.UserID)
+	require.NoError(t, err)
+	require.NotNil(t, found.ReachedAt, "February ends at midnight in São Paulo, not in UTC")
+
+	withdraw("-40.00", time.Date(2026, 3, 1, 4, 0, 0, 0, time.UTC))
+
+	found, err = repo.FindByID(ctx, goal.ID, bankroll.ID, bankroll.UserID)
+	require.NoError(t, err)
+	assert.Nil(t, found.ReachedAt)
+	assert.Equal(t, 160.0, found.Target.Float64(), "20% of the 800.00 March opened with")
+	assert.Equal(t, 800.0, found.StartBalance.Float64())
+	assert.Equal(t, 40.0, found.Accrued.Float64())
+	require.NotNil(t, found.PeriodStart)
+	assert.True(t, found.PeriodStart.Equal(time.Date(2026, 3, 1, 3, 0, 0, 0, time.UTC)))
+
+	milestones, _, err := repo.ListMilestones(ctx, bankroll.ID, bankroll.UserID, MilestoneListFilter{Limit: 10})
+	require.NoError(t, err)
+	assert.Len(t, milestones, 1)
+}
+
+func TestPostgresGoalRepository_ListByBankrollID(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewPostgresGoalRepository(db)
+	ctx := context.Background()
+	bankroll := createTestBankroll(t, db, 1, "1000.00")
+	first := createTestGoal(t, repo, bankroll, GoalTypeProfit, "100.00")
+	second := createTestGoal(t, repo, bankroll, GoalTypeBalance, "1500.00")
+	third := createTestGoal(t, repo, bankroll, GoalTypeCashOut, "200.00")
+
+	goals, total, err := repo.ListByBankrollID(ctx, bankroll.ID, bankroll.UserID, GoalListFilter{Limit: 2})
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), total)
+	require.Len(t, goals, 2)
+	assert.Equal(t, first.ID, goals[0].ID)
+	assert.Equal(t, second.ID, goals[1].ID)
+
+	goals, _, err = repo.ListByBankrollID(ctx, bankroll.ID, bankroll.UserID, GoalListFilter{Limit: 2, AfterID: second.ID})
+	require.NoError(t, err)
+	require.Len(t, goals, 1)
+	assert.Equal(t, third.ID, goals[0].ID)
+
+	goals, _, err = repo.ListByBankrollID(ctx, bankroll.ID, bankroll.UserID, GoalListFilter{Limit: 2, Offset: 2})
+	require.NoError(t, err)
+	require.Len(t, goals, 1)
+	assert.Equal(t, third.ID, goals[0].ID)
+
+	goals, total, err = repo.ListByBankrollID(ctx, bankroll.ID, 2, GoalListFilter{Limit: 2})
+	require.NoError(t, err)
+	assert.Zero(t, total)
+	assert.Empty(t, goals)
+}
+
+func TestPostgresGoalRepository_Delete(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewPostgresGoalRepository(db)
+	ctx := context.Background()
+	bankroll := createTestBankroll(t, db, 1, "1000.00")
+	goal := createTestGoal(t, repo, bankroll, GoalTypeProfit, "500.00")
+
+	assert.ErrorIs(t, repo.Delete(ctx, goal.ID, bankroll.ID, 2), ErrGoalNotFound)
+	require.NoError(t, repo.Delete(ctx, goal.ID, bankroll.ID, bankroll.UserID))
+
+	_, err := repo.FindByID(ctx, goal.ID, bankroll.ID, bankroll.UserID)
+	assert.ErrorIs(t, err, ErrGoalNotFound)
+}
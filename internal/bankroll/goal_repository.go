@@ -0,0 +1,17 @@
+package bankroll
+
+import (
+	"context"
+	"time"
+
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
+)
+
+type GoalRepository interface {
+	Create(ctx context.Context, goal *BankrollGoal) error
+	FindByID(ctx context.Context, id uint, bankrollID uint, userID uint) (*BankrollGoal, error)
+	ListByBankrollID(ctx context.Context, bankrollID uint, userID uint, filter GoalListFilter) ([]*BankrollGoal, int64, error)
+	Delete(ctx context.Context, id uint, bankrollID uint, userID uint) error
+	ListMilestones(ctx context.Context, bankrollID uint, userID uint, filter MilestoneListFilter) ([]*GoalMilestone, int64, error)
+	RealizedResult(ctx context.Context, bankrollID uint, since time.Time) (domain.Decimal, error)
+}
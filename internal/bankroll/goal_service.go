@@ -0,0 +1,382 @@
+package bankroll
+
+import (
+	"context"
+	"log/slog"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
+	"github.com/opinedajr/micro-stakes-api/internal/shared/pagination"
+)
+
+// goalRateWindow is how far back the profit rate behind goal projections is
+// measured.
+const goalRateWindow = 30
+
+type GoalService interface {
+	CreateGoal(ctx context.Context, userID uint, bankrollID uint, input GoalInput) (*GoalOutput, error)
+	ListGoals(ctx context.Context, userID uint, bankrollID uint, input ListGoalsInput) (*GoalPageOutput, error)
+	GetGoal(ctx context.Context, userID uint, bankrollID uint, goalID uint) (*GoalOutput, error)
+	DeleteGoal(ctx context.Context, userID uint, bankrollID uint, goalID uint) error
+	ListMilestones(ctx context.Context, userID uint, bankrollID uint, input ListMilestonesInput) (*GoalMilestonePageOutput, error)
+}
+
+type goalService struct {
+	repo         GoalRepository
+	bankrollRepo BankrollRepository
+	logger       *slog.Logger
+}
+
+func NewGoalService(repo GoalRepository, bankrollRepo BankrollRepository, logger *slog.Logger) GoalService {
+	return &goalService{
+		repo:         repo,
+		bankrollRepo: bankrollRepo,
+		logger:       logger,
+	}
+}
+
+func (s *goalService) CreateGoal(ctx context.Context, userID uint, bankrollID uint, input GoalInput) (*GoalOutput, error) {
+	bankroll, err := s.bankrollRepo.FindByID(ctx, bankrollID, userID)
+	if err != nil {
+		s.logger.Error("bankroll not found", "error", err, "user_id", userID, "bankroll_id", bankrollID)
+		return nil, err
+	}
+
+	loc := bankroll.Location()
+
+	now := time.Now()
+	goal := &BankrollGoal{
+		BankrollID:   bankrollID,
+		UserID:       userID,
+		Name:         strings.TrimSpace(input.Name),
+		Type:         input.Type,
+		StartBalance: bankroll.CurrentBalance,
+		Accrued:      domain.Zero,
+	}
+	if err := applyGoalInput(bankroll, goal, input, now, loc); err != nil {
+		s.logger.Error("invalid goal", "error", err, "user_id", userID, "bankroll_id", bankrollID, "type", input.Type)
+		return nil, err
+	}
+
+	if err := s.repo.Create(ctx, goal); err != nil {
+		s.logger.Error("failed to create goal", "error", err, "user_id", userID, "bankroll_id", bankrollID)
+		return nil, err
+	}
+
+	rate, err := s.profitRate(ctx, bankrollID, now)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("goal created", "user_id", userID, "bankroll_id", bankrollID, "goal_id", goal.ID, "type", goal.Type, "target", goal.Target, "recurrence", goal.Recurrence)
+
+	return toGoalOutput(bankroll, goal, rate, now, loc), nil
+}
+
+func (s *goalService) ListGoals(ctx context.Context, userID uint, bankrollID uint, input ListGoalsInput) (*GoalPageOutput, error) {
+	params, err := input.Params.Normalize()
+	if err != nil {
+		s.logger.Error("invalid list parameters", "error", err, "user_id", userID)
+		return nil, WrapError(ErrValidationFailed, err.Error())
+	}
+
+	filter := GoalListFilter{
+		Limit:  params.Limit + 1,
+		Offset: params.Offset,
+	}
+	if params.Cursor != "" {
+		cursor, err := pagination.DecodeCursor(params.Cursor, "id", false)
+		if err != nil {
+			s.logger.Error("invalid cursor", "error", err, "user_id", userID)
+			return nil, WrapError(ErrValidationFailed, err.Error())
+		}
+		filter.AfterID = cursor.ID
+	}
+
+	bankroll, err := s.bankrollRepo.FindByID(ctx, bankrollID, userID)
+	if err != nil {
+		s.logger.Error("bankroll not found", "error", err, "user_id", userID, "bankroll_id", bankrollID)
+		return nil, err
+	}
+
+	goals, total, err := s.repo.ListByBankrollID(ctx, bankrollID, userID, filter)
+	if err != nil {
+		s.logger.Error("failed to list goals", "error", err, "user_id", userID, "bankroll_id", bankrollID)
+		return nil, err
+	}
+
+	goals, more := pagination.Trim(goals, params.Limit)
+	nextCursor := ""
+	if more {
+		nextCursor = pagination.EncodeCursor("id", false, "", goals[len(goals)-1].ID)
+	}
+
+	loc := bankroll.Location()
+	now := time.Now()
+	rate, err := s.profitRate(ctx, bankrollID, now)
+	if err != nil {
+		return nil, err
+	}
+
+	outputs := make([]*GoalOutput, len(goals))
+	for i, goal := range goals {
+		outputs[i] = toGoalOutput(bankroll, goal, rate, now, loc)
+	}
+
+	s.logger.Info("goals listed", "user_id", userID, "bankroll_id", bankrollID, "count", len(outputs), "total", total)
+
+	return pagination.NewPage(outputs, total, params, nextCursor), nil
+}
+
+func (s *goalService) GetGoal(ctx context.Context, userID uint, bankrollID uint, goalID uint) (*GoalOutput, error) {
+	bankroll, err := s.bankrollRepo.FindByID(ctx, bankrollID, userID)
+	if err != nil {
+		s.logger.Error("bankroll not found", "error", err, "user_id", userID, "bankroll_id", bankrollID)
+		return nil, err
+	}
+
+	goal, err := s.repo.FindByID(ctx, goalID, bankrollID, userID)
+	if err != nil {
+		s.logger.Error("goal not found", "error", err, "user_id", userID, "bankroll_id", bankrollID, "goal_id", goalID)
+		return nil, err
+	}
+
+	loc := bankroll.Location()
+	now := time.Now()
+	rate, err := s.profitRate(ctx, bankrollID, now)
+	if err != nil {
+		return nil, err
+	}
+
+	return toGoalOutput(bankroll, goal, rate, now, loc), nil
+}
+
+func (s *goalService) DeleteGoal(ctx context.Context, userID uint, bankrollID uint, goalID uint) error {
+	if _, err := s.bankrollRepo.FindByID(ctx, bankrollID, userID); err != nil {
+		s.logger.Error("bankroll not found", "error", err, "user_id", userID, "bankroll_id", bankrollID)
+		return err
+	}
+
+	if err := s.repo.Delete(ctx, goalID, bankrollID, userID); err != nil {
+		s.logger.Error("failed to delete goal", "error", err, "user_id", userID, "goal_id", goalID)
+		return err
+	}
+
+	s.logger.Info("goal deleted", "user_id", userID, "bankroll_id", bankrollID, "goal_id", goalID)
+
+	return nil
+}
+
+func (s *goalService) ListMilestones(ctx context.Context, userID uint, bankrollID uint, input ListMilestonesInput) (*GoalMilestonePageOutput, error) {
+	params, err := input.Params.Normalize()
+	if err != nil {
+		s.logger.Error("invalid list parameters", "error", err, "user_id", userID)
+		return nil, WrapError(ErrValidationFailed, err.Error())
+	}
+
+	filter := MilestoneListFilter{
+		Limit:  params.Limit + 1,
+		Offset: params.Offset,
+	}
+	if params.Cursor != "" {
+		cursor, err := pagination.DecodeCursor(params.Cursor, "reached_at", true)
+		if err == nil {
+			var reachedAt time.Time
+			reachedAt, err = time.Parse(time.RFC3339Nano, cursor.Value)
+			filter.After = &BankrollCursor{Value: reachedAt, ID: cursor.ID}
+		}
+		if err != nil {
+			s.logger.Error("invalid cursor", "error", err, "user_id", userID)
+			return nil, WrapError(ErrValidationFailed, pagination.ErrInvalidCursor.Error())
+		}
+	}
+
+	if _, err := s.bankrollRepo.FindByID(ctx, bankrollID, userID); err != nil {
+		s.logger.Error("bankroll not found", "error", err, "user_id", userID, "bankroll_id", bankrollID)
+		return nil, err
+	}
+
+	milestones, total, err := s.repo.ListMilestones(ctx, bankrollID, userID, filter)
+	if err != nil {
+		s.logger.Error("failed to list goal milestones", "error", err, "user_id", userID, "bankroll_id", bankrollID)
+		return nil, err
+	}
+
+	milestones, more := pagination.Trim(milestones, params.Limit)
+	nextCursor := ""
+	if more {
+		last := milestones[len(milestones)-1]
+		nextCursor = pagination.EncodeCursor("reached_at", true, last.ReachedAt.UTC().Format(time.RFC3339Nano), last.ID)
+	}
+
+	outputs := make([]*GoalMilestoneOutput, len(milestones))
+	for i, milestone := range milestones {
+		outputs[i] = &GoalMilestoneOutput{
+			ID:            milestone.ID,
+			GoalID:        milestone.GoalID,
+			BankrollID:    milestone.BankrollID,
+			GoalName:      milestone.GoalName,
+			GoalType:      milestone.GoalType,
+			Target:        milestone.Target,
+			Progress:      milestone.Progress,
+			TransactionID: milestone.TransactionID,
+			ReachedAt:     milestone.ReachedAt,
+		}
+	}
+
+	s.logger.Info("goal milestones listed", "user_id", userID, "bankroll_id", bankrollID, "count", len(outputs), "total", total)
+
+	return pagination.NewPage(outputs, total, params, nextCursor), nil
+}
+
+// profitRate is the bankroll's average daily result over the recent window.
+func (s *goalService) profitRate(ctx context.Context, bankrollID uint, now time.Time) (domain.Decimal, error) {
+	result, err := s.repo.RealizedResult(ctx, bankrollID, now.AddDate(0, 0, -goalRateWindow))
+	if err != nil {
+		s.logger.Error("failed to compute profit rate", "error", err, "bankroll_id", bankrollID)
+		return domain.Zero, err
+	}
+	return result.Div(domain.NewDecimalFromInt(goalRateWindow)), nil
+}
+
+// applyGoalInput validates the input against the bankroll and sets the goal's
+// target, recurrence and deadline.
+func applyGoalInput(bankroll *Bankroll, goal *BankrollGoal, input GoalInput, now time.Time, loc *time.Location) error {
+	if !input.Type.IsValid() {
+		return ErrInvalidGoalType
+	}
+	if goal.Name == "" {
+		return WrapError(ErrInvalidGoal, "name is required")
+	}
+
+	switch {
+	case input.TargetPercent != nil && input.Target != nil:
+		return WrapError(ErrInvalidGoal, "target and target_percent are exclusive")
+	case input.TargetPercent != nil:
+		if input.Type != GoalTypeCashOut {
+			return WrapError(ErrInvalidGoal, "target_percent is only allowed on cash_out goals")
+		}
+		percent := *input.TargetPercent
+		if !percent.IsPositive() || percent.GreaterThan(hundred) || !percent.FitsScale(2) {
+			return WrapError(ErrInvalidGoal, "target_percent must be above 0 and at most 100")
+		}
+		goal.TargetPercent = &percent
+		goal.Target = bankroll.Currency.Round(bankroll.CurrentBalance.Mul(percent).Div(hundred))
+	case input.Target != nil:
+		if !bankroll.Currency.Fits(*input.Target) {
+			return ErrInvalidPrecision
+		}
+		goal.Target = *input.Target
+	default:
+		return WrapError(ErrInvalidGoal, "target is required")
+	}
+	if !goal.Target.IsPositive() {
+		return WrapError(ErrInvalidGoal, "target must be positive")
+	}
+	if input.Type == GoalTypeBalance && !goal.Target.GreaterThan(bankroll.CurrentBalance) {
+		return WrapError(ErrInvalidGoal, "target must be above the current balance")
+	}
+
+	if input.Recurrence != "" {
+		if !input.Recurrence.IsValid() {
+			return WrapError(ErrInvalidGoal, "recurrence must be weekly or monthly")
+		}
+		if input.Type == GoalTypeBalance {
+			return WrapError(ErrInvalidGoal, "balance goals cannot recur")
+		}
+		start := input.Recurrence.Start(now, loc)
+		goal.Recurrence = input.Recurrence
+		goal.PeriodStart = &start
+	}
+
+	if input.Deadline != "" {
+		deadline, err := parseDate(input.Deadline)
+		if err != nil {
+			return WrapError(ErrInvalidGoal, "deadline must be a YYYY-MM-DD date")
+		}
+		goal.Deadline = &deadline
+		if !goal.isOpen(now, loc) {
+			return WrapError(ErrInvalidGoal, "deadline has already passed")
+		}
+	}
+	return nil
+}
+
+// toGoalOutput reports the goal as of now. A recurring goal whose period has
+// ended without any entry to start it over is shown as the new period would
+// open.
+func toGoalOutput(bankroll *Bankroll, goal *BankrollGoal, rate domain.Decimal, now time.Time, loc *time.Location) *GoalOutput {
+	currency := bankroll.Currency
+	goal.roll(now, loc, bankroll.CurrentBalance, currency)
+	progress := currency.Round(goal.Progress(bankroll.CurrentBalance))
+	remaining := goal.Target.Sub(progress)
+	if remaining.IsNegative() || goal.ReachedAt != nil {
+		remaining = domain.Zero
+	}
+
+	output := &GoalOutput{
+		ID:              goal.ID,
+		BankrollID:      goal.BankrollID,
+		Name:            goal.Name,
+		Type:            goal.Type,
+		Status:          goal.Status(now, loc),
+		Target:          goal.Target,
+		TargetPercent:   goal.TargetPercent,
+		Recurrence:      goal.Recurrence,
+		PeriodStart:     goal.PeriodStart,
+		PeriodEnd:       goal.PeriodEnd(),
+		StartBalance:    goal.StartBalance,
+		Progress:        progress,
+		ProgressPercent: goalProgressPercent(goal, progress),
+		Remaining:       remaining,
+		DailyProfitRate: currency.Round(rate),
+		ReachedAt:       goal.ReachedAt,
+		CreatedAt:       goal.CreatedAt,
+		UpdatedAt:       goal.UpdatedAt,
+	}
+	if goal.Deadline != nil {
+		deadline := goal.Deadline.Format("2006-01-02")
+		output.Deadline = &deadline
+	}
+	if output.Status != GoalStatusActive {
+		return output
+	}
+
+	due := goal.Deadline != nil || output.PeriodEnd != nil
+	if rate.IsPositive() {
+		days := int(math.Ceil(remaining.Float64() / rate.Float64()))
+		projected := now.In(loc).AddDate(0, 0, days)
+		date := projected.Format("2006-01-02")
+		output.ProjectedCompletion = &date
+		if due {
+			onTrack := goal.isOpen(projected, loc) && (output.PeriodEnd == nil || projected.Before(*output.PeriodEnd))
+			output.OnTrack = &onTrack
+		}
+	} else if due {
+		onTrack := false
+		output.OnTrack = &onTrack
+	}
+	return output
+}
+
+// goalProgressPercent is the share of the goal covered so far, capped at
+// 100. Balance goals count from the balance they started at.
+func goalProgressPercent(goal *BankrollGoal, progress domain.Decimal) domain.Decimal {
+	if goal.ReachedAt != nil {
+		return hundred.Round(2)
+	}
+	done, span := progress, goal.Target
+	if goal.Type == GoalTypeBalance {
+		done, span = progress.Sub(goal.StartBalance), goal.Target.Sub(goal.StartBalance)
+	}
+	if !span.IsPositive() || !done.IsPositive() {
+		return domain.Zero
+	}
+	percent := done.Div(span).Mul(hundred).Round(2)
+	if percent.GreaterThan(hundred) {
+		return hundred.Round(2)
+	}
+	return percent
+}
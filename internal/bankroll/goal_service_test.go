@@ -0,0 +1,269 @@
+package bankroll
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"log/slog"
+
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
+	"github.com/opinedajr/micro-stakes-api/internal/shared/pagination"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type MockGoalRepository struct {
+	mock.Mock
+}
+
+func (m *MockGoalRepository) Create(ctx context.Context, goal *BankrollGoal) error {
+	args := m.Called(ctx, goal)
+	return args.Error(0)
+}
+
+func (m *MockGoalRepository) FindByID(ctx context.Context, id uint, bankrollID uint, userID uint) (*BankrollGoal, error) {
+	args := m.Called(ctx, id, bankrollID, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*BankrollGoal), args.Error(1)
+}
+
+func (m *MockGoalRepository) ListByBankrollID(ctx context.Context, bankrollID uint, userID uint, filter GoalListFilter) ([]*BankrollGoal, int64, error) {
+	args := m.Called(ctx, bankrollID, userID, filter)
+	if args.Get(0) == nil {
+		return nil, 0, args.Error(2)
+	}
+	return args.Get(0).([]*BankrollGoal), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockGoalRepository) Delete(ctx context.Context, id uint, bankrollID uint, userID uint) error {
+	args := m.Called(ctx, id, bankrollID, userID)
+	return args.Error(0)
+}
+
+func (m *MockGoalRepository) ListMilestones(ctx context.Context, bankrollID uint, userID uint, filter MilestoneListFilter) ([]*GoalMilestone, int64, error) {
+	args := m.Called(ctx, bankrollID, userID, filter)
+	if args.Get(0) == nil {
+		return nil, 0, args.Error(2)
+	}
+	return args.Get(0).([]*GoalMilestone), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockGoalRepository) RealizedResult(ctx context.Context, bankrollID uint, since time.Time) (domain.Decimal, error) {
+	args := m.Called(ctx, bankrollID, since)
+	return args.Get(0).(domain.Decimal), args.Error(1)
+}
+
+func newGoalServiceForTest() (GoalService, *MockGoalRepository, *MockBankrollRepository) {
+	repo := new(MockGoalRepository)
+	bankrollRepo := new(MockBankrollRepository)
+	return NewGoalService(repo, bankrollRepo, slog.Default()), repo, bankrollRepo
+}
+
+func TestCreateGoal(t *testing.T) {
+	t.Run("balance goal with projection", func(t *testing.T) {
+		service, repo, bankrollRepo := newGoalServiceForTest()
+
+		bankrollRepo.On("FindByID", mock.Anything, uint(1), uint(1)).Return(ruleTestBankroll(), nil).Once()
+		repo.On("Create", mock.Anything, mock.MatchedBy(func(goal *BankrollGoal) bool {
+			return goal.Type == GoalTypeBalance && goal.Name == "Ten grand" && goal.StartBalance.String() == "900.00"
+		})).Return(nil).Once()
+		repo.On("RealizedResult", mock.Anything, uint(1), mock.Anything).Return(domain.MustParseDecimal("300.00"), nil).Once()
+
+		deadline := time.Now().UTC().AddDate(0, 0, 5).Format("2006-01-02")
+		output, err := service.CreateGoal(context.Background(), 1, 1, GoalInput{
+			Name:     " Ten grand ",
+			Type:     GoalTypeBalance,
+			Target:   decimalPtr("1000.00"),
+			Deadline: deadline,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, GoalStatusActive, output.Status)
+		assert.Equal(t, "900.00", output.Progress.String())
+		assert.Equal(t, "0", output.ProgressPercent.String())
+		assert.Equal(t, "100.00", output.Remaining.String())
+		assert.Equal(t, "10.00", output.DailyProfitRate.String())
+		require.NotNil(t, output.ProjectedCompletion)
+		assert.Equal(t, time.Now().UTC().AddDate(0, 0, 10).Format("2006-01-02"), *output.ProjectedCompletion)
+		require.NotNil(t, output.OnTrack)
+		assert.False(t, *output.OnTrack)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("cash out goal as a share of the balance", func(t *testing.T) {
+		service, repo, bankrollRepo := newGoalServiceForTest()
+
+		bankrollRepo.On("FindByID", mock.Anything, uint(1), uint(1)).Return(ruleTestBankroll(), nil).Once()
+		repo.On("Create", mock.Anything, mock.Anything).Return(nil).Once()
+		repo.On("RealizedResult", mock.Anything, uint(1), mock.Anything).Return(domain.MustParseDecimal("-30.00"), nil).Once()
+
+		output, err := service.CreateGoal(context.Background(), 1, 1, GoalInput{
+			Name:          "Monthly cash out",
+			Type:          GoalTypeCashOut,
+			TargetPercent: decimalPtr("20"),
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "180.00", output.Target.String())
+		assert.Equal(t, "0.00", output.Progress.String())
+		assert.Nil(t, output.ProjectedCompletion)
+		assert.Nil(t, output.OnTrack)
+	})
+
+	t.Run("monthly cash out goal", func(t *testing.T) {
+		repo := new(MockGoalRepository)
+		bankrollRepo := new(MockBankrollRepository)
+		service := NewGoalService(repo, bankrollRepo, slog.Default())
+
+		saoPaulo, err := time.LoadLocation("America/Sao_Paulo")
+		require.NoError(t, err)
+		bankroll := ruleTestBankroll()
+		bankroll.Timezone = "America/Sao_Paulo"
+		bankrollRepo.On("FindByID", mock.Anything, uint(1), uint(1)).Return(bankroll, nil).Once()
+		repo.On("Create", mock.Anything, mock.MatchedBy(func(goal *BankrollGoal) bool {
+			return goal.Recurrence == GoalRecurrenceMonthly && goal.PeriodStart != nil &&
+				goal.PeriodStart.Equal(GoalRecurrenceMonthly.Start(time.Now(), saoPaulo))
+		})).Return(nil).Once()
+		repo.On("RealizedResult", mock.Anything, uint(1), mock.Anything).Return(domain.Zero, nil).Once()
+
+		output, err := service.CreateGoal(context.Background(), 1, 1, GoalInput{
+			Name:          "Monthly cash out",
+			Type:          GoalTypeCashOut,
+			TargetPercent: decimalPtr("20"),
+			Recurrence:    GoalRecurrenceMonthly,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "180.00", output.Target.String())
+		assert.Equal(t, GoalRecurrenceMonthly, output.Recurrence)
+		require.NotNil(t, output.PeriodEnd)
+		assert.True(t, output.PeriodEnd.Equal(output.PeriodStart.AddDate(0, 1, 0)))
+		require.NotNil(t, output.OnTrack)
+		assert.False(t, *output.OnTrack)
+		repo.AssertExpectations(t)
+	})
+
+	tests := []struct {
+		name  string
+		input GoalInput
+		err   error
+	}{
+		{"recurring balance goal", GoalInput{Name: "x", Type: GoalTypeBalance, Target: decimalPtr("1000.00"), Recurrence: GoalRecurrenceMonthly}, ErrInvalidGoal},
+		{"invalid recurrence", GoalInput{Name: "x", Type: GoalTypeCashOut, Target: decimalPtr("10"), Recurrence: "daily"}, ErrInvalidGoal},
+		{"invalid type", GoalInput{Name: "x", Type: "savings", Target: decimalPtr("10")}, ErrInvalidGoalType},
+		{"missing target", GoalInput{Name: "x", Type: GoalTypeProfit}, ErrInvalidGoal},
+		{"percent on profit goal", GoalInput{Name: "x", Type: GoalTypeProfit, TargetPercent: decimalPtr("10")}, ErrInvalidGoal},
+		{"balance already above target", GoalInput{Name: "x", Type: GoalTypeBalance, Target: decimalPtr("900.00")}, ErrInvalidGoal},
+		{"deadline passed", GoalInput{Name: "x", Type: GoalTypeProfit, Target: decimalPtr("10"), Deadline: "2020-01-01"}, ErrInvalidGoal},
+		{"bad deadline", GoalInput{Name: "x", Type: GoalTypeProfit, Target: decimalPtr("10"), Deadline: "December"}, ErrInvalidGoal},
+		{"too many decimals", GoalInput{Name: "x", Type: GoalTypeProfit, Target: decimalPtr("10.001")}, ErrInvalidPrecision},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, repo, bankrollRepo := newGoalServiceForTest()
+
+			bankrollRepo.On("FindByID", mock.Anything, uint(1), uint(1)).Return(ruleTestBankroll(), nil).Once()
+
+			_, err := service.CreateGoal(context.Background(), 1, 1, tt.input)
+
+			assert.ErrorIs(t, err, tt.err)
+			repo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestListGoals(t *testing.T) {
+	service, repo, bankrollRepo := newGoalServiceForTest()
+
+	reachedAt := time.Date(2026, 3, 2, 12, 0, 0, 0, time.UTC)
+	expired := time.Date(2026, 1, 31, 0, 0, 0, 0, time.UTC)
+	bankrollRepo.On("FindByID", mock.Anything, uint(1), uint(1)).Return(ruleTestBankroll(), nil).Once()
+	repo.On("ListByBankrollID", mock.Anything, uint(1), uint(1), GoalListFilter{Limit: pagination.DefaultLimit + 1}).Return([]*BankrollGoal{
+		{ID: 1, Type: GoalTypeBalance, Target: domain.MustParseDecimal("1000.00"), StartBalance: domain.MustParseDecimal("800.00")},
+		{ID: 2, Type: GoalTypeProfit, Target: domain.MustParseDecimal("50.00"), Accrued: domain.MustParseDecimal("75.00"), ReachedAt: &reachedAt},
+		{ID: 3, Type: GoalTypeCashOut, Target: domain.MustParseDecimal("200.00"), Accrued: domain.MustParseDecimal("50.00"), Deadline: &expired},
+	}, int64(3), nil).Once()
+	repo.On("RealizedResult", mock.Anything, uint(1), mock.Anything).Return(domain.MustParseDecimal("60.00"), nil).Once()
+
+	page, err := service.ListGoals(context.Background(), 1, 1, ListGoalsInput{})
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), page.Total)
+	assert.Empty(t, page.NextCursor)
+	outputs := page.Items
+	require.Len(t, outputs, 3)
+	assert.Equal(t, "50.00", outputs[0].ProgressPercent.String(), "balance goals count from their starting balance")
+	require.NotNil(t, outputs[0].ProjectedCompletion)
+	assert.Equal(t, time.Now().UTC().AddDate(0, 0, 50).Format("2006-01-02"), *outputs[0].ProjectedCompletion)
+	assert.Nil(t, outputs[0].OnTrack)
+
+	assert.Equal(t, GoalStatusReached, outputs[1].Status)
+	assert.Equal(t, "100.00", outputs[1].ProgressPercent.String())
+	assert.Equal(t, "0", outputs[1].Remaining.String())
+	assert.Nil(t, outputs[1].ProjectedCompletion)
+
+	assert.Equal(t, GoalStatusMissed, outputs[2].Status)
+	assert.Equal(t, "25.00", outputs[2].ProgressPercent.String())
+	assert.Nil(t, outputs[2].ProjectedCompletion)
+	require.NotNil(t, outputs[2].Deadline)
+	assert.Equal(t, "2026-01-31", *outputs[2].Deadline)
+}
+
+func TestListGoalsStartsRecurringGoalsOver(t *testing.T) {
+	service, repo, bankrollRepo := newGoalServiceForTest()
+
+	lastMonth := GoalRecurrenceMonthly.Start(time.Now(), time.UTC).AddDate(0, -1, 0)
+	reachedAt := lastMonth.AddDate(0, 0, 3)
+	bankrollRepo.On("FindByID", mock.Anything, uint(1), uint(1)).Return(ruleTestBankroll(), nil).Once()
+	repo.On("ListByBankrollID", mock.Anything, uint(1), uint(1), mock.Anything).Return([]*BankrollGoal{{
+		ID:            1,
+		Type:          GoalTypeCashOut,
+		Target:        domain.MustParseDecimal("250.00"),
+		TargetPercent: decimalPtr("20"),
+		Recurrence:    GoalRecurrenceMonthly,
+		PeriodStart:   &lastMonth,
+		StartBalance:  domain.MustParseDecimal("1250.00"),
+		Accrued:       domain.MustParseDecimal("250.00"),
+		ReachedAt:     &reachedAt,
+	}}, int64(1), nil).Once()
+	repo.On("RealizedResult", mock.Anything, uint(1), mock.Anything).Return(domain.Zero, nil).Once()
+
+	page, err := service.ListGoals(context.Background(), 1, 1, ListGoalsInput{})
+
+	require.NoError(t, err)
+	outputs := page.Items
+	require.Len(t, outputs, 1)
+	assert.Equal(t, GoalStatusActive, outputs[0].Status)
+	assert.Equal(t, "180.00", outputs[0].Target.String(), "the target follows the balance the period opens with")
+	assert.Equal(t, "0.00", outputs[0].Progress.String())
+	assert.Nil(t, outputs[0].ReachedAt)
+	assert.True(t, outputs[0].PeriodStart.Equal(lastMonth.AddDate(0, 1, 0)))
+}
+
+func TestBankrollGoalDeadlineInBankrollTimeZone(t *testing.T) {
+	saoPaulo, err := time.LoadLocation("America/Sao_Paulo")
+	require.NoError(t, err)
+	deadline := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	goal := &BankrollGoal{Deadline: &deadline}
+	lateEvening := time.Date(2026, 3, 2, 1, 0, 0, 0, time.UTC)
+
+	assert.False(t, goal.isOpen(lateEvening, time.UTC))
+	assert.True(t, goal.isOpen(lateEvening, saoPaulo), "it is still March 1st in São Paulo")
+	assert.Equal(t, GoalStatusActive, goal.Status(lateEvening, saoPaulo))
+	assert.Equal(t, GoalStatusMissed, goal.Status(lateEvening.Add(3*time.Hour), saoPaulo))
+}
+
+func TestDeleteGoal(t *testing.T) {
+	service, repo, bankrollRepo := newGoalServiceForTest()
+
+	bankrollRepo.On("FindByID", mock.Anything, uint(1), uint(1)).Return(ruleTestBankroll(), nil).Once()
+	repo.On("Delete", mock.Anything, uint(9), uint(1), uint(1)).Return(ErrGoalNotFound).Once()
+
+	err := service.DeleteGoal(context.Background(), 1, 1, 9)
+
+	assert.ErrorIs(t, err, ErrGoalNotFound)
+}
@@ -2,8 +2,10 @@ package bankroll
 
 import (
 	"errors"
+	"fmt"
 	"net/http"
 	"strconv"
+	"strings"
 
 	"log/slog"
 
@@ -34,31 +36,43 @@ func (h *BankrollHandler) CreateBankroll(c *gin.Context) {
 		return
 	}
 
-	userID, err := h.getUserID(c)
+	userID, err := getUserID(c)
 	if err != nil {
-		h.handleError(c, err)
+		handleError(c, h.logger, err)
 		return
 	}
 
 	output, err := h.service.CreateBankroll(c.Request.Context(), userID, input)
 	if err != nil {
-		h.handleError(c, err)
+		handleError(c, h.logger, err)
 		return
 	}
 
+	setETag(c, output.Version)
 	c.JSON(http.StatusCreated, output)
 }
 
 func (h *BankrollHandler) ListBankrolls(c *gin.Context) {
-	userID, err := h.getUserID(c)
+	var input ListBankrollsInput
+	if err := c.ShouldBindQuery(&input); err != nil {
+		h.logger.Error("invalid query parameters", "error", err)
+		c.JSON(http.StatusBadRequest, ErrorOutput{
+			Error:   "Invalid query parameters",
+			Code:    "VALIDATION_ERROR",
+			Details: nil,
+		})
+		return
+	}
+
+	userID, err := getUserID(c)
 	if err != nil {
-		h.handleError(c, err)
+		handleError(c, h.logger, err)
 		return
 	}
 
-	outputs, err := h.service.ListBankrolls(c.Request.Context(), userID)
+	outputs, err := h.service.ListBankrolls(c.Request.Context(), userID, input)
 	if err != nil {
-		h.handleError(c, err)
+		handleError(c, h.logger, err)
 		return
 	}
 
@@ -66,25 +80,26 @@ func (h *BankrollHandler) ListBankrolls(c *gin.Context) {
 }
 
 func (h *BankrollHandler) GetBankroll(c *gin.Context) {
-	userID, err := h.getUserID(c)
+	userID, err := getUserID(c)
 	if err != nil {
-		h.handleError(c, err)
+		handleError(c, h.logger, err)
 		return
 	}
 
 	bankrollIDStr := c.Param("bankrollId")
 	bankrollID, err := strconv.ParseUint(bankrollIDStr, 10, 32)
 	if err != nil {
-		h.handleError(c, ErrUnauthorized)
+		handleError(c, h.logger, ErrUnauthorized)
 		return
 	}
 
 	output, err := h.service.GetBankroll(c.Request.Context(), userID, uint(bankrollID))
 	if err != nil {
-		h.handleError(c, err)
+		handleError(c, h.logger, err)
 		return
 	}
 
+	setETag(c, output.Version)
 	c.JSON(http.StatusOK, output)
 }
 
@@ -102,52 +117,204 @@ func (h *BankrollHandler) UpdateBankroll(c *gin.Context) {
 		return
 	}
 
-	userID, err := h.getUserID(c)
+	userID, err := getUserID(c)
 	if err != nil {
-		h.handleError(c, err)
+		handleError(c, h.logger, err)
 		return
 	}
 
 	bankrollIDStr := c.Param("bankrollId")
 	bankrollID, err := strconv.ParseUint(bankrollIDStr, 10, 32)
 	if err != nil {
-		h.handleError(c, ErrUnauthorized)
+		handleError(c, h.logger, ErrUnauthorized)
+		return
+	}
+
+	input.ExpectedVersion, err = ifMatchVersion(c)
+	if err != nil {
+		handleError(c, h.logger, err)
 		return
 	}
 
 	output, err := h.service.UpdateBankroll(c.Request.Context(), userID, uint(bankrollID), input)
 	if err != nil {
-		h.handleError(c, err)
+		handleError(c, h.logger, err)
 		return
 	}
 
+	setETag(c, output.Version)
+	c.JSON(http.StatusOK, output)
+}
+
+func (h *BankrollHandler) PatchBankroll(c *gin.Context) {
+	if contentType := c.ContentType(); contentType != "application/merge-patch+json" && contentType != "application/json" {
+		c.JSON(http.StatusUnsupportedMediaType, ErrorOutput{
+			Error: "Content-Type must be application/merge-patch+json",
+			Code:  "UNSUPPORTED_MEDIA_TYPE",
+		})
+		return
+	}
+
+	data, err := c.GetRawData()
+	if err != nil {
+		h.logger.Error("invalid request body", "error", err)
+		c.JSON(http.StatusBadRequest, ErrorOutput{
+			Error:   "Invalid request body",
+			Code:    "VALIDATION_ERROR",
+			Details: nil,
+		})
+		return
+	}
+
+	userID, err := getUserID(c)
+	if err != nil {
+		handleError(c, h.logger, err)
+		return
+	}
+
+	bankrollIDStr := c.Param("bankrollId")
+	bankrollID, err := strconv.ParseUint(bankrollIDStr, 10, 32)
+	if err != nil {
+		handleError(c, h.logger, ErrUnauthorized)
+		return
+	}
+
+	input, err := ParseBankrollPatch(data)
+	if err != nil {
+		handleError(c, h.logger, err)
+		return
+	}
+
+	input.ExpectedVersion, err = ifMatchVersion(c)
+	if err != nil {
+		handleError(c, h.logger, err)
+		return
+	}
+
+	output, err := h.service.PatchBankroll(c.Request.Context(), userID, uint(bankrollID), input)
+	if err != nil {
+		handleError(c, h.logger, err)
+		return
+	}
+
+	setETag(c, output.Version)
 	c.JSON(http.StatusOK, output)
 }
 
 func (h *BankrollHandler) ResetBankroll(c *gin.Context) {
-	userID, err := h.getUserID(c)
+	var input ResetBankrollInput
+	if c.Request.Body != nil && c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&input); err != nil {
+			h.logger.Error("invalid request body", "error", err)
+			c.JSON(http.StatusBadRequest, ErrorOutput{
+				Error:   "Invalid request body",
+				Code:    "VALIDATION_ERROR",
+				Details: nil,
+			})
+			return
+		}
+	}
+
+	userID, err := getUserID(c)
+	if err != nil {
+		handleError(c, h.logger, err)
+		return
+	}
+
+	bankrollIDStr := c.Param("bankrollId")
+	bankrollID, err := strconv.ParseUint(bankrollIDStr, 10, 32)
+	if err != nil {
+		handleError(c, h.logger, ErrUnauthorized)
+		return
+	}
+
+	input.ExpectedVersion, err = ifMatchVersion(c)
+	if err != nil {
+		handleError(c, h.logger, err)
+		return
+	}
+
+	output, err := h.service.ResetBankroll(c.Request.Context(), userID, uint(bankrollID), input)
+	if err != nil {
+		handleError(c, h.logger, err)
+		return
+	}
+
+	setETag(c, output.Version)
+	c.JSON(http.StatusOK, output)
+}
+
+func (h *BankrollHandler) DeleteBankroll(c *gin.Context) {
+	userID, err := getUserID(c)
+	if err != nil {
+		handleError(c, h.logger, err)
+		return
+	}
+
+	bankrollIDStr := c.Param("bankrollId")
+	bankrollID, err := strconv.ParseUint(bankrollIDStr, 10, 32)
+	if err != nil {
+		handleError(c, h.logger, ErrUnauthorized)
+		return
+	}
+
+	if err := h.service.DeleteBankroll(c.Request.Context(), userID, uint(bankrollID)); err != nil {
+		handleError(c, h.logger, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (h *BankrollHandler) ArchiveBankroll(c *gin.Context) {
+	userID, err := getUserID(c)
+	if err != nil {
+		handleError(c, h.logger, err)
+		return
+	}
+
+	bankrollIDStr := c.Param("bankrollId")
+	bankrollID, err := strconv.ParseUint(bankrollIDStr, 10, 32)
+	if err != nil {
+		handleError(c, h.logger, ErrUnauthorized)
+		return
+	}
+
+	output, err := h.service.ArchiveBankroll(c.Request.Context(), userID, uint(bankrollID))
+	if err != nil {
+		handleError(c, h.logger, err)
+		return
+	}
+
+	setETag(c, output.Version)
+	c.JSON(http.StatusOK, output)
+}
+
+func (h *BankrollHandler) RestoreBankroll(c *gin.Context) {
+	userID, err := getUserID(c)
 	if err != nil {
-		h.handleError(c, err)
+		handleError(c, h.logger, err)
 		return
 	}
 
 	bankrollIDStr := c.Param("bankrollId")
 	bankrollID, err := strconv.ParseUint(bankrollIDStr, 10, 32)
 	if err != nil {
-		h.handleError(c, ErrUnauthorized)
+		handleError(c, h.logger, ErrUnauthorized)
 		return
 	}
 
-	output, err := h.service.ResetBankroll(c.Request.Context(), userID, uint(bankrollID))
+	output, err := h.service.RestoreBankroll(c.Request.Context(), userID, uint(bankrollID))
 	if err != nil {
-		h.handleError(c, err)
+		handleError(c, h.logger, err)
 		return
 	}
 
+	setETag(c, output.Version)
 	c.JSON(http.StatusOK, output)
 }
 
-func (h *BankrollHandler) handleError(c *gin.Context, err error) {
+func handleError(c *gin.Context, logger *slog.Logger, err error) {
 	switch {
 	case errors.Is(err, ErrBankrollNotFound):
 		c.JSON(http.StatusNotFound, ErrorOutput{
@@ -165,7 +332,7 @@ func (h *BankrollHandler) handleError(c *gin.Context, err error) {
 			Code:  "VALIDATION_ERROR",
 		})
 	case errors.Is(err, ErrDatabaseError):
-		h.logger.Error("database error", "error", err)
+		logger.Error("database error", "error", err)
 		c.JSON(http.StatusInternalServerError, ErrorOutput{
 			Error: "Database error occurred",
 			Code:  "DATABASE_ERROR",
@@ -195,8 +362,148 @@ func (h *BankrollHandler) handleError(c *gin.Context, err error) {
 			Error: "Cannot modify initial or current balance on update",
 			Code:  "CANNOT_MODIFY_BALANCE",
 		})
+	case errors.Is(err, ErrInvalidPrecision):
+		c.JSON(http.StatusBadRequest, ErrorOutput{
+			Error: "Amount exceeds currency precision",
+			Code:  "INVALID_PRECISION",
+		})
+	case errors.Is(err, ErrBankrollArchived):
+		c.JSON(http.StatusConflict, ErrorOutput{
+			Error: "Bankroll is archived",
+			Code:  "BANKROLL_ARCHIVED",
+		})
+	case errors.Is(err, ErrBankrollNotArchived):
+		c.JSON(http.StatusConflict, ErrorOutput{
+			Error: "Bankroll is not archived or deleted",
+			Code:  "BANKROLL_NOT_ARCHIVED",
+		})
+	case errors.Is(err, ErrBankrollInPlay):
+		c.JSON(http.StatusConflict, ErrorOutput{
+			Error: "Bankroll has money in play",
+			Code:  "BANKROLL_IN_PLAY",
+		})
+	case errors.Is(err, ErrVersionConflict):
+		c.JSON(http.StatusPreconditionFailed, ErrorOutput{
+			Error: "Bankroll was modified by another request",
+			Code:  "VERSION_CONFLICT",
+		})
+	case errors.Is(err, ErrInsufficientFunds):
+		c.JSON(http.StatusUnprocessableEntity, ErrorOutput{
+			Error: "Insufficient funds in bankroll",
+			Code:  "INSUFFICIENT_FUNDS",
+		})
+	case errors.Is(err, ErrInvalidTransactionType):
+		c.JSON(http.StatusBadRequest, ErrorOutput{
+			Error: "Invalid transaction type",
+			Code:  "INVALID_TRANSACTION_TYPE",
+		})
+	case errors.Is(err, ErrInvalidAmount):
+		c.JSON(http.StatusBadRequest, ErrorOutput{
+			Error: "Invalid transaction amount",
+			Code:  "INVALID_AMOUNT",
+		})
+	case errors.Is(err, ErrSameBankrollTransfer):
+		c.JSON(http.StatusBadRequest, ErrorOutput{
+			Error: "Cannot transfer to the same bankroll",
+			Code:  "SAME_BANKROLL_TRANSFER",
+		})
+	case errors.Is(err, ErrInvalidExchangeRate):
+		c.JSON(http.StatusBadRequest, ErrorOutput{
+			Error: "Invalid exchange rate",
+			Code:  "INVALID_EXCHANGE_RATE",
+		})
+	case errors.Is(err, ErrExchangeRateNotFound):
+		c.JSON(http.StatusUnprocessableEntity, ErrorOutput{
+			Error: "Exchange rate not found",
+			Code:  "EXCHANGE_RATE_NOT_FOUND",
+		})
+	case errors.Is(err, ErrInvalidInterval):
+		c.JSON(http.StatusBadRequest, ErrorOutput{
+			Error: "Invalid history interval",
+			Code:  "INVALID_INTERVAL",
+		})
+	case errors.Is(err, ErrRuleNotFound):
+		c.JSON(http.StatusNotFound, ErrorOutput{
+			Error: "Bankroll rule not found",
+			Code:  "RULE_NOT_FOUND",
+		})
+	case errors.Is(err, ErrInvalidRuleType):
+		c.JSON(http.StatusBadRequest, ErrorOutput{
+			Error: "Invalid rule type",
+			Code:  "INVALID_RULE_TYPE",
+		})
+	case errors.Is(err, ErrInvalidRuleSeverity):
+		c.JSON(http.StatusBadRequest, ErrorOutput{
+			Error: "Invalid rule severity",
+			Code:  "INVALID_RULE_SEVERITY",
+		})
+	case errors.Is(err, ErrInvalidRuleValue):
+		c.JSON(http.StatusBadRequest, ErrorOutput{
+			Error: "Invalid rule value",
+			Code:  "INVALID_RULE_VALUE",
+		})
+	case errors.Is(err, ErrLimitsNotFound):
+		c.JSON(http.StatusNotFound, ErrorOutput{
+			Error: "Bankroll limits not found",
+			Code:  "LIMITS_NOT_FOUND",
+		})
+	case errors.Is(err, ErrInvalidLimit):
+		c.JSON(http.StatusBadRequest, ErrorOutput{
+			Error: "Stop limits must be positive and at least one is required",
+			Code:  "INVALID_LIMIT",
+		})
+	case errors.Is(err, ErrInvalidTimezone):
+		c.JSON(http.StatusBadRequest, ErrorOutput{
+			Error: "Invalid timezone",
+			Code:  "INVALID_TIMEZONE",
+		})
+	case errors.Is(err, ErrInvalidGameType):
+		c.JSON(http.StatusBadRequest, ErrorOutput{
+			Error: "Invalid game type",
+			Code:  "INVALID_GAME_TYPE",
+		})
+	case errors.Is(err, ErrInvalidStakeLadder):
+		c.JSON(http.StatusBadRequest, ErrorOutput{
+			Error: "Stake levels must have unique names and increasing positive buy-ins",
+			Code:  "INVALID_STAKE_LADDER",
+		})
+	case errors.Is(err, ErrInvalidRiskParams):
+		c.JSON(http.StatusBadRequest, ErrorOutput{
+			Error: "Invalid risk simulation parameters",
+			Code:  "INVALID_RISK_PARAMS",
+		})
+	case errors.Is(err, ErrInsufficientRiskData):
+		c.JSON(http.StatusUnprocessableEntity, ErrorOutput{
+			Error: "Not enough settled results to estimate win rate and standard deviation",
+			Code:  "INSUFFICIENT_DATA",
+		})
+	case errors.Is(err, ErrGoalNotFound):
+		c.JSON(http.StatusNotFound, ErrorOutput{
+			Error: "Bankroll goal not found",
+			Code:  "GOAL_NOT_FOUND",
+		})
+	case errors.Is(err, ErrInvalidGoalType):
+		c.JSON(http.StatusBadRequest, ErrorOutput{
+			Error: "Invalid goal type",
+			Code:  "INVALID_GOAL_TYPE",
+		})
+	case errors.Is(err, ErrInvalidGoal):
+		c.JSON(http.StatusBadRequest, ErrorOutput{
+			Error: err.Error(),
+			Code:  "INVALID_GOAL",
+		})
+	case errors.Is(err, ErrRakebackDealNotFound):
+		c.JSON(http.StatusNotFound, ErrorOutput{
+			Error: "Rakeback deal not found",
+			Code:  "RAKEBACK_DEAL_NOT_FOUND",
+		})
+	case errors.Is(err, ErrInvalidRakebackDeal):
+		c.JSON(http.StatusBadRequest, ErrorOutput{
+			Error: err.Error(),
+			Code:  "INVALID_RAKEBACK_DEAL",
+		})
 	default:
-		h.logger.Error("unexpected error", "error", err)
+		logger.Error("unexpected error", "error", err)
 		c.JSON(http.StatusInternalServerError, ErrorOutput{
 			Error: "An unexpected error occurred",
 			Code:  "INTERNAL_ERROR",
@@ -204,7 +511,7 @@ func (h *BankrollHandler) handleError(c *gin.Context, err error) {
 	}
 }
 
-func (h *BankrollHandler) getUserID(c *gin.Context) (uint, error) {
+func getUserID(c *gin.Context) (uint, error) {
 	userIDStr, exists := c.Get("userID")
 	if !exists {
 		return 0, ErrUnauthorized
@@ -222,3 +529,24 @@ func (h *BankrollHandler) getUserID(c *gin.Context) (uint, error) {
 
 	return uint(parsedID), nil
 }
+
+// ifMatchVersion reads the bankroll version the client last saw from the
+// If-Match header. A missing header or "*" yields zero, meaning no
+// precondition.
+func ifMatchVersion(c *gin.Context) (uint, error) {
+	header := strings.TrimSpace(c.GetHeader("If-Match"))
+	if header == "" || header == "*" {
+		return 0, nil
+	}
+
+	tag := strings.Trim(strings.TrimPrefix(header, "W/"), `"`)
+	version, err := strconv.ParseUint(tag, 10, 32)
+	if err != nil || version == 0 {
+		return 0, WrapError(ErrValidationFailed, "invalid If-Match header")
+	}
+	return uint(version), nil
+}
+
+func setETag(c *gin.Context, version uint) {
+	c.Header("ETag", fmt.Sprintf(`"%d"`, version))
+}
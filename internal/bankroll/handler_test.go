@@ -6,17 +6,28 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"os"
 	"testing"
 	"time"
 
 	"log/slog"
 
 	"github.com/gin-gonic/gin"
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
+	"github.com/opinedajr/micro-stakes-api/internal/shared/pagination"
+	customValidator "github.com/opinedajr/micro-stakes-api/internal/shared/validator"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
+func TestMain(m *testing.M) {
+	if err := customValidator.RegisterBindingValidators(); err != nil {
+		panic(err)
+	}
+	os.Exit(m.Run())
+}
+
 type MockBankrollServiceForHandler struct {
 	mock.Mock
 }
@@ -37,12 +48,12 @@ func (m *MockBankrollServiceForHandler) UpdateBankroll(ctx context.Context, user
 	return args.Get(0).(*BankrollOutput), args.Error(1)
 }
 
-func (m *MockBankrollServiceForHandler) ListBankrolls(ctx context.Context, userID uint) ([]*BankrollOutput, error) {
-	args := m.Called(ctx, userID)
+func (m *MockBankrollServiceForHandler) ListBankrolls(ctx context.Context, userID uint, input ListBankrollsInput) (*BankrollPageOutput, error) {
+	args := m.Called(ctx, userID, input)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
-	return args.Get(0).([]*BankrollOutput), args.Error(1)
+	return args.Get(0).(*BankrollPageOutput), args.Error(1)
 }
 
 func (m *MockBankrollServiceForHandler) GetBankroll(ctx context.Context, userID uint, bankrollID uint) (*BankrollOutput, error) {
@@ -53,7 +64,28 @@ func (m *MockBankrollServiceForHandler) GetBankroll(ctx context.Context, userID
 	return args.Get(0).(*BankrollOutput), args.Error(1)
 }
 
-func (m *MockBankrollServiceForHandler) ResetBankroll(ctx context.Context, userID uint, bankrollID uint) (*BankrollOutput, error) {
+func (m *MockBankrollServiceForHandler) ResetBankroll(ctx context.Context, userID uint, bankrollID uint, input ResetBankrollInput) (*BankrollOutput, error) {
+	args := m.Called(ctx, userID, bankrollID, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*BankrollOutput), args.Error(1)
+}
+
+func (m *MockBankrollServiceForHandler) DeleteBankroll(ctx context.Context, userID uint, bankrollID uint) error {
+	args := m.Called(ctx, userID, bankrollID)
+	return args.Error(0)
+}
+
+func (m *MockBankrollServiceForHandler) ArchiveBankroll(ctx context.Context, userID uint, bankrollID uint) (*BankrollOutput, error) {
+	args := m.Called(ctx, userID, bankrollID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*BankrollOutput), args.Error(1)
+}
+
+func (m *MockBankrollServiceForHandler) RestoreBankroll(ctx context.Context, userID uint, bankrollID uint) (*BankrollOutput, error) {
 	args := m.Called(ctx, userID, bankrollID)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -61,6 +93,14 @@ func (m *MockBankrollServiceForHandler) ResetBankroll(ctx context.Context, userI
 	return args.Get(0).(*BankrollOutput), args.Error(1)
 }
 
+func (m *MockBankrollServiceForHandler) PatchBankroll(ctx context.Context, userID uint, bankrollID uint, input PatchBankrollInput) (*BankrollOutput, error) {
+	args := m.Called(ctx, userID, bankrollID, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*BankrollOutput), args.Error(1)
+}
+
 func TestCreateBankrollHandler(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		mockService := new(MockBankrollServiceForHandler)
@@ -74,10 +114,10 @@ func TestCreateBankrollHandler(t *testing.T) {
 			ID:                   1,
 			Name:                 "Main Bankroll",
 			Currency:             CurrencyBRL,
-			InitialBalance:       1000.00,
-			CurrentBalance:       1000.00,
+			InitialBalance:       domain.MustParseDecimal("1000.00"),
+			CurrentBalance:       domain.MustParseDecimal("1000.00"),
 			StartDate:            "2026-02-01",
-			CommissionPercentage: 5.0,
+			CommissionPercentage: domain.MustParseDecimal("5.0"),
 			CreatedAt:            createdAt,
 			UpdatedAt:            updatedAt,
 		}
@@ -85,17 +125,17 @@ func TestCreateBankrollHandler(t *testing.T) {
 		mockService.On("CreateBankroll", mock.Anything, uint(1), mock.MatchedBy(func(input CreateBankrollInput) bool {
 			return input.Name == "Main Bankroll" &&
 				input.Currency == CurrencyBRL &&
-				input.InitialBalance == 1000.00 &&
+				input.InitialBalance.Float64() == 1000.00 &&
 				input.StartDate == "2026-02-01" &&
-				input.CommissionPercentage == 5.0
+				input.CommissionPercentage.Float64() == 5.0
 		})).Return(expectedOutput, nil).Once()
 
 		requestBody := CreateBankrollInput{
 			Name:                 "Main Bankroll",
 			Currency:             CurrencyBRL,
-			InitialBalance:       1000.00,
+			InitialBalance:       domain.MustParseDecimal("1000.00"),
 			StartDate:            "2026-02-01",
-			CommissionPercentage: 5.0,
+			CommissionPercentage: domain.MustParseDecimal("5.0"),
 		}
 
 		bodyBytes, err := json.Marshal(requestBody)
@@ -121,8 +161,8 @@ func TestCreateBankrollHandler(t *testing.T) {
 		assert.Equal(t, uint(1), response.ID)
 		assert.Equal(t, "Main Bankroll", response.Name)
 		assert.Equal(t, CurrencyBRL, response.Currency)
-		assert.Equal(t, 1000.00, response.InitialBalance)
-		assert.Equal(t, 1000.00, response.CurrentBalance)
+		assert.Equal(t, 1000.00, response.InitialBalance.Float64())
+		assert.Equal(t, 1000.00, response.CurrentBalance.Float64())
 
 		mockService.AssertExpectations(t)
 	})
@@ -192,9 +232,9 @@ func TestCreateBankrollHandler(t *testing.T) {
 		requestBody := CreateBankrollInput{
 			Name:                 "Main Bankroll",
 			Currency:             CurrencyBRL,
-			InitialBalance:       1000.00,
+			InitialBalance:       domain.MustParseDecimal("1000.00"),
 			StartDate:            "2026-02-01",
-			CommissionPercentage: 5.0,
+			CommissionPercentage: domain.MustParseDecimal("5.0"),
 		}
 
 		bodyBytes, err := json.Marshal(requestBody)
@@ -233,9 +273,9 @@ func TestCreateBankrollHandler(t *testing.T) {
 		requestBody := CreateBankrollInput{
 			Name:                 "Main Bankroll",
 			Currency:             CurrencyBRL,
-			InitialBalance:       1000.00,
+			InitialBalance:       domain.MustParseDecimal("1000.00"),
 			StartDate:            "2026-02-01",
-			CommissionPercentage: 5.0,
+			CommissionPercentage: domain.MustParseDecimal("5.0"),
 		}
 
 		bodyBytes, err := json.Marshal(requestBody)
@@ -271,9 +311,9 @@ func TestCreateBankrollHandler(t *testing.T) {
 		requestBody := CreateBankrollInput{
 			Name:                 "Main Bankroll",
 			Currency:             CurrencyBRL,
-			InitialBalance:       1000.00,
+			InitialBalance:       domain.MustParseDecimal("1000.00"),
 			StartDate:            "2026-02-01",
-			CommissionPercentage: 5.0,
+			CommissionPercentage: domain.MustParseDecimal("5.0"),
 		}
 
 		bodyBytes, err := json.Marshal(requestBody)
@@ -315,10 +355,10 @@ func TestUpdateBankrollHandler(t *testing.T) {
 			ID:                   1,
 			Name:                 "Updated Bankroll",
 			Currency:             CurrencyBRL,
-			InitialBalance:       1000.00,
-			CurrentBalance:       1000.00,
+			InitialBalance:       domain.MustParseDecimal("1000.00"),
+			CurrentBalance:       domain.MustParseDecimal("1000.00"),
 			StartDate:            "2026-02-01",
-			CommissionPercentage: 3.0,
+			CommissionPercentage: domain.MustParseDecimal("3.0"),
 			CreatedAt:            createdAt,
 			UpdatedAt:            updatedAt,
 		}
@@ -327,14 +367,14 @@ func TestUpdateBankrollHandler(t *testing.T) {
 			return input.Name == "Updated Bankroll" &&
 				input.Currency == CurrencyBRL &&
 				input.StartDate == "2026-02-01" &&
-				input.CommissionPercentage == 3.0
+				input.CommissionPercentage.Float64() == 3.0
 		})).Return(expectedOutput, nil).Once()
 
 		requestBody := UpdateBankrollInput{
 			Name:                 "Updated Bankroll",
 			Currency:             CurrencyBRL,
 			StartDate:            "2026-02-01",
-			CommissionPercentage: 3.0,
+			CommissionPercentage: domain.MustParseDecimal("3.0"),
 		}
 
 		bodyBytes, err := json.Marshal(requestBody)
@@ -361,9 +401,9 @@ func TestUpdateBankrollHandler(t *testing.T) {
 		assert.Equal(t, uint(1), response.ID)
 		assert.Equal(t, "Updated Bankroll", response.Name)
 		assert.Equal(t, CurrencyBRL, response.Currency)
-		assert.Equal(t, 3.0, response.CommissionPercentage)
-		assert.Equal(t, 1000.00, response.InitialBalance)
-		assert.Equal(t, 1000.00, response.CurrentBalance)
+		assert.Equal(t, 3.0, response.CommissionPercentage.Float64())
+		assert.Equal(t, 1000.00, response.InitialBalance.Float64())
+		assert.Equal(t, 1000.00, response.CurrentBalance.Float64())
 
 		mockService.AssertExpectations(t)
 	})
@@ -435,7 +475,7 @@ func TestUpdateBankrollHandler(t *testing.T) {
 			Name:                 "Updated Bankroll",
 			Currency:             CurrencyBRL,
 			StartDate:            "2026-02-01",
-			CommissionPercentage: 3.0,
+			CommissionPercentage: domain.MustParseDecimal("3.0"),
 		}
 
 		bodyBytes, err := json.Marshal(requestBody)
@@ -476,7 +516,7 @@ func TestUpdateBankrollHandler(t *testing.T) {
 			Name:                 "Updated Bankroll",
 			Currency:             CurrencyBRL,
 			StartDate:            "2026-02-01",
-			CommissionPercentage: 3.0,
+			CommissionPercentage: domain.MustParseDecimal("3.0"),
 		}
 
 		bodyBytes, err := json.Marshal(requestBody)
@@ -517,7 +557,7 @@ func TestUpdateBankrollHandler(t *testing.T) {
 			Name:                 "Updated Bankroll",
 			Currency:             CurrencyBRL,
 			StartDate:            "2026-02-01",
-			CommissionPercentage: 3.0,
+			CommissionPercentage: domain.MustParseDecimal("3.0"),
 		}
 
 		bodyBytes, err := json.Marshal(requestBody)
@@ -555,7 +595,7 @@ func TestUpdateBankrollHandler(t *testing.T) {
 			Name:                 "Updated Bankroll",
 			Currency:             CurrencyBRL,
 			StartDate:            "2026-02-01",
-			CommissionPercentage: 3.0,
+			CommissionPercentage: domain.MustParseDecimal("3.0"),
 		}
 
 		bodyBytes, err := json.Marshal(requestBody)
@@ -599,10 +639,10 @@ func TestListBankrollsHandler(t *testing.T) {
 				ID:                   1,
 				Name:                 "Bankroll 1",
 				Currency:             CurrencyBRL,
-				InitialBalance:       1000.00,
-				CurrentBalance:       1000.00,
+				InitialBalance:       domain.MustParseDecimal("1000.00"),
+				CurrentBalance:       domain.MustParseDecimal("1000.00"),
 				StartDate:            "2026-02-01",
-				CommissionPercentage: 5.0,
+				CommissionPercentage: domain.MustParseDecimal("5.0"),
 				CreatedAt:            createdAt,
 				UpdatedAt:            updatedAt,
 			},
@@ -610,16 +650,17 @@ func TestListBankrollsHandler(t *testing.T) {
 				ID:                   2,
 				Name:                 "Bankroll 2",
 				Currency:             CurrencyUSD,
-				InitialBalance:       500.00,
-				CurrentBalance:       500.00,
+				InitialBalance:       domain.MustParseDecimal("500.00"),
+				CurrentBalance:       domain.MustParseDecimal("500.00"),
 				StartDate:            "2026-02-01",
-				CommissionPercentage: 3.0,
+				CommissionPercentage: domain.MustParseDecimal("3.0"),
 				CreatedAt:            createdAt,
 				UpdatedAt:            updatedAt,
 			},
 		}
 
-		mockService.On("ListBankrolls", mock.Anything, uint(1)).Return(expectedOutputs, nil).Once()
+		mockService.On("ListBankrolls", mock.Anything, uint(1), ListBankrollsInput{}).
+			Return(pagination.NewPage(expectedOutputs, 2, pagination.Params{Limit: 20}, ""), nil).Once()
 
 		req, err := http.NewRequest(http.MethodGet, "/bankrolls", nil)
 		require.NoError(t, err)
@@ -633,13 +674,14 @@ func TestListBankrollsHandler(t *testing.T) {
 
 		assert.Equal(t, http.StatusOK, w.Code)
 
-		var response []*BankrollOutput
+		var response BankrollPageOutput
 		err = json.Unmarshal(w.Body.Bytes(), &response)
 		require.NoError(t, err)
 
-		assert.Len(t, response, 2)
-		assert.Equal(t, "Bankroll 1", response[0].Name)
-		assert.Equal(t, "Bankroll 2", response[1].Name)
+		assert.Len(t, response.Items, 2)
+		assert.Equal(t, int64(2), response.Total)
+		assert.Equal(t, "Bankroll 1", response.Items[0].Name)
+		assert.Equal(t, "Bankroll 2", response.Items[1].Name)
 
 		mockService.AssertExpectations(t)
 	})
@@ -649,7 +691,8 @@ func TestListBankrollsHandler(t *testing.T) {
 		logger := slog.Default()
 		handler := NewBankrollHandler(mockService, logger)
 
-		mockService.On("ListBankrolls", mock.Anything, uint(1)).Return([]*BankrollOutput{}, nil).Once()
+		mockService.On("ListBankrolls", mock.Anything, uint(1), ListBankrollsInput{}).
+			Return(pagination.NewPage([]*BankrollOutput{}, 0, pagination.Params{Limit: 20}, ""), nil).Once()
 
 		req, err := http.NewRequest(http.MethodGet, "/bankrolls", nil)
 		require.NoError(t, err)
@@ -663,11 +706,12 @@ func TestListBankrollsHandler(t *testing.T) {
 
 		assert.Equal(t, http.StatusOK, w.Code)
 
-		var response []*BankrollOutput
+		var response BankrollPageOutput
 		err = json.Unmarshal(w.Body.Bytes(), &response)
 		require.NoError(t, err)
 
-		assert.Empty(t, response)
+		assert.Empty(t, response.Items)
+		assert.Empty(t, response.NextCursor)
 
 		mockService.AssertExpectations(t)
 	})
@@ -677,7 +721,7 @@ func TestListBankrollsHandler(t *testing.T) {
 		logger := slog.Default()
 		handler := NewBankrollHandler(mockService, logger)
 
-		mockService.On("ListBankrolls", mock.Anything, uint(1)).Return(nil, ErrDatabaseError).Once()
+		mockService.On("ListBankrolls", mock.Anything, uint(1), mock.Anything).Return(nil, ErrDatabaseError).Once()
 
 		req, err := http.NewRequest(http.MethodGet, "/bankrolls", nil)
 		require.NoError(t, err)
@@ -725,6 +769,52 @@ func TestListBankrollsHandler(t *testing.T) {
 
 		mockService.AssertNotCalled(t, "ListBankrolls")
 	})
+
+	t.Run("query parameters", func(t *testing.T) {
+		mockService := new(MockBankrollServiceForHandler)
+		handler := NewBankrollHandler(mockService, slog.Default())
+
+		expected := ListBankrollsInput{
+			Currency: CurrencyUSD,
+			Name:     "poker",
+			Sort:     BankrollSortCurrentBalance,
+			Order:    "desc",
+			Params:   pagination.Params{Limit: 10, Cursor: "abc"},
+		}
+		mockService.On("ListBankrolls", mock.Anything, uint(1), expected).
+			Return(pagination.NewPage([]*BankrollOutput{}, 0, expected.Params, ""), nil).Once()
+
+		req, err := http.NewRequest(http.MethodGet, "/bankrolls?currency=USD&name=poker&sort=current_balance&order=desc&limit=10&cursor=abc", nil)
+		require.NoError(t, err)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = req
+		c.Set("userID", "1")
+
+		handler.ListBankrolls(c)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("invalid order", func(t *testing.T) {
+		mockService := new(MockBankrollServiceForHandler)
+		handler := NewBankrollHandler(mockService, slog.Default())
+
+		req, err := http.NewRequest(http.MethodGet, "/bankrolls?order=sideways", nil)
+		require.NoError(t, err)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = req
+		c.Set("userID", "1")
+
+		handler.ListBankrolls(c)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		mockService.AssertNotCalled(t, "ListBankrolls")
+	})
 }
 
 func TestGetBankrollHandler(t *testing.T) {
@@ -740,10 +830,10 @@ func TestGetBankrollHandler(t *testing.T) {
 			ID:                   1,
 			Name:                 "Main Bankroll",
 			Currency:             CurrencyBRL,
-			InitialBalance:       1000.00,
-			CurrentBalance:       1000.00,
+			InitialBalance:       domain.MustParseDecimal("1000.00"),
+			CurrentBalance:       domain.MustParseDecimal("1000.00"),
 			StartDate:            "2026-02-01",
-			CommissionPercentage: 5.0,
+			CommissionPercentage: domain.MustParseDecimal("5.0"),
 			CreatedAt:            createdAt,
 			UpdatedAt:            updatedAt,
 		}
@@ -770,8 +860,8 @@ func TestGetBankrollHandler(t *testing.T) {
 		assert.Equal(t, uint(1), response.ID)
 		assert.Equal(t, "Main Bankroll", response.Name)
 		assert.Equal(t, CurrencyBRL, response.Currency)
-		assert.Equal(t, 1000.00, response.InitialBalance)
-		assert.Equal(t, 1000.00, response.CurrentBalance)
+		assert.Equal(t, 1000.00, response.InitialBalance.Float64())
+		assert.Equal(t, 1000.00, response.CurrentBalance.Float64())
 
 		mockService.AssertExpectations(t)
 	})
@@ -903,15 +993,15 @@ func TestResetBankrollHandler(t *testing.T) {
 			ID:                   1,
 			Name:                 "Main Bankroll",
 			Currency:             CurrencyBRL,
-			InitialBalance:       0.0,
-			CurrentBalance:       0.0,
+			InitialBalance:       domain.MustParseDecimal("0.0"),
+			CurrentBalance:       domain.MustParseDecimal("0.0"),
 			StartDate:            "2026-02-01",
-			CommissionPercentage: 5.0,
+			CommissionPercentage: domain.MustParseDecimal("5.0"),
 			CreatedAt:            createdAt,
 			UpdatedAt:            updatedAt,
 		}
 
-		mockService.On("ResetBankroll", mock.Anything, uint(1), uint(1)).Return(expectedOutput, nil).Once()
+		mockService.On("ResetBankroll", mock.Anything, uint(1), uint(1), ResetBankrollInput{}).Return(expectedOutput, nil).Once()
 
 		req, err := http.NewRequest(http.MethodPost, "/bankrolls/1/reset", nil)
 		require.NoError(t, err)
@@ -932,8 +1022,8 @@ func TestResetBankrollHandler(t *testing.T) {
 
 		assert.Equal(t, uint(1), response.ID)
 		assert.Equal(t, "Main Bankroll", response.Name)
-		assert.Equal(t, 0.0, response.InitialBalance)
-		assert.Equal(t, 0.0, response.CurrentBalance)
+		assert.Equal(t, 0.0, response.InitialBalance.Float64())
+		assert.Equal(t, 0.0, response.CurrentBalance.Float64())
 
 		mockService.AssertExpectations(t)
 	})
@@ -943,7 +1033,7 @@ func TestResetBankrollHandler(t *testing.T) {
 		logger := slog.Default()
 		handler := NewBankrollHandler(mockService, logger)
 
-		mockService.On("ResetBankroll", mock.Anything, uint(1), uint(999)).Return(nil, ErrBankrollNotFound).Once()
+		mockService.On("ResetBankroll", mock.Anything, uint(1), uint(999), ResetBankrollInput{}).Return(nil, ErrBankrollNotFound).Once()
 
 		req, err := http.NewRequest(http.MethodPost, "/bankrolls/999/reset", nil)
 		require.NoError(t, err)
@@ -973,7 +1063,7 @@ func TestResetBankrollHandler(t *testing.T) {
 		logger := slog.Default()
 		handler := NewBankrollHandler(mockService, logger)
 
-		mockService.On("ResetBankroll", mock.Anything, uint(1), uint(1)).Return(nil, ErrDatabaseError).Once()
+		mockService.On("ResetBankroll", mock.Anything, uint(1), uint(1), ResetBankrollInput{}).Return(nil, ErrDatabaseError).Once()
 
 		req, err := http.NewRequest(http.MethodPost, "/bankrolls/1/reset", nil)
 		require.NoError(t, err)
@@ -1051,4 +1141,404 @@ func TestResetBankrollHandler(t *testing.T) {
 
 		mockService.AssertNotCalled(t, "ResetBankroll")
 	})
+
+	t.Run("reset to a new amount", func(t *testing.T) {
+		mockService := new(MockBankrollServiceForHandler)
+		handler := NewBankrollHandler(mockService, slog.Default())
+
+		input := ResetBankrollInput{Amount: domain.MustParseDecimal("500")}
+		mockService.On("ResetBankroll", mock.Anything, uint(1), uint(1), mock.MatchedBy(func(in ResetBankrollInput) bool {
+			return in.Amount.Equal(input.Amount)
+		})).Return(&BankrollOutput{ID: 1, InitialBalance: input.Amount, CurrentBalance: input.Amount}, nil).Once()
+
+		req, err := http.NewRequest(http.MethodPost, "/bankrolls/1/reset", bytes.NewBufferString(`{"amount":"500"}`))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = req
+		c.Params = gin.Params{gin.Param{Key: "bankrollId", Value: "1"}}
+		c.Set("userID", "1")
+
+		handler.ResetBankroll(c)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("invalid body", func(t *testing.T) {
+		mockService := new(MockBankrollServiceForHandler)
+		handler := NewBankrollHandler(mockService, slog.Default())
+
+		req, err := http.NewRequest(http.MethodPost, "/bankrolls/1/reset", bytes.NewBufferString(`{"amount":`))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = req
+		c.Params = gin.Params{gin.Param{Key: "bankrollId", Value: "1"}}
+		c.Set("userID", "1")
+
+		handler.ResetBankroll(c)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		mockService.AssertNotCalled(t, "ResetBankroll")
+	})
+}
+
+func TestDeleteBankrollHandler(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		mockService := new(MockBankrollServiceForHandler)
+		handler := NewBankrollHandler(mockService, slog.Default())
+
+		mockService.On("DeleteBankroll", mock.Anything, uint(1), uint(1)).Return(nil).Once()
+
+		req, err := http.NewRequest(http.MethodDelete, "/bankrolls/1", nil)
+		require.NoError(t, err)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = req
+		c.Params = gin.Params{gin.Param{Key: "bankrollId", Value: "1"}}
+		c.Set("userID", "1")
+
+		handler.DeleteBankroll(c)
+
+		assert.Equal(t, http.StatusNoContent, c.Writer.Status())
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("money in play", func(t *testing.T) {
+		mockService := new(MockBankrollServiceForHandler)
+		handler := NewBankrollHandler(mockService, slog.Default())
+
+		mockService.On("DeleteBankroll", mock.Anything, uint(1), uint(1)).Return(ErrBankrollInPlay).Once()
+
+		req, err := http.NewRequest(http.MethodDelete, "/bankrolls/1", nil)
+		require.NoError(t, err)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = req
+		c.Params = gin.Params{gin.Param{Key: "bankrollId", Value: "1"}}
+		c.Set("userID", "1")
+
+		handler.DeleteBankroll(c)
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+		assert.Contains(t, w.Body.String(), "BANKROLL_IN_PLAY")
+	})
+}
+
+func TestArchiveBankrollHandler(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		mockService := new(MockBankrollServiceForHandler)
+		handler := NewBankrollHandler(mockService, slog.Default())
+
+		archivedAt := time.Now()
+		mockService.On("ArchiveBankroll", mock.Anything, uint(1), uint(1)).
+			Return(&BankrollOutput{ID: 1, Archived: true, ArchivedAt: &archivedAt}, nil).Once()
+
+		req, err := http.NewRequest(http.MethodPost, "/bankrolls/1/archive", nil)
+		require.NoError(t, err)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = req
+		c.Params = gin.Params{gin.Param{Key: "bankrollId", Value: "1"}}
+		c.Set("userID", "1")
+
+		handler.ArchiveBankroll(c)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var response BankrollOutput
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.True(t, response.Archived)
+		assert.NotNil(t, response.ArchivedAt)
+	})
+
+	t.Run("already archived", func(t *testing.T) {
+		mockService := new(MockBankrollServiceForHandler)
+		handler := NewBankrollHandler(mockService, slog.Default())
+
+		mockService.On("ArchiveBankroll", mock.Anything, uint(1), uint(1)).Return(nil, ErrBankrollArchived).Once()
+
+		req, err := http.NewRequest(http.MethodPost, "/bankrolls/1/archive", nil)
+		require.NoError(t, err)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = req
+		c.Params = gin.Params{gin.Param{Key: "bankrollId", Value: "1"}}
+		c.Set("userID", "1")
+
+		handler.ArchiveBankroll(c)
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+		assert.Contains(t, w.Body.String(), "BANKROLL_ARCHIVED")
+	})
+}
+
+func TestRestoreBankrollHandler(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		mockService := new(MockBankrollServiceForHandler)
+		handler := NewBankrollHandler(mockService, slog.Default())
+
+		mockService.On("RestoreBankroll", mock.Anything, uint(1), uint(1)).
+			Return(&BankrollOutput{ID: 1, Name: "Main Bankroll"}, nil).Once()
+
+		req, err := http.NewRequest(http.MethodPost, "/bankrolls/1/restore", nil)
+		require.NoError(t, err)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = req
+		c.Params = gin.Params{gin.Param{Key: "bankrollId", Value: "1"}}
+		c.Set("userID", "1")
+
+		handler.RestoreBankroll(c)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("name taken", func(t *testing.T) {
+		mockService := new(MockBankrollServiceForHandler)
+		handler := NewBankrollHandler(mockService, slog.Default())
+
+		mockService.On("RestoreBankroll", mock.Anything, uint(1), uint(1)).Return(nil, ErrBankrollNameExists).Once()
+
+		req, err := http.NewRequest(http.MethodPost, "/bankrolls/1/restore", nil)
+		require.NoError(t, err)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = req
+		c.Params = gin.Params{gin.Param{Key: "bankrollId", Value: "1"}}
+		c.Set("userID", "1")
+
+		handler.RestoreBankroll(c)
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+		assert.Contains(t, w.Body.String(), "BANKROLL_NAME_EXISTS")
+	})
+}
+
+func TestBankrollHandlerConditionalRequests(t *testing.T) {
+	updateBody := `{"name":"Main","currency":"BRL","start_date":"2026-02-01","commission_percentage":"5"}`
+
+	t.Run("get returns the version as ETag", func(t *testing.T) {
+		mockService := new(MockBankrollServiceForHandler)
+		handler := NewBankrollHandler(mockService, slog.Default())
+
+		mockService.On("GetBankroll", mock.Anything, uint(1), uint(1)).Return(&BankrollOutput{ID: 1, Version: 4}, nil).Once()
+
+		req, err := http.NewRequest(http.MethodGet, "/bankrolls/1", nil)
+		require.NoError(t, err)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = req
+		c.Params = gin.Params{gin.Param{Key: "bankrollId", Value: "1"}}
+		c.Set("userID", "1")
+
+		handler.GetBankroll(c)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, `"4"`, w.Header().Get("ETag"))
+	})
+
+	t.Run("update passes If-Match to the service", func(t *testing.T) {
+		mockService := new(MockBankrollServiceForHandler)
+		handler := NewBankrollHandler(mockService, slog.Default())
+
+		mockService.On("UpdateBankroll", mock.Anything, uint(1), uint(1), mock.MatchedBy(func(input UpdateBankrollInput) bool {
+			return input.ExpectedVersion == 4
+		})).Return(&BankrollOutput{ID: 1, Version: 5}, nil).Once()
+
+		req, err := http.NewRequest(http.MethodPut, "/bankrolls/1", bytes.NewBufferString(updateBody))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("If-Match", `W/"4"`)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = req
+		c.Params = gin.Params{gin.Param{Key: "bankrollId", Value: "1"}}
+		c.Set("userID", "1")
+
+		handler.UpdateBankroll(c)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, `"5"`, w.Header().Get("ETag"))
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("version conflict", func(t *testing.T) {
+		mockService := new(MockBankrollServiceForHandler)
+		handler := NewBankrollHandler(mockService, slog.Default())
+
+		mockService.On("UpdateBankroll", mock.Anything, uint(1), uint(1), mock.Anything).Return(nil, ErrVersionConflict).Once()
+
+		req, err := http.NewRequest(http.MethodPut, "/bankrolls/1", bytes.NewBufferString(updateBody))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("If-Match", `"3"`)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = req
+		c.Params = gin.Params{gin.Param{Key: "bankrollId", Value: "1"}}
+		c.Set("userID", "1")
+
+		handler.UpdateBankroll(c)
+
+		assert.Equal(t, http.StatusPreconditionFailed, w.Code)
+
+		var response ErrorOutput
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, "VERSION_CONFLICT", response.Code)
+	})
+
+	t.Run("malformed If-Match on reset", func(t *testing.T) {
+		mockService := new(MockBankrollServiceForHandler)
+		handler := NewBankrollHandler(mockService, slog.Default())
+
+		req, err := http.NewRequest(http.MethodPost, "/bankrolls/1/reset", nil)
+		require.NoError(t, err)
+		req.Header.Set("If-Match", `"abc"`)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = req
+		c.Params = gin.Params{gin.Param{Key: "bankrollId", Value: "1"}}
+		c.Set("userID", "1")
+
+		handler.ResetBankroll(c)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		mockService.AssertNotCalled(t, "ResetBankroll")
+	})
+
+	t.Run("wildcard If-Match skips the check", func(t *testing.T) {
+		mockService := new(MockBankrollServiceForHandler)
+		handler := NewBankrollHandler(mockService, slog.Default())
+
+		mockService.On("ResetBankroll", mock.Anything, uint(1), uint(1), ResetBankrollInput{}).Return(&BankrollOutput{ID: 1, Version: 2}, nil).Once()
+
+		req, err := http.NewRequest(http.MethodPost, "/bankrolls/1/reset", nil)
+		require.NoError(t, err)
+		req.Header.Set("If-Match", "*")
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = req
+		c.Params = gin.Params{gin.Param{Key: "bankrollId", Value: "1"}}
+		c.Set("userID", "1")
+
+		handler.ResetBankroll(c)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		mockService.AssertExpectations(t)
+	})
+}
+
+func TestPatchBankrollHandler(t *testing.T) {
+	newRequest := func(body string, contentType string) (*httptest.ResponseRecorder, *gin.Context) {
+		req, err := http.NewRequest(http.MethodPatch, "/bankrolls/1", bytes.NewBufferString(body))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", contentType)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = req
+		c.Params = gin.Params{gin.Param{Key: "bankrollId", Value: "1"}}
+		c.Set("userID", "1")
+		return w, c
+	}
+
+	t.Run("success", func(t *testing.T) {
+		mockService := new(MockBankrollServiceForHandler)
+		handler := NewBankrollHandler(mockService, slog.Default())
+
+		mockService.On("PatchBankroll", mock.Anything, uint(1), uint(1), mock.MatchedBy(func(input PatchBankrollInput) bool {
+			return input.Name != nil && *input.Name == "Renamed" && input.Currency == nil && input.CommissionPercentage == nil
+		})).Return(&BankrollOutput{ID: 1, Name: "Renamed", Version: 2}, nil).Once()
+
+		w, c := newRequest(`{"name":"Renamed"}`, "application/merge-patch+json")
+		handler.PatchBankroll(c)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, `"2"`, w.Header().Get("ETag"))
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("balance fields are rejected", func(t *testing.T) {
+		mockService := new(MockBankrollServiceForHandler)
+		handler := NewBankrollHandler(mockService, slog.Default())
+
+		w, c := newRequest(`{"name":"Renamed","current_balance":"10"}`, "application/merge-patch+json")
+		handler.PatchBankroll(c)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), "CANNOT_MODIFY_BALANCE")
+		mockService.AssertNotCalled(t, "PatchBankroll")
+	})
+
+	t.Run("null member is rejected", func(t *testing.T) {
+		mockService := new(MockBankrollServiceForHandler)
+		handler := NewBankrollHandler(mockService, slog.Default())
+
+		w, c := newRequest(`{"currency":null}`, "application/merge-patch+json")
+		handler.PatchBankroll(c)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), "VALIDATION_ERROR")
+		mockService.AssertNotCalled(t, "PatchBankroll")
+	})
+
+	t.Run("unsupported media type", func(t *testing.T) {
+		mockService := new(MockBankrollServiceForHandler)
+		handler := NewBankrollHandler(mockService, slog.Default())
+
+		w, c := newRequest(`name=Renamed`, "application/x-www-form-urlencoded")
+		handler.PatchBankroll(c)
+
+		assert.Equal(t, http.StatusUnsupportedMediaType, w.Code)
+		mockService.AssertNotCalled(t, "PatchBankroll")
+	})
+}
+
+func TestParseBankrollPatch(t *testing.T) {
+	input, err := ParseBankrollPatch([]byte(`{"currency":"USD","commission_percentage":"2.5","start_date":"2026-03-01"}`))
+	require.NoError(t, err)
+	assert.Nil(t, input.Name)
+	assert.Equal(t, CurrencyUSD, *input.Currency)
+	assert.Equal(t, "2.5", input.CommissionPercentage.String())
+	assert.Equal(t, "2026-03-01", *input.StartDate)
+	assert.Nil(t, input.Timezone)
+
+	input, err = ParseBankrollPatch([]byte(`{"timezone":"America/Sao_Paulo"}`))
+	require.NoError(t, err)
+	assert.False(t, input.IsEmpty())
+	assert.Equal(t, "America/Sao_Paulo", *input.Timezone)
+
+	input, err = ParseBankrollPatch([]byte(`{}`))
+	require.NoError(t, err)
+	assert.True(t, input.IsEmpty())
+
+	_, err = ParseBankrollPatch([]byte(`{"initial_balance":"1"}`))
+	assert.ErrorIs(t, err, ErrCannotModifyBalance)
+
+	_, err = ParseBankrollPatch([]byte(`{"owner":"me"}`))
+	assert.ErrorIs(t, err, ErrValidationFailed)
+
+	_, err = ParseBankrollPatch([]byte(`["name"]`))
+	assert.ErrorIs(t, err, ErrValidationFailed)
+
+	_, err = ParseBankrollPatch([]byte(`{"name":42}`))
+	assert.ErrorIs(t, err, ErrValidationFailed)
 }
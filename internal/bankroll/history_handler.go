@@ -0,0 +1,56 @@
+package bankroll
+
+import (
+	"net/http"
+	"strconv"
+
+	"log/slog"
+
+	"github.com/gin-gonic/gin"
+)
+
+type HistoryHandler struct {
+	service HistoryService
+	logger  *slog.Logger
+}
+
+func NewHistoryHandler(service HistoryService, logger *slog.Logger) *HistoryHandler {
+	return &HistoryHandler{
+		service: service,
+		logger:  logger,
+	}
+}
+
+func (h *HistoryHandler) GetBalanceHistory(c *gin.Context) {
+	var input BalanceHistoryInput
+	if err := c.ShouldBindQuery(&input); err != nil {
+		h.logger.Error("invalid query parameters", "error", err)
+		c.JSON(http.StatusBadRequest, ErrorOutput{
+			Error:   "Invalid query parameters",
+			Code:    "VALIDATION_ERROR",
+			Details: nil,
+		})
+		return
+	}
+
+	userID, err := getUserID(c)
+	if err != nil {
+		handleError(c, h.logger, err)
+		return
+	}
+
+	bankrollIDStr := c.Param("bankrollId")
+	bankrollID, err := strconv.ParseUint(bankrollIDStr, 10, 32)
+	if err != nil {
+		handleError(c, h.logger, ErrUnauthorized)
+		return
+	}
+
+	output, err := h.service.GetBalanceHistory(c.Request.Context(), userID, uint(bankrollID), input)
+	if err != nil {
+		handleError(c, h.logger, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
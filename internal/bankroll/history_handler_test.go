@@ -0,0 +1,73 @@
+package bankroll
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"log/slog"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type MockHistoryServiceForHandler struct {
+	mock.Mock
+}
+
+func (m *MockHistoryServiceForHandler) GetBalanceHistory(ctx context.Context, userID uint, bankrollID uint, input BalanceHistoryInput) (*BalanceHistoryOutput, error) {
+	args := m.Called(ctx, userID, bankrollID, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*BalanceHistoryOutput), args.Error(1)
+}
+
+func TestGetBalanceHistoryHandler(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		mockService := new(MockHistoryServiceForHandler)
+		handler := NewHistoryHandler(mockService, slog.Default())
+
+		expected := BalanceHistoryInput{From: "2026-03-01", To: "2026-03-31", Interval: HistoryIntervalWeek}
+		mockService.On("GetBalanceHistory", mock.Anything, uint(1), uint(1), expected).
+			Return(&BalanceHistoryOutput{BankrollID: 1, Interval: HistoryIntervalWeek, Points: []BalancePointOutput{}}, nil).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest(http.MethodGet, "/bankrolls/1/history?from=2026-03-01&to=2026-03-31&interval=week", nil)
+		c.Params = gin.Params{{Key: "bankrollId", Value: "1"}}
+		c.Set("userID", "1")
+
+		handler.GetBalanceHistory(c)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		var response BalanceHistoryOutput
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, HistoryIntervalWeek, response.Interval)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("invalid interval", func(t *testing.T) {
+		mockService := new(MockHistoryServiceForHandler)
+		handler := NewHistoryHandler(mockService, slog.Default())
+
+		mockService.On("GetBalanceHistory", mock.Anything, uint(1), uint(1), mock.Anything).Return(nil, ErrInvalidInterval).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest(http.MethodGet, "/bankrolls/1/history?interval=hour", nil)
+		c.Params = gin.Params{{Key: "bankrollId", Value: "1"}}
+		c.Set("userID", "1")
+
+		handler.GetBalanceHistory(c)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		var response ErrorOutput
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, "INVALID_INTERVAL", response.Code)
+	})
+}
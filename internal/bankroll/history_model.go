@@ -0,0 +1,60 @@
+package bankroll
+
+import (
+	"time"
+
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
+)
+
+type HistoryInterval string
+
+const (
+	HistoryIntervalDay   HistoryInterval = "day"
+	HistoryIntervalWeek  HistoryInterval = "week"
+	HistoryIntervalMonth HistoryInterval = "month"
+)
+
+// MaxHistoryPoints bounds the number of buckets returned by one request.
+const MaxHistoryPoints = 1000
+
+func (i HistoryInterval) IsValid() bool {
+	switch i {
+	case HistoryIntervalDay, HistoryIntervalWeek, HistoryIntervalMonth:
+		return true
+	}
+	return false
+}
+
+// Truncate returns the start of the bucket containing t. Weeks start on
+// Monday; all buckets are in UTC.
+func (i HistoryInterval) Truncate(t time.Time) time.Time {
+	year, month, day := t.UTC().Date()
+	start := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	switch i {
+	case HistoryIntervalWeek:
+		offset := (int(start.Weekday()) + 6) % 7
+		return start.AddDate(0, 0, -offset)
+	case HistoryIntervalMonth:
+		return time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+	}
+	return start
+}
+
+// Next returns the start of the bucket following the one starting at start.
+func (i HistoryInterval) Next(start time.Time) time.Time {
+	switch i {
+	case HistoryIntervalWeek:
+		return start.AddDate(0, 0, 7)
+	case HistoryIntervalMonth:
+		return start.AddDate(0, 1, 0)
+	}
+	return start.AddDate(0, 0, 1)
+}
+
+// HistoryEntry is a ledger amount placed at the moment it affected the
+// balance: the end of a session or the settlement of a bet, and the posting
+// time for every other entry.
+type HistoryEntry struct {
+	At     time.Time
+	Amount domain.Decimal
+}
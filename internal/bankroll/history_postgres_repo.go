@@ -0,0 +1,63 @@
+package bankroll
+
+import (
+	"context"
+	"time"
+
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
+	"gorm.io/gorm"
+)
+
+// Backdated sessions and late bet settlements are posted to the ledger when
+// they are recorded, so their entries are placed at the time they happened:
+// ledgerEntryAt is that time for a ledger row t joined with ledgerEntryJoins.
+const (
+	ledgerEntryJoins = `
+LEFT JOIN poker_sessions s ON t.reference_type = 'session' AND s.id = t.reference_id
+LEFT JOIN bets b ON t.reference_type = 'bet' AND b.id = t.reference_id`
+	ledgerEntryAt = `COALESCE(s.ended_at, b.settled_at, t.created_at)`
+)
+
+const historyEntriesQuery = `
+SELECT s.ended_at AS session_ended_at, b.settled_at AS bet_settled_at, t.created_at AS created_at, t.amount AS amount
+FROM bankroll_transactions t` + ledgerEntryJoins + `
+WHERE t.bankroll_id = ? AND ` + ledgerEntryAt + ` >= ?
+ORDER BY ` + ledgerEntryAt + `, t.id`
+
+type historyEntryRow struct {
+	SessionEndedAt *time.Time
+	BetSettledAt   *time.Time
+	CreatedAt      time.Time
+	Amount         domain.Decimal
+}
+
+type postgresHistoryRepository struct {
+	db *gorm.DB
+}
+
+func NewPostgresHistoryRepository(db *gorm.DB) HistoryRepository {
+	return &postgresHistoryRepository{
+		db: db,
+	}
+}
+
+func (r *postgresHistoryRepository) ListEntries(ctx context.Context, bankrollID uint, since time.Time) ([]HistoryEntry, error) {
+	var rows []historyEntryRow
+	err := r.db.WithContext(ctx).Raw(historyEntriesQuery, bankrollID, since).Scan(&rows).Error
+	if err != nil {
+		return nil, WrapError(ErrDatabaseError, err.Error())
+	}
+
+	entries := make([]HistoryEntry, len(rows))
+	for i, row := range rows {
+		at := row.CreatedAt
+		switch {
+		case row.SessionEndedAt != nil:
+			at = *row.SessionEndedAt
+		case row.BetSettledAt != nil:
+			at = *row.BetSettledAt
+		}
+		entries[i] = HistoryEntry{At: at, Amount: row.Amount}
+	}
+	return entries, nil
+}
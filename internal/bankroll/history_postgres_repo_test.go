@@ -0,0 +1,77 @@
+package bankroll
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+// Minimal stand-ins for the bets and poker_sessions tables, which belong to
+// packages that import bankroll.
+type historyTestBet struct {
+	ID        uint `gorm:"primaryKey"`
+	SettledAt *time.Time
+}
+
+func (historyTestBet) TableName() string {
+	return "bets"
+}
+
+type historyTestSession struct {
+	ID      uint `gorm:"primaryKey"`
+	EndedAt *time.Time
+}
+
+func (historyTestSession) TableName() string {
+	return "poker_sessions"
+}
+
+func postHistoryEntry(t *testing.T, db *gorm.DB, bankroll *Bankroll, transaction *Transaction) {
+	transaction.BankrollID = bankroll.ID
+	err := db.Transaction(func(tx *gorm.DB) error {
+		return ApplyTransaction(tx, transaction, bankroll.UserID)
+	})
+	require.NoError(t, err)
+}
+
+func TestPostgresHistoryRepository_ListEntries(t *testing.T) {
+	db := setupTestDB(t)
+	require.NoError(t, db.AutoMigrate(&historyTestBet{}, &historyTestSession{}))
+	repo := NewPostgresHistoryRepository(db)
+	ctx := context.Background()
+	bankroll := createTestBankroll(t, db, 1, "1000.00")
+
+	endedAt := time.Date(2026, 3, 1, 23, 0, 0, 0, time.UTC)
+	require.NoError(t, db.Create(&historyTestSession{ID: 7, EndedAt: &endedAt}).Error)
+	settledAt := time.Date(2026, 3, 5, 18, 0, 0, 0, time.UTC)
+	require.NoError(t, db.Create(&historyTestBet{ID: 3, SettledAt: &settledAt}).Error)
+
+	sessionID, betID := uint(7), uint(3)
+	postHistoryEntry(t, db, bankroll, &Transaction{Type: TransactionTypeDeposit, Amount: domain.MustParseDecimal("200.00")})
+	postHistoryEntry(t, db, bankroll, &Transaction{
+		Type: TransactionTypeBetSettlement, Amount: domain.MustParseDecimal("-25.00"),
+		ReferenceType: "bet", ReferenceID: &betID,
+	})
+	postHistoryEntry(t, db, bankroll, &Transaction{
+		Type: TransactionTypeSessionResult, Amount: domain.MustParseDecimal("80.00"),
+		ReferenceType: "session", ReferenceID: &sessionID,
+	})
+
+	entries, err := repo.ListEntries(ctx, bankroll.ID, time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC))
+
+	require.NoError(t, err)
+	require.Len(t, entries, 3)
+	assert.True(t, entries[0].At.Equal(endedAt))
+	assert.Equal(t, 80.00, entries[0].Amount.Float64())
+	assert.True(t, entries[1].At.Equal(settledAt))
+	assert.Equal(t, 200.00, entries[2].Amount.Float64())
+
+	entries, err = repo.ListEntries(ctx, bankroll.ID, time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	assert.Len(t, entries, 2)
+}
@@ -0,0 +1,10 @@
+package bankroll
+
+import (
+	"context"
+	"time"
+)
+
+type HistoryRepository interface {
+	ListEntries(ctx context.Context, bankrollID uint, since time.Time) ([]HistoryEntry, error)
+}
@@ -0,0 +1,134 @@
+package bankroll
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+type HistoryService interface {
+	GetBalanceHistory(ctx context.Context, userID uint, bankrollID uint, input BalanceHistoryInput) (*BalanceHistoryOutput, error)
+}
+
+type historyService struct {
+	repo         HistoryRepository
+	bankrollRepo BankrollRepository
+	logger       *slog.Logger
+}
+
+func NewHistoryService(repo HistoryRepository, bankrollRepo BankrollRepository, logger *slog.Logger) HistoryService {
+	return &historyService{
+		repo:         repo,
+		bankrollRepo: bankrollRepo,
+		logger:       logger,
+	}
+}
+
+// GetBalanceHistory rebuilds the closing balance of every bucket between from
+// and to by walking back from the current balance, so buckets without
+// activity carry the previous balance forward.
+func (s *historyService) GetBalanceHistory(ctx context.Context, userID uint, bankrollID uint, input BalanceHistoryInput) (*BalanceHistoryOutput, error) {
+	interval := input.Interval
+	if interval == "" {
+		interval = HistoryIntervalDay
+	}
+	if !interval.IsValid() {
+		s.logger.Error("invalid history interval", "interval", input.Interval, "user_id", userID)
+		return nil, ErrInvalidInterval
+	}
+
+	bankroll, err := s.bankrollRepo.FindByID(ctx, bankrollID, userID)
+	if err != nil {
+		s.logger.Error("bankroll not found", "error", err, "user_id", userID, "bankroll_id", bankrollID)
+		return nil, err
+	}
+
+	from, to, err := historyRange(input, bankroll.StartDate)
+	if err != nil {
+		s.logger.Error("invalid history range", "error", err, "from", input.From, "to", input.To)
+		return nil, err
+	}
+
+	first := interval.Truncate(from)
+	end := to.AddDate(0, 0, 1)
+	count := 0
+	for start := first; start.Before(end); start = interval.Next(start) {
+		count++
+		if count > MaxHistoryPoints {
+			s.logger.Error("history range too large", "from", from, "to", to, "interval", interval)
+			return nil, WrapError(ErrValidationFailed, "date range too large for interval")
+		}
+	}
+
+	entries, err := s.repo.ListEntries(ctx, bankrollID, first)
+	if err != nil {
+		s.logger.Error("failed to list history entries", "error", err, "user_id", userID, "bankroll_id", bankrollID)
+		return nil, err
+	}
+
+	balance := bankroll.CurrentBalance
+	for _, entry := range entries {
+		balance = balance.Sub(entry.Amount)
+	}
+
+	points := make([]BalancePointOutput, 0, count)
+	next := 0
+	for start := first; start.Before(end); start = interval.Next(start) {
+		closing := interval.Next(start)
+		if closing.After(end) {
+			closing = end
+		}
+
+		opening := balance
+		for next < len(entries) && entries[next].At.Before(closing) {
+			balance = balance.Add(entries[next].Amount)
+			next++
+		}
+
+		points = append(points, BalancePointOutput{
+			Date:    start.Format("2006-01-02"),
+			Balance: bankroll.Currency.Round(balance),
+			Change:  bankroll.Currency.Round(balance.Sub(opening)),
+		})
+	}
+
+	s.logger.Info("balance history computed", "user_id", userID, "bankroll_id", bankrollID, "interval", interval, "points", len(points))
+
+	return &BalanceHistoryOutput{
+		BankrollID: bankrollID,
+		Currency:   bankroll.Currency,
+		Interval:   interval,
+		From:       from.Format("2006-01-02"),
+		To:         to.Format("2006-01-02"),
+		Points:     points,
+	}, nil
+}
+
+// historyRange parses the requested dates, defaulting to the bankroll's start
+// date and today.
+func historyRange(input BalanceHistoryInput, startDate time.Time) (time.Time, time.Time, error) {
+	to := HistoryIntervalDay.Truncate(time.Now())
+	if input.To != "" {
+		parsed, err := parseDate(input.To)
+		if err != nil {
+			return time.Time{}, time.Time{}, WrapError(ErrValidationFailed, "invalid to date format")
+		}
+		to = parsed
+	}
+
+	from := HistoryIntervalDay.Truncate(startDate)
+	if input.From != "" {
+		parsed, err := parseDate(input.From)
+		if err != nil {
+			return time.Time{}, time.Time{}, WrapError(ErrValidationFailed, "invalid from date format")
+		}
+		from = parsed
+	} else if from.After(to) {
+		from = to
+	}
+
+	if from.After(to) {
+		return time.Time{}, time.Time{}, WrapError(ErrValidationFailed, "from must not be after to")
+	}
+	return from, to, nil
+}
@@ -0,0 +1,127 @@
+package bankroll
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"log/slog"
+)
+
+type MockHistoryRepository struct {
+	mock.Mock
+}
+
+func (m *MockHistoryRepository) ListEntries(ctx context.Context, bankrollID uint, since time.Time) ([]HistoryEntry, error) {
+	args := m.Called(ctx, bankrollID, since)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]HistoryEntry), args.Error(1)
+}
+
+func historyEntry(at string, amount string) HistoryEntry {
+	parsed, err := time.Parse(time.RFC3339, at)
+	if err != nil {
+		panic(err)
+	}
+	return HistoryEntry{At: parsed, Amount: domain.MustParseDecimal(amount)}
+}
+
+func TestHistoryInterval(t *testing.T) {
+	at := time.Date(2026, 3, 5, 15, 30, 0, 0, time.UTC)
+
+	assert.Equal(t, time.Date(2026, 3, 5, 0, 0, 0, 0, time.UTC), HistoryIntervalDay.Truncate(at))
+	assert.Equal(t, time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC), HistoryIntervalWeek.Truncate(at))
+	assert.Equal(t, time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC), HistoryIntervalMonth.Truncate(at))
+	assert.Equal(t, time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC), HistoryIntervalMonth.Next(HistoryIntervalMonth.Truncate(at)))
+	assert.False(t, HistoryInterval("year").IsValid())
+}
+
+func TestGetBalanceHistory(t *testing.T) {
+	t.Run("success - fills gaps forward", func(t *testing.T) {
+		mockRepo := new(MockHistoryRepository)
+		mockBankrollRepo := new(MockBankrollRepository)
+		service := NewHistoryService(mockRepo, mockBankrollRepo, slog.Default())
+		ctx := context.Background()
+
+		bankroll := &Bankroll{ID: 1, UserID: 1, Currency: CurrencyBRL, CurrentBalance: domain.MustParseDecimal("1130.00")}
+		mockBankrollRepo.On("FindByID", ctx, uint(1), uint(1)).Return(bankroll, nil).Once()
+		mockRepo.On("ListEntries", ctx, uint(1), time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)).Return([]HistoryEntry{
+			historyEntry("2026-03-01T20:00:00Z", "100.00"),
+			historyEntry("2026-03-03T10:00:00Z", "-50.00"),
+			historyEntry("2026-03-03T22:00:00Z", "30.00"),
+			historyEntry("2026-03-10T12:00:00Z", "50.00"),
+		}, nil).Once()
+
+		output, err := service.GetBalanceHistory(ctx, 1, 1, BalanceHistoryInput{From: "2026-03-01", To: "2026-03-04"})
+
+		require.NoError(t, err)
+		assert.Equal(t, HistoryIntervalDay, output.Interval)
+		require.Len(t, output.Points, 4)
+		assert.Equal(t, "2026-03-01", output.Points[0].Date)
+		assert.Equal(t, "1100.00", output.Points[0].Balance.String())
+		assert.Equal(t, "100.00", output.Points[0].Change.String())
+		assert.Equal(t, "1100.00", output.Points[1].Balance.String())
+		assert.Equal(t, "0.00", output.Points[1].Change.String())
+		assert.Equal(t, "1080.00", output.Points[2].Balance.String())
+		assert.Equal(t, "1080.00", output.Points[3].Balance.String())
+	})
+
+	t.Run("monthly buckets", func(t *testing.T) {
+		mockRepo := new(MockHistoryRepository)
+		mockBankrollRepo := new(MockBankrollRepository)
+		service := NewHistoryService(mockRepo, mockBankrollRepo, slog.Default())
+
+		bankroll := &Bankroll{ID: 1, UserID: 1, Currency: CurrencyUSD, CurrentBalance: domain.MustParseDecimal("500.00")}
+		mockBankrollRepo.On("FindByID", mock.Anything, uint(1), uint(1)).Return(bankroll, nil).Once()
+		mockRepo.On("ListEntries", mock.Anything, uint(1), time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)).Return([]HistoryEntry{
+			historyEntry("2026-02-14T20:00:00Z", "200.00"),
+		}, nil).Once()
+
+		output, err := service.GetBalanceHistory(context.Background(), 1, 1, BalanceHistoryInput{
+			From: "2026-01-15", To: "2026-03-31", Interval: HistoryIntervalMonth,
+		})
+
+		require.NoError(t, err)
+		require.Len(t, output.Points, 3)
+		assert.Equal(t, "300.00", output.Points[0].Balance.String())
+		assert.Equal(t, "2026-02-01", output.Points[1].Date)
+		assert.Equal(t, "500.00", output.Points[1].Balance.String())
+		assert.Equal(t, "500.00", output.Points[2].Balance.String())
+	})
+
+	t.Run("invalid interval", func(t *testing.T) {
+		service := NewHistoryService(new(MockHistoryRepository), new(MockBankrollRepository), slog.Default())
+
+		_, err := service.GetBalanceHistory(context.Background(), 1, 1, BalanceHistoryInput{Interval: "hour"})
+
+		assert.ErrorIs(t, err, ErrInvalidInterval)
+	})
+
+	t.Run("range too large", func(t *testing.T) {
+		mockBankrollRepo := new(MockBankrollRepository)
+		service := NewHistoryService(new(MockHistoryRepository), mockBankrollRepo, slog.Default())
+
+		mockBankrollRepo.On("FindByID", mock.Anything, uint(1), uint(1)).Return(&Bankroll{ID: 1, UserID: 1}, nil).Once()
+
+		_, err := service.GetBalanceHistory(context.Background(), 1, 1, BalanceHistoryInput{From: "2020-01-01", To: "2026-01-01"})
+
+		assert.ErrorIs(t, err, ErrValidationFailed)
+	})
+
+	t.Run("bankroll not found", func(t *testing.T) {
+		mockBankrollRepo := new(MockBankrollRepository)
+		service := NewHistoryService(new(MockHistoryRepository), mockBankrollRepo, slog.Default())
+
+		mockBankrollRepo.On("FindByID", mock.Anything, uint(1), uint(2)).Return(nil, ErrBankrollNotFound).Once()
+
+		_, err := service.GetBalanceHistory(context.Background(), 2, 1, BalanceHistoryInput{})
+
+		assert.ErrorIs(t, err, ErrBankrollNotFound)
+	})
+}
@@ -0,0 +1,101 @@
+package bankroll
+
+import (
+	"net/http"
+	"strconv"
+
+	"log/slog"
+
+	"github.com/gin-gonic/gin"
+)
+
+type LimitHandler struct {
+	service LimitService
+	logger  *slog.Logger
+}
+
+func NewLimitHandler(service LimitService, logger *slog.Logger) *LimitHandler {
+	return &LimitHandler{
+		service: service,
+		logger:  logger,
+	}
+}
+
+func (h *LimitHandler) GetLimits(c *gin.Context) {
+	userID, err := getUserID(c)
+	if err != nil {
+		handleError(c, h.logger, err)
+		return
+	}
+
+	bankrollIDStr := c.Param("bankrollId")
+	bankrollID, err := strconv.ParseUint(bankrollIDStr, 10, 32)
+	if err != nil {
+		handleError(c, h.logger, ErrUnauthorized)
+		return
+	}
+
+	output, err := h.service.GetLimits(c.Request.Context(), userID, uint(bankrollID))
+	if err != nil {
+		handleError(c, h.logger, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
+
+func (h *LimitHandler) SetLimits(c *gin.Context) {
+	var input LimitsInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		h.logger.Error("invalid request body", "error", err)
+		c.JSON(http.StatusBadRequest, ErrorOutput{
+			Error:   "Invalid request body",
+			Code:    "VALIDATION_ERROR",
+			Details: nil,
+		})
+		return
+	}
+
+	userID, err := getUserID(c)
+	if err != nil {
+		handleError(c, h.logger, err)
+		return
+	}
+
+	bankrollIDStr := c.Param("bankrollId")
+	bankrollID, err := strconv.ParseUint(bankrollIDStr, 10, 32)
+	if err != nil {
+		handleError(c, h.logger, ErrUnauthorized)
+		return
+	}
+
+	output, err := h.service.SetLimits(c.Request.Context(), userID, uint(bankrollID), input)
+	if err != nil {
+		handleError(c, h.logger, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
+
+func (h *LimitHandler) DeleteLimits(c *gin.Context) {
+	userID, err := getUserID(c)
+	if err != nil {
+		handleError(c, h.logger, err)
+		return
+	}
+
+	bankrollIDStr := c.Param("bankrollId")
+	bankrollID, err := strconv.ParseUint(bankrollIDStr, 10, 32)
+	if err != nil {
+		handleError(c, h.logger, ErrUnauthorized)
+		return
+	}
+
+	if err := h.service.DeleteLimits(c.Request.Context(), userID, uint(bankrollID)); err != nil {
+		handleError(c, h.logger, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
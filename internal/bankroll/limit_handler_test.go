@@ -0,0 +1,114 @@
+package bankroll
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"log/slog"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type MockLimitService struct {
+	MockLimitChecker
+}
+
+func (m *MockLimitService) GetLimits(ctx context.Context, userID uint, bankrollID uint) (*LimitsOutput, error) {
+	args := m.Called(ctx, userID, bankrollID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*LimitsOutput), args.Error(1)
+}
+
+func (m *MockLimitService) SetLimits(ctx context.Context, userID uint, bankrollID uint, input LimitsInput) (*LimitsOutput, error) {
+	args := m.Called(ctx, userID, bankrollID, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*LimitsOutput), args.Error(1)
+}
+
+func (m *MockLimitService) DeleteLimits(ctx context.Context, userID uint, bankrollID uint) error {
+	args := m.Called(ctx, userID, bankrollID)
+	return args.Error(0)
+}
+
+func TestSetLimitsHandler(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		mockService := new(MockLimitService)
+		handler := NewLimitHandler(mockService, slog.Default())
+
+		until := time.Date(2026, 3, 5, 3, 0, 0, 0, time.UTC)
+		mockService.On("SetLimits", mock.Anything, uint(1), uint(2), mock.MatchedBy(func(input LimitsInput) bool {
+			return input.DailyStopLoss.String() == "100"
+		})).Return(&LimitsOutput{
+			BankrollID:    2,
+			Timezone:      "America/Sao_Paulo",
+			DailyStopLoss: decimalPtr("100.00"),
+			Lock:          LimitLockOutput{Locked: true, Reason: LimitReasonDailyStopLoss, LockedUntil: &until},
+		}, nil).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = newRuleRequest(t, http.MethodPut, "/bankrolls/2/limits", `{"daily_stop_loss":"100"}`)
+		c.Params = gin.Params{{Key: "bankrollId", Value: "2"}}
+		c.Set("userID", "1")
+
+		handler.SetLimits(c)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		var response LimitsOutput
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.True(t, response.Lock.Locked)
+		assert.Equal(t, LimitReasonDailyStopLoss, response.Lock.Reason)
+		mockService.AssertExpectations(t)
+	})
+}
+
+func TestGetLimitsHandler(t *testing.T) {
+	t.Run("limits not found", func(t *testing.T) {
+		mockService := new(MockLimitService)
+		handler := NewLimitHandler(mockService, slog.Default())
+
+		mockService.On("GetLimits", mock.Anything, uint(1), uint(2)).Return(nil, ErrLimitsNotFound).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodGet, "/bankrolls/2/limits", nil)
+		c.Params = gin.Params{{Key: "bankrollId", Value: "2"}}
+		c.Set("userID", "1")
+
+		handler.GetLimits(c)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		var response ErrorOutput
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, "LIMITS_NOT_FOUND", response.Code)
+	})
+}
+
+func TestDeleteLimitsHandler(t *testing.T) {
+	mockService := new(MockLimitService)
+	handler := NewLimitHandler(mockService, slog.Default())
+
+	mockService.On("DeleteLimits", mock.Anything, uint(1), uint(2)).Return(nil).Once()
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodDelete, "/bankrolls/2/limits", nil)
+	c.Params = gin.Params{{Key: "bankrollId", Value: "2"}}
+	c.Set("userID", "1")
+
+	handler.DeleteLimits(c)
+
+	assert.Equal(t, http.StatusNoContent, c.Writer.Status())
+	mockService.AssertExpectations(t)
+}
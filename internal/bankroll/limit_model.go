@@ -0,0 +1,118 @@
+package bankroll
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
+)
+
+type LimitPeriod string
+
+const (
+	LimitPeriodDaily  LimitPeriod = "daily"
+	LimitPeriodWeekly LimitPeriod = "weekly"
+)
+
+// Start returns the beginning of the period containing at, in loc. Weeks
+// start on Monday.
+func (p LimitPeriod) Start(at time.Time, loc *time.Location) time.Time {
+	local := at.In(loc)
+	start := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, loc)
+	if p == LimitPeriodWeekly {
+		start = start.AddDate(0, 0, -((int(start.Weekday()) + 6) % 7))
+	}
+	return start
+}
+
+// End returns the beginning of the period that follows the one starting at
+// start.
+func (p LimitPeriod) End(start time.Time) time.Time {
+	if p == LimitPeriodWeekly {
+		return start.AddDate(0, 0, 7)
+	}
+	return start.AddDate(0, 0, 1)
+}
+
+type LimitReason string
+
+const (
+	LimitReasonDailyStopLoss  LimitReason = "daily_stop_loss"
+	LimitReasonDailyStopWin   LimitReason = "daily_stop_win"
+	LimitReasonWeeklyStopLoss LimitReason = "weekly_stop_loss"
+	LimitReasonWeeklyStopWin  LimitReason = "weekly_stop_win"
+)
+
+// BankrollLimits holds the stop-loss and stop-win thresholds of a bankroll.
+// A nil threshold is not enforced. Periods are counted in the bankroll's time
+// zone.
+type BankrollLimits struct {
+	ID             uint            `gorm:"primaryKey;autoIncrement"`
+	BankrollID     uint            `gorm:"not null;uniqueIndex"`
+	UserID         uint            `gorm:"not null;index"`
+	DailyStopLoss  *domain.Decimal `gorm:"type:decimal(27,8)"`
+	DailyStopWin   *domain.Decimal `gorm:"type:decimal(27,8)"`
+	WeeklyStopLoss *domain.Decimal `gorm:"type:decimal(27,8)"`
+	WeeklyStopWin  *domain.Decimal `gorm:"type:decimal(27,8)"`
+	CreatedAt      time.Time       `gorm:"autoCreateTime"`
+	UpdatedAt      time.Time       `gorm:"autoUpdateTime"`
+}
+
+func (BankrollLimits) TableName() string {
+	return "bankroll_limits"
+}
+
+// BankrollLimitLock keeps the lock that was in force when the limits were
+// changed or removed, so the bankroll stays locked until the period that
+// triggered it ends.
+type BankrollLimitLock struct {
+	ID          uint           `gorm:"primaryKey;autoIncrement"`
+	BankrollID  uint           `gorm:"not null;uniqueIndex"`
+	UserID      uint           `gorm:"not null;index"`
+	Reason      LimitReason    `gorm:"type:varchar(30);not null"`
+	Threshold   domain.Decimal `gorm:"type:decimal(27,8);not null"`
+	Result      domain.Decimal `gorm:"type:decimal(27,8);not null"`
+	LockedUntil time.Time      `gorm:"not null"`
+	CreatedAt   time.Time      `gorm:"autoCreateTime"`
+	UpdatedAt   time.Time      `gorm:"autoUpdateTime"`
+}
+
+func (BankrollLimitLock) TableName() string {
+	return "bankroll_limit_locks"
+}
+
+func (l *BankrollLimitLock) output() LimitLockOutput {
+	threshold, result, until := l.Threshold, l.Result, l.LockedUntil
+	return LimitLockOutput{
+		Locked:      true,
+		Reason:      l.Reason,
+		Threshold:   &threshold,
+		Result:      &result,
+		LockedUntil: &until,
+	}
+}
+
+// longerLock returns whichever of the two locks lifts last.
+func longerLock(a, b LimitLockOutput) LimitLockOutput {
+	if !b.Locked {
+		return a
+	}
+	if !a.Locked || b.LockedUntil.After(*a.LockedUntil) {
+		return b
+	}
+	return a
+}
+
+// LimitReachedError is returned when a stop limit locks the bankroll. It
+// unwraps to ErrLimitReached.
+type LimitReachedError struct {
+	Lock LimitLockOutput
+}
+
+func (e *LimitReachedError) Error() string {
+	return fmt.Sprintf("%s: %s until %s", ErrLimitReached, e.Lock.Reason, e.Lock.LockedUntil.Format(time.RFC3339))
+}
+
+func (e *LimitReachedError) Unwrap() error {
+	return ErrLimitReached
+}
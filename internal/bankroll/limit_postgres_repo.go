@@ -0,0 +1,110 @@
+package bankroll
+
+import (
+	"context"
+	"time"
+
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type postgresLimitRepository struct {
+	db *gorm.DB
+}
+
+func NewPostgresLimitRepository(db *gorm.DB) LimitRepository {
+	return &postgresLimitRepository{
+		db: db,
+	}
+}
+
+func (r *postgresLimitRepository) FindByBankrollID(ctx context.Context, bankrollID uint, userID uint) (*BankrollLimits, error) {
+	var limits BankrollLimits
+	err := r.db.WithContext(ctx).
+		Where("bankroll_id = ? AND user_id = ?", bankrollID, userID).
+		First(&limits).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, ErrLimitsNotFound
+		}
+		return nil, WrapError(ErrDatabaseError, err.Error())
+	}
+	return &limits, nil
+}
+
+func (r *postgresLimitRepository) ListByBankrollIDs(ctx context.Context, bankrollIDs []uint) ([]*BankrollLimits, error) {
+	var limits []*BankrollLimits
+	if len(bankrollIDs) == 0 {
+		return limits, nil
+	}
+	err := r.db.WithContext(ctx).
+		Where("bankroll_id IN ?", bankrollIDs).
+		Find(&limits).Error
+	if err != nil {
+		return nil, WrapError(ErrDatabaseError, err.Error())
+	}
+	return limits, nil
+}
+
+// Upsert replaces the bankroll's limits, creating the row on first use.
+func (r *postgresLimitRepository) Upsert(ctx context.Context, limits *BankrollLimits) error {
+	err := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "bankroll_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{
+			"daily_stop_loss", "daily_stop_win", "weekly_stop_loss", "weekly_stop_win", "updated_at",
+		}),
+	}).Create(limits).Error
+	if err != nil {
+		return WrapError(ErrDatabaseError, err.Error())
+	}
+	return nil
+}
+
+func (r *postgresLimitRepository) Delete(ctx context.Context, bankrollID uint, userID uint) error {
+	result := r.db.WithContext(ctx).
+		Where("bankroll_id = ? AND user_id = ?", bankrollID, userID).
+		Delete(&BankrollLimits{})
+	if result.Error != nil {
+		return WrapError(ErrDatabaseError, result.Error.Error())
+	}
+	if result.RowsAffected == 0 {
+		return ErrLimitsNotFound
+	}
+	return nil
+}
+
+// KeepLock stores the lock in force before the limits change, replacing the
+// one kept earlier.
+func (r *postgresLimitRepository) KeepLock(ctx context.Context, lock *BankrollLimitLock) error {
+	err := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "bankroll_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{
+			"reason", "threshold", "result", "locked_until", "updated_at",
+		}),
+	}).Create(lock).Error
+	if err != nil {
+		return WrapError(ErrDatabaseError, err.Error())
+	}
+	return nil
+}
+
+// ListLocks returns the kept locks of the bankrolls that have not lifted at
+// at.
+func (r *postgresLimitRepository) ListLocks(ctx context.Context, bankrollIDs []uint, at time.Time) ([]*BankrollLimitLock, error) {
+	var locks []*BankrollLimitLock
+	if len(bankrollIDs) == 0 {
+		return locks, nil
+	}
+	err := r.db.WithContext(ctx).
+		Where("bankroll_id IN ? AND locked_until > ?", bankrollIDs, at).
+		Find(&locks).Error
+	if err != nil {
+		return nil, WrapError(ErrDatabaseError, err.Error())
+	}
+	return locks, nil
+}
+
+func (r *postgresLimitRepository) RealizedResult(ctx context.Context, bankrollID uint, since time.Time) (domain.Decimal, error) {
+	return realizedResult(r.db.WithContext(ctx), bankrollID, since)
+}
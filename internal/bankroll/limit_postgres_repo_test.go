@@ -0,0 +1,113 @@
+package bankroll
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostgresLimitRepository_UpsertFindDelete(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewPostgresLimitRepository(db)
+	ctx := context.Background()
+	bankroll := createTestBankroll(t, db, 1, "1000.00")
+
+	_, err := repo.FindByBankrollID(ctx, bankroll.ID, 1)
+	assert.ErrorIs(t, err, ErrLimitsNotFound)
+
+	require.NoError(t, repo.Upsert(ctx, &BankrollLimits{
+		BankrollID:    bankroll.ID,
+		UserID:        1,
+		DailyStopLoss: decimalPtr("100.00"),
+	}))
+	require.NoError(t, repo.Upsert(ctx, &BankrollLimits{
+		BankrollID:    bankroll.ID,
+		UserID:        1,
+		WeeklyStopWin: decimalPtr("500.00"),
+	}))
+
+	found, err := repo.FindByBankrollID(ctx, bankroll.ID, 1)
+	require.NoError(t, err)
+	assert.Nil(t, found.DailyStopLoss, "upsert replaces every threshold")
+	require.NotNil(t, found.WeeklyStopWin)
+	assert.Equal(t, 500.0, found.WeeklyStopWin.Float64())
+
+	_, err = repo.FindByBankrollID(ctx, bankroll.ID, 2)
+	assert.ErrorIs(t, err, ErrLimitsNotFound)
+
+	all, err := repo.ListByBankrollIDs(ctx, []uint{bankroll.ID, bankroll.ID + 1})
+	require.NoError(t, err)
+	assert.Len(t, all, 1)
+
+	assert.ErrorIs(t, repo.Delete(ctx, bankroll.ID, 2), ErrLimitsNotFound)
+	require.NoError(t, repo.Delete(ctx, bankroll.ID, 1))
+	_, err = repo.FindByBankrollID(ctx, bankroll.ID, 1)
+	assert.ErrorIs(t, err, ErrLimitsNotFound)
+}
+
+func TestLimitService_AdjustmentDoesNotLiftLock(t *testing.T) {
+	db := setupTestDB(t)
+	require.NoError(t, db.AutoMigrate(&ruleTestSession{}))
+	bankrollRepo := NewPostgresBankrollRepository(db)
+	repo := NewPostgresLimitRepository(db)
+	service := NewLimitService(repo, bankrollRepo, slog.Default())
+	ctx := context.Background()
+	bankroll := createTestBankroll(t, db, 1, "1000.00")
+
+	require.NoError(t, repo.Upsert(ctx, &BankrollLimits{
+		BankrollID:    bankroll.ID,
+		UserID:        1,
+		DailyStopLoss: decimalPtr("100.00"),
+	}))
+	postHistoryEntry(t, db, bankroll, &Transaction{Type: TransactionTypeBetSettlement, Amount: domain.MustParseDecimal("-150.00")})
+
+	lock, err := service.LockStatus(ctx, bankroll, time.Now())
+	require.NoError(t, err)
+	require.True(t, lock.Locked)
+
+	postHistoryEntry(t, db, bankroll, &Transaction{Type: TransactionTypeAdjustment, Amount: domain.MustParseDecimal("200.00")})
+
+	lock, err = service.LockStatus(ctx, bankroll, time.Now())
+	require.NoError(t, err)
+	assert.True(t, lock.Locked, "an adjustment is not play and leaves the lock in place")
+	assert.Equal(t, LimitReasonDailyStopLoss, lock.Reason)
+	assert.Equal(t, "-150.00", lock.Result.Round(2).String())
+}
+
+func TestLimitService_ChangingLimitsKeepsLock(t *testing.T) {
+	db := setupTestDB(t)
+	require.NoError(t, db.AutoMigrate(&ruleTestSession{}))
+	repo := NewPostgresLimitRepository(db)
+	service := NewLimitService(repo, NewPostgresBankrollRepository(db), slog.Default())
+	ctx := context.Background()
+	bankroll := createTestBankroll(t, db, 1, "1000.00")
+
+	_, err := service.SetLimits(ctx, 1, bankroll.ID, LimitsInput{DailyStopLoss: decimalPtr("100.00")})
+	require.NoError(t, err)
+	postHistoryEntry(t, db, bankroll, &Transaction{Type: TransactionTypeBetSettlement, Amount: domain.MustParseDecimal("-150.00")})
+
+	output, err := service.SetLimits(ctx, 1, bankroll.ID, LimitsInput{DailyStopLoss: decimalPtr("500.00")})
+	require.NoError(t, err)
+	assert.True(t, output.Lock.Locked, "raising the threshold does not lift the lock")
+	assert.Equal(t, LimitReasonDailyStopLoss, output.Lock.Reason)
+
+	require.NoError(t, service.DeleteLimits(ctx, 1, bankroll.ID))
+
+	lock, err := service.LockStatus(ctx, bankroll, time.Now())
+	require.NoError(t, err)
+	require.True(t, lock.Locked, "removing the limits does not lift the lock")
+	assert.Equal(t, 100.0, lock.Threshold.Float64())
+
+	lock, err = service.LockStatus(ctx, bankroll, *lock.LockedUntil)
+	require.NoError(t, err)
+	assert.False(t, lock.Locked, "the lock lifts when its period ends")
+
+	locks, err := service.LockStatuses(ctx, []*Bankroll{bankroll}, time.Now())
+	require.NoError(t, err)
+	assert.True(t, locks[bankroll.ID].Locked)
+}
@@ -0,0 +1,18 @@
+package bankroll
+
+import (
+	"context"
+	"time"
+
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
+)
+
+type LimitRepository interface {
+	FindByBankrollID(ctx context.Context, bankrollID uint, userID uint) (*BankrollLimits, error)
+	ListByBankrollIDs(ctx context.Context, bankrollIDs []uint) ([]*BankrollLimits, error)
+	Upsert(ctx context.Context, limits *BankrollLimits) error
+	Delete(ctx context.Context, bankrollID uint, userID uint) error
+	KeepLock(ctx context.Context, lock *BankrollLimitLock) error
+	ListLocks(ctx context.Context, bankrollIDs []uint, at time.Time) ([]*BankrollLimitLock, error)
+	RealizedResult(ctx context.Context, bankrollID uint, since time.Time) (domain.Decimal, error)
+}
@@ -0,0 +1,303 @@
+package bankroll
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
+)
+
+type LimitService interface {
+	GetLimits(ctx context.Context, userID uint, bankrollID uint) (*LimitsOutput, error)
+	SetLimits(ctx context.Context, userID uint, bankrollID uint, input LimitsInput) (*LimitsOutput, error)
+	DeleteLimits(ctx context.Context, userID uint, bankrollID uint) error
+	LimitChecker
+}
+
+// LimitChecker reports whether a stop limit locks a bankroll at a given
+// moment. A lock in force when the limits were changed or removed holds until
+// its period ends; otherwise bankrolls without limits are never locked.
+type LimitChecker interface {
+	LockStatus(ctx context.Context, bankroll *Bankroll, at time.Time) (*LimitLockOutput, error)
+	LockStatuses(ctx context.Context, bankrolls []*Bankroll, at time.Time) (map[uint]*LimitLockOutput, error)
+}
+
+type limitService struct {
+	repo         LimitRepository
+	bankrollRepo BankrollRepository
+	logger       *slog.Logger
+}
+
+func NewLimitService(repo LimitRepository, bankrollRepo BankrollRepository, logger *slog.Logger) LimitService {
+	return &limitService{
+		repo:         repo,
+		bankrollRepo: bankrollRepo,
+		logger:       logger,
+	}
+}
+
+func (s *limitService) GetLimits(ctx context.Context, userID uint, bankrollID uint) (*LimitsOutput, error) {
+	bankroll, err := s.bankrollRepo.FindByID(ctx, bankrollID, userID)
+	if err != nil {
+		s.logger.Error("bankroll not found", "error", err, "user_id", userID, "bankroll_id", bankrollID)
+		return nil, err
+	}
+
+	limits, err := s.repo.FindByBankrollID(ctx, bankrollID, userID)
+	if err != nil {
+		s.logger.Error("failed to get limits", "error", err, "user_id", userID, "bankroll_id", bankrollID)
+		return nil, err
+	}
+
+	return s.toLimitsOutput(ctx, bankroll, limits)
+}
+
+func (s *limitService) SetLimits(ctx context.Context, userID uint, bankrollID uint, input LimitsInput) (*LimitsOutput, error) {
+	bankroll, err := s.bankrollRepo.FindByID(ctx, bankrollID, userID)
+	if err != nil {
+		s.logger.Error("bankroll not found", "error", err, "user_id", userID, "bankroll_id", bankrollID)
+		return nil, err
+	}
+
+	thresholds := []*domain.Decimal{input.DailyStopLoss, input.DailyStopWin, input.WeeklyStopLoss, input.WeeklyStopWin}
+	set := 0
+	for _, threshold := range thresholds {
+		if threshold == nil {
+			continue
+		}
+		if !threshold.IsPositive() {
+			s.logger.Error("invalid stop limit", "threshold", *threshold, "user_id", userID, "bankroll_id", bankrollID)
+			return nil, ErrInvalidLimit
+		}
+		if !bankroll.Currency.Fits(*threshold) {
+			s.logger.Error("stop limit exceeds currency precision", "threshold", *threshold, "currency", bankroll.Currency, "user_id", userID)
+			return nil, ErrInvalidPrecision
+		}
+		set++
+	}
+	if set == 0 {
+		s.logger.Error("no stop limit set", "user_id", userID, "bankroll_id", bankrollID)
+		return nil, WrapError(ErrInvalidLimit, "at least one stop limit is required")
+	}
+
+	limits := &BankrollLimits{
+		BankrollID:     bankrollID,
+		UserID:         userID,
+		DailyStopLoss:  input.DailyStopLoss,
+		DailyStopWin:   input.DailyStopWin,
+		WeeklyStopLoss: input.WeeklyStopLoss,
+		WeeklyStopWin:  input.WeeklyStopWin,
+	}
+	if err := s.keepLock(ctx, bankroll, time.Now()); err != nil {
+		s.logger.Error("failed to keep limit lock", "error", err, "user_id", userID, "bankroll_id", bankrollID)
+		return nil, err
+	}
+	if err := s.repo.Upsert(ctx, limits); err != nil {
+		s.logger.Error("failed to save limits", "error", err, "user_id", userID, "bankroll_id", bankrollID)
+		return nil, err
+	}
+
+	s.logger.Info("limits set", "user_id", userID, "bankroll_id", bankrollID)
+
+	return s.toLimitsOutput(ctx, bankroll, limits)
+}
+
+func (s *limitService) DeleteLimits(ctx context.Context, userID uint, bankrollID uint) error {
+	bankroll, err := s.bankrollRepo.FindByID(ctx, bankrollID, userID)
+	if err != nil {
+		s.logger.Error("bankroll not found", "error", err, "user_id", userID, "bankroll_id", bankrollID)
+		return err
+	}
+
+	if err := s.keepLock(ctx, bankroll, time.Now()); err != nil {
+		s.logger.Error("failed to keep limit lock", "error", err, "user_id", userID, "bankroll_id", bankrollID)
+		return err
+	}
+	if err := s.repo.Delete(ctx, bankrollID, userID); err != nil {
+		s.logger.Error("failed to delete limits", "error", err, "user_id", userID, "bankroll_id", bankrollID)
+		return err
+	}
+
+	s.logger.Info("limits deleted", "user_id", userID, "bankroll_id", bankrollID)
+
+	return nil
+}
+
+func (s *limitService) LockStatus(ctx context.Context, bankroll *Bankroll, at time.Time) (*LimitLockOutput, error) {
+	var lock LimitLockOutput
+	limits, err := s.repo.FindByBankrollID(ctx, bankroll.ID, bankroll.UserID)
+	switch {
+	case err == nil:
+		state, err := s.evaluate(ctx, bankroll, limits, at)
+		if err != nil {
+			return nil, err
+		}
+		lock = state.lock
+	case !errors.Is(err, ErrLimitsNotFound):
+		return nil, err
+	}
+
+	return s.withKeptLock(ctx, bankroll.ID, lock, at)
+}
+
+// keepLock stores the lock in force at at before the limits are changed or
+// removed, so the new limits cannot lift it early.
+func (s *limitService) keepLock(ctx context.Context, bankroll *Bankroll, at time.Time) error {
+	lock, err := s.LockStatus(ctx, bankroll, at)
+	if err != nil {
+		return err
+	}
+	if !lock.Locked {
+		return nil
+	}
+	return s.repo.KeepLock(ctx, &BankrollLimitLock{
+		BankrollID:  bankroll.ID,
+		UserID:      bankroll.UserID,
+		Reason:      lock.Reason,
+		Threshold:   *lock.Threshold,
+		Result:      *lock.Result,
+		LockedUntil: *lock.LockedUntil,
+	})
+}
+
+// withKeptLock extends lock with the one kept from earlier limits when that
+// one lifts later.
+func (s *limitService) withKeptLock(ctx context.Context, bankrollID uint, lock LimitLockOutput, at time.Time) (*LimitLockOutput, error) {
+	kept, err := s.repo.ListLocks(ctx, []uint{bankrollID}, at)
+	if err != nil {
+		return nil, err
+	}
+	for _, k := range kept {
+		lock = longerLock(lock, k.output())
+	}
+	return &lock, nil
+}
+
+// LockStatuses loads the limits and kept locks of all the bankrolls at once
+// and only queries results for the ones that have limits.
+func (s *limitService) LockStatuses(ctx context.Context, bankrolls []*Bankroll, at time.Time) (map[uint]*LimitLockOutput, error) {
+	ids := make([]uint, len(bankrolls))
+	for i, b := range bankrolls {
+		ids[i] = b.ID
+	}
+	all, err := s.repo.ListByBankrollIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	byBankroll := make(map[uint]*BankrollLimits, len(all))
+	for _, limits := range all {
+		byBankroll[limits.BankrollID] = limits
+	}
+
+	statuses := make(map[uint]*LimitLockOutput, len(bankrolls))
+	for _, b := range bankrolls {
+		limits, ok := byBankroll[b.ID]
+		if !ok {
+			statuses[b.ID] = &LimitLockOutput{}
+			continue
+		}
+		state, err := s.evaluate(ctx, b, limits, at)
+		if err != nil {
+			return nil, err
+		}
+		statuses[b.ID] = &state.lock
+	}
+
+	kept, err := s.repo.ListLocks(ctx, ids, at)
+	if err != nil {
+		return nil, err
+	}
+	for _, k := range kept {
+		lock := longerLock(*statuses[k.BankrollID], k.output())
+		statuses[k.BankrollID] = &lock
+	}
+	return statuses, nil
+}
+
+type limitState struct {
+	dailyResult  domain.Decimal
+	weeklyResult domain.Decimal
+	lock         LimitLockOutput
+}
+
+// evaluate computes the realized result of the current day and week and, when
+// several thresholds are crossed, reports the lock that lasts longest.
+func (s *limitService) evaluate(ctx context.Context, bankroll *Bankroll, limits *BankrollLimits, at time.Time) (*limitState, error) {
+	loc := bankroll.Location()
+	state := &limitState{dailyResult: domain.Zero, weeklyResult: domain.Zero}
+
+	periods := []struct {
+		period   LimitPeriod
+		stopLoss *domain.Decimal
+		stopWin  *domain.Decimal
+		loss     LimitReason
+		win      LimitReason
+		result   *domain.Decimal
+	}{
+		{LimitPeriodDaily, limits.DailyStopLoss, limits.DailyStopWin, LimitReasonDailyStopLoss, LimitReasonDailyStopWin, &state.dailyResult},
+		{LimitPeriodWeekly, limits.WeeklyStopLoss, limits.WeeklyStopWin, LimitReasonWeeklyStopLoss, LimitReasonWeeklyStopWin, &state.weeklyResult},
+	}
+	for _, p := range periods {
+		if p.stopLoss == nil && p.stopWin == nil {
+			continue
+		}
+		start := p.period.Start(at, loc)
+		result, err := s.repo.RealizedResult(ctx, limits.BankrollID, start)
+		if err != nil {
+			return nil, err
+		}
+		*p.result = result
+
+		var reason LimitReason
+		var threshold domain.Decimal
+		switch {
+		case p.stopLoss != nil && result.Neg().GreaterThanOrEqual(*p.stopLoss):
+			reason, threshold = p.loss, *p.stopLoss
+		case p.stopWin != nil && result.GreaterThanOrEqual(*p.stopWin):
+			reason, threshold = p.win, *p.stopWin
+		default:
+			continue
+		}
+		until := p.period.End(start).UTC()
+		if state.lock.Locked && !until.After(*state.lock.LockedUntil) {
+			continue
+		}
+		state.lock = LimitLockOutput{
+			Locked:      true,
+			Reason:      reason,
+			Threshold:   &threshold,
+			Result:      &result,
+			LockedUntil: &until,
+		}
+	}
+	return state, nil
+}
+
+func (s *limitService) toLimitsOutput(ctx context.Context, bankroll *Bankroll, limits *BankrollLimits) (*LimitsOutput, error) {
+	now := time.Now()
+	state, err := s.evaluate(ctx, bankroll, limits, now)
+	if err != nil {
+		s.logger.Error("failed to evaluate limits", "error", err, "bankroll_id", limits.BankrollID)
+		return nil, err
+	}
+	lock, err := s.withKeptLock(ctx, bankroll.ID, state.lock, now)
+	if err != nil {
+		s.logger.Error("failed to load kept limit lock", "error", err, "bankroll_id", limits.BankrollID)
+		return nil, err
+	}
+
+	return &LimitsOutput{
+		BankrollID:     limits.BankrollID,
+		Timezone:       bankroll.Location().String(),
+		DailyStopLoss:  limits.DailyStopLoss,
+		DailyStopWin:   limits.DailyStopWin,
+		WeeklyStopLoss: limits.WeeklyStopLoss,
+		WeeklyStopWin:  limits.WeeklyStopWin,
+		DailyResult:    state.dailyResult,
+		WeeklyResult:   state.weeklyResult,
+		Lock:           *lock,
+		UpdatedAt:      limits.UpdatedAt,
+	}, nil
+}
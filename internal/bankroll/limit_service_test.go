@@ -0,0 +1,280 @@
+package bankroll
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"log/slog"
+
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type MockLimitRepository struct {
+	mock.Mock
+}
+
+// newMockLimitRepository returns a repository that keeps no locks.
+func newMockLimitRepository() *MockLimitRepository {
+	repo := new(MockLimitRepository)
+	repo.On("ListLocks", mock.Anything, mock.Anything, mock.Anything).Return([]*BankrollLimitLock{}, nil).Maybe()
+	return repo
+}
+
+func (m *MockLimitRepository) FindByBankrollID(ctx context.Context, bankrollID uint, userID uint) (*BankrollLimits, error) {
+	args := m.Called(ctx, bankrollID, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*BankrollLimits), args.Error(1)
+}
+
+func (m *MockLimitRepository) ListByBankrollIDs(ctx context.Context, bankrollIDs []uint) ([]*BankrollLimits, error) {
+	args := m.Called(ctx, bankrollIDs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*BankrollLimits), args.Error(1)
+}
+
+func (m *MockLimitRepository) Upsert(ctx context.Context, limits *BankrollLimits) error {
+	args := m.Called(ctx, limits)
+	return args.Error(0)
+}
+
+func (m *MockLimitRepository) Delete(ctx context.Context, bankrollID uint, userID uint) error {
+	args := m.Called(ctx, bankrollID, userID)
+	return args.Error(0)
+}
+
+func (m *MockLimitRepository) KeepLock(ctx context.Context, lock *BankrollLimitLock) error {
+	args := m.Called(ctx, lock)
+	return args.Error(0)
+}
+
+func (m *MockLimitRepository) ListLocks(ctx context.Context, bankrollIDs []uint, at time.Time) ([]*BankrollLimitLock, error) {
+	args := m.Called(ctx, bankrollIDs, at)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*BankrollLimitLock), args.Error(1)
+}
+
+func (m *MockLimitRepository) RealizedResult(ctx context.Context, bankrollID uint, since time.Time) (domain.Decimal, error) {
+	args := m.Called(ctx, bankrollID, since)
+	return args.Get(0).(domain.Decimal), args.Error(1)
+}
+
+type MockLimitChecker struct {
+	mock.Mock
+}
+
+func (m *MockLimitChecker) LockStatus(ctx context.Context, bankroll *Bankroll, at time.Time) (*LimitLockOutput, error) {
+	args := m.Called(ctx, bankroll, at)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*LimitLockOutput), args.Error(1)
+}
+
+func (m *MockLimitChecker) LockStatuses(ctx context.Context, bankrolls []*Bankroll, at time.Time) (map[uint]*LimitLockOutput, error) {
+	args := m.Called(ctx, bankrolls, at)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[uint]*LimitLockOutput), args.Error(1)
+}
+
+// noLimits returns a checker under which no bankroll is ever locked.
+func noLimits() *MockLimitChecker {
+	checker := new(MockLimitChecker)
+	checker.On("LockStatus", mock.Anything, mock.Anything, mock.Anything).Return(&LimitLockOutput{}, nil).Maybe()
+	checker.On("LockStatuses", mock.Anything, mock.Anything, mock.Anything).Return(map[uint]*LimitLockOutput{}, nil).Maybe()
+	return checker
+}
+
+func decimalPtr(value string) *domain.Decimal {
+	d := domain.MustParseDecimal(value)
+	return &d
+}
+
+func TestLimitPeriodStart(t *testing.T) {
+	saoPaulo, err := time.LoadLocation("America/Sao_Paulo")
+	require.NoError(t, err)
+
+	// Monday 01:00 UTC is still Sunday evening in São Paulo.
+	at := time.Date(2026, 3, 2, 1, 0, 0, 0, time.UTC)
+
+	daily := LimitPeriodDaily.Start(at, saoPaulo)
+	assert.True(t, daily.Equal(time.Date(2026, 3, 1, 3, 0, 0, 0, time.UTC)))
+	assert.True(t, LimitPeriodDaily.End(daily).Equal(time.Date(2026, 3, 2, 3, 0, 0, 0, time.UTC)))
+
+	weekly := LimitPeriodWeekly.Start(at, saoPaulo)
+	assert.True(t, weekly.Equal(time.Date(2026, 2, 23, 3, 0, 0, 0, time.UTC)))
+	assert.True(t, LimitPeriodWeekly.End(weekly).Equal(time.Date(2026, 3, 2, 3, 0, 0, 0, time.UTC)))
+
+	assert.True(t, LimitPeriodWeekly.Start(at, time.UTC).Equal(time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)))
+}
+
+func TestSetLimits(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		mockRepo := newMockLimitRepository()
+		mockBankrollRepo := new(MockBankrollRepository)
+		service := NewLimitService(mockRepo, mockBankrollRepo, slog.Default())
+
+		mockBankrollRepo.On("FindByID", mock.Anything, uint(1), uint(1)).Return(ruleTestBankroll(), nil).Once()
+		mockRepo.On("FindByBankrollID", mock.Anything, uint(1), uint(1)).Return(nil, ErrLimitsNotFound).Once()
+		mockRepo.On("Upsert", mock.Anything, mock.MatchedBy(func(l *BankrollLimits) bool {
+			return l.DailyStopLoss.String() == "100.00" && l.WeeklyStopWin == nil
+		})).Return(nil).Once()
+		mockRepo.On("RealizedResult", mock.Anything, uint(1), mock.AnythingOfType("time.Time")).
+			Return(domain.MustParseDecimal("-40.00"), nil).Once()
+
+		output, err := service.SetLimits(context.Background(), 1, 1, LimitsInput{DailyStopLoss: decimalPtr("100.00")})
+
+		require.NoError(t, err)
+		assert.Equal(t, "UTC", output.Timezone)
+		assert.Equal(t, "-40.00", output.DailyResult.String())
+		assert.False(t, output.Lock.Locked)
+		mockRepo.AssertExpectations(t)
+	})
+
+	tests := []struct {
+		name  string
+		input LimitsInput
+		err   error
+	}{
+		{"non positive threshold", LimitsInput{WeeklyStopWin: decimalPtr("0")}, ErrInvalidLimit},
+		{"no threshold", LimitsInput{}, ErrInvalidLimit},
+		{"exceeds currency precision", LimitsInput{DailyStopWin: decimalPtr("10.001")}, ErrInvalidPrecision},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := newMockLimitRepository()
+			mockBankrollRepo := new(MockBankrollRepository)
+			service := NewLimitService(mockRepo, mockBankrollRepo, slog.Default())
+
+			mockBankrollRepo.On("FindByID", mock.Anything, uint(1), uint(1)).Return(ruleTestBankroll(), nil).Once()
+
+			_, err := service.SetLimits(context.Background(), 1, 1, tt.input)
+
+			assert.ErrorIs(t, err, tt.err)
+			mockRepo.AssertNotCalled(t, "Upsert", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestLockStatus(t *testing.T) {
+	now := time.Date(2026, 3, 4, 15, 0, 0, 0, time.UTC)
+	dayStart := time.Date(2026, 3, 4, 0, 0, 0, 0, time.UTC)
+	weekStart := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+
+	t.Run("no limits", func(t *testing.T) {
+		mockRepo := newMockLimitRepository()
+		service := NewLimitService(mockRepo, new(MockBankrollRepository), slog.Default())
+
+		mockRepo.On("FindByBankrollID", mock.Anything, uint(1), uint(1)).Return(nil, ErrLimitsNotFound).Once()
+
+		lock, err := service.LockStatus(context.Background(), ruleTestBankroll(), now)
+
+		require.NoError(t, err)
+		assert.False(t, lock.Locked)
+	})
+
+	t.Run("daily stop loss reached", func(t *testing.T) {
+		mockRepo := newMockLimitRepository()
+		service := NewLimitService(mockRepo, new(MockBankrollRepository), slog.Default())
+
+		limits := &BankrollLimits{BankrollID: 1, UserID: 1, DailyStopLoss: decimalPtr("100.00")}
+		mockRepo.On("FindByBankrollID", mock.Anything, uint(1), uint(1)).Return(limits, nil).Once()
+		mockRepo.On("RealizedResult", mock.Anything, uint(1), dayStart).Return(domain.MustParseDecimal("-100.00"), nil).Once()
+
+		lock, err := service.LockStatus(context.Background(), ruleTestBankroll(), now)
+
+		require.NoError(t, err)
+		assert.True(t, lock.Locked)
+		assert.Equal(t, LimitReasonDailyStopLoss, lock.Reason)
+		assert.Equal(t, "100.00", lock.Threshold.String())
+		assert.True(t, lock.LockedUntil.Equal(time.Date(2026, 3, 5, 0, 0, 0, 0, time.UTC)))
+	})
+
+	t.Run("stop win below threshold", func(t *testing.T) {
+		mockRepo := newMockLimitRepository()
+		service := NewLimitService(mockRepo, new(MockBankrollRepository), slog.Default())
+
+		limits := &BankrollLimits{BankrollID: 1, UserID: 1, DailyStopWin: decimalPtr("200.00")}
+		mockRepo.On("FindByBankrollID", mock.Anything, uint(1), uint(1)).Return(limits, nil).Once()
+		mockRepo.On("RealizedResult", mock.Anything, uint(1), dayStart).Return(domain.MustParseDecimal("199.99"), nil).Once()
+
+		lock, err := service.LockStatus(context.Background(), ruleTestBankroll(), now)
+
+		require.NoError(t, err)
+		assert.False(t, lock.Locked)
+	})
+
+	t.Run("weekly lock outlasts daily lock", func(t *testing.T) {
+		mockRepo := newMockLimitRepository()
+		service := NewLimitService(mockRepo, new(MockBankrollRepository), slog.Default())
+
+		limits := &BankrollLimits{
+			BankrollID:    1,
+			UserID:        1,
+			DailyStopWin:  decimalPtr("100.00"),
+			WeeklyStopWin: decimalPtr("300.00"),
+		}
+		mockRepo.On("FindByBankrollID", mock.Anything, uint(1), uint(1)).Return(limits, nil).Once()
+		mockRepo.On("RealizedResult", mock.Anything, uint(1), dayStart).Return(domain.MustParseDecimal("150.00"), nil).Once()
+		mockRepo.On("RealizedResult", mock.Anything, uint(1), weekStart).Return(domain.MustParseDecimal("320.00"), nil).Once()
+
+		lock, err := service.LockStatus(context.Background(), ruleTestBankroll(), now)
+
+		require.NoError(t, err)
+		assert.Equal(t, LimitReasonWeeklyStopWin, lock.Reason)
+		assert.True(t, lock.LockedUntil.Equal(time.Date(2026, 3, 9, 0, 0, 0, 0, time.UTC)))
+	})
+
+	t.Run("kept lock holds without limits", func(t *testing.T) {
+		mockRepo := new(MockLimitRepository)
+		service := NewLimitService(mockRepo, new(MockBankrollRepository), slog.Default())
+
+		kept := &BankrollLimitLock{
+			BankrollID:  1,
+			UserID:      1,
+			Reason:      LimitReasonWeeklyStopLoss,
+			Threshold:   domain.MustParseDecimal("300.00"),
+			Result:      domain.MustParseDecimal("-320.00"),
+			LockedUntil: time.Date(2026, 3, 9, 0, 0, 0, 0, time.UTC),
+		}
+		mockRepo.On("FindByBankrollID", mock.Anything, uint(1), uint(1)).Return(nil, ErrLimitsNotFound).Once()
+		mockRepo.On("ListLocks", mock.Anything, []uint{1}, now).Return([]*BankrollLimitLock{kept}, nil).Once()
+
+		lock, err := service.LockStatus(context.Background(), ruleTestBankroll(), now)
+
+		require.NoError(t, err)
+		assert.True(t, lock.Locked)
+		assert.Equal(t, LimitReasonWeeklyStopLoss, lock.Reason)
+		assert.True(t, lock.LockedUntil.Equal(kept.LockedUntil))
+	})
+}
+
+func TestLockStatuses(t *testing.T) {
+	mockRepo := newMockLimitRepository()
+	service := NewLimitService(mockRepo, new(MockBankrollRepository), slog.Default())
+	now := time.Date(2026, 3, 4, 15, 0, 0, 0, time.UTC)
+
+	bankrolls := []*Bankroll{{ID: 1, UserID: 1}, {ID: 2, UserID: 1}}
+	limits := &BankrollLimits{BankrollID: 2, UserID: 1, DailyStopLoss: decimalPtr("50.00")}
+	mockRepo.On("ListByBankrollIDs", mock.Anything, []uint{1, 2}).Return([]*BankrollLimits{limits}, nil).Once()
+	mockRepo.On("RealizedResult", mock.Anything, uint(2), time.Date(2026, 3, 4, 0, 0, 0, 0, time.UTC)).
+		Return(domain.MustParseDecimal("-75.00"), nil).Once()
+
+	locks, err := service.LockStatuses(context.Background(), bankrolls, now)
+
+	require.NoError(t, err)
+	assert.False(t, locks[1].Locked)
+	assert.True(t, locks[2].Locked)
+	mockRepo.AssertExpectations(t)
+}
@@ -2,28 +2,36 @@ package bankroll
 
 import (
 	"time"
+	// Embedded so user time zones load on hosts without a zoneinfo database.
+	_ "time/tzdata"
 
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
+	"github.com/opinedajr/micro-stakes-api/internal/shared/pagination"
 	"gorm.io/gorm"
 )
 
-type Currency string
+type Currency = domain.Currency
 
 const (
-	CurrencyBRL Currency = "BRL"
-	CurrencyUSD Currency = "USD"
-	CurrencyEUR Currency = "EUR"
-	CurrencyBTC Currency = "BTC"
+	CurrencyBRL = domain.CurrencyBRL
+	CurrencyUSD = domain.CurrencyUSD
+	CurrencyEUR = domain.CurrencyEUR
+	CurrencyBTC = domain.CurrencyBTC
 )
 
 type Bankroll struct {
 	ID                   uint           `gorm:"primaryKey;autoIncrement"`
 	UserID               uint           `gorm:"not null;index"`
 	Name                 string         `gorm:"type:varchar(100);not null"`
-	Currency             Currency       `gorm:"type:varchar(4);not null"`
-	InitialBalance       float64        `gorm:"type:decimal(19,4);not null"`
-	CurrentBalance       float64        `gorm:"type:decimal(19,4);not null"`
+	Currency             Currency       `gorm:"type:varchar(10);not null"`
+	InitialBalance       domain.Decimal `gorm:"type:decimal(27,8);not null"`
+	CurrentBalance       domain.Decimal `gorm:"type:decimal(27,8);not null"`
+	InPlay               domain.Decimal `gorm:"type:decimal(27,8);not null;default:0"`
 	StartDate            time.Time      `gorm:"type:date;not null"`
-	CommissionPercentage float64        `gorm:"type:decimal(5,2);not null"`
+	CommissionPercentage domain.Decimal `gorm:"type:decimal(5,2);not null"`
+	Timezone             string         `gorm:"type:varchar(64);not null;default:UTC"`
+	ArchivedAt           *time.Time     `gorm:"index"`
+	Version              uint           `gorm:"not null;default:1"`
 	CreatedAt            time.Time      `gorm:"autoCreateTime"`
 	UpdatedAt            time.Time      `gorm:"autoUpdateTime"`
 	DeletedAt            gorm.DeletedAt `gorm:"index"`
@@ -32,3 +40,92 @@ type Bankroll struct {
 func (Bankroll) TableName() string {
 	return "bankrolls"
 }
+
+func (b *Bankroll) IsArchived() bool {
+	return b.ArchivedAt != nil
+}
+
+// Location returns the time zone the bankroll counts its days, weeks and
+// goal and rakeback periods in, falling back to UTC for a zone that no
+// longer loads.
+func (b *Bankroll) Location() *time.Location {
+	if b.Timezone == "" {
+		return time.UTC
+	}
+	loc, err := time.LoadLocation(b.Timezone)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
+type BankrollSort string
+
+const (
+	BankrollSortName           BankrollSort = "name"
+	BankrollSortCreatedAt      BankrollSort = "created_at"
+	BankrollSortCurrentBalance BankrollSort = "current_balance"
+)
+
+func (s BankrollSort) IsValid() bool {
+	switch s {
+	case BankrollSortName, BankrollSortCreatedAt, BankrollSortCurrentBalance:
+		return true
+	}
+	return false
+}
+
+// CursorValue returns the bankroll's value for the sort column as stored in
+// a pagination cursor.
+func (s BankrollSort) CursorValue(bankroll *Bankroll) string {
+	switch s {
+	case BankrollSortName:
+		return bankroll.Name
+	case BankrollSortCurrentBalance:
+		return bankroll.CurrentBalance.String()
+	}
+	return bankroll.CreatedAt.UTC().Format(time.RFC3339Nano)
+}
+
+// ParseCursor converts a decoded cursor back into a typed keyset position.
+func (s BankrollSort) ParseCursor(cursor pagination.Cursor) (*BankrollCursor, error) {
+	var value interface{}
+	switch s {
+	case BankrollSortName:
+		value = cursor.Value
+	case BankrollSortCurrentBalance:
+		balance, err := domain.ParseDecimal(cursor.Value)
+		if err != nil {
+			return nil, pagination.ErrInvalidCursor
+		}
+		value = balance
+	default:
+		createdAt, err := time.Parse(time.RFC3339Nano, cursor.Value)
+		if err != nil {
+			return nil, pagination.ErrInvalidCursor
+		}
+		value = createdAt
+	}
+	return &BankrollCursor{Value: value, ID: cursor.ID}, nil
+}
+
+type BankrollCursor struct {
+	Value interface{}
+	ID    uint
+}
+
+// BankrollListFilter selects a page of a user's bankrolls. After, when set,
+// replaces Offset with keyset paging.
+type BankrollListFilter struct {
+	Currency     Currency
+	Name         string
+	CreatedAfter *time.Time
+	// IncludeArchived also returns archived bankrolls; soft-deleted rows are
+	// never listed.
+	IncludeArchived bool
+	Sort            BankrollSort
+	Descending      bool
+	After           *BankrollCursor
+	Limit           int
+	Offset          int
+}
@@ -0,0 +1,56 @@
+package bankroll
+
+import (
+	"net/http"
+	"strconv"
+
+	"log/slog"
+
+	"github.com/gin-gonic/gin"
+)
+
+type PeriodHandler struct {
+	service PeriodService
+	logger  *slog.Logger
+}
+
+func NewPeriodHandler(service PeriodService, logger *slog.Logger) *PeriodHandler {
+	return &PeriodHandler{
+		service: service,
+		logger:  logger,
+	}
+}
+
+func (h *PeriodHandler) ListPeriods(c *gin.Context) {
+	var input ListPeriodsInput
+	if err := c.ShouldBindQuery(&input); err != nil {
+		h.logger.Error("invalid query parameters", "error", err)
+		c.JSON(http.StatusBadRequest, ErrorOutput{
+			Error:   "Invalid query parameters",
+			Code:    "VALIDATION_ERROR",
+			Details: nil,
+		})
+		return
+	}
+
+	userID, err := getUserID(c)
+	if err != nil {
+		handleError(c, h.logger, err)
+		return
+	}
+
+	bankrollIDStr := c.Param("bankrollId")
+	bankrollID, err := strconv.ParseUint(bankrollIDStr, 10, 32)
+	if err != nil {
+		handleError(c, h.logger, ErrUnauthorized)
+		return
+	}
+
+	output, err := h.service.ListPeriods(c.Request.Context(), userID, uint(bankrollID), input)
+	if err != nil {
+		handleError(c, h.logger, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
@@ -0,0 +1,98 @@
+package bankroll
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"log/slog"
+
+	"github.com/gin-gonic/gin"
+	"github.com/opinedajr/micro-stakes-api/internal/shared/pagination"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type MockPeriodService struct {
+	mock.Mock
+}
+
+func (m *MockPeriodService) ListPeriods(ctx context.Context, userID uint, bankrollID uint, input ListPeriodsInput) (*PeriodPageOutput, error) {
+	args := m.Called(ctx, userID, bankrollID, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*PeriodPageOutput), args.Error(1)
+}
+
+func TestListPeriodsHandler(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		mockService := new(MockPeriodService)
+		handler := NewPeriodHandler(mockService, slog.Default())
+
+		input := ListPeriodsInput{Params: pagination.Params{Limit: 5}}
+		mockService.On("ListPeriods", mock.Anything, uint(1), uint(2), input).
+			Return(pagination.NewPage([]*PeriodOutput{{ID: 7, BankrollID: 2}}, 1, input.Params, ""), nil).Once()
+
+		req, err := http.NewRequest(http.MethodGet, "/bankrolls/2/periods?limit=5", nil)
+		require.NoError(t, err)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = req
+		c.Params = gin.Params{gin.Param{Key: "bankrollId", Value: "2"}}
+		c.Set("userID", "1")
+
+		handler.ListPeriods(c)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var response PeriodPageOutput
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		require.Len(t, response.Items, 1)
+		assert.Equal(t, uint(7), response.Items[0].ID)
+		assert.Equal(t, int64(1), response.Total)
+	})
+
+	t.Run("bankroll not found", func(t *testing.T) {
+		mockService := new(MockPeriodService)
+		handler := NewPeriodHandler(mockService, slog.Default())
+
+		mockService.On("ListPeriods", mock.Anything, uint(1), uint(9), ListPeriodsInput{}).Return(nil, ErrBankrollNotFound).Once()
+
+		req, err := http.NewRequest(http.MethodGet, "/bankrolls/9/periods", nil)
+		require.NoError(t, err)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = req
+		c.Params = gin.Params{gin.Param{Key: "bankrollId", Value: "9"}}
+		c.Set("userID", "1")
+
+		handler.ListPeriods(c)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("invalid bankroll ID", func(t *testing.T) {
+		mockService := new(MockPeriodService)
+		handler := NewPeriodHandler(mockService, slog.Default())
+
+		req, err := http.NewRequest(http.MethodGet, "/bankrolls/abc/periods", nil)
+		require.NoError(t, err)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = req
+		c.Params = gin.Params{gin.Param{Key: "bankrollId", Value: "abc"}}
+		c.Set("userID", "1")
+
+		handler.ListPeriods(c)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+		mockService.AssertNotCalled(t, "ListPeriods")
+	})
+}
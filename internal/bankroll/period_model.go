@@ -0,0 +1,34 @@
+package bankroll
+
+import (
+	"time"
+
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
+)
+
+// BankrollPeriod is the snapshot of a bankroll run archived when the bankroll
+// is reset. Profit only counts results from play, so deposits and withdrawals
+// made during the run do not inflate it.
+type BankrollPeriod struct {
+	ID             uint           `gorm:"primaryKey;autoIncrement"`
+	BankrollID     uint           `gorm:"not null;index"`
+	UserID         uint           `gorm:"not null;index"`
+	StartDate      time.Time      `gorm:"type:date;not null"`
+	EndDate        time.Time      `gorm:"type:date;not null"`
+	StartedAt      time.Time      `gorm:"not null"`
+	EndedAt        time.Time      `gorm:"not null"`
+	InitialBalance domain.Decimal `gorm:"type:decimal(27,8);not null"`
+	FinalBalance   domain.Decimal `gorm:"type:decimal(27,8);not null"`
+	Profit         domain.Decimal `gorm:"type:decimal(27,8);not null"`
+	CreatedAt      time.Time      `gorm:"autoCreateTime;index"`
+}
+
+func (BankrollPeriod) TableName() string {
+	return "bankroll_periods"
+}
+
+type PeriodListFilter struct {
+	After  *BankrollCursor
+	Limit  int
+	Offset int
+}
@@ -0,0 +1,90 @@
+package bankroll
+
+import (
+	"context"
+	"time"
+
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
+	"github.com/opinedajr/micro-stakes-api/internal/shared/pagination"
+	"gorm.io/gorm"
+)
+
+type postgresPeriodRepository struct {
+	db *gorm.DB
+}
+
+func NewPostgresPeriodRepository(db *gorm.DB) PeriodRepository {
+	return &postgresPeriodRepository{
+		db: db,
+	}
+}
+
+// ListByBankrollID returns the archived periods newest first together with
+// the total number of periods of the bankroll.
+func (r *postgresPeriodRepository) ListByBankrollID(ctx context.Context, bankrollID uint, userID uint, filter PeriodListFilter) ([]*BankrollPeriod, int64, error) {
+	query := r.db.WithContext(ctx).Model(&BankrollPeriod{}).
+		Where("bankroll_id = ? AND user_id = ?", bankrollID, userID)
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, WrapError(ErrDatabaseError, err.Error())
+	}
+
+	if filter.After != nil {
+		query = query.Where(pagination.KeysetCondition("created_at", true),
+			filter.After.Value, filter.After.Value, filter.After.ID)
+	} else if filter.Offset > 0 {
+		query = query.Offset(filter.Offset)
+	}
+
+	var periods []*BankrollPeriod
+	err := query.Order(pagination.OrderClause("created_at", true)).
+		Limit(filter.Limit).
+		Find(&periods).Error
+	if err != nil {
+		return nil, 0, WrapError(ErrDatabaseError, err.Error())
+	}
+	return periods, total, nil
+}
+
+// archivePeriod snapshots the run that ends now. The run started when the
+// previous period was archived, or when the bankroll was created. Its profit
+// counts results by when they were played, as the balance history places
+// them. It must run inside the transaction that resets the bankroll.
+func archivePeriod(tx *gorm.DB, bankroll *Bankroll, now time.Time) (*BankrollPeriod, error) {
+	startedAt := bankroll.CreatedAt
+	var previous BankrollPeriod
+	err := tx.Where("bankroll_id = ?", bankroll.ID).
+		Order("ended_at DESC, id DESC").
+		First(&previous).Error
+	if err == nil {
+		startedAt = previous.EndedAt
+	} else if err != gorm.ErrRecordNotFound {
+		return nil, WrapError(ErrDatabaseError, err.Error())
+	}
+
+	var amounts []domain.Decimal
+	err = tx.Table("bankroll_transactions t").
+		Joins(ledgerEntryJoins).
+		Where("t.bankroll_id = ? AND t.type IN ? AND "+ledgerEntryAt+" >= ?", bankroll.ID, ResultTransactionTypes, startedAt).
+		Pluck("t.amount", &amounts).Error
+	if err != nil {
+		return nil, WrapError(ErrDatabaseError, err.Error())
+	}
+
+	period := &BankrollPeriod{
+		BankrollID:     bankroll.ID,
+		UserID:         bankroll.UserID,
+		StartDate:      bankroll.StartDate,
+		EndDate:        now.UTC().Truncate(24 * time.Hour),
+		StartedAt:      startedAt,
+		EndedAt:        now,
+		InitialBalance: bankroll.InitialBalance,
+		FinalBalance:   bankroll.CurrentBalance,
+		Profit:         domain.SumDecimals(amounts...),
+	}
+	if err := tx.Create(period).Error; err != nil {
+		return nil, WrapError(ErrDatabaseError, err.Error())
+	}
+	return period, nil
+}
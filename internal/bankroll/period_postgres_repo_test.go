@@ -0,0 +1,161 @@
+package bankroll
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostgresBankrollRepository_ResetArchivesPeriod(t *testing.T) {
+	t.Run("snapshots the run and starts a new one", func(t *testing.T) {
+		db := setupTestDB(t)
+		require.NoError(t, db.AutoMigrate(&historyTestBet{}, &historyTestSession{}))
+		repo := NewPostgresBankrollRepository(db)
+		transactions := NewPostgresTransactionRepository(db)
+		ctx := context.Background()
+		bankroll := createTestBankroll(t, db, 1, "1000.00")
+
+		require.NoError(t, transactions.Create(ctx, &Transaction{
+			BankrollID: bankroll.ID,
+			Type:       TransactionTypeDeposit,
+			Amount:     domain.MustParseDecimal("200.00"),
+		}, 1, 0))
+		require.NoError(t, transactions.Create(ctx, &Transaction{
+			BankrollID: bankroll.ID,
+			Type:       TransactionTypeAdjustment,
+			Amount:     domain.MustParseDecimal("50.00"),
+		}, 1, 0))
+
+		period, err := repo.Reset(ctx, bankroll.ID, 1, domain.MustParseDecimal("300.00"), 0)
+		require.NoError(t, err)
+
+		assert.NotZero(t, period.ID)
+		assert.Equal(t, "1000.00", period.InitialBalance.Round(2).String())
+		assert.Equal(t, "1250.00", period.FinalBalance.Round(2).String())
+		assert.Equal(t, "50.00", period.Profit.Round(2).String())
+		assert.Equal(t, "2026-02-01", period.StartDate.Format("2006-01-02"))
+
+		reset, err := repo.FindByID(ctx, bankroll.ID, 1)
+		require.NoError(t, err)
+		assert.Equal(t, 300.0, reset.InitialBalance.Float64())
+		assert.Equal(t, 300.0, reset.CurrentBalance.Float64())
+		assert.Equal(t, period.EndDate.Format("2006-01-02"), reset.StartDate.Format("2006-01-02"))
+
+		entries, _, err := transactions.ListByBankrollID(ctx, bankroll.ID, 1, TransactionListFilter{Limit: 10})
+		require.NoError(t, err)
+		require.Len(t, entries, 3)
+		assert.Equal(t, TransactionTypeReset, entries[0].Type)
+		assert.Equal(t, -950.0, entries[0].Amount.Float64())
+		assert.Equal(t, "period", entries[0].ReferenceType)
+		assert.Equal(t, period.ID, *entries[0].ReferenceID)
+
+		require.NoError(t, transactions.Create(ctx, &Transaction{
+			BankrollID: bankroll.ID,
+			Type:       TransactionTypeAdjustment,
+			Amount:     domain.MustParseDecimal("-20.00"),
+		}, 1, 0))
+
+		second, err := repo.Reset(ctx, bankroll.ID, 1, domain.MustParseDecimal("280.00"), 0)
+		require.NoError(t, err)
+
+		assert.Equal(t, "-20.00", second.Profit.Round(2).String())
+		assert.Equal(t, "280.00", second.FinalBalance.Round(2).String())
+		assert.False(t, second.StartedAt.Before(period.EndedAt))
+
+		entries, _, err = transactions.ListByBankrollID(ctx, bankroll.ID, 1, TransactionListFilter{Limit: 10})
+		require.NoError(t, err)
+		assert.Len(t, entries, 4, "reset to the same balance posts no ledger entry")
+	})
+
+	t.Run("counts results by when they were played", func(t *testing.T) {
+		db := setupTestDB(t)
+		require.NoError(t, db.AutoMigrate(&historyTestBet{}, &historyTestSession{}))
+		repo := NewPostgresBankrollRepository(db)
+		ctx := context.Background()
+		bankroll := createTestBankroll(t, db, 1, "1000.00")
+
+		first, err := repo.Reset(ctx, bankroll.ID, 1, domain.MustParseDecimal("1000.00"), 0)
+		require.NoError(t, err)
+
+		endedAt := first.EndedAt.Add(-48 * time.Hour)
+		require.NoError(t, db.Create(&historyTestSession{ID: 7, EndedAt: &endedAt}).Error)
+		sessionID := uint(7)
+		postHistoryEntry(t, db, bankroll, &Transaction{
+			Type: TransactionTypeSessionResult, Amount: domain.MustParseDecimal("-40.00"),
+			ReferenceType: "session", ReferenceID: &sessionID,
+		})
+		postHistoryEntry(t, db, bankroll, &Transaction{Type: TransactionTypeAdjustment, Amount: domain.MustParseDecimal("15.00")})
+
+		second, err := repo.Reset(ctx, bankroll.ID, 1, domain.MustParseDecimal("1000.00"), 0)
+		require.NoError(t, err)
+
+		assert.Equal(t, "15.00", second.Profit.Round(2).String(), "a session played before the run started is not part of it")
+	})
+
+	t.Run("money in play", func(t *testing.T) {
+		db := setupTestDB(t)
+		repo := NewPostgresBankrollRepository(db)
+		ctx := context.Background()
+		bankroll := createTestBankroll(t, db, 1, "1000.00")
+
+		require.NoError(t, AdjustInPlay(db, bankroll.ID, 1, domain.MustParseDecimal("100")))
+
+		_, err := repo.Reset(ctx, bankroll.ID, 1, domain.Zero, 0)
+
+		assert.ErrorIs(t, err, ErrBankrollInPlay)
+
+		var count int64
+		require.NoError(t, db.Model(&BankrollPeriod{}).Count(&count).Error)
+		assert.Zero(t, count)
+	})
+}
+
+func TestPostgresPeriodRepository_ListByBankrollID(t *testing.T) {
+	db := setupTestDB(t)
+	require.NoError(t, db.AutoMigrate(&historyTestBet{}, &historyTestSession{}))
+	bankrollRepo := NewPostgresBankrollRepository(db)
+	repo := NewPostgresPeriodRepository(db)
+	ctx := context.Background()
+	bankroll := createTestBankroll(t, db, 1, "100.00")
+
+	for i := 0; i < 3; i++ {
+		_, err := bankrollRepo.Reset(ctx, bankroll.ID, 1, domain.NewDecimalFromInt(int64(200+i*100)), 0)
+		require.NoError(t, err)
+	}
+
+	t.Run("newest first", func(t *testing.T) {
+		periods, total, err := repo.ListByBankrollID(ctx, bankroll.ID, 1, PeriodListFilter{Limit: 10})
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), total)
+		require.Len(t, periods, 3)
+		assert.Equal(t, "300.00", periods[0].InitialBalance.Round(2).String())
+		assert.Equal(t, "100.00", periods[2].InitialBalance.Round(2).String())
+	})
+
+	t.Run("keyset after cursor", func(t *testing.T) {
+		first, _, err := repo.ListByBankrollID(ctx, bankroll.ID, 1, PeriodListFilter{Limit: 1})
+		require.NoError(t, err)
+		require.Len(t, first, 1)
+
+		rest, _, err := repo.ListByBankrollID(ctx, bankroll.ID, 1, PeriodListFilter{
+			After: &BankrollCursor{Value: first[0].CreatedAt, ID: first[0].ID},
+			Limit: 10,
+		})
+		require.NoError(t, err)
+		require.Len(t, rest, 2)
+		assert.Equal(t, "200.00", rest[0].InitialBalance.Round(2).String())
+	})
+
+	t.Run("other user", func(t *testing.T) {
+		periods, total, err := repo.ListByBankrollID(ctx, bankroll.ID, 2, PeriodListFilter{Limit: 10})
+
+		require.NoError(t, err)
+		assert.Zero(t, total)
+		assert.Empty(t, periods)
+	})
+}
@@ -0,0 +1,9 @@
+package bankroll
+
+import (
+	"context"
+)
+
+type PeriodRepository interface {
+	ListByBankrollID(ctx context.Context, bankrollID uint, userID uint, filter PeriodListFilter) ([]*BankrollPeriod, int64, error)
+}
@@ -0,0 +1,91 @@
+package bankroll
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/opinedajr/micro-stakes-api/internal/shared/pagination"
+)
+
+type PeriodService interface {
+	ListPeriods(ctx context.Context, userID uint, bankrollID uint, input ListPeriodsInput) (*PeriodPageOutput, error)
+}
+
+type periodService struct {
+	repo         PeriodRepository
+	bankrollRepo BankrollRepository
+	logger       *slog.Logger
+}
+
+func NewPeriodService(repo PeriodRepository, bankrollRepo BankrollRepository, logger *slog.Logger) PeriodService {
+	return &periodService{
+		repo:         repo,
+		bankrollRepo: bankrollRepo,
+		logger:       logger,
+	}
+}
+
+func (s *periodService) ListPeriods(ctx context.Context, userID uint, bankrollID uint, input ListPeriodsInput) (*PeriodPageOutput, error) {
+	params, err := input.Params.Normalize()
+	if err != nil {
+		s.logger.Error("invalid list parameters", "error", err, "user_id", userID)
+		return nil, WrapError(ErrValidationFailed, err.Error())
+	}
+
+	filter := PeriodListFilter{
+		Limit:  params.Limit + 1,
+		Offset: params.Offset,
+	}
+	if params.Cursor != "" {
+		cursor, err := pagination.DecodeCursor(params.Cursor, string(BankrollSortCreatedAt), true)
+		if err == nil {
+			filter.After, err = BankrollSortCreatedAt.ParseCursor(cursor)
+		}
+		if err != nil {
+			s.logger.Error("invalid cursor", "error", err, "user_id", userID)
+			return nil, WrapError(ErrValidationFailed, err.Error())
+		}
+	}
+
+	if _, err := s.bankrollRepo.FindByID(ctx, bankrollID, userID); err != nil {
+		s.logger.Error("bankroll not found", "error", err, "user_id", userID, "bankroll_id", bankrollID)
+		return nil, err
+	}
+
+	periods, total, err := s.repo.ListByBankrollID(ctx, bankrollID, userID, filter)
+	if err != nil {
+		s.logger.Error("failed to list periods", "error", err, "user_id", userID, "bankroll_id", bankrollID)
+		return nil, err
+	}
+
+	periods, more := pagination.Trim(periods, params.Limit)
+	nextCursor := ""
+	if more {
+		last := periods[len(periods)-1]
+		nextCursor = pagination.EncodeCursor(string(BankrollSortCreatedAt), true, last.CreatedAt.UTC().Format(time.RFC3339Nano), last.ID)
+	}
+
+	outputs := make([]*PeriodOutput, len(periods))
+	for i, p := range periods {
+		outputs[i] = toPeriodOutput(p)
+	}
+
+	s.logger.Info("periods listed", "user_id", userID, "bankroll_id", bankrollID, "count", len(outputs), "total", total)
+
+	return pagination.NewPage(outputs, total, params, nextCursor), nil
+}
+
+func toPeriodOutput(period *BankrollPeriod) *PeriodOutput {
+	return &PeriodOutput{
+		ID:             period.ID,
+		BankrollID:     period.BankrollID,
+		StartDate:      period.StartDate.Format("2006-01-02"),
+		EndDate:        period.EndDate.Format("2006-01-02"),
+		StartedAt:      period.StartedAt,
+		EndedAt:        period.EndedAt,
+		InitialBalance: period.InitialBalance,
+		FinalBalance:   period.FinalBalance,
+		Profit:         period.Profit,
+	}
+}
@@ -0,0 +1,77 @@
+package bankroll
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
+	"github.com/opinedajr/micro-stakes-api/internal/shared/pagination"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"log/slog"
+)
+
+type MockPeriodRepository struct {
+	mock.Mock
+}
+
+func (m *MockPeriodRepository) ListByBankrollID(ctx context.Context, bankrollID uint, userID uint, filter PeriodListFilter) ([]*BankrollPeriod, int64, error) {
+	args := m.Called(ctx, bankrollID, userID, filter)
+	if args.Get(0) == nil {
+		return nil, 0, args.Error(2)
+	}
+	return args.Get(0).([]*BankrollPeriod), args.Get(1).(int64), args.Error(2)
+}
+
+func TestListPeriods(t *testing.T) {
+	t.Run("success with next cursor", func(t *testing.T) {
+		mockRepo := new(MockPeriodRepository)
+		mockBankrollRepo := new(MockBankrollRepository)
+		service := NewPeriodService(mockRepo, mockBankrollRepo, slog.Default())
+		ctx := context.Background()
+
+		createdAt := time.Date(2026, 5, 1, 10, 0, 0, 0, time.UTC)
+		periods := []*BankrollPeriod{
+			{ID: 3, BankrollID: 1, StartDate: createdAt, EndDate: createdAt, Profit: domain.MustParseDecimal("-20"), CreatedAt: createdAt},
+			{ID: 2, BankrollID: 1, StartDate: createdAt, EndDate: createdAt, Profit: domain.MustParseDecimal("50"), CreatedAt: createdAt.Add(-time.Hour)},
+		}
+
+		mockBankrollRepo.On("FindByID", ctx, uint(1), uint(1)).Return(&Bankroll{ID: 1, UserID: 1}, nil).Once()
+		mockRepo.On("ListByBankrollID", ctx, uint(1), uint(1), PeriodListFilter{Limit: 2}).Return(periods, int64(4), nil).Once()
+
+		page, err := service.ListPeriods(ctx, 1, 1, ListPeriodsInput{Params: pagination.Params{Limit: 1}})
+
+		require.NoError(t, err)
+		require.Len(t, page.Items, 1)
+		assert.Equal(t, uint(3), page.Items[0].ID)
+		assert.Equal(t, "-20", page.Items[0].Profit.String())
+		assert.Equal(t, int64(4), page.Total)
+		assert.Equal(t, pagination.EncodeCursor("created_at", true, createdAt.Format(time.RFC3339Nano), 3), page.NextCursor)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("bankroll not found", func(t *testing.T) {
+		mockRepo := new(MockPeriodRepository)
+		mockBankrollRepo := new(MockBankrollRepository)
+		service := NewPeriodService(mockRepo, mockBankrollRepo, slog.Default())
+		ctx := context.Background()
+
+		mockBankrollRepo.On("FindByID", ctx, uint(9), uint(1)).Return(nil, ErrBankrollNotFound).Once()
+
+		page, err := service.ListPeriods(ctx, 1, 9, ListPeriodsInput{})
+
+		assert.ErrorIs(t, err, ErrBankrollNotFound)
+		assert.Nil(t, page)
+		mockRepo.AssertNotCalled(t, "ListByBankrollID", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("invalid cursor", func(t *testing.T) {
+		service := NewPeriodService(new(MockPeriodRepository), new(MockBankrollRepository), slog.Default())
+
+		_, err := service.ListPeriods(context.Background(), 1, 1, ListPeriodsInput{Params: pagination.Params{Cursor: "%%%"}})
+
+		assert.ErrorIs(t, err, ErrValidationFailed)
+	})
+}
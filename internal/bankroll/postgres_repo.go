@@ -2,8 +2,12 @@ package bankroll
 
 import (
 	"context"
+	"time"
 
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
+	"github.com/opinedajr/micro-stakes-api/internal/shared/pagination"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type postgresBankrollRepository struct {
@@ -30,12 +34,18 @@ func (r *postgresBankrollRepository) Create(ctx context.Context, bankroll *Bankr
 		return WrapError(ErrDatabaseError, err.Error())
 	}
 
+	if bankroll.Version == 0 {
+		bankroll.Version = 1
+	}
+
 	if err := r.db.WithContext(ctx).Create(bankroll).Error; err != nil {
 		return WrapError(ErrDatabaseError, err.Error())
 	}
 	return nil
 }
 
+// Update saves the editable fields. When bankroll.Version is set the row is
+// only written if it still has that version.
 func (r *postgresBankrollRepository) Update(ctx context.Context, bankroll *Bankroll) error {
 	var existingBankroll Bankroll
 	err := r.db.WithContext(ctx).
@@ -62,13 +72,24 @@ func (r *postgresBankrollRepository) Update(ctx context.Context, bankroll *Bankr
 		return WrapError(ErrDatabaseError, err.Error())
 	}
 
-	if err := r.db.WithContext(ctx).Model(&existingBankroll).Updates(map[string]interface{}{
+	query := r.db.WithContext(ctx).Model(&existingBankroll)
+	if bankroll.Version != 0 {
+		query = query.Where("version = ?", bankroll.Version)
+	}
+
+	result := query.Updates(map[string]interface{}{
 		"name":                  bankroll.Name,
 		"currency":              bankroll.Currency,
 		"start_date":            bankroll.StartDate,
 		"commission_percentage": bankroll.CommissionPercentage,
-	}).Error; err != nil {
-		return WrapError(ErrDatabaseError, err.Error())
+		"timezone":              bankroll.Timezone,
+		"version":               gorm.Expr("version + 1"),
+	})
+	if result.Error != nil {
+		return WrapError(ErrDatabaseError, result.Error.Error())
+	}
+	if result.RowsAffected == 0 {
+		return ErrVersionConflict
 	}
 	return nil
 }
@@ -82,6 +103,49 @@ func (r *postgresBankrollRepository) ListByUserID(ctx context.Context, userID ui
 	return bankrolls, nil
 }
 
+// List returns the requested page together with the number of bankrolls
+// matching the filter across all pages.
+func (r *postgresBankrollRepository) List(ctx context.Context, userID uint, filter BankrollListFilter) ([]*Bankroll, int64, error) {
+	query := r.db.WithContext(ctx).Model(&Bankroll{}).Where("user_id = ?", userID)
+	if filter.Currency != "" {
+		query = query.Where("currency = ?", filter.Currency)
+	}
+	if filter.Name != "" {
+		query = query.Where(`LOWER(name) LIKE ? ESCAPE '\'`, pagination.ContainsPattern(filter.Name))
+	}
+	if filter.CreatedAfter != nil {
+		query = query.Where("created_at >= ?", *filter.CreatedAfter)
+	}
+	if !filter.IncludeArchived {
+		query = query.Where("archived_at IS NULL")
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, WrapError(ErrDatabaseError, err.Error())
+	}
+
+	column := string(filter.Sort)
+	if !filter.Sort.IsValid() {
+		column = string(BankrollSortCreatedAt)
+	}
+	if filter.After != nil {
+		query = query.Where(pagination.KeysetCondition(column, filter.Descending),
+			filter.After.Value, filter.After.Value, filter.After.ID)
+	} else if filter.Offset > 0 {
+		query = query.Offset(filter.Offset)
+	}
+
+	var bankrolls []*Bankroll
+	err := query.Order(pagination.OrderClause(column, filter.Descending)).
+		Limit(filter.Limit).
+		Find(&bankrolls).Error
+	if err != nil {
+		return nil, 0, WrapError(ErrDatabaseError, err.Error())
+	}
+	return bankrolls, total, nil
+}
+
 func (r *postgresBankrollRepository) FindByID(ctx context.Context, id uint, userID uint) (*Bankroll, error) {
 	var bankroll Bankroll
 	err := r.db.WithContext(ctx).Where("id = ? AND user_id = ?", id, userID).First(&bankroll).Error
@@ -94,19 +158,136 @@ func (r *postgresBankrollRepository) FindByID(ctx context.Context, id uint, user
 	return &bankroll, nil
 }
 
-func (r *postgresBankrollRepository) Reset(ctx context.Context, id uint, userID uint) error {
+// Reset archives the current run as a period and starts a new one with the
+// given amount. The balance change is posted to the ledger so the balance
+// history stays consistent across resets.
+func (r *postgresBankrollRepository) Reset(ctx context.Context, id uint, userID uint, amount domain.Decimal, expectedVersion uint) (*BankrollPeriod, error) {
+	var period *BankrollPeriod
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var bankroll Bankroll
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ? AND user_id = ?", id, userID).
+			First(&bankroll).Error
+		if err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return ErrBankrollNotFound
+			}
+			return WrapError(ErrDatabaseError, err.Error())
+		}
+
+		if expectedVersion != 0 && bankroll.Version != expectedVersion {
+			return ErrVersionConflict
+		}
+
+		if bankroll.InPlay.IsPositive() {
+			return ErrBankrollInPlay
+		}
+
+		now := time.Now()
+		period, err = archivePeriod(tx, &bankroll, now)
+		if err != nil {
+			return err
+		}
+
+		if delta := amount.Sub(bankroll.CurrentBalance); !delta.IsZero() {
+			err := ApplyTransaction(tx, &Transaction{
+				BankrollID:    bankroll.ID,
+				Type:          TransactionTypeReset,
+				Amount:        delta,
+				Description:   "Bankroll reset",
+				ReferenceType: "period",
+				ReferenceID:   &period.ID,
+			}, userID)
+			if err != nil {
+				return err
+			}
+		}
+
+		err = tx.Model(&Bankroll{}).
+			Where("id = ?", bankroll.ID).
+			Updates(map[string]interface{}{
+				"initial_balance": amount,
+				"start_date":      period.EndDate,
+				"version":         gorm.Expr("version + 1"),
+			}).Error
+		if err != nil {
+			return WrapError(ErrDatabaseError, err.Error())
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return period, nil
+}
+
+func (r *postgresBankrollRepository) Delete(ctx context.Context, id uint, userID uint) error {
+	result := r.db.WithContext(ctx).Where("id = ? AND user_id = ?", id, userID).Delete(&Bankroll{})
+	if result.Error != nil {
+		return WrapError(ErrDatabaseError, result.Error.Error())
+	}
+	if result.RowsAffected == 0 {
+		return ErrBankrollNotFound
+	}
+	return nil
+}
+
+func (r *postgresBankrollRepository) Archive(ctx context.Context, id uint, userID uint) error {
 	result := r.db.WithContext(ctx).Model(&Bankroll{}).
-		Where("id = ? AND user_id = ?", id, userID).
+		Where("id = ? AND user_id = ? AND archived_at IS NULL", id, userID).
 		Updates(map[string]interface{}{
-			"initial_balance": 0,
-			"current_balance": 0,
+			"archived_at": time.Now(),
+			"version":     gorm.Expr("version + 1"),
 		})
-
 	if result.Error != nil {
 		return WrapError(ErrDatabaseError, result.Error.Error())
 	}
 	if result.RowsAffected == 0 {
-		return ErrBankrollNotFound
+		return ErrBankrollArchived
 	}
 	return nil
 }
+
+// Restore brings back an archived or soft-deleted bankroll. A deleted
+// bankroll cannot be restored while another live bankroll uses its name.
+func (r *postgresBankrollRepository) Restore(ctx context.Context, id uint, userID uint) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var bankroll Bankroll
+		err := tx.Unscoped().Where("id = ? AND user_id = ?", id, userID).First(&bankroll).Error
+		if err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return ErrBankrollNotFound
+			}
+			return WrapError(ErrDatabaseError, err.Error())
+		}
+
+		if !bankroll.DeletedAt.Valid && !bankroll.IsArchived() {
+			return ErrBankrollNotArchived
+		}
+
+		if bankroll.DeletedAt.Valid {
+			var count int64
+			err := tx.Model(&Bankroll{}).
+				Where("user_id = ? AND name = ? AND id != ?", userID, bankroll.Name, id).
+				Count(&count).Error
+			if err != nil {
+				return WrapError(ErrDatabaseError, err.Error())
+			}
+			if count > 0 {
+				return ErrBankrollNameExists
+			}
+		}
+
+		err = tx.Unscoped().Model(&Bankroll{}).
+			Where("id = ? AND user_id = ?", id, userID).
+			Updates(map[string]interface{}{
+				"archived_at": nil,
+				"deleted_at":  nil,
+				"version":     gorm.Expr("version + 1"),
+			}).Error
+		if err != nil {
+			return WrapError(ErrDatabaseError, err.Error())
+		}
+		return nil
+	})
+}
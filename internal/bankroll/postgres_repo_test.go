@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"gorm.io/driver/sqlite"
@@ -15,7 +16,7 @@ func setupTestDB(t *testing.T) *gorm.DB {
 	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
 	require.NoError(t, err)
 
-	db.AutoMigrate(&Bankroll{})
+	db.AutoMigrate(&Bankroll{}, &Transaction{}, &Transfer{}, &BankrollPeriod{}, &BankrollRule{}, &BankrollLimits{}, &BankrollLimitLock{}, &StakeLevel{}, &BankrollStats{}, &BankrollGoal{}, &GoalMilestone{}, &RakebackDeal{}, &RakebackPayout{}, &RakebackPayoutItem{})
 
 	return db
 }
@@ -33,10 +34,10 @@ func TestPostgresBankrollRepository_Create(t *testing.T) {
 			UserID:               1,
 			Name:                 "Main Bankroll",
 			Currency:             CurrencyBRL,
-			InitialBalance:       1000.00,
-			CurrentBalance:       1000.00,
+			InitialBalance:       domain.MustParseDecimal("1000.00"),
+			CurrentBalance:       domain.MustParseDecimal("1000.00"),
 			StartDate:            startDate,
-			CommissionPercentage: 5.0,
+			CommissionPercentage: domain.MustParseDecimal("5.0"),
 		}
 
 		err = repo.Create(ctx, bankroll)
@@ -46,8 +47,8 @@ func TestPostgresBankrollRepository_Create(t *testing.T) {
 		assert.Equal(t, uint(1), bankroll.UserID)
 		assert.Equal(t, "Main Bankroll", bankroll.Name)
 		assert.Equal(t, CurrencyBRL, bankroll.Currency)
-		assert.Equal(t, 1000.00, bankroll.InitialBalance)
-		assert.Equal(t, 1000.00, bankroll.CurrentBalance)
+		assert.Equal(t, 1000.00, bankroll.InitialBalance.Float64())
+		assert.Equal(t, 1000.00, bankroll.CurrentBalance.Float64())
 	})
 
 	t.Run("duplicate name per user", func(t *testing.T) {
@@ -62,10 +63,10 @@ func TestPostgresBankrollRepository_Create(t *testing.T) {
 			UserID:               1,
 			Name:                 "Main Bankroll",
 			Currency:             CurrencyBRL,
-			InitialBalance:       1000.00,
-			CurrentBalance:       1000.00,
+			InitialBalance:       domain.MustParseDecimal("1000.00"),
+			CurrentBalance:       domain.MustParseDecimal("1000.00"),
 			StartDate:            startDate,
-			CommissionPercentage: 5.0,
+			CommissionPercentage: domain.MustParseDecimal("5.0"),
 		}
 
 		err = repo.Create(ctx, bankroll1)
@@ -75,10 +76,10 @@ func TestPostgresBankrollRepository_Create(t *testing.T) {
 			UserID:               1,
 			Name:                 "Main Bankroll",
 			Currency:             CurrencyUSD,
-			InitialBalance:       500.00,
-			CurrentBalance:       500.00,
+			InitialBalance:       domain.MustParseDecimal("500.00"),
+			CurrentBalance:       domain.MustParseDecimal("500.00"),
 			StartDate:            startDate,
-			CommissionPercentage: 3.0,
+			CommissionPercentage: domain.MustParseDecimal("3.0"),
 		}
 
 		err = repo.Create(ctx, bankroll2)
@@ -99,10 +100,10 @@ func TestPostgresBankrollRepository_Create(t *testing.T) {
 			UserID:               1,
 			Name:                 "Main Bankroll",
 			Currency:             CurrencyBRL,
-			InitialBalance:       1000.00,
-			CurrentBalance:       1000.00,
+			InitialBalance:       domain.MustParseDecimal("1000.00"),
+			CurrentBalance:       domain.MustParseDecimal("1000.00"),
 			StartDate:            startDate,
-			CommissionPercentage: 5.0,
+			CommissionPercentage: domain.MustParseDecimal("5.0"),
 		}
 
 		err = repo.Create(ctx, bankroll1)
@@ -112,10 +113,10 @@ func TestPostgresBankrollRepository_Create(t *testing.T) {
 			UserID:               2,
 			Name:                 "Main Bankroll",
 			Currency:             CurrencyUSD,
-			InitialBalance:       500.00,
-			CurrentBalance:       500.00,
+			InitialBalance:       domain.MustParseDecimal("500.00"),
+			CurrentBalance:       domain.MustParseDecimal("500.00"),
 			StartDate:            startDate,
-			CommissionPercentage: 3.0,
+			CommissionPercentage: domain.MustParseDecimal("3.0"),
 		}
 
 		err = repo.Create(ctx, bankroll2)
@@ -138,30 +139,30 @@ func TestPostgresBankrollRepository_ListByUserID(t *testing.T) {
 			UserID:               1,
 			Name:                 "Bankroll 1",
 			Currency:             CurrencyBRL,
-			InitialBalance:       1000.00,
-			CurrentBalance:       1000.00,
+			InitialBalance:       domain.MustParseDecimal("1000.00"),
+			CurrentBalance:       domain.MustParseDecimal("1000.00"),
 			StartDate:            startDate,
-			CommissionPercentage: 5.0,
+			CommissionPercentage: domain.MustParseDecimal("5.0"),
 		}
 
 		bankroll2 := &Bankroll{
 			UserID:               1,
 			Name:                 "Bankroll 2",
 			Currency:             CurrencyUSD,
-			InitialBalance:       500.00,
-			CurrentBalance:       500.00,
+			InitialBalance:       domain.MustParseDecimal("500.00"),
+			CurrentBalance:       domain.MustParseDecimal("500.00"),
 			StartDate:            startDate,
-			CommissionPercentage: 3.0,
+			CommissionPercentage: domain.MustParseDecimal("3.0"),
 		}
 
 		bankroll3 := &Bankroll{
 			UserID:               2,
 			Name:                 "Other User Bankroll",
 			Currency:             CurrencyEUR,
-			InitialBalance:       2000.00,
-			CurrentBalance:       2000.00,
+			InitialBalance:       domain.MustParseDecimal("2000.00"),
+			CurrentBalance:       domain.MustParseDecimal("2000.00"),
 			StartDate:            startDate,
-			CommissionPercentage: 7.0,
+			CommissionPercentage: domain.MustParseDecimal("7.0"),
 		}
 
 		err = repo.Create(ctx, bankroll1)
@@ -198,6 +199,109 @@ func TestPostgresBankrollRepository_ListByUserID(t *testing.T) {
 	})
 }
 
+func TestPostgresBankrollRepository_List(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewPostgresBankrollRepository(db)
+	ctx := context.Background()
+
+	base := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	fixtures := []struct {
+		name     string
+		currency Currency
+		balance  string
+		userID   uint
+	}{
+		{"Stars Cash", CurrencyUSD, "300.00", 1},
+		{"Betfair", CurrencyBRL, "1500.00", 1},
+		{"Live Poker", CurrencyBRL, "80.00", 1},
+		{"Stars MTT", CurrencyUSD, "950.00", 1},
+		{"Stranger", CurrencyUSD, "10.00", 2},
+	}
+	for i, f := range fixtures {
+		b := &Bankroll{
+			UserID:         f.userID,
+			Name:           f.name,
+			Currency:       f.currency,
+			InitialBalance: domain.MustParseDecimal(f.balance),
+			CurrentBalance: domain.MustParseDecimal(f.balance),
+			StartDate:      base,
+			CreatedAt:      base.AddDate(0, 0, i),
+		}
+		require.NoError(t, repo.Create(ctx, b))
+	}
+
+	names := func(bankrolls []*Bankroll) []string {
+		result := make([]string, len(bankrolls))
+		for i, b := range bankrolls {
+			result[i] = b.Name
+		}
+		return result
+	}
+
+	t.Run("default order by created_at", func(t *testing.T) {
+		bankrolls, total, err := repo.List(ctx, 1, BankrollListFilter{Sort: BankrollSortCreatedAt, Limit: 10})
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(4), total)
+		assert.Equal(t, []string{"Stars Cash", "Betfair", "Live Poker", "Stars MTT"}, names(bankrolls))
+	})
+
+	t.Run("filters", func(t *testing.T) {
+		createdAfter := base.AddDate(0, 0, 1)
+		bankrolls, total, err := repo.List(ctx, 1, BankrollListFilter{
+			Currency:     CurrencyUSD,
+			Name:         "stars",
+			CreatedAfter: &createdAfter,
+			Sort:         BankrollSortName,
+			Limit:        10,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), total)
+		assert.Equal(t, []string{"Stars MTT"}, names(bankrolls))
+	})
+
+	t.Run("name filter matches wildcards literally", func(t *testing.T) {
+		for _, name := range []string{"%", "_", "st_rs"} {
+			bankrolls, total, err := repo.List(ctx, 1, BankrollListFilter{Name: name, Sort: BankrollSortName, Limit: 10})
+
+			require.NoError(t, err)
+			assert.Zero(t, total, name)
+			assert.Empty(t, bankrolls, name)
+		}
+	})
+
+	t.Run("sort by balance descending with offset", func(t *testing.T) {
+		bankrolls, total, err := repo.List(ctx, 1, BankrollListFilter{
+			Sort:       BankrollSortCurrentBalance,
+			Descending: true,
+			Limit:      2,
+			Offset:     1,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(4), total)
+		assert.Equal(t, []string{"Stars MTT", "Stars Cash"}, names(bankrolls))
+	})
+
+	t.Run("keyset after cursor", func(t *testing.T) {
+		first, _, err := repo.List(ctx, 1, BankrollListFilter{Sort: BankrollSortName, Limit: 2})
+		require.NoError(t, err)
+		require.Len(t, first, 2)
+
+		last := first[len(first)-1]
+		bankrolls, total, err := repo.List(ctx, 1, BankrollListFilter{
+			Sort:  BankrollSortName,
+			After: &BankrollCursor{Value: last.Name, ID: last.ID},
+			Limit: 10,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(4), total)
+		assert.Equal(t, []string{"Stars Cash", "Stars MTT"}, names(bankrolls))
+	})
+}
+
 func TestPostgresBankrollRepository_FindByID(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		db := setupTestDB(t)
@@ -211,10 +315,10 @@ func TestPostgresBankrollRepository_FindByID(t *testing.T) {
 			UserID:               1,
 			Name:                 "Main Bankroll",
 			Currency:             CurrencyBRL,
-			InitialBalance:       1000.00,
-			CurrentBalance:       1000.00,
+			InitialBalance:       domain.MustParseDecimal("1000.00"),
+			CurrentBalance:       domain.MustParseDecimal("1000.00"),
 			StartDate:            startDate,
-			CommissionPercentage: 5.0,
+			CommissionPercentage: domain.MustParseDecimal("5.0"),
 		}
 
 		err = repo.Create(ctx, bankroll)
@@ -252,10 +356,10 @@ func TestPostgresBankrollRepository_FindByID(t *testing.T) {
 			UserID:               1,
 			Name:                 "Main Bankroll",
 			Currency:             CurrencyBRL,
-			InitialBalance:       1000.00,
-			CurrentBalance:       1000.00,
+			InitialBalance:       domain.MustParseDecimal("1000.00"),
+			CurrentBalance:       domain.MustParseDecimal("1000.00"),
 			StartDate:            startDate,
-			CommissionPercentage: 5.0,
+			CommissionPercentage: domain.MustParseDecimal("5.0"),
 		}
 
 		err = repo.Create(ctx, bankroll)
@@ -282,17 +386,17 @@ func TestPostgresBankrollRepository_Update(t *testing.T) {
 			UserID:               1,
 			Name:                 "Main Bankroll",
 			Currency:             CurrencyBRL,
-			InitialBalance:       1000.00,
-			CurrentBalance:       1000.00,
+			InitialBalance:       domain.MustParseDecimal("1000.00"),
+			CurrentBalance:       domain.MustParseDecimal("1000.00"),
 			StartDate:            startDate,
-			CommissionPercentage: 5.0,
+			CommissionPercentage: domain.MustParseDecimal("5.0"),
 		}
 
 		err = repo.Create(ctx, bankroll)
 		require.NoError(t, err)
 
 		bankroll.Name = "Updated Bankroll"
-		bankroll.CommissionPercentage = 3.0
+		bankroll.CommissionPercentage = domain.MustParseDecimal("3.0")
 
 		err = repo.Update(ctx, bankroll)
 
@@ -301,9 +405,9 @@ func TestPostgresBankrollRepository_Update(t *testing.T) {
 		updated, err := repo.FindByID(ctx, bankroll.ID, 1)
 		require.NoError(t, err)
 		assert.Equal(t, "Updated Bankroll", updated.Name)
-		assert.Equal(t, 3.0, updated.CommissionPercentage)
-		assert.Equal(t, 1000.00, updated.InitialBalance)
-		assert.Equal(t, 1000.00, updated.CurrentBalance)
+		assert.Equal(t, 3.0, updated.CommissionPercentage.Float64())
+		assert.Equal(t, 1000.00, updated.InitialBalance.Float64())
+		assert.Equal(t, 1000.00, updated.CurrentBalance.Float64())
 	})
 
 	t.Run("duplicate name per user", func(t *testing.T) {
@@ -318,20 +422,20 @@ func TestPostgresBankrollRepository_Update(t *testing.T) {
 			UserID:               1,
 			Name:                 "Bankroll 1",
 			Currency:             CurrencyBRL,
-			InitialBalance:       1000.00,
-			CurrentBalance:       1000.00,
+			InitialBalance:       domain.MustParseDecimal("1000.00"),
+			CurrentBalance:       domain.MustParseDecimal("1000.00"),
 			StartDate:            startDate,
-			CommissionPercentage: 5.0,
+			CommissionPercentage: domain.MustParseDecimal("5.0"),
 		}
 
 		bankroll2 := &Bankroll{
 			UserID:               1,
 			Name:                 "Bankroll 2",
 			Currency:             CurrencyUSD,
-			InitialBalance:       500.00,
-			CurrentBalance:       500.00,
+			InitialBalance:       domain.MustParseDecimal("500.00"),
+			CurrentBalance:       domain.MustParseDecimal("500.00"),
 			StartDate:            startDate,
-			CommissionPercentage: 3.0,
+			CommissionPercentage: domain.MustParseDecimal("3.0"),
 		}
 
 		err = repo.Create(ctx, bankroll1)
@@ -359,20 +463,20 @@ func TestPostgresBankrollRepository_Update(t *testing.T) {
 			UserID:               1,
 			Name:                 "Main Bankroll",
 			Currency:             CurrencyBRL,
-			InitialBalance:       1000.00,
-			CurrentBalance:       1000.00,
+			InitialBalance:       domain.MustParseDecimal("1000.00"),
+			CurrentBalance:       domain.MustParseDecimal("1000.00"),
 			StartDate:            startDate,
-			CommissionPercentage: 5.0,
+			CommissionPercentage: domain.MustParseDecimal("5.0"),
 		}
 
 		bankroll2 := &Bankroll{
 			UserID:               2,
 			Name:                 "Main Bankroll",
 			Currency:             CurrencyUSD,
-			InitialBalance:       500.00,
-			CurrentBalance:       500.00,
+			InitialBalance:       domain.MustParseDecimal("500.00"),
+			CurrentBalance:       domain.MustParseDecimal("500.00"),
 			StartDate:            startDate,
-			CommissionPercentage: 3.0,
+			CommissionPercentage: domain.MustParseDecimal("3.0"),
 		}
 
 		err = repo.Create(ctx, bankroll1)
@@ -381,20 +485,21 @@ func TestPostgresBankrollRepository_Update(t *testing.T) {
 		err = repo.Create(ctx, bankroll2)
 		require.NoError(t, err)
 
-		bankroll2.CommissionPercentage = 4.0
+		bankroll2.CommissionPercentage = domain.MustParseDecimal("4.0")
 		err = repo.Update(ctx, bankroll2)
 
 		assert.NoError(t, err)
 
 		updated, err := repo.FindByID(ctx, bankroll2.ID, 2)
 		require.NoError(t, err)
-		assert.Equal(t, 4.0, updated.CommissionPercentage)
+		assert.Equal(t, 4.0, updated.CommissionPercentage.Float64())
 	})
 }
 
 func TestPostgresBankrollRepository_Reset(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		db := setupTestDB(t)
+		require.NoError(t, db.AutoMigrate(&historyTestBet{}, &historyTestSession{}))
 		repo := NewPostgresBankrollRepository(db)
 		ctx := context.Background()
 
@@ -405,23 +510,24 @@ func TestPostgresBankrollRepository_Reset(t *testing.T) {
 			UserID:               1,
 			Name:                 "Main Bankroll",
 			Currency:             CurrencyBRL,
-			InitialBalance:       1000.00,
-			CurrentBalance:       1000.00,
+			InitialBalance:       domain.MustParseDecimal("1000.00"),
+			CurrentBalance:       domain.MustParseDecimal("1000.00"),
 			StartDate:            startDate,
-			CommissionPercentage: 5.0,
+			CommissionPercentage: domain.MustParseDecimal("5.0"),
 		}
 
 		err = repo.Create(ctx, bankroll)
 		require.NoError(t, err)
 
-		err = repo.Reset(ctx, bankroll.ID, 1)
+		period, err := repo.Reset(ctx, bankroll.ID, 1, domain.Zero, 0)
 
 		assert.NoError(t, err)
+		assert.Equal(t, "1000.00", period.FinalBalance.Round(2).String())
 
 		reset, err := repo.FindByID(ctx, bankroll.ID, 1)
 		require.NoError(t, err)
-		assert.Equal(t, 0.0, reset.InitialBalance)
-		assert.Equal(t, 0.0, reset.CurrentBalance)
+		assert.Equal(t, 0.0, reset.InitialBalance.Float64())
+		assert.Equal(t, 0.0, reset.CurrentBalance.Float64())
 	})
 
 	t.Run("not found", func(t *testing.T) {
@@ -429,9 +535,151 @@ func TestPostgresBankrollRepository_Reset(t *testing.T) {
 		repo := NewPostgresBankrollRepository(db)
 		ctx := context.Background()
 
-		err := repo.Reset(ctx, 999, 1)
+		_, err := repo.Reset(ctx, 999, 1, domain.Zero, 0)
 
 		assert.Error(t, err)
 		assert.ErrorIs(t, err, ErrBankrollNotFound)
 	})
 }
+
+func TestPostgresBankrollRepository_ArchiveDeleteRestore(t *testing.T) {
+	newBankroll := func(t *testing.T, repo BankrollRepository, name string) *Bankroll {
+		b := &Bankroll{
+			UserID:         1,
+			Name:           name,
+			Currency:       CurrencyBRL,
+			InitialBalance: domain.MustParseDecimal("100.00"),
+			CurrentBalance: domain.MustParseDecimal("100.00"),
+			StartDate:      time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+		}
+		require.NoError(t, repo.Create(context.Background(), b))
+		return b
+	}
+
+	t.Run("archived bankrolls are hidden from listing by default", func(t *testing.T) {
+		repo := NewPostgresBankrollRepository(setupTestDB(t))
+		ctx := context.Background()
+
+		active := newBankroll(t, repo, "Active")
+		archived := newBankroll(t, repo, "Old")
+
+		require.NoError(t, repo.Archive(ctx, archived.ID, 1))
+		assert.ErrorIs(t, repo.Archive(ctx, archived.ID, 1), ErrBankrollArchived)
+
+		bankrolls, total, err := repo.List(ctx, 1, BankrollListFilter{Sort: BankrollSortCreatedAt, Limit: 10})
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), total)
+		assert.Equal(t, active.ID, bankrolls[0].ID)
+
+		_, total, err = repo.List(ctx, 1, BankrollListFilter{Sort: BankrollSortCreatedAt, IncludeArchived: true, Limit: 10})
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), total)
+
+		found, err := repo.FindByID(ctx, archived.ID, 1)
+		require.NoError(t, err)
+		assert.True(t, found.IsArchived())
+
+		require.NoError(t, repo.Restore(ctx, archived.ID, 1))
+		found, err = repo.FindByID(ctx, archived.ID, 1)
+		require.NoError(t, err)
+		assert.False(t, found.IsArchived())
+
+		assert.ErrorIs(t, repo.Restore(ctx, archived.ID, 1), ErrBankrollNotArchived)
+	})
+
+	t.Run("deleted name can be reused", func(t *testing.T) {
+		repo := NewPostgresBankrollRepository(setupTestDB(t))
+		ctx := context.Background()
+
+		deleted := newBankroll(t, repo, "Stars")
+		require.NoError(t, repo.Delete(ctx, deleted.ID, 1))
+		assert.ErrorIs(t, repo.Delete(ctx, deleted.ID, 1), ErrBankrollNotFound)
+
+		_, err := repo.FindByID(ctx, deleted.ID, 1)
+		assert.ErrorIs(t, err, ErrBankrollNotFound)
+
+		replacement := newBankroll(t, repo, "Stars")
+		assert.NotEqual(t, deleted.ID, replacement.ID)
+
+		other := newBankroll(t, repo, "Party")
+		other.Name = "Stars"
+		assert.ErrorIs(t, repo.Update(ctx, other), ErrBankrollNameExists)
+
+		assert.ErrorIs(t, repo.Restore(ctx, deleted.ID, 1), ErrBankrollNameExists)
+
+		require.NoError(t, repo.Delete(ctx, replacement.ID, 1))
+		require.NoError(t, repo.Restore(ctx, deleted.ID, 1))
+
+		restored, err := repo.FindByID(ctx, deleted.ID, 1)
+		require.NoError(t, err)
+		assert.Equal(t, "Stars", restored.Name)
+	})
+
+	t.Run("other users cannot touch the bankroll", func(t *testing.T) {
+		repo := NewPostgresBankrollRepository(setupTestDB(t))
+		ctx := context.Background()
+
+		b := newBankroll(t, repo, "Mine")
+
+		assert.ErrorIs(t, repo.Delete(ctx, b.ID, 2), ErrBankrollNotFound)
+		assert.ErrorIs(t, repo.Restore(ctx, b.ID, 2), ErrBankrollNotFound)
+	})
+}
+
+func TestPostgresBankrollRepository_Version(t *testing.T) {
+	t.Run("writes bump the version", func(t *testing.T) {
+		db := setupTestDB(t)
+		repo := NewPostgresBankrollRepository(db)
+		ctx := context.Background()
+		bankroll := createTestBankroll(t, db, 1, "100.00")
+		assert.Equal(t, uint(1), bankroll.Version)
+
+		bankroll.Name = "Renamed"
+		bankroll.Version = 1
+		require.NoError(t, repo.Update(ctx, bankroll))
+
+		require.NoError(t, NewPostgresTransactionRepository(db).Create(ctx, &Transaction{
+			BankrollID: bankroll.ID,
+			Type:       TransactionTypeDeposit,
+			Amount:     domain.MustParseDecimal("10.00"),
+		}, 1, 2))
+
+		found, err := repo.FindByID(ctx, bankroll.ID, 1)
+		require.NoError(t, err)
+		assert.Equal(t, uint(3), found.Version)
+		assert.Equal(t, "Renamed", found.Name)
+	})
+
+	t.Run("stale version is rejected", func(t *testing.T) {
+		db := setupTestDB(t)
+		repo := NewPostgresBankrollRepository(db)
+		transactions := NewPostgresTransactionRepository(db)
+		ctx := context.Background()
+		bankroll := createTestBankroll(t, db, 1, "100.00")
+
+		require.NoError(t, transactions.Create(ctx, &Transaction{
+			BankrollID: bankroll.ID,
+			Type:       TransactionTypeDeposit,
+			Amount:     domain.MustParseDecimal("10.00"),
+		}, 1, 0))
+
+		stale := &Bankroll{ID: bankroll.ID, UserID: 1, Name: "Stale", Currency: CurrencyBRL, StartDate: bankroll.StartDate, Version: 1}
+		assert.ErrorIs(t, repo.Update(ctx, stale), ErrVersionConflict)
+
+		err := transactions.Create(ctx, &Transaction{
+			BankrollID: bankroll.ID,
+			Type:       TransactionTypeDeposit,
+			Amount:     domain.MustParseDecimal("10.00"),
+		}, 1, 1)
+		assert.ErrorIs(t, err, ErrVersionConflict)
+
+		_, err = repo.Reset(ctx, bankroll.ID, 1, domain.Zero, 1)
+		assert.ErrorIs(t, err, ErrVersionConflict)
+
+		found, err := repo.FindByID(ctx, bankroll.ID, 1)
+		require.NoError(t, err)
+		assert.Equal(t, "Main Bankroll", found.Name)
+		assert.Equal(t, 110.0, found.CurrentBalance.Float64())
+		assert.Equal(t, uint(2), found.Version)
+	})
+}
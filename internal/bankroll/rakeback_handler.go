@@ -0,0 +1,190 @@
+package bankroll
+
+import (
+	"net/http"
+	"strconv"
+
+	"log/slog"
+
+	"github.com/gin-gonic/gin"
+)
+
+type RakebackHandler struct {
+	service RakebackService
+	logger  *slog.Logger
+}
+
+func NewRakebackHandler(service RakebackService, logger *slog.Logger) *RakebackHandler {
+	return &RakebackHandler{
+		service: service,
+		logger:  logger,
+	}
+}
+
+func (h *RakebackHandler) CreateDeal(c *gin.Context) {
+	var input RakebackDealInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		h.logger.Error("invalid request body", "error", err)
+		c.JSON(http.StatusBadRequest, ErrorOutput{
+			Error:   "Invalid request body",
+			Code:    "VALIDATION_ERROR",
+			Details: nil,
+		})
+		return
+	}
+
+	userID, err := getUserID(c)
+	if err != nil {
+		handleError(c, h.logger, err)
+		return
+	}
+
+	bankrollIDStr := c.Param("bankrollId")
+	bankrollID, err := strconv.ParseUint(bankrollIDStr, 10, 32)
+	if err != nil {
+		handleError(c, h.logger, ErrUnauthorized)
+		return
+	}
+
+	output, err := h.service.CreateDeal(c.Request.Context(), userID, uint(bankrollID), input)
+	if err != nil {
+		handleError(c, h.logger, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, output)
+}
+
+func (h *RakebackHandler) ListDeals(c *gin.Context) {
+	var input ListRakebackDealsInput
+	if err := c.ShouldBindQuery(&input); err != nil {
+		h.logger.Error("invalid query parameters", "error", err)
+		c.JSON(http.StatusBadRequest, ErrorOutput{
+			Error:   "Invalid query parameters",
+			Code:    "VALIDATION_ERROR",
+			Details: nil,
+		})
+		return
+	}
+
+	userID, err := getUserID(c)
+	if err != nil {
+		handleError(c, h.logger, err)
+		return
+	}
+
+	bankrollIDStr := c.Param("bankrollId")
+	bankrollID, err := strconv.ParseUint(bankrollIDStr, 10, 32)
+	if err != nil {
+		handleError(c, h.logger, ErrUnauthorized)
+		return
+	}
+
+	output, err := h.service.ListDeals(c.Request.Context(), userID, uint(bankrollID), input)
+	if err != nil {
+		handleError(c, h.logger, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
+
+func (h *RakebackHandler) UpdateDeal(c *gin.Context) {
+	var input UpdateRakebackDealInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		h.logger.Error("invalid request body", "error", err)
+		c.JSON(http.StatusBadRequest, ErrorOutput{
+			Error:   "Invalid request body",
+			Code:    "VALIDATION_ERROR",
+			Details: nil,
+		})
+		return
+	}
+
+	userID, err := getUserID(c)
+	if err != nil {
+		handleError(c, h.logger, err)
+		return
+	}
+
+	bankrollID, dealID, err := rakebackDealParams(c)
+	if err != nil {
+		handleError(c, h.logger, err)
+		return
+	}
+
+	output, err := h.service.UpdateDeal(c.Request.Context(), userID, bankrollID, dealID, input)
+	if err != nil {
+		handleError(c, h.logger, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
+
+func (h *RakebackHandler) DeleteDeal(c *gin.Context) {
+	userID, err := getUserID(c)
+	if err != nil {
+		handleError(c, h.logger, err)
+		return
+	}
+
+	bankrollID, dealID, err := rakebackDealParams(c)
+	if err != nil {
+		handleError(c, h.logger, err)
+		return
+	}
+
+	if err := h.service.DeleteDeal(c.Request.Context(), userID, bankrollID, dealID); err != nil {
+		handleError(c, h.logger, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (h *RakebackHandler) ListPayouts(c *gin.Context) {
+	var input ListRakebackPayoutsInput
+	if err := c.ShouldBindQuery(&input); err != nil {
+		h.logger.Error("invalid query parameters", "error", err)
+		c.JSON(http.StatusBadRequest, ErrorOutput{
+			Error:   "Invalid query parameters",
+			Code:    "VALIDATION_ERROR",
+			Details: nil,
+		})
+		return
+	}
+
+	userID, err := getUserID(c)
+	if err != nil {
+		handleError(c, h.logger, err)
+		return
+	}
+
+	bankrollIDStr := c.Param("bankrollId")
+	bankrollID, err := strconv.ParseUint(bankrollIDStr, 10, 32)
+	if err != nil {
+		handleError(c, h.logger, ErrUnauthorized)
+		return
+	}
+
+	output, err := h.service.ListPayouts(c.Request.Context(), userID, uint(bankrollID), input)
+	if err != nil {
+		handleError(c, h.logger, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
+
+func rakebackDealParams(c *gin.Context) (uint, uint, error) {
+	bankrollID, err := strconv.ParseUint(c.Param("bankrollId"), 10, 32)
+	if err != nil {
+		return 0, 0, ErrUnauthorized
+	}
+	dealID, err := strconv.ParseUint(c.Param("dealId"), 10, 32)
+	if err != nil {
+		return 0, 0, ErrRakebackDealNotFound
+	}
+	return uint(bankrollID), uint(dealID), nil
+}
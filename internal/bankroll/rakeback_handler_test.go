@@ -0,0 +1,160 @@
+package bankroll
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"log/slog"
+
+	"github.com/gin-gonic/gin"
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
+	"github.com/opinedajr/micro-stakes-api/internal/shared/pagination"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type MockRakebackService struct {
+	mock.Mock
+}
+
+func (m *MockRakebackService) CreateDeal(ctx context.Context, userID uint, bankrollID uint, input RakebackDealInput) (*RakebackDealOutput, error) {
+	args := m.Called(ctx, userID, bankrollID, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*RakebackDealOutput), args.Error(1)
+}
+
+func (m *MockRakebackService) ListDeals(ctx context.Context, userID uint, bankrollID uint, input ListRakebackDealsInput) (*RakebackDealPageOutput, error) {
+	args := m.Called(ctx, userID, bankrollID, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*RakebackDealPageOutput), args.Error(1)
+}
+
+func (m *MockRakebackService) UpdateDeal(ctx context.Context, userID uint, bankrollID uint, dealID uint, input UpdateRakebackDealInput) (*RakebackDealOutput, error) {
+	args := m.Called(ctx, userID, bankrollID, dealID, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*RakebackDealOutput), args.Error(1)
+}
+
+func (m *MockRakebackService) DeleteDeal(ctx context.Context, userID uint, bankrollID uint, dealID uint) error {
+	args := m.Called(ctx, userID, bankrollID, dealID)
+	return args.Error(0)
+}
+
+func (m *MockRakebackService) ListPayouts(ctx context.Context, userID uint, bankrollID uint, input ListRakebackPayoutsInput) (*RakebackPayoutPageOutput, error) {
+	args := m.Called(ctx, userID, bankrollID, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*RakebackPayoutPageOutput), args.Error(1)
+}
+
+func TestCreateRakebackDealHandler(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		mockService := new(MockRakebackService)
+		handler := NewRakebackHandler(mockService, slog.Default())
+
+		input := RakebackDealInput{Name: "Club deal", Source: RakebackSourceRake, Percentage: domain.MustParseDecimal("30"), Period: RakebackPeriodWeekly}
+		mockService.On("CreateDeal", mock.Anything, uint(1), uint(2), input).
+			Return(&RakebackDealOutput{ID: 5, BankrollID: 2, Source: RakebackSourceRake, Percentage: input.Percentage, Active: true}, nil).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = newRuleRequest(t, http.MethodPost, "/bankrolls/2/rakeback-deals", `{"name":"Club deal","source":"rake","percentage":"30","period":"weekly"}`)
+		c.Params = gin.Params{{Key: "bankrollId", Value: "2"}}
+		c.Set("userID", "1")
+
+		handler.CreateDeal(c)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		var response RakebackDealOutput
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, uint(5), response.ID)
+		assert.True(t, response.Active)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("invalid deal", func(t *testing.T) {
+		mockService := new(MockRakebackService)
+		handler := NewRakebackHandler(mockService, slog.Default())
+
+		mockService.On("CreateDeal", mock.Anything, uint(1), uint(2), mock.AnythingOfType("bankroll.RakebackDealInput")).
+			Return(nil, WrapError(ErrInvalidRakebackDeal, "period must be weekly or monthly")).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = newRuleRequest(t, http.MethodPost, "/bankrolls/2/rakeback-deals", `{"name":"Club deal","source":"rake","percentage":"30","period":"daily"}`)
+		c.Params = gin.Params{{Key: "bankrollId", Value: "2"}}
+		c.Set("userID", "1")
+
+		handler.CreateDeal(c)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		var response ErrorOutput
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, "INVALID_RAKEBACK_DEAL", response.Code)
+	})
+}
+
+func TestUpdateRakebackDealHandler(t *testing.T) {
+	mockService := new(MockRakebackService)
+	handler := NewRakebackHandler(mockService, slog.Default())
+
+	mockService.On("UpdateDeal", mock.Anything, uint(1), uint(2), uint(9), mock.AnythingOfType("bankroll.UpdateRakebackDealInput")).
+		Return(nil, ErrRakebackDealNotFound).Once()
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = newRuleRequest(t, http.MethodPut, "/bankrolls/2/rakeback-deals/9", `{"name":"Club deal","percentage":"35","active":false}`)
+	c.Params = gin.Params{{Key: "bankrollId", Value: "2"}, {Key: "dealId", Value: "9"}}
+	c.Set("userID", "1")
+
+	handler.UpdateDeal(c)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	var response ErrorOutput
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, "RAKEBACK_DEAL_NOT_FOUND", response.Code)
+}
+
+func TestListRakebackPayoutsHandler(t *testing.T) {
+	mockService := new(MockRakebackService)
+	handler := NewRakebackHandler(mockService, slog.Default())
+
+	transactionID := uint(12)
+	mockService.On("ListPayouts", mock.Anything, uint(1), uint(2), ListRakebackPayoutsInput{Params: pagination.Params{Offset: 3}}).Return(&RakebackPayoutPageOutput{
+		Items: []*RakebackPayoutOutput{
+			{ID: 1, DealID: 5, BankrollID: 2, Basis: domain.MustParseDecimal("40.00"), Amount: domain.MustParseDecimal("12.00"), TransactionID: &transactionID},
+		},
+		Total:  4,
+		Limit:  pagination.DefaultLimit,
+		Offset: 3,
+	}, nil).Once()
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/bankrolls/2/rakeback-payouts?offset=3", nil)
+	c.Params = gin.Params{{Key: "bankrollId", Value: "2"}}
+	c.Set("userID", "1")
+
+	handler.ListPayouts(c)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	var response RakebackPayoutPageOutput
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	require.Len(t, response.Items, 1)
+	assert.Equal(t, int64(4), response.Total)
+	assert.Equal(t, 3, response.Offset)
+	assert.Equal(t, "12.00", response.Items[0].Amount.String())
+	require.NotNil(t, response.Items[0].TransactionID)
+	assert.Equal(t, uint(12), *response.Items[0].TransactionID)
+}
@@ -0,0 +1,151 @@
+package bankroll
+
+import (
+	"time"
+
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
+)
+
+type RakebackSource string
+
+const (
+	// RakebackSourceRake pays back a share of the rake taken in poker
+	// sessions.
+	RakebackSourceRake RakebackSource = "rake"
+	// RakebackSourceCommission pays back a share of the exchange commission
+	// charged on settled bets.
+	RakebackSourceCommission RakebackSource = "commission"
+)
+
+func (s RakebackSource) IsValid() bool {
+	return s == RakebackSourceRake || s == RakebackSourceCommission
+}
+
+type RakebackPeriod string
+
+const (
+	RakebackPeriodWeekly  RakebackPeriod = "weekly"
+	RakebackPeriodMonthly RakebackPeriod = "monthly"
+)
+
+func (p RakebackPeriod) IsValid() bool {
+	return p == RakebackPeriodWeekly || p == RakebackPeriodMonthly
+}
+
+// Start returns the beginning of the payout period containing at, counted in
+// loc. Weeks start on Monday.
+func (p RakebackPeriod) Start(at time.Time, loc *time.Location) time.Time {
+	local := at.In(loc)
+	if p == RakebackPeriodMonthly {
+		return time.Date(local.Year(), local.Month(), 1, 0, 0, 0, 0, loc)
+	}
+	start := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, loc)
+	return start.AddDate(0, 0, -((int(start.Weekday()) + 6) % 7))
+}
+
+// End returns the beginning of the period that follows the one starting at
+// start.
+func (p RakebackPeriod) End(start time.Time) time.Time {
+	if p == RakebackPeriodMonthly {
+		return start.AddDate(0, 1, 0)
+	}
+	return start.AddDate(0, 0, 7)
+}
+
+// RakebackDeal pays Percentage of the rake or commission a bankroll generates
+// back into it at the end of every period. Venue, when set, narrows a rake
+// deal to sessions played there. PaidThrough is the start of the first period
+// not paid out yet. Sessions and bets count from AccruesFrom on; what each
+// payout credited is kept as RakebackPayoutItem rows, so those that show up
+// late, change or drop out after their period was paid are settled on the
+// next payout.
+type RakebackDeal struct {
+	ID          uint           `gorm:"primaryKey;autoIncrement"`
+	BankrollID  uint           `gorm:"not null;index"`
+	UserID      uint           `gorm:"not null;index"`
+	Name        string         `gorm:"type:varchar(100);not null"`
+	Source      RakebackSource `gorm:"type:varchar(20);not null"`
+	Percentage  domain.Decimal `gorm:"type:decimal(5,2);not null"`
+	Period      RakebackPeriod `gorm:"type:varchar(10);not null"`
+	Venue       string         `gorm:"type:varchar(100);not null;default:''"`
+	Active      bool           `gorm:"not null;default:true"`
+	PaidThrough time.Time      `gorm:"not null;index"`
+	AccruesFrom time.Time      `gorm:"not null"`
+	CreatedAt   time.Time      `gorm:"autoCreateTime"`
+	UpdatedAt   time.Time      `gorm:"autoUpdateTime"`
+}
+
+func (RakebackDeal) TableName() string {
+	return "rakeback_deals"
+}
+
+// Due returns the start of every period of the deal that has ended by now
+// and has not been paid out. Periods are counted in loc.
+func (d *RakebackDeal) Due(now time.Time, loc *time.Location) []time.Time {
+	var due []time.Time
+	for start := d.PaidThrough.In(loc); !d.Period.End(start).After(now); start = d.Period.End(start) {
+		due = append(due, start)
+	}
+	return due
+}
+
+// Rakeback returns the deal's share of basis in the bankroll's currency.
+// A negative basis, left by commission refunds, pays nothing.
+func (d *RakebackDeal) Rakeback(basis domain.Decimal, currency Currency) domain.Decimal {
+	if !basis.IsPositive() {
+		return domain.Zero
+	}
+	return currency.Round(basis.Mul(d.Percentage).Div(hundred))
+}
+
+// RakebackDealListFilter selects a page of a bankroll's deals, oldest first.
+// AfterID, when set, replaces Offset with keyset paging.
+type RakebackDealListFilter struct {
+	AfterID uint
+	Limit   int
+	Offset  int
+}
+
+// RakebackPayoutListFilter selects a page of a bankroll's payouts, latest
+// period first. After, when set, replaces Offset with keyset paging.
+type RakebackPayoutListFilter struct {
+	After  *BankrollCursor
+	Limit  int
+	Offset int
+}
+
+// RakebackPayout settles one period of a deal. Basis includes adjustments for
+// sessions or bets of earlier periods. TransactionID is nil when the period
+// earned nothing and no ledger entry was posted.
+type RakebackPayout struct {
+	ID            uint           `gorm:"primaryKey;autoIncrement"`
+	DealID        uint           `gorm:"not null;uniqueIndex:idx_rakeback_payouts_deal_period"`
+	BankrollID    uint           `gorm:"not null;index"`
+	UserID        uint           `gorm:"not null;index"`
+	PeriodStart   time.Time      `gorm:"not null;uniqueIndex:idx_rakeback_payouts_deal_period"`
+	PeriodEnd     time.Time      `gorm:"not null"`
+	Basis         domain.Decimal `gorm:"type:decimal(27,8);not null"`
+	Percentage    domain.Decimal `gorm:"type:decimal(5,2);not null"`
+	Amount        domain.Decimal `gorm:"type:decimal(27,8);not null"`
+	TransactionID *uint
+	CreatedAt     time.Time `gorm:"autoCreateTime"`
+}
+
+func (RakebackPayout) TableName() string {
+	return "rakeback_payouts"
+}
+
+// RakebackPayoutItem records the rake or commission of one session or bet
+// credited by a payout. Amount is the change since the deal last credited
+// it, negative when it was lowered or no longer counts.
+type RakebackPayoutItem struct {
+	ID       uint           `gorm:"primaryKey;autoIncrement"`
+	PayoutID uint           `gorm:"not null;index"`
+	DealID   uint           `gorm:"not null;index:idx_rakeback_payout_items_deal_source"`
+	SourceID uint           `gorm:"not null;index:idx_rakeback_payout_items_deal_source"`
+	Amount   domain.Decimal `gorm:"type:decimal(27,8);not null"`
+}
+
+func (RakebackPayoutItem) TableName() string {
+	return "rakeback_payout_items"
+}
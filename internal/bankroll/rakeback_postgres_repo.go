@@ -0,0 +1,302 @@
+package bankroll
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
+	"github.com/opinedajr/micro-stakes-api/internal/shared/pagination"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type postgresRakebackRepository struct {
+	db *gorm.DB
+}
+
+func NewPostgresRakebackRepository(db *gorm.DB) RakebackRepository {
+	return &postgresRakebackRepository{
+		db: db,
+	}
+}
+
+func (r *postgresRakebackRepository) Create(ctx context.Context, deal *RakebackDeal) error {
+	if err := r.db.WithContext(ctx).Create(deal).Error; err != nil {
+		return WrapError(ErrDatabaseError, err.Error())
+	}
+	return nil
+}
+
+func (r *postgresRakebackRepository) FindByID(ctx context.Context, id uint, bankrollID uint, userID uint) (*RakebackDeal, error) {
+	var deal RakebackDeal
+	err := r.db.WithContext(ctx).
+		Where("id = ? AND bankroll_id = ? AND user_id = ?", id, bankrollID, userID).
+		First(&deal).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, ErrRakebackDealNotFound
+		}
+		return nil, WrapError(ErrDatabaseError, err.Error())
+	}
+	return &deal, nil
+}
+
+// ListByBankrollID returns a page of the bankroll's deals together with the
+// total number of deals.
+func (r *postgresRakebackRepository) ListByBankrollID(ctx context.Context, bankrollID uint, userID uint, filter RakebackDealListFilter) ([]*RakebackDeal, int64, error) {
+	query := r.db.WithContext(ctx).Model(&RakebackDeal{}).
+		Where("bankroll_id = ? AND user_id = ?", bankrollID, userID)
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, WrapError(ErrDatabaseError, err.Error())
+	}
+
+	if filter.AfterID != 0 {
+		query = query.Where("id > ?", filter.AfterID)
+	} else if filter.Offset > 0 {
+		query = query.Offset(filter.Offset)
+	}
+
+	var deals []*RakebackDeal
+	if err := query.Order("id").Limit(filter.Limit).Find(&deals).Error; err != nil {
+		return nil, 0, WrapError(ErrDatabaseError, err.Error())
+	}
+	return deals, total, nil
+}
+
+func (r *postgresRakebackRepository) Update(ctx context.Context, deal *RakebackDeal) error {
+	result := r.db.WithContext(ctx).Model(&RakebackDeal{}).
+		Where("id = ? AND bankroll_id = ? AND user_id = ?", deal.ID, deal.BankrollID, deal.UserID).
+		Updates(map[string]interface{}{
+			"name":         deal.Name,
+			"percentage":   deal.Percentage,
+			"venue":        deal.Venue,
+			"active":       deal.Active,
+			"paid_through": deal.PaidThrough,
+			"updated_at":   time.Now(),
+		})
+	if result.Error != nil {
+		return WrapError(ErrDatabaseError, result.Error.Error())
+	}
+	if result.RowsAffected == 0 {
+		return ErrRakebackDealNotFound
+	}
+	return nil
+}
+
+func (r *postgresRakebackRepository) Delete(ctx context.Context, id uint, bankrollID uint, userID uint) error {
+	result := r.db.WithContext(ctx).
+		Where("id = ? AND bankroll_id = ? AND user_id = ?", id, bankrollID, userID).
+		Delete(&RakebackDeal{})
+	if result.Error != nil {
+		return WrapError(ErrDatabaseError, result.Error.Error())
+	}
+	if result.RowsAffected == 0 {
+		return ErrRakebackDealNotFound
+	}
+	return nil
+}
+
+// ListPayouts returns a page of the bankroll's payouts together with the
+// total number of payouts.
+func (r *postgresRakebackRepository) ListPayouts(ctx context.Context, bankrollID uint, userID uint, filter RakebackPayoutListFilter) ([]*RakebackPayout, int64, error) {
+	query := r.db.WithContext(ctx).Model(&RakebackPayout{}).
+		Where("bankroll_id = ? AND user_id = ?", bankrollID, userID)
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, WrapError(ErrDatabaseError, err.Error())
+	}
+
+	if filter.After != nil {
+		query = query.Where(pagination.KeysetCondition("period_start", true),
+			filter.After.Value, filter.After.Value, filter.After.ID)
+	} else if filter.Offset > 0 {
+		query = query.Offset(filter.Offset)
+	}
+
+	var payouts []*RakebackPayout
+	err := query.Order(pagination.OrderClause("period_start", true)).
+		Limit(filter.Limit).
+		Find(&payouts).Error
+	if err != nil {
+		return nil, 0, WrapError(ErrDatabaseError, err.Error())
+	}
+	return payouts, total, nil
+}
+
+// ListDue returns the active deals whose unpaid period started before now.
+// Whether that period has ended depends on the deal's period length, which
+// RakebackDeal.Due decides. Deals of deleted bankrolls are left out.
+func (r *postgresRakebackRepository) ListDue(ctx context.Context, now time.Time) ([]*RakebackDeal, error) {
+	var deals []*RakebackDeal
+	err := r.db.WithContext(ctx).
+		Joins("JOIN bankrolls ON bankrolls.id = rakeback_deals.bankroll_id AND bankrolls.deleted_at IS NULL").
+		Where("rakeback_deals.active = ? AND rakeback_deals.paid_through < ?", true, now).
+		Order("rakeback_deals.id").
+		Find(&deals).Error
+	if err != nil {
+		return nil, WrapError(ErrDatabaseError, err.Error())
+	}
+	return deals, nil
+}
+
+// Basis returns what the deal has not paid out yet for sessions that ended,
+// or bets that settled, before to.
+func (r *postgresRakebackRepository) Basis(ctx context.Context, deal *RakebackDeal, to time.Time) (domain.Decimal, error) {
+	basis, _, err := unpaidRakebackBasis(r.db.WithContext(ctx), deal, to)
+	return basis, err
+}
+
+// Pay records a period's payout, credits it to the bankroll when there is
+// anything to pay and moves the deal on to the next period, all in one
+// transaction. The basis is worked out under the deal's row lock so a period
+// is only ever paid once, along with the sessions or bets it credits;
+// ErrRakebackAlreadyPaid reports a period settled by someone else. A basis
+// that is not positive pays nothing and credits nothing, leaving it to be
+// offset on the next payout.
+func (r *postgresRakebackRepository) Pay(ctx context.Context, deal *RakebackDeal, payout *RakebackPayout) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var current RakebackDeal
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ?", deal.ID).
+			First(&current).Error
+		if err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return ErrRakebackDealNotFound
+			}
+			return WrapError(ErrDatabaseError, err.Error())
+		}
+		if !current.Active || !current.PaidThrough.Equal(payout.PeriodStart) {
+			return ErrRakebackAlreadyPaid
+		}
+
+		var bankroll Bankroll
+		if err := tx.Where("id = ?", current.BankrollID).First(&bankroll).Error; err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return ErrBankrollNotFound
+			}
+			return WrapError(ErrDatabaseError, err.Error())
+		}
+		basis, items, err := unpaidRakebackBasis(tx, &current, payout.PeriodEnd)
+		if err != nil {
+			return err
+		}
+		payout.Basis = basis
+		payout.Percentage = current.Percentage
+		payout.Amount = current.Rakeback(basis, bankroll.Currency)
+
+		if err := tx.Create(payout).Error; err != nil {
+			return WrapError(ErrDatabaseError, err.Error())
+		}
+		if basis.IsPositive() && len(items) > 0 {
+			for _, item := range items {
+				item.PayoutID = payout.ID
+			}
+			if err := tx.Create(&items).Error; err != nil {
+				return WrapError(ErrDatabaseError, err.Error())
+			}
+		}
+		if payout.Amount.IsPositive() {
+			transaction := &Transaction{
+				BankrollID:    payout.BankrollID,
+				Type:          TransactionTypeRakeback,
+				Amount:        payout.Amount,
+				Description:   fmt.Sprintf("%s rakeback %s to %s", current.Name, payout.PeriodStart.Format("2006-01-02"), payout.PeriodEnd.AddDate(0, 0, -1).Format("2006-01-02")),
+				ReferenceType: "rakeback_payout",
+				ReferenceID:   &payout.ID,
+			}
+			if err := ApplyTransaction(tx, transaction, payout.UserID); err != nil {
+				return err
+			}
+			payout.TransactionID = &transaction.ID
+			if err := tx.Model(payout).Update("transaction_id", transaction.ID).Error; err != nil {
+				return WrapError(ErrDatabaseError, err.Error())
+			}
+		}
+
+		if err := tx.Model(&current).Updates(map[string]interface{}{
+			"paid_through": payout.PeriodEnd,
+			"updated_at":   time.Now(),
+		}).Error; err != nil {
+			return WrapError(ErrDatabaseError, err.Error())
+		}
+		deal.PaidThrough = payout.PeriodEnd
+		return nil
+	})
+}
+
+// unpaidRakebackBasis compares the rake of the deal's sessions, or the
+// commission of its bets, that ended before to with what its payouts
+// credited for them. Those that ended before AccruesFrom only count once
+// credited. It returns the difference and the items that would settle it.
+func unpaidRakebackBasis(db *gorm.DB, deal *RakebackDeal, to time.Time) (domain.Decimal, []*RakebackPayoutItem, error) {
+	type sourceAmount struct {
+		ID     uint
+		Amount domain.Decimal
+	}
+	credited := db.Table("rakeback_payout_items").
+		Select("1").
+		Where("rakeback_payout_items.deal_id = ?", deal.ID)
+
+	var query *gorm.DB
+	switch deal.Source {
+	case RakebackSourceRake:
+		query = db.Table("poker_sessions").
+			Select("id, rake AS amount").
+			Where("bankroll_id = ? AND status = ? AND deleted_at IS NULL AND ended_at < ?", deal.BankrollID, "settled", to).
+			Where("ended_at >= ? OR EXISTS (?)", deal.AccruesFrom, credited.Where("rakeback_payout_items.source_id = poker_sessions.id"))
+		if deal.Venue != "" {
+			query = query.Where("venue = ?", deal.Venue)
+		}
+	case RakebackSourceCommission:
+		query = db.Table("bets").
+			Select("id, commission AS amount").
+			Where("bankroll_id = ? AND status <> ? AND deleted_at IS NULL AND settled_at < ?", deal.BankrollID, "open", to).
+			Where("settled_at >= ? OR EXISTS (?)", deal.AccruesFrom, credited.Where("rakeback_payout_items.source_id = bets.id"))
+	default:
+		return domain.Zero, nil, WrapError(ErrInvalidRakebackDeal, fmt.Sprintf("unknown source %q", deal.Source))
+	}
+	var current []sourceAmount
+	if err := query.Order("id").Scan(&current).Error; err != nil {
+		return domain.Zero, nil, WrapError(ErrDatabaseError, err.Error())
+	}
+
+	var paid []sourceAmount
+	err := db.Table("rakeback_payout_items").
+		Select("source_id AS id, SUM(amount) AS amount").
+		Where("deal_id = ?", deal.ID).
+		Group("source_id").
+		Order("source_id").
+		Scan(&paid).Error
+	if err != nil {
+		return domain.Zero, nil, WrapError(ErrDatabaseError, err.Error())
+	}
+
+	owed := make(map[uint]domain.Decimal, len(current)+len(paid))
+	var ids []uint
+	for _, source := range current {
+		owed[source.ID] = source.Amount
+		ids = append(ids, source.ID)
+	}
+	for _, source := range paid {
+		amount, ok := owed[source.ID]
+		if !ok {
+			ids = append(ids, source.ID)
+		}
+		owed[source.ID] = amount.Sub(source.Amount)
+	}
+
+	basis := domain.Zero
+	var items []*RakebackPayoutItem
+	for _, id := range ids {
+		if owed[id].IsZero() {
+			continue
+		}
+		basis = basis.Add(owed[id])
+		items = append(items, &RakebackPayoutItem{DealID: deal.ID, SourceID: id, Amount: owed[id]})
+	}
+	return basis, items, nil
+}
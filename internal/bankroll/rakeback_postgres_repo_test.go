@@ -0,0 +1,283 @@
+package bankroll
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+type rakebackTestSession struct {
+	ID         uint `gorm:"primaryKey"`
+	BankrollID uint
+	Venue      string
+	Status     string
+	Rake       domain.Decimal `gorm:"type:decimal(27,8)"`
+	EndedAt    *time.Time
+	DeletedAt  gorm.DeletedAt
+}
+
+func (rakebackTestSession) TableName() string {
+	return "poker_sessions"
+}
+
+type rakebackTestBet struct {
+	ID         uint `gorm:"primaryKey"`
+	BankrollID uint
+	Status     string
+	Commission domain.Decimal `gorm:"type:decimal(27,8)"`
+	SettledAt  *time.Time
+	DeletedAt  gorm.DeletedAt
+}
+
+func (rakebackTestBet) TableName() string {
+	return "bets"
+}
+
+func createTestRakebackDeal(t *testing.T, repo RakebackRepository, bankroll *Bankroll, source RakebackSource, paidThrough time.Time) *RakebackDeal {
+	deal := &RakebackDeal{
+		BankrollID:  bankroll.ID,
+		UserID:      bankroll.UserID,
+		Name:        "Club deal",
+		Source:      source,
+		Percentage:  domain.MustParseDecimal("25"),
+		Period:      RakebackPeriodWeekly,
+		Active:      true,
+		PaidThrough: paidThrough,
+		AccruesFrom: paidThrough,
+	}
+	require.NoError(t, repo.Create(context.Background(), deal))
+	return deal
+}
+
+func TestPostgresRakebackRepository_ListByBankrollID(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewPostgresRakebackRepository(db)
+	ctx := context.Background()
+	bankroll := createTestBankroll(t, db, 1, "1000.00")
+	start := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+	first := createTestRakebackDeal(t, repo, bankroll, RakebackSourceRake, start)
+	second := createTestRakebackDeal(t, repo, bankroll, RakebackSourceCommission, start)
+
+	deals, total, err := repo.ListByBankrollID(ctx, bankroll.ID, bankroll.UserID, RakebackDealListFilter{Limit: 1})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), total)
+	require.Len(t, deals, 1)
+	assert.Equal(t, first.ID, deals[0].ID)
+
+	deals, _, err = repo.ListByBankrollID(ctx, bankroll.ID, bankroll.UserID, RakebackDealListFilter{Limit: 1, AfterID: first.ID})
+	require.NoError(t, err)
+	require.Len(t, deals, 1)
+	assert.Equal(t, second.ID, deals[0].ID)
+
+	deals, total, err = repo.ListByBankrollID(ctx, bankroll.ID, 2, RakebackDealListFilter{Limit: 1})
+	require.NoError(t, err)
+	assert.Zero(t, total)
+	assert.Empty(t, deals)
+}
+
+func TestPostgresRakebackRepository_Basis(t *testing.T) {
+	db := setupTestDB(t)
+	require.NoError(t, db.AutoMigrate(&rakebackTestSession{}, &rakebackTestBet{}))
+	repo := NewPostgresRakebackRepository(db)
+	ctx := context.Background()
+	bankroll := createTestBankroll(t, db, 1, "1000.00")
+
+	from := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, 7)
+	inside := from.Add(36 * time.Hour)
+	before := from.Add(-time.Hour)
+	after := to
+	deletedAt := gorm.DeletedAt{Time: inside, Valid: true}
+	require.NoError(t, db.Create(&[]rakebackTestSession{
+		{ID: 1, BankrollID: bankroll.ID, Venue: "Home Club", Status: "settled", Rake: domain.MustParseDecimal("12.50"), EndedAt: &inside},
+		{ID: 2, BankrollID: bankroll.ID, Venue: "Online", Status: "settled", Rake: domain.MustParseDecimal("7.25"), EndedAt: &inside},
+		{ID: 3, BankrollID: bankroll.ID, Venue: "Home Club", Status: "settled", Rake: domain.MustParseDecimal("9.00"), EndedAt: &after},
+		{ID: 4, BankrollID: bankroll.ID, Venue: "Home Club", Status: "open", Rake: domain.MustParseDecimal("3.00")},
+		{ID: 5, BankrollID: bankroll.ID, Venue: "Home Club", Status: "settled", Rake: domain.MustParseDecimal("4.00"), EndedAt: &inside, DeletedAt: deletedAt},
+		{ID: 6, BankrollID: bankroll.ID + 1, Venue: "Home Club", Status: "settled", Rake: domain.MustParseDecimal("8.00"), EndedAt: &inside},
+		{ID: 7, BankrollID: bankroll.ID, Venue: "Home Club", Status: "settled", Rake: domain.MustParseDecimal("6.00"), EndedAt: &before},
+	}).Error)
+	require.NoError(t, db.Create(&[]rakebackTestBet{
+		{ID: 1, BankrollID: bankroll.ID, Status: "won", Commission: domain.MustParseDecimal("2.40"), SettledAt: &inside},
+		{ID: 2, BankrollID: bankroll.ID, Status: "cashed_out", Commission: domain.MustParseDecimal("1.10"), SettledAt: &inside},
+		{ID: 3, BankrollID: bankroll.ID, Status: "open", Commission: domain.Zero},
+		{ID: 4, BankrollID: bankroll.ID, Status: "won", Commission: domain.MustParseDecimal("5.00"), SettledAt: &after},
+		{ID: 5, BankrollID: bankroll.ID, Status: "won", Commission: domain.MustParseDecimal("0.70"), SettledAt: &before},
+	}).Error)
+
+	rake := createTestRakebackDeal(t, repo, bankroll, RakebackSourceRake, from)
+	basis, err := repo.Basis(ctx, rake, to)
+	require.NoError(t, err)
+	assert.Equal(t, 19.75, basis.Float64())
+
+	rake.Venue = "Home Club"
+	basis, err = repo.Basis(ctx, rake, to)
+	require.NoError(t, err)
+	assert.Equal(t, 12.5, basis.Float64())
+
+	commission := createTestRakebackDeal(t, repo, bankroll, RakebackSourceCommission, from)
+	basis, err = repo.Basis(ctx, commission, to)
+	require.NoError(t, err)
+	assert.Equal(t, 3.5, basis.Float64())
+}
+
+func TestPostgresRakebackRepository_Pay(t *testing.T) {
+	db := setupTestDB(t)
+	require.NoError(t, db.AutoMigrate(&rakebackTestSession{}, &rakebackTestBet{}))
+	repo := NewPostgresRakebackRepository(db)
+	ctx := context.Background()
+	bankroll := createTestBankroll(t, db, 1, "1000.00")
+
+	start := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 0, 7)
+	inside := start.Add(36 * time.Hour)
+	require.NoError(t, db.Create(&rakebackTestSession{ID: 1, BankrollID: bankroll.ID, Status: "settled", Rake: domain.MustParseDecimal("40.00"), EndedAt: &inside}).Error)
+	deal := createTestRakebackDeal(t, repo, bankroll, RakebackSourceRake, start)
+	payout := &RakebackPayout{
+		DealID:      deal.ID,
+		BankrollID:  bankroll.ID,
+		UserID:      bankroll.UserID,
+		PeriodStart: start,
+		PeriodEnd:   end,
+	}
+
+	require.NoError(t, repo.Pay(ctx, deal, payout))
+	assert.Equal(t, end, deal.PaidThrough)
+	assert.Equal(t, 40.0, payout.Basis.Float64())
+	assert.Equal(t, "10.00", payout.Amount.String())
+	require.NotNil(t, payout.TransactionID)
+
+	var transaction Transaction
+	require.NoError(t, db.First(&transaction, *payout.TransactionID).Error)
+	assert.Equal(t, TransactionTypeRakeback, transaction.Type)
+	assert.Equal(t, "rakeback_payout", transaction.ReferenceType)
+	assert.Equal(t, payout.ID, *transaction.ReferenceID)
+	assert.Equal(t, "Club deal rakeback 2026-03-02 to 2026-03-08", transaction.Description)
+
+	var updated Bankroll
+	require.NoError(t, db.First(&updated, bankroll.ID).Error)
+	assert.Equal(t, 1010.0, updated.CurrentBalance.Float64())
+
+	found, err := repo.FindByID(ctx, deal.ID, bankroll.ID, bankroll.UserID)
+	require.NoError(t, err)
+	assert.True(t, found.PaidThrough.Equal(end))
+
+	again := *payout
+	again.ID = 0
+	again.TransactionID = nil
+	stale := *deal
+	assert.ErrorIs(t, repo.Pay(ctx, &stale, &again), ErrRakebackAlreadyPaid)
+
+	empty := &RakebackPayout{
+		DealID:      deal.ID,
+		BankrollID:  bankroll.ID,
+		UserID:      bankroll.UserID,
+		PeriodStart: end,
+		PeriodEnd:   end.AddDate(0, 0, 7),
+	}
+	require.NoError(t, repo.Pay(ctx, deal, empty))
+	assert.Nil(t, empty.TransactionID, "periods that earned nothing post no ledger entry")
+
+	payouts, total, err := repo.ListPayouts(ctx, bankroll.ID, bankroll.UserID, RakebackPayoutListFilter{Limit: 1})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), total)
+	require.Len(t, payouts, 1)
+	assert.Equal(t, empty.ID, payouts[0].ID)
+
+	payouts, _, err = repo.ListPayouts(ctx, bankroll.ID, bankroll.UserID, RakebackPayoutListFilter{
+		Limit: 1,
+		After: &BankrollCursor{Value: empty.PeriodStart, ID: empty.ID},
+	})
+	require.NoError(t, err)
+	require.Len(t, payouts, 1)
+	assert.Equal(t, payout.ID, payouts[0].ID)
+
+	require.NoError(t, db.First(&updated, bankroll.ID).Error)
+	assert.Equal(t, 1010.0, updated.CurrentBalance.Float64())
+}
+
+func TestPostgresRakebackRepository_PaySettlesChangesToPaidPeriods(t *testing.T) {
+	db := setupTestDB(t)
+	require.NoError(t, db.AutoMigrate(&rakebackTestSession{}, &rakebackTestBet{}))
+	repo := NewPostgresRakebackRepository(db)
+	ctx := context.Background()
+	bankroll := createTestBankroll(t, db, 1, "1000.00")
+
+	start := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+	deal := createTestRakebackDeal(t, repo, bankroll, RakebackSourceRake, start)
+	week := func(n int) time.Time {
+		return start.AddDate(0, 0, 7*n)
+	}
+	during := func(n int) *time.Time {
+		at := week(n).Add(36 * time.Hour)
+		return &at
+	}
+	pay := func(n int) *RakebackPayout {
+		payout := &RakebackPayout{DealID: deal.ID, BankrollID: bankroll.ID, UserID: bankroll.UserID, PeriodStart: week(n), PeriodEnd: week(n + 1)}
+		require.NoError(t, repo.Pay(ctx, deal, payout))
+		return payout
+	}
+	session := func(id uint, rake string, endedAt *time.Time) {
+		require.NoError(t, db.Save(&rakebackTestSession{ID: id, BankrollID: bankroll.ID, Status: "settled", Rake: domain.MustParseDecimal(rake), EndedAt: endedAt}).Error)
+	}
+
+	session(1, "40.00", during(0))
+	session(2, "12.00", during(0))
+	assert.Equal(t, "13.00", pay(0).Amount.String())
+
+	session(1, "50.00", during(0))
+	session(3, "8.00", during(0))
+	require.NoError(t, db.Delete(&rakebackTestSession{}, 2).Error)
+	session(4, "20.00", during(1))
+	basis, err := repo.Basis(ctx, deal, week(2))
+	require.NoError(t, err)
+	assert.Equal(t, 26.0, basis.Float64())
+	payout := pay(1)
+	assert.Equal(t, 26.0, payout.Basis.Float64(), "late, edited and deleted sessions of the paid week are settled")
+	assert.Equal(t, "6.50", payout.Amount.String())
+
+	payout = pay(2)
+	assert.True(t, payout.Basis.IsZero(), "nothing is paid twice")
+	assert.Nil(t, payout.TransactionID)
+
+	require.NoError(t, db.Delete(&rakebackTestSession{}, 4).Error)
+	payout = pay(3)
+	assert.Equal(t, -20.0, payout.Basis.Float64())
+	assert.True(t, payout.Amount.IsZero())
+
+	session(5, "30.00", during(4))
+	payout = pay(4)
+	assert.Equal(t, 10.0, payout.Basis.Float64(), "the deleted session is offset against the next payout")
+	assert.Equal(t, "2.50", payout.Amount.String())
+
+	var updated Bankroll
+	require.NoError(t, db.First(&updated, bankroll.ID).Error)
+	assert.Equal(t, 1022.0, updated.CurrentBalance.Float64())
+}
+
+func TestPostgresRakebackRepository_ListDue(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewPostgresRakebackRepository(db)
+	ctx := context.Background()
+	bankroll := createTestBankroll(t, db, 1, "1000.00")
+
+	now := time.Date(2026, 3, 4, 9, 0, 0, 0, time.UTC)
+	due := createTestRakebackDeal(t, repo, bankroll, RakebackSourceRake, time.Date(2026, 2, 23, 0, 0, 0, 0, time.UTC))
+	createTestRakebackDeal(t, repo, bankroll, RakebackSourceRake, time.Date(2026, 3, 9, 0, 0, 0, 0, time.UTC))
+	inactive := createTestRakebackDeal(t, repo, bankroll, RakebackSourceRake, time.Date(2026, 2, 23, 0, 0, 0, 0, time.UTC))
+	inactive.Active = false
+	require.NoError(t, repo.Update(ctx, inactive))
+	deleted := createTestBankroll(t, db, 2, "500.00")
+	createTestRakebackDeal(t, repo, deleted, RakebackSourceRake, time.Date(2026, 2, 23, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, db.Delete(deleted).Error)
+
+	deals, err := repo.ListDue(ctx, now)
+	require.NoError(t, err)
+	require.Len(t, deals, 1)
+	assert.Equal(t, due.ID, deals[0].ID)
+}
@@ -0,0 +1,20 @@
+package bankroll
+
+import (
+	"context"
+	"time"
+
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
+)
+
+type RakebackRepository interface {
+	Create(ctx context.Context, deal *RakebackDeal) error
+	FindByID(ctx context.Context, id uint, bankrollID uint, userID uint) (*RakebackDeal, error)
+	ListByBankrollID(ctx context.Context, bankrollID uint, userID uint, filter RakebackDealListFilter) ([]*RakebackDeal, int64, error)
+	Update(ctx context.Context, deal *RakebackDeal) error
+	Delete(ctx context.Context, id uint, bankrollID uint, userID uint) error
+	ListPayouts(ctx context.Context, bankrollID uint, userID uint, filter RakebackPayoutListFilter) ([]*RakebackPayout, int64, error)
+	ListDue(ctx context.Context, now time.Time) ([]*RakebackDeal, error)
+	Basis(ctx context.Context, deal *RakebackDeal, to time.Time) (domain.Decimal, error)
+	Pay(ctx context.Context, deal *RakebackDeal, payout *RakebackPayout) error
+}
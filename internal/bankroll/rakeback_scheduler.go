@@ -0,0 +1,83 @@
+package bankroll
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+)
+
+// RakebackScheduler pays out rakeback deals once their periods end. Every
+// ended period is settled in order, so periods missed while the scheduler
+// was down are caught up on the next run. Periods are counted in the
+// bankroll's time zone.
+type RakebackScheduler struct {
+	repo         RakebackRepository
+	bankrollRepo BankrollRepository
+	interval     time.Duration
+	logger       *slog.Logger
+}
+
+func NewRakebackScheduler(repo RakebackRepository, bankrollRepo BankrollRepository, interval time.Duration, logger *slog.Logger) *RakebackScheduler {
+	return &RakebackScheduler{
+		repo:         repo,
+		bankrollRepo: bankrollRepo,
+		interval:     interval,
+		logger:       logger,
+	}
+}
+
+func (s *RakebackScheduler) Run(ctx context.Context) {
+	s.PayDue(ctx, time.Now().UTC())
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.PayDue(ctx, time.Now().UTC())
+		}
+	}
+}
+
+// PayDue settles every ended period of every active deal and returns how
+// many periods were settled.
+func (s *RakebackScheduler) PayDue(ctx context.Context, now time.Time) int {
+	deals, err := s.repo.ListDue(ctx, now)
+	if err != nil {
+		s.logger.Error("failed to list due rakeback deals", "error", err)
+		return 0
+	}
+
+	paid := 0
+	for _, deal := range deals {
+		bankroll, err := s.bankrollRepo.FindByID(ctx, deal.BankrollID, deal.UserID)
+		if err != nil {
+			s.logger.Error("failed to load rakeback bankroll", "error", err, "deal_id", deal.ID, "bankroll_id", deal.BankrollID)
+			continue
+		}
+		loc := bankroll.Location()
+
+		for _, start := range deal.Due(now, loc) {
+			payout := &RakebackPayout{
+				DealID:      deal.ID,
+				BankrollID:  deal.BankrollID,
+				UserID:      deal.UserID,
+				PeriodStart: start,
+				PeriodEnd:   deal.Period.End(start),
+			}
+			if err := s.repo.Pay(ctx, deal, payout); err != nil {
+				if !errors.Is(err, ErrRakebackAlreadyPaid) {
+					s.logger.Error("failed to pay rakeback", "error", err, "deal_id", deal.ID, "period_start", start)
+				}
+				break
+			}
+			paid++
+			s.logger.Info("rakeback paid", "deal_id", deal.ID, "user_id", deal.UserID, "bankroll_id", deal.BankrollID, "period_start", start, "basis", payout.Basis, "amount", payout.Amount)
+		}
+	}
+	return paid
+}
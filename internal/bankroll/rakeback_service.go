@@ -0,0 +1,309 @@
+package bankroll
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
+	"github.com/opinedajr/micro-stakes-api/internal/shared/pagination"
+)
+
+type RakebackService interface {
+	CreateDeal(ctx context.Context, userID uint, bankrollID uint, input RakebackDealInput) (*RakebackDealOutput, error)
+	ListDeals(ctx context.Context, userID uint, bankrollID uint, input ListRakebackDealsInput) (*RakebackDealPageOutput, error)
+	UpdateDeal(ctx context.Context, userID uint, bankrollID uint, dealID uint, input UpdateRakebackDealInput) (*RakebackDealOutput, error)
+	DeleteDeal(ctx context.Context, userID uint, bankrollID uint, dealID uint) error
+	ListPayouts(ctx context.Context, userID uint, bankrollID uint, input ListRakebackPayoutsInput) (*RakebackPayoutPageOutput, error)
+}
+
+type rakebackService struct {
+	repo         RakebackRepository
+	bankrollRepo BankrollRepository
+	logger       *slog.Logger
+}
+
+func NewRakebackService(repo RakebackRepository, bankrollRepo BankrollRepository, logger *slog.Logger) RakebackService {
+	return &rakebackService{
+		repo:         repo,
+		bankrollRepo: bankrollRepo,
+		logger:       logger,
+	}
+}
+
+func (s *rakebackService) CreateDeal(ctx context.Context, userID uint, bankrollID uint, input RakebackDealInput) (*RakebackDealOutput, error) {
+	bankroll, err := s.bankrollRepo.FindByID(ctx, bankrollID, userID)
+	if err != nil {
+		s.logger.Error("bankroll not found", "error", err, "user_id", userID, "bankroll_id", bankrollID)
+		return nil, err
+	}
+
+	if !input.Source.IsValid() {
+		s.logger.Error("invalid rakeback source", "source", input.Source, "user_id", userID)
+		return nil, WrapError(ErrInvalidRakebackDeal, "source must be rake or commission")
+	}
+	if !input.Period.IsValid() {
+		s.logger.Error("invalid rakeback period", "period", input.Period, "user_id", userID)
+		return nil, WrapError(ErrInvalidRakebackDeal, "period must be weekly or monthly")
+	}
+
+	loc := bankroll.Location()
+	now := time.Now()
+	start := input.Period.Start(now, loc)
+	deal := &RakebackDeal{
+		BankrollID:  bankrollID,
+		UserID:      userID,
+		Source:      input.Source,
+		Period:      input.Period,
+		Active:      true,
+		PaidThrough: start,
+		AccruesFrom: start,
+	}
+	if err := applyRakebackTerms(deal, input.Name, input.Percentage, input.Venue); err != nil {
+		s.logger.Error("invalid rakeback deal", "error", err, "user_id", userID, "bankroll_id", bankrollID)
+		return nil, err
+	}
+
+	if err := s.repo.Create(ctx, deal); err != nil {
+		s.logger.Error("failed to create rakeback deal", "error", err, "user_id", userID, "bankroll_id", bankrollID)
+		return nil, err
+	}
+
+	s.logger.Info("rakeback deal created", "user_id", userID, "bankroll_id", bankrollID, "deal_id", deal.ID, "source", deal.Source, "percentage", deal.Percentage, "period", deal.Period)
+
+	return s.toDealOutput(ctx, bankroll, deal, now, loc)
+}
+
+func (s *rakebackService) ListDeals(ctx context.Context, userID uint, bankrollID uint, input ListRakebackDealsInput) (*RakebackDealPageOutput, error) {
+	params, err := input.Params.Normalize()
+	if err != nil {
+		s.logger.Error("invalid list parameters", "error", err, "user_id", userID)
+		return nil, WrapError(ErrValidationFailed, err.Error())
+	}
+
+	filter := RakebackDealListFilter{
+		Limit:  params.Limit + 1,
+		Offset: params.Offset,
+	}
+	if params.Cursor != "" {
+		cursor, err := pagination.DecodeCursor(params.Cursor, "id", false)
+		if err != nil {
+			s.logger.Error("invalid cursor", "error", err, "user_id", userID)
+			return nil, WrapError(ErrValidationFailed, err.Error())
+		}
+		filter.AfterID = cursor.ID
+	}
+
+	bankroll, err := s.bankrollRepo.FindByID(ctx, bankrollID, userID)
+	if err != nil {
+		s.logger.Error("bankroll not found", "error", err, "user_id", userID, "bankroll_id", bankrollID)
+		return nil, err
+	}
+
+	deals, total, err := s.repo.ListByBankrollID(ctx, bankrollID, userID, filter)
+	if err != nil {
+		s.logger.Error("failed to list rakeback deals", "error", err, "user_id", userID, "bankroll_id", bankrollID)
+		return nil, err
+	}
+
+	deals, more := pagination.Trim(deals, params.Limit)
+	nextCursor := ""
+	if more {
+		nextCursor = pagination.EncodeCursor("id", false, "", deals[len(deals)-1].ID)
+	}
+
+	loc := bankroll.Location()
+	now := time.Now()
+	outputs := make([]*RakebackDealOutput, len(deals))
+	for i, deal := range deals {
+		output, err := s.toDealOutput(ctx, bankroll, deal, now, loc)
+		if err != nil {
+			return nil, err
+		}
+		outputs[i] = output
+	}
+
+	s.logger.Info("rakeback deals listed", "user_id", userID, "bankroll_id", bankrollID, "count", len(outputs), "total", total)
+
+	return pagination.NewPage(outputs, total, params, nextCursor), nil
+}
+
+// UpdateDeal changes a deal's terms. A deal that is switched back on starts
+// accruing again from the current period, still settling changes to what it
+// paid before.
+func (s *rakebackService) UpdateDeal(ctx context.Context, userID uint, bankrollID uint, dealID uint, input UpdateRakebackDealInput) (*RakebackDealOutput, error) {
+	bankroll, err := s.bankrollRepo.FindByID(ctx, bankrollID, userID)
+	if err != nil {
+		s.logger.Error("bankroll not found", "error", err, "user_id", userID, "bankroll_id", bankrollID)
+		return nil, err
+	}
+
+	deal, err := s.repo.FindByID(ctx, dealID, bankrollID, userID)
+	if err != nil {
+		s.logger.Error("rakeback deal not found", "error", err, "user_id", userID, "bankroll_id", bankrollID, "deal_id", dealID)
+		return nil, err
+	}
+	if err := applyRakebackTerms(deal, input.Name, input.Percentage, input.Venue); err != nil {
+		s.logger.Error("invalid rakeback deal", "error", err, "user_id", userID, "deal_id", dealID)
+		return nil, err
+	}
+
+	loc := bankroll.Location()
+	now := time.Now()
+	if input.Active != nil {
+		if *input.Active && !deal.Active {
+			deal.PaidThrough = deal.Period.Start(now, loc)
+			deal.AccruesFrom = deal.PaidThrough
+		}
+		deal.Active = *input.Active
+	}
+
+	if err := s.repo.Update(ctx, deal); err != nil {
+		s.logger.Error("failed to update rakeback deal", "error", err, "user_id", userID, "deal_id", dealID)
+		return nil, err
+	}
+
+	s.logger.Info("rakeback deal updated", "user_id", userID, "bankroll_id", bankrollID, "deal_id", dealID, "percentage", deal.Percentage, "active", deal.Active)
+
+	return s.toDealOutput(ctx, bankroll, deal, now, loc)
+}
+
+func (s *rakebackService) DeleteDeal(ctx context.Context, userID uint, bankrollID uint, dealID uint) error {
+	if _, err := s.bankrollRepo.FindByID(ctx, bankrollID, userID); err != nil {
+		s.logger.Error("bankroll not found", "error", err, "user_id", userID, "bankroll_id", bankrollID)
+		return err
+	}
+
+	if err := s.repo.Delete(ctx, dealID, bankrollID, userID); err != nil {
+		s.logger.Error("failed to delete rakeback deal", "error", err, "user_id", userID, "deal_id", dealID)
+		return err
+	}
+
+	s.logger.Info("rakeback deal deleted", "user_id", userID, "bankroll_id", bankrollID, "deal_id", dealID)
+
+	return nil
+}
+
+func (s *rakebackService) ListPayouts(ctx context.Context, userID uint, bankrollID uint, input ListRakebackPayoutsInput) (*RakebackPayoutPageOutput, error) {
+	params, err := input.Params.Normalize()
+	if err != nil {
+		s.logger.Error("invalid list parameters", "error", err, "user_id", userID)
+		return nil, WrapError(ErrValidationFailed, err.Error())
+	}
+
+	filter := RakebackPayoutListFilter{
+		Limit:  params.Limit + 1,
+		Offset: params.Offset,
+	}
+	if params.Cursor != "" {
+		cursor, err := pagination.DecodeCursor(params.Cursor, "period_start", true)
+		if err == nil {
+			var periodStart time.Time
+			periodStart, err = time.Parse(time.RFC3339Nano, cursor.Value)
+			filter.After = &BankrollCursor{Value: periodStart, ID: cursor.ID}
+		}
+		if err != nil {
+			s.logger.Error("invalid cursor", "error", err, "user_id", userID)
+			return nil, WrapError(ErrValidationFailed, pagination.ErrInvalidCursor.Error())
+		}
+	}
+
+	if _, err := s.bankrollRepo.FindByID(ctx, bankrollID, userID); err != nil {
+		s.logger.Error("bankroll not found", "error", err, "user_id", userID, "bankroll_id", bankrollID)
+		return nil, err
+	}
+
+	payouts, total, err := s.repo.ListPayouts(ctx, bankrollID, userID, filter)
+	if err != nil {
+		s.logger.Error("failed to list rakeback payouts", "error", err, "user_id", userID, "bankroll_id", bankrollID)
+		return nil, err
+	}
+
+	payouts, more := pagination.Trim(payouts, params.Limit)
+	nextCursor := ""
+	if more {
+		last := payouts[len(payouts)-1]
+		nextCursor = pagination.EncodeCursor("period_start", true, last.PeriodStart.UTC().Format(time.RFC3339Nano), last.ID)
+	}
+
+	outputs := make([]*RakebackPayoutOutput, len(payouts))
+	for i, payout := range payouts {
+		outputs[i] = &RakebackPayoutOutput{
+			ID:            payout.ID,
+			DealID:        payout.DealID,
+			BankrollID:    payout.BankrollID,
+			PeriodStart:   payout.PeriodStart,
+			PeriodEnd:     payout.PeriodEnd,
+			Basis:         payout.Basis,
+			Percentage:    payout.Percentage,
+			Amount:        payout.Amount,
+			TransactionID: payout.TransactionID,
+			CreatedAt:     payout.CreatedAt,
+		}
+	}
+
+	s.logger.Info("rakeback payouts listed", "user_id", userID, "bankroll_id", bankrollID, "count", len(outputs), "total", total)
+
+	return pagination.NewPage(outputs, total, params, nextCursor), nil
+}
+
+// toDealOutput adds what an active deal has accrued over its unpaid periods,
+// each one paid out and rounded on its own the way Pay would. The first one
+// includes adjustments to periods already paid.
+func (s *rakebackService) toDealOutput(ctx context.Context, bankroll *Bankroll, deal *RakebackDeal, now time.Time, loc *time.Location) (*RakebackDealOutput, error) {
+	output := &RakebackDealOutput{
+		ID:              deal.ID,
+		BankrollID:      deal.BankrollID,
+		Name:            deal.Name,
+		Source:          deal.Source,
+		Percentage:      deal.Percentage,
+		Period:          deal.Period,
+		Venue:           deal.Venue,
+		Active:          deal.Active,
+		AccruedBasis:    domain.Zero,
+		AccruedRakeback: domain.Zero,
+		AccruingSince:   deal.PaidThrough,
+		CreatedAt:       deal.CreatedAt,
+		UpdatedAt:       deal.UpdatedAt,
+	}
+	if !deal.Active {
+		return output, nil
+	}
+
+	paid := domain.Zero
+	for start := deal.PaidThrough.In(loc); start.Before(now); start = deal.Period.End(start) {
+		basis, err := s.repo.Basis(ctx, deal, deal.Period.End(start))
+		if err != nil {
+			s.logger.Error("failed to compute rakeback basis", "error", err, "deal_id", deal.ID)
+			return nil, err
+		}
+		output.AccruedBasis = basis
+		if basis.Sub(paid).IsPositive() {
+			output.AccruedRakeback = output.AccruedRakeback.Add(deal.Rakeback(basis.Sub(paid), bankroll.Currency))
+			paid = basis
+		}
+	}
+	output.AccruedBasis = bankroll.Currency.Round(output.AccruedBasis)
+	nextPayout := deal.Period.End(deal.PaidThrough.In(loc))
+	output.NextPayoutAt = &nextPayout
+	return output, nil
+}
+
+func applyRakebackTerms(deal *RakebackDeal, name string, percentage domain.Decimal, venue string) error {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return WrapError(ErrInvalidRakebackDeal, "name is required")
+	}
+	if !percentage.IsPositive() || percentage.GreaterThan(hundred) || !percentage.FitsScale(2) {
+		return WrapError(ErrInvalidRakebackDeal, "percentage must be above 0 and at most 100")
+	}
+	venue = strings.TrimSpace(venue)
+	if venue != "" && deal.Source != RakebackSourceRake {
+		return WrapError(ErrInvalidRakebackDeal, "venue only applies to rake deals")
+	}
+	deal.Name = name
+	deal.Percentage = percentage
+	deal.Venue = venue
+	return nil
+}
@@ -0,0 +1,336 @@
+package bankroll
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"log/slog"
+
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
+	"github.com/opinedajr/micro-stakes-api/internal/shared/pagination"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type MockRakebackRepository struct {
+	mock.Mock
+}
+
+func (m *MockRakebackRepository) Create(ctx context.Context, deal *RakebackDeal) error {
+	args := m.Called(ctx, deal)
+	return args.Error(0)
+}
+
+func (m *MockRakebackRepository) FindByID(ctx context.Context, id uint, bankrollID uint, userID uint) (*RakebackDeal, error) {
+	args := m.Called(ctx, id, bankrollID, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*RakebackDeal), args.Error(1)
+}
+
+func (m *MockRakebackRepository) ListByBankrollID(ctx context.Context, bankrollID uint, userID uint, filter RakebackDealListFilter) ([]*RakebackDeal, int64, error) {
+	args := m.Called(ctx, bankrollID, userID, filter)
+	if args.Get(0) == nil {
+		return nil, 0, args.Error(2)
+	}
+	return args.Get(0).([]*RakebackDeal), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockRakebackRepository) Update(ctx context.Context, deal *RakebackDeal) error {
+	args := m.Called(ctx, deal)
+	return args.Error(0)
+}
+
+func (m *MockRakebackRepository) Delete(ctx context.Context, id uint, bankrollID uint, userID uint) error {
+	args := m.Called(ctx, id, bankrollID, userID)
+	return args.Error(0)
+}
+
+func (m *MockRakebackRepository) ListPayouts(ctx context.Context, bankrollID uint, userID uint, filter RakebackPayoutListFilter) ([]*RakebackPayout, int64, error) {
+	args := m.Called(ctx, bankrollID, userID, filter)
+	if args.Get(0) == nil {
+		return nil, 0, args.Error(2)
+	}
+	return args.Get(0).([]*RakebackPayout), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockRakebackRepository) ListDue(ctx context.Context, now time.Time) ([]*RakebackDeal, error) {
+	args := m.Called(ctx, now)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*RakebackDeal), args.Error(1)
+}
+
+func (m *MockRakebackRepository) Basis(ctx context.Context, deal *RakebackDeal, to time.Time) (domain.Decimal, error) {
+	args := m.Called(ctx, deal, to)
+	return args.Get(0).(domain.Decimal), args.Error(1)
+}
+
+func (m *MockRakebackRepository) Pay(ctx context.Context, deal *RakebackDeal, payout *RakebackPayout) error {
+	args := m.Called(ctx, deal, payout)
+	return args.Error(0)
+}
+
+func newRakebackServiceForTest() (RakebackService, *MockRakebackRepository, *MockBankrollRepository) {
+	repo := new(MockRakebackRepository)
+	bankrollRepo := new(MockBankrollRepository)
+	return NewRakebackService(repo, bankrollRepo, slog.Default()), repo, bankrollRepo
+}
+
+func testRakebackDeal(paidThrough time.Time) *RakebackDeal {
+	return &RakebackDeal{
+		ID:          5,
+		BankrollID:  1,
+		UserID:      1,
+		Name:        "Club deal",
+		Source:      RakebackSourceRake,
+		Percentage:  domain.MustParseDecimal("30"),
+		Period:      RakebackPeriodWeekly,
+		Active:      true,
+		PaidThrough: paidThrough,
+		AccruesFrom: paidThrough,
+	}
+}
+
+func TestRakebackPeriod(t *testing.T) {
+	// 2026-03-05 is a Thursday.
+	at := time.Date(2026, 3, 5, 15, 30, 0, 0, time.UTC)
+
+	weekly := RakebackPeriodWeekly.Start(at, time.UTC)
+	assert.Equal(t, time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC), weekly)
+	assert.Equal(t, time.Date(2026, 3, 9, 0, 0, 0, 0, time.UTC), RakebackPeriodWeekly.End(weekly))
+	assert.Equal(t, time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC), RakebackPeriodWeekly.Start(time.Date(2026, 3, 8, 23, 59, 0, 0, time.UTC), time.UTC), "sunday closes the week")
+
+	monthly := RakebackPeriodMonthly.Start(at, time.UTC)
+	assert.Equal(t, time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC), monthly)
+	assert.Equal(t, time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC), RakebackPeriodMonthly.End(monthly))
+
+	saoPaulo, err := time.LoadLocation("America/Sao_Paulo")
+	require.NoError(t, err)
+	assert.True(t, time.Date(2026, 3, 1, 3, 0, 0, 0, time.UTC).Equal(RakebackPeriodMonthly.Start(time.Date(2026, 3, 1, 4, 0, 0, 0, time.UTC), saoPaulo)))
+	assert.True(t, time.Date(2026, 2, 1, 3, 0, 0, 0, time.UTC).Equal(RakebackPeriodMonthly.Start(time.Date(2026, 3, 1, 2, 0, 0, 0, time.UTC), saoPaulo)), "it is still February in São Paulo")
+}
+
+func TestRakebackDeal_Due(t *testing.T) {
+	deal := testRakebackDeal(time.Date(2026, 2, 16, 0, 0, 0, 0, time.UTC))
+
+	assert.Empty(t, deal.Due(time.Date(2026, 2, 22, 23, 0, 0, 0, time.UTC), time.UTC))
+	assert.Equal(t, []time.Time{
+		time.Date(2026, 2, 16, 0, 0, 0, 0, time.UTC),
+		time.Date(2026, 2, 23, 0, 0, 0, 0, time.UTC),
+	}, deal.Due(time.Date(2026, 3, 3, 0, 0, 0, 0, time.UTC), time.UTC), "missed periods are caught up in order")
+
+	saoPaulo, err := time.LoadLocation("America/Sao_Paulo")
+	require.NoError(t, err)
+	local := testRakebackDeal(time.Date(2026, 2, 16, 3, 0, 0, 0, time.UTC))
+	assert.Empty(t, local.Due(time.Date(2026, 2, 23, 2, 0, 0, 0, time.UTC), saoPaulo), "the week ends at midnight in São Paulo")
+	assert.Len(t, local.Due(time.Date(2026, 2, 23, 3, 0, 0, 0, time.UTC), saoPaulo), 1)
+}
+
+func TestRakebackDeal_Rakeback(t *testing.T) {
+	deal := testRakebackDeal(time.Now())
+	deal.Percentage = domain.MustParseDecimal("27.5")
+
+	assert.Equal(t, "11.55", deal.Rakeback(domain.MustParseDecimal("42.00"), CurrencyBRL).String())
+	assert.True(t, deal.Rakeback(domain.MustParseDecimal("-3.00"), CurrencyBRL).IsZero(), "refunded commission pays nothing")
+}
+
+func TestCreateRakebackDeal(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		service, repo, bankrollRepo := newRakebackServiceForTest()
+
+		bankrollRepo.On("FindByID", mock.Anything, uint(1), uint(1)).Return(ruleTestBankroll(), nil).Once()
+		repo.On("Create", mock.Anything, mock.MatchedBy(func(deal *RakebackDeal) bool {
+			return deal.Name == "Club deal" && deal.Venue == "Home Club" && deal.Active &&
+				deal.PaidThrough.Equal(RakebackPeriodWeekly.Start(time.Now(), time.UTC)) && deal.AccruesFrom.Equal(deal.PaidThrough)
+		})).Return(nil).Once()
+		repo.On("Basis", mock.Anything, mock.Anything, mock.Anything).Return(domain.MustParseDecimal("40.00"), nil).Once()
+
+		output, err := service.CreateDeal(context.Background(), 1, 1, RakebackDealInput{
+			Name:       " Club deal ",
+			Source:     RakebackSourceRake,
+			Percentage: domain.MustParseDecimal("30"),
+			Period:     RakebackPeriodWeekly,
+			Venue:      "Home Club",
+		})
+
+		require.NoError(t, err)
+		assert.True(t, output.Active)
+		assert.Equal(t, "40.00", output.AccruedBasis.String())
+		assert.Equal(t, "12.00", output.AccruedRakeback.String())
+		require.NotNil(t, output.NextPayoutAt)
+		assert.Equal(t, RakebackPeriodWeekly.End(output.AccruingSince), *output.NextPayoutAt)
+		repo.AssertExpectations(t)
+	})
+
+	tests := []struct {
+		name  string
+		input RakebackDealInput
+	}{
+		{"invalid source", RakebackDealInput{Name: "x", Source: "tips", Percentage: domain.MustParseDecimal("10"), Period: RakebackPeriodWeekly}},
+		{"invalid period", RakebackDealInput{Name: "x", Source: RakebackSourceRake, Percentage: domain.MustParseDecimal("10"), Period: "daily"}},
+		{"blank name", RakebackDealInput{Name: " ", Source: RakebackSourceRake, Percentage: domain.MustParseDecimal("10"), Period: RakebackPeriodWeekly}},
+		{"zero percentage", RakebackDealInput{Name: "x", Source: RakebackSourceRake, Percentage: domain.Zero, Period: RakebackPeriodWeekly}},
+		{"percentage above 100", RakebackDealInput{Name: "x", Source: RakebackSourceRake, Percentage: domain.MustParseDecimal("100.01"), Period: RakebackPeriodWeekly}},
+		{"too many decimals", RakebackDealInput{Name: "x", Source: RakebackSourceRake, Percentage: domain.MustParseDecimal("10.125"), Period: RakebackPeriodWeekly}},
+		{"venue on commission deal", RakebackDealInput{Name: "x", Source: RakebackSourceCommission, Percentage: domain.MustParseDecimal("10"), Period: RakebackPeriodMonthly, Venue: "Exchange"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, repo, bankrollRepo := newRakebackServiceForTest()
+
+			bankrollRepo.On("FindByID", mock.Anything, uint(1), uint(1)).Return(ruleTestBankroll(), nil).Once()
+
+			_, err := service.CreateDeal(context.Background(), 1, 1, tt.input)
+
+			assert.ErrorIs(t, err, ErrInvalidRakebackDeal)
+			repo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestListRakebackDeals(t *testing.T) {
+	service, repo, bankrollRepo := newRakebackServiceForTest()
+
+	lastWeek := RakebackPeriodWeekly.Start(time.Now(), time.UTC).AddDate(0, 0, -7)
+	active := testRakebackDeal(lastWeek)
+	inactive := testRakebackDeal(lastWeek)
+	inactive.ID = 6
+	inactive.Active = false
+
+	bankrollRepo.On("FindByID", mock.Anything, uint(1), uint(1)).Return(ruleTestBankroll(), nil).Once()
+	repo.On("ListByBankrollID", mock.Anything, uint(1), uint(1), RakebackDealListFilter{Limit: 3}).Return([]*RakebackDeal{active, inactive}, int64(2), nil).Once()
+	repo.On("Basis", mock.Anything, active, lastWeek.AddDate(0, 0, 7)).Return(domain.MustParseDecimal("10.01"), nil).Once()
+	repo.On("Basis", mock.Anything, active, lastWeek.AddDate(0, 0, 14)).Return(domain.MustParseDecimal("20.02"), nil).Once()
+
+	page, err := service.ListDeals(context.Background(), 1, 1, ListRakebackDealsInput{Params: pagination.Params{Limit: 2}})
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), page.Total)
+	assert.Empty(t, page.NextCursor)
+	outputs := page.Items
+	require.Len(t, outputs, 2)
+	assert.Equal(t, "20.02", outputs[0].AccruedBasis.String())
+	assert.Equal(t, "6.00", outputs[0].AccruedRakeback.String(), "each period is rounded on its own")
+	require.NotNil(t, outputs[0].NextPayoutAt)
+	assert.Equal(t, lastWeek.AddDate(0, 0, 7), *outputs[0].NextPayoutAt)
+
+	assert.True(t, outputs[1].AccruedRakeback.IsZero())
+	assert.Nil(t, outputs[1].NextPayoutAt)
+	repo.AssertExpectations(t)
+}
+
+func TestListRakebackPayouts(t *testing.T) {
+	service, repo, bankrollRepo := newRakebackServiceForTest()
+
+	periodStart := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+	bankrollRepo.On("FindByID", mock.Anything, uint(1), uint(1)).Return(ruleTestBankroll(), nil).Once()
+	repo.On("ListPayouts", mock.Anything, uint(1), uint(1), mock.MatchedBy(func(filter RakebackPayoutListFilter) bool {
+		return filter.Limit == 2 && filter.After != nil && filter.After.ID == 9
+	})).Return([]*RakebackPayout{
+		{ID: 8, DealID: 5, BankrollID: 1, PeriodStart: periodStart},
+		{ID: 7, DealID: 5, BankrollID: 1, PeriodStart: periodStart.AddDate(0, 0, -7)},
+	}, int64(5), nil).Once()
+
+	cursor := pagination.EncodeCursor("period_start", true, periodStart.AddDate(0, 0, 7).Format(time.RFC3339Nano), 9)
+	page, err := service.ListPayouts(context.Background(), 1, 1, ListRakebackPayoutsInput{Params: pagination.Params{Limit: 1, Cursor: cursor}})
+
+	require.NoError(t, err)
+	require.Len(t, page.Items, 1)
+	assert.Equal(t, uint(8), page.Items[0].ID)
+	assert.Equal(t, pagination.EncodeCursor("period_start", true, periodStart.Format(time.RFC3339Nano), 8), page.NextCursor)
+
+	_, err = service.ListPayouts(context.Background(), 1, 1, ListRakebackPayoutsInput{Params: pagination.Params{Cursor: pagination.EncodeCursor("id", false, "", 1)}})
+	assert.ErrorIs(t, err, ErrValidationFailed)
+	repo.AssertExpectations(t)
+}
+
+func TestUpdateRakebackDeal(t *testing.T) {
+	t.Run("reactivated deal accrues from the current period", func(t *testing.T) {
+		service, repo, bankrollRepo := newRakebackServiceForTest()
+
+		deal := testRakebackDeal(time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC))
+		deal.Active = false
+		current := RakebackPeriodWeekly.Start(time.Now(), time.UTC)
+		active := true
+
+		bankrollRepo.On("FindByID", mock.Anything, uint(1), uint(1)).Return(ruleTestBankroll(), nil).Once()
+		repo.On("FindByID", mock.Anything, uint(5), uint(1), uint(1)).Return(deal, nil).Once()
+		repo.On("Update", mock.Anything, mock.MatchedBy(func(deal *RakebackDeal) bool {
+			return deal.Active && deal.PaidThrough.Equal(current) && deal.AccruesFrom.Equal(current) && deal.Percentage.String() == "35"
+		})).Return(nil).Once()
+		repo.On("Basis", mock.Anything, deal, current.AddDate(0, 0, 7)).Return(domain.Zero, nil).Once()
+
+		output, err := service.UpdateDeal(context.Background(), 1, 1, 5, UpdateRakebackDealInput{
+			Name:       "Club deal",
+			Percentage: domain.MustParseDecimal("35"),
+			Active:     &active,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, current, output.AccruingSince)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("deal not found", func(t *testing.T) {
+		service, repo, bankrollRepo := newRakebackServiceForTest()
+
+		bankrollRepo.On("FindByID", mock.Anything, uint(1), uint(1)).Return(ruleTestBankroll(), nil).Once()
+		repo.On("FindByID", mock.Anything, uint(9), uint(1), uint(1)).Return(nil, ErrRakebackDealNotFound).Once()
+
+		_, err := service.UpdateDeal(context.Background(), 1, 1, 9, UpdateRakebackDealInput{
+			Name:       "Club deal",
+			Percentage: domain.MustParseDecimal("35"),
+		})
+
+		assert.ErrorIs(t, err, ErrRakebackDealNotFound)
+		repo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+}
+
+func TestRakebackScheduler_PayDue(t *testing.T) {
+	t.Run("pays every ended period in order", func(t *testing.T) {
+		repo := new(MockRakebackRepository)
+		bankrollRepo := new(MockBankrollRepository)
+		scheduler := NewRakebackScheduler(repo, bankrollRepo, time.Hour, slog.Default())
+		ctx := context.Background()
+		first := time.Date(2026, 2, 16, 0, 0, 0, 0, time.UTC)
+		second := first.AddDate(0, 0, 7)
+		now := time.Date(2026, 3, 4, 9, 0, 0, 0, time.UTC)
+		deal := testRakebackDeal(first)
+
+		repo.On("ListDue", ctx, now).Return([]*RakebackDeal{deal}, nil).Once()
+		bankrollRepo.On("FindByID", ctx, uint(1), uint(1)).Return(ruleTestBankroll(), nil).Once()
+		repo.On("Pay", ctx, deal, mock.MatchedBy(func(payout *RakebackPayout) bool {
+			return payout.PeriodStart.Equal(first) && payout.PeriodEnd.Equal(second)
+		})).Return(nil).Once()
+		repo.On("Pay", ctx, deal, mock.MatchedBy(func(payout *RakebackPayout) bool {
+			return payout.PeriodStart.Equal(second) && payout.PeriodEnd.Equal(second.AddDate(0, 0, 7))
+		})).Return(nil).Once()
+
+		paid := scheduler.PayDue(ctx, now)
+
+		assert.Equal(t, 2, paid)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("stops at a period paid meanwhile", func(t *testing.T) {
+		repo := new(MockRakebackRepository)
+		bankrollRepo := new(MockBankrollRepository)
+		scheduler := NewRakebackScheduler(repo, bankrollRepo, time.Hour, slog.Default())
+		now := time.Date(2026, 3, 4, 9, 0, 0, 0, time.UTC)
+
+		repo.On("ListDue", mock.Anything, now).Return([]*RakebackDeal{testRakebackDeal(time.Date(2026, 2, 16, 0, 0, 0, 0, time.UTC))}, nil).Once()
+		bankrollRepo.On("FindByID", mock.Anything, uint(1), uint(1)).Return(ruleTestBankroll(), nil).Once()
+		repo.On("Pay", mock.Anything, mock.Anything, mock.Anything).Return(ErrRakebackAlreadyPaid).Once()
+
+		paid := scheduler.PayDue(context.Background(), now)
+
+		assert.Equal(t, 0, paid)
+		repo.AssertExpectations(t)
+	})
+}
@@ -2,12 +2,18 @@ package bankroll
 
 import (
 	"context"
+
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
 )
 
 type BankrollRepository interface {
 	Create(ctx context.Context, bankroll *Bankroll) error
 	Update(ctx context.Context, bankroll *Bankroll) error
 	ListByUserID(ctx context.Context, userID uint) ([]*Bankroll, error)
+	List(ctx context.Context, userID uint, filter BankrollListFilter) ([]*Bankroll, int64, error)
 	FindByID(ctx context.Context, id uint, userID uint) (*Bankroll, error)
-	Reset(ctx context.Context, id uint, userID uint) error
+	Reset(ctx context.Context, id uint, userID uint, amount domain.Decimal, expectedVersion uint) (*BankrollPeriod, error)
+	Delete(ctx context.Context, id uint, userID uint) error
+	Archive(ctx context.Context, id uint, userID uint) error
+	Restore(ctx context.Context, id uint, userID uint) error
 }
@@ -0,0 +1,90 @@
+package bankroll
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"log/slog"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type MockRiskService struct {
+	mock.Mock
+}
+
+func (m *MockRiskService) Simulate(ctx context.Context, userID uint, bankrollID uint, input RiskSimulationInput) (*RiskSimulationOutput, error) {
+	args := m.Called(ctx, userID, bankrollID, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*RiskSimulationOutput), args.Error(1)
+}
+
+func TestSimulateRiskHandler(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		mockService := new(MockRiskService)
+		handler := NewRiskHandler(mockService, slog.Default())
+
+		mockService.On("Simulate", mock.Anything, uint(1), uint(2), mock.MatchedBy(func(input RiskSimulationInput) bool {
+			return input.Source == RiskSourceBets && input.Trials == 500 && *input.Seed == 42
+		})).Return(&RiskSimulationOutput{BankrollID: 2, Source: RiskSourceBets, Trials: 500, Seed: 42, RiskOfRuin: 0.125}, nil).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = newRuleRequest(t, http.MethodPost, "/bankrolls/2/risk-simulations", `{"source":"bets","trials":500,"seed":42}`)
+		c.Params = gin.Params{{Key: "bankrollId", Value: "2"}}
+		c.Set("userID", "1")
+
+		handler.Simulate(c)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		var response RiskSimulationOutput
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, 0.125, response.RiskOfRuin)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("trials out of range", func(t *testing.T) {
+		mockService := new(MockRiskService)
+		handler := NewRiskHandler(mockService, slog.Default())
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = newRuleRequest(t, http.MethodPost, "/bankrolls/2/risk-simulations", `{"trials":1000000}`)
+		c.Params = gin.Params{{Key: "bankrollId", Value: "2"}}
+		c.Set("userID", "1")
+
+		handler.Simulate(c)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		mockService.AssertNotCalled(t, "Simulate", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("insufficient data", func(t *testing.T) {
+		mockService := new(MockRiskService)
+		handler := NewRiskHandler(mockService, slog.Default())
+
+		mockService.On("Simulate", mock.Anything, uint(1), uint(2), mock.AnythingOfType("bankroll.RiskSimulationInput")).
+			Return(nil, ErrInsufficientRiskData).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = newRuleRequest(t, http.MethodPost, "/bankrolls/2/risk-simulations", `{}`)
+		c.Params = gin.Params{{Key: "bankrollId", Value: "2"}}
+		c.Set("userID", "1")
+
+		handler.Simulate(c)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+		var response ErrorOutput
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, "INSUFFICIENT_DATA", response.Code)
+	})
+}
@@ -0,0 +1,221 @@
+package bankroll
+
+import (
+	"context"
+	"math"
+	"math/rand/v2"
+	"runtime"
+	"sort"
+	"sync"
+)
+
+type RiskSource string
+
+const (
+	RiskSourceSessions RiskSource = "sessions"
+	RiskSourceBets     RiskSource = "bets"
+	// RiskSourceCustom marks a simulation run on user-supplied parameters.
+	RiskSourceCustom RiskSource = "custom"
+)
+
+func (s RiskSource) IsValid() bool {
+	return s == RiskSourceSessions || s == RiskSourceBets
+}
+
+const (
+	DefaultRiskTrials  = 1000
+	MaxRiskTrials      = 10000
+	DefaultRiskHorizon = 500
+	MaxRiskHorizon     = 5000
+	// MinRiskSamples is the number of results needed to estimate a standard
+	// deviation from history.
+	MinRiskSamples = 2
+	// riskCurvePoints bounds how many steps of the horizon the percentile
+	// curves report.
+	riskCurvePoints = 50
+	maxRiskWorkers  = 8
+)
+
+var riskPercentiles = []int{5, 25, 50, 75, 95}
+
+// riskSimulation draws trials of horizon normally distributed results, each
+// starting from start. A trial is ruined once its balance reaches zero and
+// stays there.
+type riskSimulation struct {
+	start   float64
+	mean    float64
+	stdDev  float64
+	trials  int
+	horizon int
+	seed    int64
+	steps   []int
+}
+
+type riskTrial struct {
+	ruined           bool
+	balances         []float64
+	longestDownswing int
+	maxDrawdown      float64
+}
+
+type riskSummary struct {
+	riskOfRuin              float64
+	expectedDownswingLength float64
+	expectedMaxDrawdown     float64
+	curves                  map[int][]float64
+}
+
+func newRiskSimulation(start, mean, stdDev float64, trials, horizon int, seed int64) *riskSimulation {
+	return &riskSimulation{
+		start:   start,
+		mean:    mean,
+		stdDev:  stdDev,
+		trials:  trials,
+		horizon: horizon,
+		seed:    seed,
+		steps:   riskCurveSteps(horizon),
+	}
+}
+
+// riskCurveSteps spreads up to riskCurvePoints steps evenly over the horizon,
+// always ending on the last one.
+func riskCurveSteps(horizon int) []int {
+	points := min(horizon, riskCurvePoints)
+	steps := make([]int, points)
+	for i := range steps {
+		steps[i] = (i + 1) * horizon / points
+	}
+	return steps
+}
+
+// run spreads the trials over a bounded pool of workers. Every trial draws
+// from its own generator seeded with the simulation seed and its index, so
+// the outcome does not depend on how trials are scheduled.
+func (s *riskSimulation) run(ctx context.Context) (*riskSummary, error) {
+	results := make([]riskTrial, s.trials)
+	jobs := make(chan int)
+	workers := min(runtime.GOMAXPROCS(0), maxRiskWorkers, s.trials)
+
+	var wg sync.WaitGroup
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				results[i] = s.trial(i)
+			}
+		}()
+	}
+
+	var err error
+feed:
+	for i := range s.trials {
+		select {
+		case jobs <- i:
+		case <-ctx.Done():
+			err = ctx.Err()
+			break feed
+		}
+	}
+	close(jobs)
+	wg.Wait()
+	if err != nil {
+		return nil, err
+	}
+
+	return s.summarize(results), nil
+}
+
+func (s *riskSimulation) trial(index int) riskTrial {
+	rng := rand.New(rand.NewPCG(uint64(s.seed), uint64(index)))
+	trial := riskTrial{balances: make([]float64, 0, len(s.steps))}
+
+	balance, peak := s.start, s.start
+	downswing := 0
+	next := 0
+	for step := 1; step <= s.horizon; step++ {
+		if !trial.ruined {
+			balance += s.mean + s.stdDev*rng.NormFloat64()
+			if balance <= 0 {
+				balance = 0
+				trial.ruined = true
+			}
+		}
+
+		if balance > peak {
+			peak = balance
+			downswing = 0
+		} else {
+			downswing++
+			trial.longestDownswing = max(trial.longestDownswing, downswing)
+			trial.maxDrawdown = max(trial.maxDrawdown, peak-balance)
+		}
+
+		if next < len(s.steps) && s.steps[next] == step {
+			trial.balances = append(trial.balances, balance)
+			next++
+		}
+	}
+	return trial
+}
+
+func (s *riskSimulation) summarize(results []riskTrial) *riskSummary {
+	summary := &riskSummary{curves: make(map[int][]float64, len(riskPercentiles))}
+	ruined, downswings, drawdowns := 0, 0.0, 0.0
+	for _, trial := range results {
+		if trial.ruined {
+			ruined++
+		}
+		downswings += float64(trial.longestDownswing)
+		drawdowns += trial.maxDrawdown
+	}
+	trials := float64(len(results))
+	summary.riskOfRuin = float64(ruined) / trials
+	summary.expectedDownswingLength = downswings / trials
+	summary.expectedMaxDrawdown = drawdowns / trials
+
+	balances := make([]float64, len(results))
+	for _, p := range riskPercentiles {
+		summary.curves[p] = make([]float64, len(s.steps))
+	}
+	for point := range s.steps {
+		for i, trial := range results {
+			balances[i] = trial.balances[point]
+		}
+		sort.Float64s(balances)
+		for _, p := range riskPercentiles {
+			summary.curves[p][point] = percentile(balances, p)
+		}
+	}
+	return summary
+}
+
+// percentile reads the p-th percentile of sorted values by nearest rank.
+func percentile(sorted []float64, p int) float64 {
+	rank := int(math.Ceil(float64(p) / 100 * float64(len(sorted))))
+	return sorted[max(rank, 1)-1]
+}
+
+// analyticRiskOfRuin is the closed-form risk of ruin of a random walk with
+// normally distributed steps over an unlimited horizon.
+func analyticRiskOfRuin(start, mean, stdDev float64) float64 {
+	if mean <= 0 {
+		return 1
+	}
+	return math.Exp(-2 * mean * start / (stdDev * stdDev))
+}
+
+// meanAndStdDev returns the sample mean and sample standard deviation.
+func meanAndStdDev(values []float64) (float64, float64) {
+	sum := 0.0
+	for _, v := range values {
+		sum += v
+	}
+	mean := sum / float64(len(values))
+
+	squares := 0.0
+	for _, v := range values {
+		squares += (v - mean) * (v - mean)
+	}
+	return mean, math.Sqrt(squares / float64(len(values)-1))
+}
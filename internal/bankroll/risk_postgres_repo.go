@@ -0,0 +1,37 @@
+package bankroll
+
+import (
+	"context"
+
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
+	"gorm.io/gorm"
+)
+
+type postgresRiskRepository struct {
+	db *gorm.DB
+}
+
+func NewPostgresRiskRepository(db *gorm.DB) RiskRepository {
+	return &postgresRiskRepository{
+		db: db,
+	}
+}
+
+// ResultSamples returns the result of every settled session or bet of the
+// bankroll. Void bets returned the stake untouched and are left out.
+func (r *postgresRiskRepository) ResultSamples(ctx context.Context, bankrollID uint, source RiskSource) ([]domain.Decimal, error) {
+	query := r.db.WithContext(ctx)
+	column := "profit"
+	if source == RiskSourceBets {
+		query = query.Table("bets").Where("bankroll_id = ? AND status IN ? AND deleted_at IS NULL", bankrollID, []string{"won", "lost", "cashed_out"})
+		column = "net_profit"
+	} else {
+		query = query.Table("poker_sessions").Where("bankroll_id = ? AND status = ? AND deleted_at IS NULL", bankrollID, "settled")
+	}
+
+	var samples []domain.Decimal
+	if err := query.Order("id").Pluck(column, &samples).Error; err != nil {
+		return nil, WrapError(ErrDatabaseError, err.Error())
+	}
+	return samples, nil
+}
@@ -0,0 +1,71 @@
+package bankroll
+
+import (
+	"context"
+	"testing"
+
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+type riskTestSession struct {
+	ID         uint `gorm:"primaryKey"`
+	BankrollID uint
+	Status     string
+	Profit     domain.Decimal `gorm:"type:decimal(27,8)"`
+	DeletedAt  gorm.DeletedAt
+}
+
+func (riskTestSession) TableName() string {
+	return "poker_sessions"
+}
+
+type riskTestBet struct {
+	ID         uint `gorm:"primaryKey"`
+	BankrollID uint
+	Status     string
+	NetProfit  domain.Decimal `gorm:"type:decimal(27,8)"`
+	DeletedAt  gorm.DeletedAt
+}
+
+func (riskTestBet) TableName() string {
+	return "bets"
+}
+
+func TestPostgresRiskRepository_ResultSamples(t *testing.T) {
+	db := setupTestDB(t)
+	require.NoError(t, db.AutoMigrate(&riskTestSession{}, &riskTestBet{}))
+	repo := NewPostgresRiskRepository(db)
+	ctx := context.Background()
+
+	sessions := []*riskTestSession{
+		{BankrollID: 1, Status: "settled", Profit: domain.MustParseDecimal("40.00")},
+		{BankrollID: 1, Status: "open", Profit: domain.Zero},
+		{BankrollID: 1, Status: "settled", Profit: domain.MustParseDecimal("-25.00")},
+		{BankrollID: 1, Status: "settled", Profit: domain.MustParseDecimal("99.00")},
+		{BankrollID: 2, Status: "settled", Profit: domain.MustParseDecimal("10.00")},
+	}
+	require.NoError(t, db.Create(&sessions).Error)
+	require.NoError(t, db.Delete(&riskTestSession{}, sessions[3].ID).Error)
+
+	bets := []*riskTestBet{
+		{BankrollID: 1, Status: "won", NetProfit: domain.MustParseDecimal("9.50")},
+		{BankrollID: 1, Status: "lost", NetProfit: domain.MustParseDecimal("-10.00")},
+		{BankrollID: 1, Status: "void", NetProfit: domain.Zero},
+		{BankrollID: 1, Status: "open", NetProfit: domain.Zero},
+	}
+	require.NoError(t, db.Create(&bets).Error)
+
+	samples, err := repo.ResultSamples(ctx, 1, RiskSourceSessions)
+	require.NoError(t, err)
+	require.Len(t, samples, 2)
+	assert.Equal(t, 40.0, samples[0].Float64())
+	assert.Equal(t, -25.0, samples[1].Float64())
+
+	samples, err = repo.ResultSamples(ctx, 1, RiskSourceBets)
+	require.NoError(t, err)
+	require.Len(t, samples, 2)
+	assert.Equal(t, 9.5, samples[0].Float64())
+}
@@ -0,0 +1,11 @@
+package bankroll
+
+import (
+	"context"
+
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
+)
+
+type RiskRepository interface {
+	ResultSamples(ctx context.Context, bankrollID uint, source RiskSource) ([]domain.Decimal, error)
+}
@@ -0,0 +1,144 @@
+package bankroll
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
+)
+
+type RiskService interface {
+	Simulate(ctx context.Context, userID uint, bankrollID uint, input RiskSimulationInput) (*RiskSimulationOutput, error)
+}
+
+type riskService struct {
+	repo         RiskRepository
+	bankrollRepo BankrollRepository
+	logger       *slog.Logger
+}
+
+func NewRiskService(repo RiskRepository, bankrollRepo BankrollRepository, logger *slog.Logger) RiskService {
+	return &riskService{
+		repo:         repo,
+		bankrollRepo: bankrollRepo,
+		logger:       logger,
+	}
+}
+
+func (s *riskService) Simulate(ctx context.Context, userID uint, bankrollID uint, input RiskSimulationInput) (*RiskSimulationOutput, error) {
+	trials, horizon, err := riskBounds(input)
+	if err != nil {
+		s.logger.Error("invalid risk simulation size", "error", err, "user_id", userID)
+		return nil, err
+	}
+
+	bankroll, err := s.bankrollRepo.FindByID(ctx, bankrollID, userID)
+	if err != nil {
+		s.logger.Error("bankroll not found", "error", err, "user_id", userID, "bankroll_id", bankrollID)
+		return nil, err
+	}
+	if !bankroll.CurrentBalance.IsPositive() {
+		s.logger.Error("bankroll balance not positive", "user_id", userID, "bankroll_id", bankrollID)
+		return nil, WrapError(ErrInvalidRiskParams, "bankroll balance must be positive")
+	}
+
+	output := &RiskSimulationOutput{
+		BankrollID:      bankroll.ID,
+		Currency:        bankroll.Currency,
+		StartingBalance: bankroll.Currency.Round(bankroll.CurrentBalance),
+		Trials:          trials,
+		Horizon:         horizon,
+	}
+
+	var mean, stdDev float64
+	switch {
+	case input.WinRate != nil && input.StdDev != nil:
+		if !input.StdDev.IsPositive() {
+			s.logger.Error("invalid standard deviation", "std_dev", *input.StdDev, "user_id", userID)
+			return nil, WrapError(ErrInvalidRiskParams, "std_dev must be positive")
+		}
+		output.Source = RiskSourceCustom
+		mean, stdDev = input.WinRate.Float64(), input.StdDev.Float64()
+	case input.WinRate != nil || input.StdDev != nil:
+		s.logger.Error("partial risk parameters", "user_id", userID)
+		return nil, WrapError(ErrInvalidRiskParams, "win_rate and std_dev must be given together")
+	default:
+		source := input.Source
+		if source == "" {
+			source = RiskSourceSessions
+		}
+		if !source.IsValid() {
+			s.logger.Error("invalid risk source", "source", input.Source, "user_id", userID)
+			return nil, WrapError(ErrInvalidRiskParams, "source must be sessions or bets")
+		}
+		samples, err := s.repo.ResultSamples(ctx, bankrollID, source)
+		if err != nil {
+			s.logger.Error("failed to load result samples", "error", err, "bankroll_id", bankrollID, "source", source)
+			return nil, err
+		}
+		if len(samples) < MinRiskSamples {
+			s.logger.Error("not enough results for risk simulation", "bankroll_id", bankrollID, "source", source, "samples", len(samples))
+			return nil, ErrInsufficientRiskData
+		}
+		values := make([]float64, len(samples))
+		for i, sample := range samples {
+			values[i] = sample.Float64()
+		}
+		output.Source = source
+		output.SampleSize = len(samples)
+		mean, stdDev = meanAndStdDev(values)
+	}
+
+	seed := time.Now().UnixNano()
+	if input.Seed != nil {
+		seed = *input.Seed
+	}
+	output.Seed = seed
+
+	start := bankroll.CurrentBalance.Float64()
+	simulation := newRiskSimulation(start, mean, stdDev, trials, horizon, seed)
+	summary, err := simulation.run(ctx)
+	if err != nil {
+		s.logger.Error("risk simulation interrupted", "error", err, "bankroll_id", bankrollID)
+		return nil, err
+	}
+
+	currency := bankroll.Currency
+	amount := func(value float64) domain.Decimal {
+		return currency.Round(domain.NewDecimalFromFloat(value))
+	}
+	output.WinRate = amount(mean)
+	output.StdDev = amount(stdDev)
+	output.RiskOfRuin = summary.riskOfRuin
+	output.AnalyticRiskOfRuin = analyticRiskOfRuin(start, mean, stdDev)
+	output.ExpectedDownswingLength = summary.expectedDownswingLength
+	output.ExpectedMaxDrawdown = amount(summary.expectedMaxDrawdown)
+	output.Steps = simulation.steps
+	output.Curves = make([]RiskCurveOutput, len(riskPercentiles))
+	for i, p := range riskPercentiles {
+		balances := make([]domain.Decimal, len(summary.curves[p]))
+		for j, balance := range summary.curves[p] {
+			balances[j] = amount(balance)
+		}
+		output.Curves[i] = RiskCurveOutput{Percentile: p, Balances: balances}
+	}
+
+	s.logger.Info("risk simulated", "user_id", userID, "bankroll_id", bankrollID, "source", output.Source, "trials", trials, "horizon", horizon, "seed", seed)
+
+	return output, nil
+}
+
+func riskBounds(input RiskSimulationInput) (int, int, error) {
+	trials, horizon := input.Trials, input.Horizon
+	if trials == 0 {
+		trials = DefaultRiskTrials
+	}
+	if horizon == 0 {
+		horizon = DefaultRiskHorizon
+	}
+	if trials < 1 || trials > MaxRiskTrials || horizon < 1 || horizon > MaxRiskHorizon {
+		return 0, 0, WrapError(ErrInvalidRiskParams, "trials or horizon out of range")
+	}
+	return trials, horizon, nil
+}
@@ -0,0 +1,177 @@
+package bankroll
+
+import (
+	"context"
+	"testing"
+
+	"log/slog"
+
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type MockRiskRepository struct {
+	mock.Mock
+}
+
+func (m *MockRiskRepository) ResultSamples(ctx context.Context, bankrollID uint, source RiskSource) ([]domain.Decimal, error) {
+	args := m.Called(ctx, bankrollID, source)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.Decimal), args.Error(1)
+}
+
+func riskTestBankroll(balance string) *Bankroll {
+	return &Bankroll{ID: 1, UserID: 1, Currency: CurrencyUSD, CurrentBalance: domain.MustParseDecimal(balance)}
+}
+
+func int64Ptr(v int64) *int64 {
+	return &v
+}
+
+func TestSimulateRisk(t *testing.T) {
+	t.Run("historical sessions with fixed seed are reproducible", func(t *testing.T) {
+		mockRepo := new(MockRiskRepository)
+		mockBankrollRepo := new(MockBankrollRepository)
+		service := NewRiskService(mockRepo, mockBankrollRepo, slog.Default())
+
+		samples := []domain.Decimal{
+			domain.MustParseDecimal("50.00"),
+			domain.MustParseDecimal("-30.00"),
+			domain.MustParseDecimal("10.00"),
+			domain.MustParseDecimal("-10.00"),
+		}
+		mockBankrollRepo.On("FindByID", mock.Anything, uint(1), uint(1)).Return(riskTestBankroll("200.00"), nil).Twice()
+		mockRepo.On("ResultSamples", mock.Anything, uint(1), RiskSourceSessions).Return(samples, nil).Twice()
+
+		input := RiskSimulationInput{Trials: 300, Horizon: 200, Seed: int64Ptr(42)}
+		first, err := service.Simulate(context.Background(), 1, 1, input)
+		require.NoError(t, err)
+		second, err := service.Simulate(context.Background(), 1, 1, input)
+		require.NoError(t, err)
+
+		assert.Equal(t, first, second)
+		assert.Equal(t, RiskSourceSessions, first.Source)
+		assert.Equal(t, 4, first.SampleSize)
+		assert.Equal(t, "5.00", first.WinRate.String())
+		assert.Equal(t, "34.16", first.StdDev.String())
+		assert.Equal(t, int64(42), first.Seed)
+		assert.Greater(t, first.RiskOfRuin, 0.0)
+		assert.Less(t, first.RiskOfRuin, 1.0)
+		assert.Len(t, first.Steps, riskCurvePoints)
+		assert.Equal(t, 200, first.Steps[len(first.Steps)-1])
+		require.Len(t, first.Curves, len(riskPercentiles))
+		for i := 1; i < len(first.Curves); i++ {
+			last := len(first.Steps) - 1
+			assert.True(t, first.Curves[i].Balances[last].GreaterThanOrEqual(first.Curves[i-1].Balances[last]), "percentile curves are ordered")
+		}
+	})
+
+	t.Run("custom parameters with a strong edge", func(t *testing.T) {
+		mockRepo := new(MockRiskRepository)
+		mockBankrollRepo := new(MockBankrollRepository)
+		service := NewRiskService(mockRepo, mockBankrollRepo, slog.Default())
+
+		mockBankrollRepo.On("FindByID", mock.Anything, uint(1), uint(1)).Return(riskTestBankroll("1000.00"), nil).Once()
+
+		output, err := service.Simulate(context.Background(), 1, 1, RiskSimulationInput{
+			WinRate: decimalPtr("2"),
+			StdDev:  decimalPtr("10"),
+			Trials:  200,
+			Horizon: 100,
+			Seed:    int64Ptr(7),
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, RiskSourceCustom, output.Source)
+		assert.Zero(t, output.SampleSize)
+		assert.Zero(t, output.RiskOfRuin)
+		assert.Less(t, output.AnalyticRiskOfRuin, 1e-6)
+		median := output.Curves[2].Balances[len(output.Steps)-1].Float64()
+		assert.InDelta(t, 1200, median, 30)
+		mockRepo.AssertNotCalled(t, "ResultSamples", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("losing player always goes broke", func(t *testing.T) {
+		mockBankrollRepo := new(MockBankrollRepository)
+		service := NewRiskService(new(MockRiskRepository), mockBankrollRepo, slog.Default())
+
+		mockBankrollRepo.On("FindByID", mock.Anything, uint(1), uint(1)).Return(riskTestBankroll("100.00"), nil).Once()
+
+		output, err := service.Simulate(context.Background(), 1, 1, RiskSimulationInput{
+			WinRate: decimalPtr("-5"),
+			StdDev:  decimalPtr("1"),
+			Trials:  50,
+			Horizon: 100,
+			Seed:    int64Ptr(1),
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, 1.0, output.RiskOfRuin)
+		assert.Equal(t, 1.0, output.AnalyticRiskOfRuin)
+		assert.True(t, output.Curves[4].Balances[len(output.Steps)-1].IsZero())
+		assert.Greater(t, output.ExpectedDownswingLength, 90.0)
+	})
+
+	t.Run("not enough history", func(t *testing.T) {
+		mockRepo := new(MockRiskRepository)
+		mockBankrollRepo := new(MockBankrollRepository)
+		service := NewRiskService(mockRepo, mockBankrollRepo, slog.Default())
+
+		mockBankrollRepo.On("FindByID", mock.Anything, uint(1), uint(1)).Return(riskTestBankroll("100.00"), nil).Once()
+		mockRepo.On("ResultSamples", mock.Anything, uint(1), RiskSourceBets).
+			Return([]domain.Decimal{domain.MustParseDecimal("5")}, nil).Once()
+
+		_, err := service.Simulate(context.Background(), 1, 1, RiskSimulationInput{Source: RiskSourceBets})
+
+		assert.ErrorIs(t, err, ErrInsufficientRiskData)
+	})
+
+	tests := []struct {
+		name  string
+		input RiskSimulationInput
+	}{
+		{"win rate without std dev", RiskSimulationInput{WinRate: decimalPtr("1")}},
+		{"non positive std dev", RiskSimulationInput{WinRate: decimalPtr("1"), StdDev: decimalPtr("0")}},
+		{"unknown source", RiskSimulationInput{Source: "tournaments"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockRiskRepository)
+			mockBankrollRepo := new(MockBankrollRepository)
+			service := NewRiskService(mockRepo, mockBankrollRepo, slog.Default())
+
+			mockBankrollRepo.On("FindByID", mock.Anything, uint(1), uint(1)).Return(riskTestBankroll("100.00"), nil).Once()
+
+			_, err := service.Simulate(context.Background(), 1, 1, tt.input)
+
+			assert.ErrorIs(t, err, ErrInvalidRiskParams)
+		})
+	}
+
+	t.Run("too many trials", func(t *testing.T) {
+		mockBankrollRepo := new(MockBankrollRepository)
+		service := NewRiskService(new(MockRiskRepository), mockBankrollRepo, slog.Default())
+
+		_, err := service.Simulate(context.Background(), 1, 1, RiskSimulationInput{Trials: MaxRiskTrials + 1})
+
+		assert.ErrorIs(t, err, ErrInvalidRiskParams)
+		mockBankrollRepo.AssertNotCalled(t, "FindByID", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("cancelled context", func(t *testing.T) {
+		mockBankrollRepo := new(MockBankrollRepository)
+		service := NewRiskService(new(MockRiskRepository), mockBankrollRepo, slog.Default())
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		mockBankrollRepo.On("FindByID", ctx, uint(1), uint(1)).Return(riskTestBankroll("100.00"), nil).Once()
+
+		_, err := service.Simulate(ctx, 1, 1, RiskSimulationInput{WinRate: decimalPtr("1"), StdDev: decimalPtr("5"), Trials: MaxRiskTrials})
+
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+}
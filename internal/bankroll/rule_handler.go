@@ -0,0 +1,156 @@
+package bankroll
+
+import (
+	"net/http"
+	"strconv"
+
+	"log/slog"
+
+	"github.com/gin-gonic/gin"
+)
+
+type RuleHandler struct {
+	service RuleService
+	logger  *slog.Logger
+}
+
+func NewRuleHandler(service RuleService, logger *slog.Logger) *RuleHandler {
+	return &RuleHandler{
+		service: service,
+		logger:  logger,
+	}
+}
+
+func (h *RuleHandler) CreateRule(c *gin.Context) {
+	var input RuleInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		h.logger.Error("invalid request body", "error", err)
+		c.JSON(http.StatusBadRequest, ErrorOutput{
+			Error:   "Invalid request body",
+			Code:    "VALIDATION_ERROR",
+			Details: nil,
+		})
+		return
+	}
+
+	userID, err := getUserID(c)
+	if err != nil {
+		handleError(c, h.logger, err)
+		return
+	}
+
+	bankrollIDStr := c.Param("bankrollId")
+	bankrollID, err := strconv.ParseUint(bankrollIDStr, 10, 32)
+	if err != nil {
+		handleError(c, h.logger, ErrUnauthorized)
+		return
+	}
+
+	output, err := h.service.CreateRule(c.Request.Context(), userID, uint(bankrollID), input)
+	if err != nil {
+		handleError(c, h.logger, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, output)
+}
+
+func (h *RuleHandler) ListRules(c *gin.Context) {
+	var input ListRulesInput
+	if err := c.ShouldBindQuery(&input); err != nil {
+		h.logger.Error("invalid query parameters", "error", err)
+		c.JSON(http.StatusBadRequest, ErrorOutput{
+			Error:   "Invalid query parameters",
+			Code:    "VALIDATION_ERROR",
+			Details: nil,
+		})
+		return
+	}
+
+	userID, err := getUserID(c)
+	if err != nil {
+		handleError(c, h.logger, err)
+		return
+	}
+
+	bankrollIDStr := c.Param("bankrollId")
+	bankrollID, err := strconv.ParseUint(bankrollIDStr, 10, 32)
+	if err != nil {
+		handleError(c, h.logger, ErrUnauthorized)
+		return
+	}
+
+	output, err := h.service.ListRules(c.Request.Context(), userID, uint(bankrollID), input)
+	if err != nil {
+		handleError(c, h.logger, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
+
+func (h *RuleHandler) UpdateRule(c *gin.Context) {
+	var input RuleInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		h.logger.Error("invalid request body", "error", err)
+		c.JSON(http.StatusBadRequest, ErrorOutput{
+			Error:   "Invalid request body",
+			Code:    "VALIDATION_ERROR",
+			Details: nil,
+		})
+		return
+	}
+
+	userID, err := getUserID(c)
+	if err != nil {
+		handleError(c, h.logger, err)
+		return
+	}
+
+	bankrollID, ruleID, err := ruleParams(c)
+	if err != nil {
+		handleError(c, h.logger, err)
+		return
+	}
+
+	output, err := h.service.UpdateRule(c.Request.Context(), userID, bankrollID, ruleID, input)
+	if err != nil {
+		handleError(c, h.logger, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
+
+func (h *RuleHandler) DeleteRule(c *gin.Context) {
+	userID, err := getUserID(c)
+	if err != nil {
+		handleError(c, h.logger, err)
+		return
+	}
+
+	bankrollID, ruleID, err := ruleParams(c)
+	if err != nil {
+		handleError(c, h.logger, err)
+		return
+	}
+
+	if err := h.service.DeleteRule(c.Request.Context(), userID, bankrollID, ruleID); err != nil {
+		handleError(c, h.logger, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func ruleParams(c *gin.Context) (uint, uint, error) {
+	bankrollID, err := strconv.ParseUint(c.Param("bankrollId"), 10, 32)
+	if err != nil {
+		return 0, 0, ErrUnauthorized
+	}
+	ruleID, err := strconv.ParseUint(c.Param("ruleId"), 10, 32)
+	if err != nil {
+		return 0, 0, ErrRuleNotFound
+	}
+	return uint(bankrollID), uint(ruleID), nil
+}
@@ -0,0 +1,156 @@
+package bankroll
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"log/slog"
+
+	"github.com/gin-gonic/gin"
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type MockRuleService struct {
+	mock.Mock
+}
+
+func (m *MockRuleService) CreateRule(ctx context.Context, userID uint, bankrollID uint, input RuleInput) (*RuleOutput, error) {
+	args := m.Called(ctx, userID, bankrollID, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*RuleOutput), args.Error(1)
+}
+
+func (m *MockRuleService) ListRules(ctx context.Context, userID uint, bankrollID uint, input ListRulesInput) (*RulePageOutput, error) {
+	args := m.Called(ctx, userID, bankrollID, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*RulePageOutput), args.Error(1)
+}
+
+func (m *MockRuleService) UpdateRule(ctx context.Context, userID uint, bankrollID uint, ruleID uint, input RuleInput) (*RuleOutput, error) {
+	args := m.Called(ctx, userID, bankrollID, ruleID, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*RuleOutput), args.Error(1)
+}
+
+func (m *MockRuleService) DeleteRule(ctx context.Context, userID uint, bankrollID uint, ruleID uint) error {
+	args := m.Called(ctx, userID, bankrollID, ruleID)
+	return args.Error(0)
+}
+
+func (m *MockRuleService) Evaluate(ctx context.Context, bankroll *Bankroll, check RuleCheck) ([]RuleBreachOutput, error) {
+	args := m.Called(ctx, bankroll, check)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]RuleBreachOutput), args.Error(1)
+}
+
+func newRuleRequest(t *testing.T, method string, url string, body string) *http.Request {
+	req, err := http.NewRequest(method, url, bytes.NewBufferString(body))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+	return req
+}
+
+func TestCreateRuleHandler(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		mockService := new(MockRuleService)
+		handler := NewRuleHandler(mockService, slog.Default())
+
+		input := RuleInput{Type: RuleTypeMinBuyIns, Value: domain.MustParseDecimal("40"), Severity: RuleSeveritySoft, GameType: "nlhe"}
+		mockService.On("CreateRule", mock.Anything, uint(1), uint(2), input).
+			Return(&RuleOutput{ID: 3, BankrollID: 2, Type: RuleTypeMinBuyIns, Value: input.Value, Severity: RuleSeveritySoft}, nil).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = newRuleRequest(t, http.MethodPost, "/bankrolls/2/rules", `{"type":"min_buy_ins","value":"40","severity":"soft","game_type":"nlhe"}`)
+		c.Params = gin.Params{{Key: "bankrollId", Value: "2"}}
+		c.Set("userID", "1")
+
+		handler.CreateRule(c)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		var response RuleOutput
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, uint(3), response.ID)
+		assert.Equal(t, "40", response.Value.String())
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("invalid rule type", func(t *testing.T) {
+		mockService := new(MockRuleService)
+		handler := NewRuleHandler(mockService, slog.Default())
+
+		mockService.On("CreateRule", mock.Anything, uint(1), uint(2), mock.AnythingOfType("bankroll.RuleInput")).
+			Return(nil, ErrInvalidRuleType).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = newRuleRequest(t, http.MethodPost, "/bankrolls/2/rules", `{"type":"max_tables","value":"4"}`)
+		c.Params = gin.Params{{Key: "bankrollId", Value: "2"}}
+		c.Set("userID", "1")
+
+		handler.CreateRule(c)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		var response ErrorOutput
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, "INVALID_RULE_TYPE", response.Code)
+	})
+}
+
+func TestUpdateRuleHandler(t *testing.T) {
+	t.Run("rule not found", func(t *testing.T) {
+		mockService := new(MockRuleService)
+		handler := NewRuleHandler(mockService, slog.Default())
+
+		mockService.On("UpdateRule", mock.Anything, uint(1), uint(2), uint(9), mock.AnythingOfType("bankroll.RuleInput")).
+			Return(nil, ErrRuleNotFound).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = newRuleRequest(t, http.MethodPut, "/bankrolls/2/rules/9", `{"type":"max_daily_loss","value":"100"}`)
+		c.Params = gin.Params{{Key: "bankrollId", Value: "2"}, {Key: "ruleId", Value: "9"}}
+		c.Set("userID", "1")
+
+		handler.UpdateRule(c)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		var response ErrorOutput
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, "RULE_NOT_FOUND", response.Code)
+	})
+}
+
+func TestDeleteRuleHandler(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		mockService := new(MockRuleService)
+		handler := NewRuleHandler(mockService, slog.Default())
+
+		mockService.On("DeleteRule", mock.Anything, uint(1), uint(2), uint(3)).Return(nil).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodDelete, "/bankrolls/2/rules/3", nil)
+		c.Params = gin.Params{{Key: "bankrollId", Value: "2"}, {Key: "ruleId", Value: "3"}}
+		c.Set("userID", "1")
+
+		handler.DeleteRule(c)
+
+		assert.Equal(t, http.StatusNoContent, c.Writer.Status())
+		mockService.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,132 @@
+package bankroll
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
+)
+
+type RuleType string
+
+const (
+	// RuleTypeMaxBuyInPercent caps a session buy-in or a bet's amount at risk
+	// to a percentage of the bankroll.
+	RuleTypeMaxBuyInPercent RuleType = "max_buy_in_percent"
+	// RuleTypeMinBuyIns requires the bankroll to hold at least Value buy-ins
+	// of the session being played.
+	RuleTypeMinBuyIns RuleType = "min_buy_ins"
+	// RuleTypeMaxDailyLoss stops play once the day's realized loss reaches
+	// Value.
+	RuleTypeMaxDailyLoss RuleType = "max_daily_loss"
+	// RuleTypeMaxOpenExposure caps the money held in live sessions and open
+	// bets.
+	RuleTypeMaxOpenExposure RuleType = "max_open_exposure"
+)
+
+func (t RuleType) IsValid() bool {
+	switch t {
+	case RuleTypeMaxBuyInPercent, RuleTypeMinBuyIns, RuleTypeMaxDailyLoss, RuleTypeMaxOpenExposure:
+		return true
+	}
+	return false
+}
+
+// IsAmount reports whether the rule's value is money in the bankroll's
+// currency rather than a percentage or a count.
+func (t RuleType) IsAmount() bool {
+	return t == RuleTypeMaxDailyLoss || t == RuleTypeMaxOpenExposure
+}
+
+type RuleSeverity string
+
+const (
+	RuleSeverityHard RuleSeverity = "hard"
+	RuleSeveritySoft RuleSeverity = "soft"
+)
+
+func (s RuleSeverity) IsValid() bool {
+	return s == RuleSeverityHard || s == RuleSeveritySoft
+}
+
+// BankrollRule is a bankroll management limit checked before a session or
+// bet is recorded. GameType and Stakes, when set, narrow the rule to sessions
+// of that game and stake level; scoped rules never apply to bets.
+type BankrollRule struct {
+	ID         uint           `gorm:"primaryKey;autoIncrement"`
+	BankrollID uint           `gorm:"not null;index"`
+	UserID     uint           `gorm:"not null;index"`
+	Type       RuleType       `gorm:"type:varchar(30);not null"`
+	Value      domain.Decimal `gorm:"type:decimal(27,8);not null"`
+	Severity   RuleSeverity   `gorm:"type:varchar(10);not null"`
+	GameType   string         `gorm:"type:varchar(10)"`
+	Stakes     string         `gorm:"type:varchar(50)"`
+	CreatedAt  time.Time      `gorm:"autoCreateTime"`
+	UpdatedAt  time.Time      `gorm:"autoUpdateTime"`
+}
+
+func (BankrollRule) TableName() string {
+	return "bankroll_rules"
+}
+
+// RuleListFilter selects a page of a bankroll's rules, oldest first. AfterID,
+// when set, replaces Offset with keyset paging.
+type RuleListFilter struct {
+	AfterID uint
+	Limit   int
+	Offset  int
+}
+
+func (r *BankrollRule) IsSoft() bool {
+	return r.Severity == RuleSeveritySoft
+}
+
+// Matches reports whether the rule applies to the activity being checked.
+func (r *BankrollRule) Matches(check RuleCheck) bool {
+	if check.Activity == RuleActivityBet {
+		if r.GameType != "" || r.Stakes != "" {
+			return false
+		}
+		return r.Type != RuleTypeMinBuyIns
+	}
+	if r.GameType != "" && r.GameType != check.GameType {
+		return false
+	}
+	return r.Stakes == "" || r.Stakes == check.Stakes
+}
+
+type RuleActivity string
+
+const (
+	RuleActivitySession RuleActivity = "session"
+	RuleActivityBet     RuleActivity = "bet"
+)
+
+// RuleCheck describes a session or bet about to be recorded. Amount is the
+// buy-in or the bet's liability; Exposure is the part of it that stays open
+// once recorded, which is zero for sessions logged after they ended. EndedAt
+// is set for sessions logged after the fact; ones that ended before the day of
+// At are history and are not held to today's rules.
+type RuleCheck struct {
+	Activity RuleActivity
+	GameType string
+	Stakes   string
+	Amount   domain.Decimal
+	Exposure domain.Decimal
+	At       time.Time
+	EndedAt  *time.Time
+}
+
+// RuleViolation is returned when a hard rule would be broken. It unwraps to
+// ErrRuleViolation.
+type RuleViolation struct {
+	Breach RuleBreachOutput
+}
+
+func (v *RuleViolation) Error() string {
+	return fmt.Sprintf("%s: %s", ErrRuleViolation, v.Breach.Message)
+}
+
+func (v *RuleViolation) Unwrap() error {
+	return ErrRuleViolation
+}
@@ -0,0 +1,147 @@
+package bankroll
+
+import (
+	"context"
+	"time"
+
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
+	"gorm.io/gorm"
+)
+
+// Buy-ins of sessions that are still live are money in play, not a loss, so
+// they only count once the session is closed.
+const realizedResultQuery = `
+SELECT t.amount
+FROM bankroll_transactions t
+LEFT JOIN poker_sessions s ON t.reference_type = 'session' AND s.id = t.reference_id
+WHERE t.bankroll_id = ? AND t.type IN ? AND t.created_at >= ?
+AND (t.type <> 'session_buy_in' OR COALESCE(s.status, '') <> 'open')`
+
+type postgresRuleRepository struct {
+	db *gorm.DB
+}
+
+func NewPostgresRuleRepository(db *gorm.DB) RuleRepository {
+	return &postgresRuleRepository{
+		db: db,
+	}
+}
+
+func (r *postgresRuleRepository) Create(ctx context.Context, rule *BankrollRule) error {
+	if err := r.db.WithContext(ctx).Create(rule).Error; err != nil {
+		return WrapError(ErrDatabaseError, err.Error())
+	}
+	return nil
+}
+
+func (r *postgresRuleRepository) FindByID(ctx context.Context, id uint, bankrollID uint, userID uint) (*BankrollRule, error) {
+	var rule BankrollRule
+	err := r.db.WithContext(ctx).
+		Where("id = ? AND bankroll_id = ? AND user_id = ?", id, bankrollID, userID).
+		First(&rule).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, ErrRuleNotFound
+		}
+		return nil, WrapError(ErrDatabaseError, err.Error())
+	}
+	return &rule, nil
+}
+
+func (r *postgresRuleRepository) ListByBankrollID(ctx context.Context, bankrollID uint, userID uint) ([]*BankrollRule, error) {
+	var rules []*BankrollRule
+	err := r.db.WithContext(ctx).
+		Where("bankroll_id = ? AND user_id = ?", bankrollID, userID).
+		Order("id").
+		Find(&rules).Error
+	if err != nil {
+		return nil, WrapError(ErrDatabaseError, err.Error())
+	}
+	return rules, nil
+}
+
+// List returns a page of the bankroll's rules together with the total number
+// of rules. ListByBankrollID returns them all for evaluation.
+func (r *postgresRuleRepository) List(ctx context.Context, bankrollID uint, userID uint, filter RuleListFilter) ([]*BankrollRule, int64, error) {
+	query := r.db.WithContext(ctx).Model(&BankrollRule{}).
+		Where("bankroll_id = ? AND user_id = ?", bankrollID, userID)
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, WrapError(ErrDatabaseError, err.Error())
+	}
+
+	if filter.AfterID != 0 {
+		query = query.Where("id > ?", filter.AfterID)
+	} else if filter.Offset > 0 {
+		query = query.Offset(filter.Offset)
+	}
+
+	var rules []*BankrollRule
+	if err := query.Order("id").Limit(filter.Limit).Find(&rules).Error; err != nil {
+		return nil, 0, WrapError(ErrDatabaseError, err.Error())
+	}
+	return rules, total, nil
+}
+
+func (r *postgresRuleRepository) Update(ctx context.Context, rule *BankrollRule) error {
+	result := r.db.WithContext(ctx).Model(&BankrollRule{}).
+		Where("id = ? AND bankroll_id = ? AND user_id = ?", rule.ID, rule.BankrollID, rule.UserID).
+		Updates(map[string]interface{}{
+			"type":       rule.Type,
+			"value":      rule.Value,
+			"severity":   rule.Severity,
+			"game_type":  rule.GameType,
+			"stakes":     rule.Stakes,
+			"updated_at": time.Now(),
+		})
+	if result.Error != nil {
+		return WrapError(ErrDatabaseError, result.Error.Error())
+	}
+	if result.RowsAffected == 0 {
+		return ErrRuleNotFound
+	}
+	return nil
+}
+
+func (r *postgresRuleRepository) Delete(ctx context.Context, id uint, bankrollID uint, userID uint) error {
+	result := r.db.WithContext(ctx).
+		Where("id = ? AND bankroll_id = ? AND user_id = ?", id, bankrollID, userID).
+		Delete(&BankrollRule{})
+	if result.Error != nil {
+		return WrapError(ErrDatabaseError, result.Error.Error())
+	}
+	if result.RowsAffected == 0 {
+		return ErrRuleNotFound
+	}
+	return nil
+}
+
+// RealizedResult sums the results of play posted to the ledger since the
+// given time. Deposits, withdrawals, transfers and adjustments are not play.
+func (r *postgresRuleRepository) RealizedResult(ctx context.Context, bankrollID uint, since time.Time) (domain.Decimal, error) {
+	return realizedResult(r.db.WithContext(ctx), bankrollID, since)
+}
+
+func realizedResult(db *gorm.DB, bankrollID uint, since time.Time) (domain.Decimal, error) {
+	var amounts []domain.Decimal
+	err := db.Raw(realizedResultQuery, bankrollID, PlayTransactionTypes, since).Scan(&amounts).Error
+	if err != nil {
+		return domain.Zero, WrapError(ErrDatabaseError, err.Error())
+	}
+	return domain.SumDecimals(amounts...), nil
+}
+
+// OpenBetLiability returns the amount at risk in the bankroll's unsettled
+// bets.
+func (r *postgresRuleRepository) OpenBetLiability(ctx context.Context, bankrollID uint) (domain.Decimal, error) {
+	var liabilities []domain.Decimal
+	err := r.db.WithContext(ctx).
+		Table("bets").
+		Where("bankroll_id = ? AND status = ? AND deleted_at IS NULL", bankrollID, "open").
+		Pluck("liability", &liabilities).Error
+	if err != nil {
+		return domain.Zero, WrapError(ErrDatabaseError, err.Error())
+	}
+	return domain.SumDecimals(liabilities...), nil
+}
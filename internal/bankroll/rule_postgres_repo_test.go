@@ -0,0 +1,140 @@
+package bankroll
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+type ruleTestBet struct {
+	ID         uint `gorm:"primaryKey"`
+	BankrollID uint
+	Status     string
+	Liability  domain.Decimal `gorm:"type:decimal(27,8)"`
+	DeletedAt  gorm.DeletedAt
+}
+
+func (ruleTestBet) TableName() string {
+	return "bets"
+}
+
+type ruleTestSession struct {
+	ID     uint `gorm:"primaryKey"`
+	Status string
+}
+
+func (ruleTestSession) TableName() string {
+	return "poker_sessions"
+}
+
+func TestPostgresRuleRepository_CRUD(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewPostgresRuleRepository(db)
+	ctx := context.Background()
+	bankroll := createTestBankroll(t, db, 1, "1000.00")
+
+	rule := &BankrollRule{
+		BankrollID: bankroll.ID,
+		UserID:     1,
+		Type:       RuleTypeMinBuyIns,
+		Value:      domain.MustParseDecimal("40"),
+		Severity:   RuleSeverityHard,
+		GameType:   "nlhe",
+	}
+	require.NoError(t, repo.Create(ctx, rule))
+	assert.NotZero(t, rule.ID)
+
+	_, err := repo.FindByID(ctx, rule.ID, bankroll.ID, 2)
+	assert.ErrorIs(t, err, ErrRuleNotFound)
+
+	rule.Value = domain.MustParseDecimal("50")
+	rule.Severity = RuleSeveritySoft
+	require.NoError(t, repo.Update(ctx, rule))
+
+	found, err := repo.FindByID(ctx, rule.ID, bankroll.ID, 1)
+	require.NoError(t, err)
+	assert.Equal(t, 50.0, found.Value.Float64())
+	assert.Equal(t, RuleSeveritySoft, found.Severity)
+	assert.Equal(t, "nlhe", found.GameType)
+
+	rules, err := repo.ListByBankrollID(ctx, bankroll.ID, 1)
+	require.NoError(t, err)
+	assert.Len(t, rules, 1)
+
+	second := &BankrollRule{BankrollID: bankroll.ID, UserID: 1, Type: RuleTypeMinBuyIns, Value: domain.MustParseDecimal("40"), Severity: RuleSeverityHard}
+	require.NoError(t, repo.Create(ctx, second))
+	page, total, err := repo.List(ctx, bankroll.ID, 1, RuleListFilter{Limit: 1})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), total)
+	require.Len(t, page, 1)
+	assert.Equal(t, rule.ID, page[0].ID)
+	page, _, err = repo.List(ctx, bankroll.ID, 1, RuleListFilter{Limit: 1, AfterID: rule.ID})
+	require.NoError(t, err)
+	require.Len(t, page, 1)
+	assert.Equal(t, second.ID, page[0].ID)
+	page, _, err = repo.List(ctx, bankroll.ID, 1, RuleListFilter{Limit: 1, Offset: 1})
+	require.NoError(t, err)
+	require.Len(t, page, 1)
+	assert.Equal(t, second.ID, page[0].ID)
+
+	assert.ErrorIs(t, repo.Delete(ctx, rule.ID, bankroll.ID, 2), ErrRuleNotFound)
+	require.NoError(t, repo.Delete(ctx, rule.ID, bankroll.ID, 1))
+	assert.ErrorIs(t, repo.Update(ctx, rule), ErrRuleNotFound)
+}
+
+func TestPostgresRuleRepository_RealizedResult(t *testing.T) {
+	db := setupTestDB(t)
+	require.NoError(t, db.AutoMigrate(&ruleTestSession{}))
+	repo := NewPostgresRuleRepository(db)
+	ctx := context.Background()
+	bankroll := createTestBankroll(t, db, 1, "1000.00")
+
+	require.NoError(t, db.Create(&ruleTestSession{ID: 1, Status: "settled"}).Error)
+	require.NoError(t, db.Create(&ruleTestSession{ID: 2, Status: "open"}).Error)
+
+	since := time.Now().Add(-time.Hour)
+	closedID, openID := uint(1), uint(2)
+	entries := []*Transaction{
+		{Type: TransactionTypeAdjustment, Amount: domain.MustParseDecimal("-10.00"), CreatedAt: since.Add(-time.Hour)},
+		{Type: TransactionTypeDeposit, Amount: domain.MustParseDecimal("500.00")},
+		{Type: TransactionTypeAdjustment, Amount: domain.MustParseDecimal("200.00")},
+		{Type: TransactionTypeBetSettlement, Amount: domain.MustParseDecimal("-30.00")},
+		{Type: TransactionTypeSessionBuyIn, Amount: domain.MustParseDecimal("-50.00"), ReferenceType: "session", ReferenceID: &closedID},
+		{Type: TransactionTypeSessionCashOut, Amount: domain.MustParseDecimal("20.00"), ReferenceType: "session", ReferenceID: &closedID},
+		{Type: TransactionTypeSessionBuyIn, Amount: domain.MustParseDecimal("-100.00"), ReferenceType: "session", ReferenceID: &openID},
+	}
+	for _, entry := range entries {
+		postHistoryEntry(t, db, bankroll, entry)
+	}
+
+	result, err := repo.RealizedResult(ctx, bankroll.ID, since)
+
+	require.NoError(t, err)
+	assert.Equal(t, "-60.00", result.Round(2).String(), "deposits, adjustments, older entries and live buy-ins are not counted")
+}
+
+func TestPostgresRuleRepository_OpenBetLiability(t *testing.T) {
+	db := setupTestDB(t)
+	require.NoError(t, db.AutoMigrate(&ruleTestBet{}))
+	repo := NewPostgresRuleRepository(db)
+	ctx := context.Background()
+
+	bets := []*ruleTestBet{
+		{BankrollID: 1, Status: "open", Liability: domain.MustParseDecimal("25.00")},
+		{BankrollID: 1, Status: "open", Liability: domain.MustParseDecimal("90.00")},
+		{BankrollID: 1, Status: "won", Liability: domain.MustParseDecimal("40.00")},
+		{BankrollID: 2, Status: "open", Liability: domain.MustParseDecimal("70.00")},
+	}
+	require.NoError(t, db.Create(&bets).Error)
+	require.NoError(t, db.Delete(&ruleTestBet{}, bets[1].ID).Error)
+
+	liability, err := repo.OpenBetLiability(ctx, 1)
+
+	require.NoError(t, err)
+	assert.Equal(t, 25.0, liability.Float64())
+}
@@ -0,0 +1,19 @@
+package bankroll
+
+import (
+	"context"
+	"time"
+
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
+)
+
+type RuleRepository interface {
+	Create(ctx context.Context, rule *BankrollRule) error
+	FindByID(ctx context.Context, id uint, bankrollID uint, userID uint) (*BankrollRule, error)
+	ListByBankrollID(ctx context.Context, bankrollID uint, userID uint) ([]*BankrollRule, error)
+	List(ctx context.Context, bankrollID uint, userID uint, filter RuleListFilter) ([]*BankrollRule, int64, error)
+	Update(ctx context.Context, rule *BankrollRule) error
+	Delete(ctx context.Context, id uint, bankrollID uint, userID uint) error
+	RealizedResult(ctx context.Context, bankrollID uint, since time.Time) (domain.Decimal, error)
+	OpenBetLiability(ctx context.Context, bankrollID uint) (domain.Decimal, error)
+}
@@ -0,0 +1,337 @@
+package bankroll
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
+	"github.com/opinedajr/micro-stakes-api/internal/shared/pagination"
+)
+
+type RuleService interface {
+	CreateRule(ctx context.Context, userID uint, bankrollID uint, input RuleInput) (*RuleOutput, error)
+	ListRules(ctx context.Context, userID uint, bankrollID uint, input ListRulesInput) (*RulePageOutput, error)
+	UpdateRule(ctx context.Context, userID uint, bankrollID uint, ruleID uint, input RuleInput) (*RuleOutput, error)
+	DeleteRule(ctx context.Context, userID uint, bankrollID uint, ruleID uint) error
+	RuleEvaluator
+}
+
+// RuleEvaluator checks a session or bet against the bankroll's stop limits and
+// rules before it is recorded. A locked bankroll is reported as a
+// *LimitReachedError and a broken hard rule as a *RuleViolation; broken soft
+// rules are returned as warnings.
+type RuleEvaluator interface {
+	Evaluate(ctx context.Context, bankroll *Bankroll, check RuleCheck) ([]RuleBreachOutput, error)
+}
+
+type ruleService struct {
+	repo         RuleRepository
+	bankrollRepo BankrollRepository
+	limits       LimitChecker
+	logger       *slog.Logger
+}
+
+func NewRuleService(repo RuleRepository, bankrollRepo BankrollRepository, limits LimitChecker, logger *slog.Logger) RuleService {
+	return &ruleService{
+		repo:         repo,
+		bankrollRepo: bankrollRepo,
+		limits:       limits,
+		logger:       logger,
+	}
+}
+
+func (s *ruleService) CreateRule(ctx context.Context, userID uint, bankrollID uint, input RuleInput) (*RuleOutput, error) {
+	bankroll, err := s.bankrollRepo.FindByID(ctx, bankrollID, userID)
+	if err != nil {
+		s.logger.Error("bankroll not found", "error", err, "user_id", userID, "bankroll_id", bankrollID)
+		return nil, err
+	}
+
+	rule := &BankrollRule{
+		BankrollID: bankrollID,
+		UserID:     userID,
+	}
+	if err := s.apply(bankroll, rule, input); err != nil {
+		s.logger.Error("invalid rule", "error", err, "user_id", userID, "bankroll_id", bankrollID, "type", input.Type, "value", input.Value)
+		return nil, err
+	}
+
+	if err := s.repo.Create(ctx, rule); err != nil {
+		s.logger.Error("failed to create rule", "error", err, "user_id", userID, "bankroll_id", bankrollID)
+		return nil, err
+	}
+
+	s.logger.Info("rule created", "user_id", userID, "bankroll_id", bankrollID, "rule_id", rule.ID, "type", rule.Type, "value", rule.Value, "severity", rule.Severity)
+
+	return toRuleOutput(rule), nil
+}
+
+func (s *ruleService) ListRules(ctx context.Context, userID uint, bankrollID uint, input ListRulesInput) (*RulePageOutput, error) {
+	params, err := input.Params.Normalize()
+	if err != nil {
+		s.logger.Error("invalid list parameters", "error", err, "user_id", userID)
+		return nil, WrapError(ErrValidationFailed, err.Error())
+	}
+
+	filter := RuleListFilter{
+		Limit:  params.Limit + 1,
+		Offset: params.Offset,
+	}
+	if params.Cursor != "" {
+		cursor, err := pagination.DecodeCursor(params.Cursor, "id", false)
+		if err != nil {
+			s.logger.Error("invalid cursor", "error", err, "user_id", userID)
+			return nil, WrapError(ErrValidationFailed, err.Error())
+		}
+		filter.AfterID = cursor.ID
+	}
+
+	if _, err := s.bankrollRepo.FindByID(ctx, bankrollID, userID); err != nil {
+		s.logger.Error("bankroll not found", "error", err, "user_id", userID, "bankroll_id", bankrollID)
+		return nil, err
+	}
+
+	rules, total, err := s.repo.List(ctx, bankrollID, userID, filter)
+	if err != nil {
+		s.logger.Error("failed to list rules", "error", err, "user_id", userID, "bankroll_id", bankrollID)
+		return nil, err
+	}
+
+	rules, more := pagination.Trim(rules, params.Limit)
+	nextCursor := ""
+	if more {
+		nextCursor = pagination.EncodeCursor("id", false, "", rules[len(rules)-1].ID)
+	}
+
+	outputs := make([]*RuleOutput, len(rules))
+	for i, rule := range rules {
+		outputs[i] = toRuleOutput(rule)
+	}
+
+	s.logger.Info("rules listed", "user_id", userID, "bankroll_id", bankrollID, "count", len(outputs), "total", total)
+
+	return pagination.NewPage(outputs, total, params, nextCursor), nil
+}
+
+func (s *ruleService) UpdateRule(ctx context.Context, userID uint, bankrollID uint, ruleID uint, input RuleInput) (*RuleOutput, error) {
+	bankroll, err := s.bankrollRepo.FindByID(ctx, bankrollID, userID)
+	if err != nil {
+		s.logger.Error("bankroll not found", "error", err, "user_id", userID, "bankroll_id", bankrollID)
+		return nil, err
+	}
+
+	rule, err := s.repo.FindByID(ctx, ruleID, bankrollID, userID)
+	if err != nil {
+		s.logger.Error("rule not found", "error", err, "user_id", userID, "bankroll_id", bankrollID, "rule_id", ruleID)
+		return nil, err
+	}
+	if err := s.apply(bankroll, rule, input); err != nil {
+		s.logger.Error("invalid rule", "error", err, "user_id", userID, "rule_id", ruleID, "type", input.Type, "value", input.Value)
+		return nil, err
+	}
+
+	if err := s.repo.Update(ctx, rule); err != nil {
+		s.logger.Error("failed to update rule", "error", err, "user_id", userID, "rule_id", ruleID)
+		return nil, err
+	}
+
+	s.logger.Info("rule updated", "user_id", userID, "bankroll_id", bankrollID, "rule_id", ruleID, "type", rule.Type, "value", rule.Value, "severity", rule.Severity)
+
+	return toRuleOutput(rule), nil
+}
+
+func (s *ruleService) DeleteRule(ctx context.Context, userID uint, bankrollID uint, ruleID uint) error {
+	if _, err := s.bankrollRepo.FindByID(ctx, bankrollID, userID); err != nil {
+		s.logger.Error("bankroll not found", "error", err, "user_id", userID, "bankroll_id", bankrollID)
+		return err
+	}
+
+	if err := s.repo.Delete(ctx, ruleID, bankrollID, userID); err != nil {
+		s.logger.Error("failed to delete rule", "error", err, "user_id", userID, "rule_id", ruleID)
+		return err
+	}
+
+	s.logger.Info("rule deleted", "user_id", userID, "bankroll_id", bankrollID, "rule_id", ruleID)
+
+	return nil
+}
+
+func (s *ruleService) Evaluate(ctx context.Context, bankroll *Bankroll, check RuleCheck) ([]RuleBreachOutput, error) {
+	today := LimitPeriodDaily.Start(check.At, bankroll.Location())
+	if check.EndedAt != nil && check.EndedAt.Before(today) {
+		return nil, nil
+	}
+
+	lock, err := s.limits.LockStatus(ctx, bankroll, check.At)
+	if err != nil {
+		s.logger.Error("failed to check limits", "error", err, "bankroll_id", bankroll.ID)
+		return nil, err
+	}
+	if lock.Locked {
+		s.logger.Info("bankroll locked by stop limit", "user_id", bankroll.UserID, "bankroll_id", bankroll.ID, "reason", lock.Reason, "locked_until", lock.LockedUntil)
+		return nil, &LimitReachedError{Lock: *lock}
+	}
+
+	rules, err := s.repo.ListByBankrollID(ctx, bankroll.ID, bankroll.UserID)
+	if err != nil {
+		s.logger.Error("failed to list rules", "error", err, "bankroll_id", bankroll.ID)
+		return nil, err
+	}
+
+	evaluation := &ruleEvaluation{repo: s.repo, bankroll: bankroll, check: check, today: today}
+	var warnings []RuleBreachOutput
+	for _, rule := range rules {
+		if !rule.Matches(check) {
+			continue
+		}
+		breach, err := evaluation.breach(ctx, rule)
+		if err != nil {
+			s.logger.Error("failed to evaluate rule", "error", err, "bankroll_id", bankroll.ID, "rule_id", rule.ID)
+			return nil, err
+		}
+		if breach == nil {
+			continue
+		}
+		if !rule.IsSoft() {
+			s.logger.Info("rule violated", "user_id", bankroll.UserID, "bankroll_id", bankroll.ID, "rule_id", rule.ID, "type", rule.Type, "activity", check.Activity)
+			return nil, &RuleViolation{Breach: *breach}
+		}
+		s.logger.Info("soft rule exceeded", "user_id", bankroll.UserID, "bankroll_id", bankroll.ID, "rule_id", rule.ID, "type", rule.Type, "activity", check.Activity)
+		warnings = append(warnings, *breach)
+	}
+	return warnings, nil
+}
+
+// ruleValueScale is the precision of percentage and buy-in count rules.
+const ruleValueScale int32 = 2
+
+var hundred = domain.NewDecimalFromInt(100)
+
+// apply validates the input against the bankroll and copies it onto rule.
+func (s *ruleService) apply(bankroll *Bankroll, rule *BankrollRule, input RuleInput) error {
+	if !input.Type.IsValid() {
+		return ErrInvalidRuleType
+	}
+	severity := input.Severity
+	if severity == "" {
+		severity = RuleSeverityHard
+	}
+	if !severity.IsValid() {
+		return ErrInvalidRuleSeverity
+	}
+	if !input.Value.IsPositive() {
+		return ErrInvalidRuleValue
+	}
+	if input.Type.IsAmount() {
+		if !bankroll.Currency.Fits(input.Value) {
+			return ErrInvalidPrecision
+		}
+	} else if !input.Value.FitsScale(ruleValueScale) {
+		return ErrInvalidRuleValue
+	}
+	if input.Type == RuleTypeMaxBuyInPercent && input.Value.GreaterThan(hundred) {
+		return ErrInvalidRuleValue
+	}
+
+	rule.Type = input.Type
+	rule.Value = input.Value
+	rule.Severity = severity
+	rule.GameType = input.GameType
+	rule.Stakes = input.Stakes
+	return nil
+}
+
+// ruleEvaluation checks rules against one activity, loading the figures that
+// need a query at most once. today is the start of the check's day in the
+// bankroll's time zone.
+type ruleEvaluation struct {
+	repo     RuleRepository
+	bankroll *Bankroll
+	check    RuleCheck
+	today    time.Time
+
+	dailyResult  *domain.Decimal
+	betLiability *domain.Decimal
+}
+
+func (e *ruleEvaluation) breach(ctx context.Context, rule *BankrollRule) (*RuleBreachOutput, error) {
+	currency := e.bankroll.Currency
+	total := e.bankroll.CurrentBalance.Add(e.bankroll.InPlay)
+	output := &RuleBreachOutput{
+		RuleID:   rule.ID,
+		Type:     rule.Type,
+		Severity: rule.Severity,
+		Limit:    rule.Value,
+	}
+
+	switch rule.Type {
+	case RuleTypeMaxBuyInPercent:
+		if !e.check.Amount.IsPositive() {
+			return nil, nil
+		}
+		limit := currency.Round(total.Mul(rule.Value).Div(hundred))
+		if e.check.Amount.LessThanOrEqual(limit) {
+			return nil, nil
+		}
+		output.Limit = limit
+		output.Actual = e.check.Amount
+		output.Message = fmt.Sprintf("amount %s exceeds %s%% of the bankroll (%s)", e.check.Amount, rule.Value, limit)
+	case RuleTypeMinBuyIns:
+		if !e.check.Amount.IsPositive() {
+			return nil, nil
+		}
+		buyIns := total.Div(e.check.Amount).Round(ruleValueScale)
+		if buyIns.GreaterThanOrEqual(rule.Value) {
+			return nil, nil
+		}
+		output.Actual = buyIns
+		output.Message = fmt.Sprintf("bankroll holds %s buy-ins, at least %s are required", buyIns, rule.Value)
+	case RuleTypeMaxDailyLoss:
+		if e.dailyResult == nil {
+			result, err := e.repo.RealizedResult(ctx, e.bankroll.ID, e.today)
+			if err != nil {
+				return nil, err
+			}
+			e.dailyResult = &result
+		}
+		loss := e.dailyResult.Neg()
+		if loss.LessThan(rule.Value) {
+			return nil, nil
+		}
+		output.Actual = loss
+		output.Message = fmt.Sprintf("daily loss of %s reached the %s limit", loss, rule.Value)
+	case RuleTypeMaxOpenExposure:
+		if e.betLiability == nil {
+			liability, err := e.repo.OpenBetLiability(ctx, e.bankroll.ID)
+			if err != nil {
+				return nil, err
+			}
+			e.betLiability = &liability
+		}
+		exposure := e.bankroll.InPlay.Add(*e.betLiability).Add(e.check.Exposure)
+		if exposure.LessThanOrEqual(rule.Value) {
+			return nil, nil
+		}
+		output.Actual = exposure
+		output.Message = fmt.Sprintf("open exposure of %s exceeds the %s limit", exposure, rule.Value)
+	default:
+		return nil, nil
+	}
+	return output, nil
+}
+
+func toRuleOutput(rule *BankrollRule) *RuleOutput {
+	return &RuleOutput{
+		ID:         rule.ID,
+		BankrollID: rule.BankrollID,
+		Type:       rule.Type,
+		Value:      rule.Value,
+		Severity:   rule.Severity,
+		GameType:   rule.GameType,
+		Stakes:     rule.Stakes,
+		CreatedAt:  rule.CreatedAt,
+		UpdatedAt:  rule.UpdatedAt,
+	}
+}
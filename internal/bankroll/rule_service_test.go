@@ -0,0 +1,386 @@
+package bankroll
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
+	"github.com/opinedajr/micro-stakes-api/internal/shared/pagination"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"log/slog"
+)
+
+type MockRuleRepository struct {
+	mock.Mock
+}
+
+func (m *MockRuleRepository) Create(ctx context.Context, rule *BankrollRule) error {
+	args := m.Called(ctx, rule)
+	return args.Error(0)
+}
+
+func (m *MockRuleRepository) FindByID(ctx context.Context, id uint, bankrollID uint, userID uint) (*BankrollRule, error) {
+	args := m.Called(ctx, id, bankrollID, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*BankrollRule), args.Error(1)
+}
+
+func (m *MockRuleRepository) ListByBankrollID(ctx context.Context, bankrollID uint, userID uint) ([]*BankrollRule, error) {
+	args := m.Called(ctx, bankrollID, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*BankrollRule), args.Error(1)
+}
+
+func (m *MockRuleRepository) List(ctx context.Context, bankrollID uint, userID uint, filter RuleListFilter) ([]*BankrollRule, int64, error) {
+	args := m.Called(ctx, bankrollID, userID, filter)
+	if args.Get(0) == nil {
+		return nil, 0, args.Error(2)
+	}
+	return args.Get(0).([]*BankrollRule), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockRuleRepository) Update(ctx context.Context, rule *BankrollRule) error {
+	args := m.Called(ctx, rule)
+	return args.Error(0)
+}
+
+func (m *MockRuleRepository) Delete(ctx context.Context, id uint, bankrollID uint, userID uint) error {
+	args := m.Called(ctx, id, bankrollID, userID)
+	return args.Error(0)
+}
+
+func (m *MockRuleRepository) RealizedResult(ctx context.Context, bankrollID uint, since time.Time) (domain.Decimal, error) {
+	args := m.Called(ctx, bankrollID, since)
+	return args.Get(0).(domain.Decimal), args.Error(1)
+}
+
+func (m *MockRuleRepository) OpenBetLiability(ctx context.Context, bankrollID uint) (domain.Decimal, error) {
+	args := m.Called(ctx, bankrollID)
+	return args.Get(0).(domain.Decimal), args.Error(1)
+}
+
+func ruleTestBankroll() *Bankroll {
+	return &Bankroll{
+		ID:             1,
+		UserID:         1,
+		Currency:       CurrencyBRL,
+		CurrentBalance: domain.MustParseDecimal("900.00"),
+		InPlay:         domain.MustParseDecimal("100.00"),
+	}
+}
+
+func testRule(id uint, ruleType RuleType, value string, severity RuleSeverity) *BankrollRule {
+	return &BankrollRule{
+		ID:         id,
+		BankrollID: 1,
+		UserID:     1,
+		Type:       ruleType,
+		Value:      domain.MustParseDecimal(value),
+		Severity:   severity,
+	}
+}
+
+func TestCreateRule(t *testing.T) {
+	t.Run("success - defaults to hard", func(t *testing.T) {
+		mockRepo := new(MockRuleRepository)
+		mockBankrollRepo := new(MockBankrollRepository)
+		service := NewRuleService(mockRepo, mockBankrollRepo, noLimits(), slog.Default())
+		ctx := context.Background()
+
+		mockBankrollRepo.On("FindByID", ctx, uint(1), uint(1)).Return(ruleTestBankroll(), nil).Once()
+		mockRepo.On("Create", ctx, mock.MatchedBy(func(rule *BankrollRule) bool {
+			return rule.Type == RuleTypeMaxBuyInPercent && rule.Severity == RuleSeverityHard && rule.UserID == 1
+		})).Run(func(args mock.Arguments) {
+			args.Get(1).(*BankrollRule).ID = 4
+		}).Return(nil).Once()
+
+		output, err := service.CreateRule(ctx, 1, 1, RuleInput{Type: RuleTypeMaxBuyInPercent, Value: domain.MustParseDecimal("5")})
+
+		require.NoError(t, err)
+		assert.Equal(t, uint(4), output.ID)
+		assert.Equal(t, RuleSeverityHard, output.Severity)
+		mockRepo.AssertExpectations(t)
+	})
+
+	invalid := []struct {
+		name  string
+		input RuleInput
+		err   error
+	}{
+		{"unknown type", RuleInput{Type: "max_tables", Value: domain.MustParseDecimal("4")}, ErrInvalidRuleType},
+		{"unknown severity", RuleInput{Type: RuleTypeMinBuyIns, Value: domain.MustParseDecimal("40"), Severity: "strict"}, ErrInvalidRuleSeverity},
+		{"non-positive value", RuleInput{Type: RuleTypeMinBuyIns, Value: domain.Zero}, ErrInvalidRuleValue},
+		{"percentage above 100", RuleInput{Type: RuleTypeMaxBuyInPercent, Value: domain.MustParseDecimal("120")}, ErrInvalidRuleValue},
+		{"amount beyond currency precision", RuleInput{Type: RuleTypeMaxDailyLoss, Value: domain.MustParseDecimal("50.001")}, ErrInvalidPrecision},
+	}
+	for _, tc := range invalid {
+		t.Run("validation error - "+tc.name, func(t *testing.T) {
+			mockRepo := new(MockRuleRepository)
+			mockBankrollRepo := new(MockBankrollRepository)
+			service := NewRuleService(mockRepo, mockBankrollRepo, noLimits(), slog.Default())
+
+			mockBankrollRepo.On("FindByID", mock.Anything, uint(1), uint(1)).Return(ruleTestBankroll(), nil).Once()
+
+			_, err := service.CreateRule(context.Background(), 1, 1, tc.input)
+
+			assert.ErrorIs(t, err, tc.err)
+			mockRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestListRules(t *testing.T) {
+	t.Run("success - pages by id", func(t *testing.T) {
+		mockRepo := new(MockRuleRepository)
+		mockBankrollRepo := new(MockBankrollRepository)
+		service := NewRuleService(mockRepo, mockBankrollRepo, noLimits(), slog.Default())
+		ctx := context.Background()
+
+		mockBankrollRepo.On("FindByID", ctx, uint(1), uint(1)).Return(ruleTestBankroll(), nil).Once()
+		mockRepo.On("List", ctx, uint(1), uint(1), RuleListFilter{AfterID: 2, Limit: 2}).Return([]*BankrollRule{
+			testRule(3, RuleTypeMaxBuyInPercent, "5", RuleSeverityHard),
+			testRule(4, RuleTypeMinBuyIns, "40", RuleSeveritySoft),
+		}, int64(4), nil).Once()
+
+		cursor := pagination.EncodeCursor("id", false, "", 2)
+		page, err := service.ListRules(ctx, 1, 1, ListRulesInput{Params: pagination.Params{Limit: 1, Cursor: cursor}})
+
+		assert.NoError(t, err)
+		require.Len(t, page.Items, 1)
+		assert.Equal(t, uint(3), page.Items[0].ID)
+		assert.Equal(t, int64(4), page.Total)
+		assert.Equal(t, pagination.EncodeCursor("id", false, "", 3), page.NextCursor)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("validation error - limit above maximum", func(t *testing.T) {
+		mockRepo := new(MockRuleRepository)
+		service := NewRuleService(mockRepo, new(MockBankrollRepository), noLimits(), slog.Default())
+
+		_, err := service.ListRules(context.Background(), 1, 1, ListRulesInput{Params: pagination.Params{Limit: 101}})
+
+		assert.ErrorIs(t, err, ErrValidationFailed)
+		mockRepo.AssertNotCalled(t, "List", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestUpdateRule(t *testing.T) {
+	t.Run("rule not found", func(t *testing.T) {
+		mockRepo := new(MockRuleRepository)
+		mockBankrollRepo := new(MockBankrollRepository)
+		service := NewRuleService(mockRepo, mockBankrollRepo, noLimits(), slog.Default())
+
+		mockBankrollRepo.On("FindByID", mock.Anything, uint(1), uint(1)).Return(ruleTestBankroll(), nil).Once()
+		mockRepo.On("FindByID", mock.Anything, uint(9), uint(1), uint(1)).Return(nil, ErrRuleNotFound).Once()
+
+		_, err := service.UpdateRule(context.Background(), 1, 1, 9, RuleInput{Type: RuleTypeMinBuyIns, Value: domain.MustParseDecimal("40")})
+
+		assert.ErrorIs(t, err, ErrRuleNotFound)
+	})
+}
+
+func TestEvaluateRules(t *testing.T) {
+	now := time.Date(2026, 3, 1, 20, 30, 0, 0, time.UTC)
+	sessionCheck := func(buyIn string, exposure string) RuleCheck {
+		return RuleCheck{
+			Activity: RuleActivitySession,
+			GameType: "nlhe",
+			Stakes:   "NL50",
+			Amount:   domain.MustParseDecimal(buyIn),
+			Exposure: domain.MustParseDecimal(exposure),
+			At:       now,
+		}
+	}
+
+	t.Run("hard max buy-in percent violated", func(t *testing.T) {
+		mockRepo := new(MockRuleRepository)
+		service := NewRuleService(mockRepo, new(MockBankrollRepository), noLimits(), slog.Default())
+
+		mockRepo.On("ListByBankrollID", mock.Anything, uint(1), uint(1)).
+			Return([]*BankrollRule{testRule(2, RuleTypeMaxBuyInPercent, "5", RuleSeverityHard)}, nil).Once()
+
+		_, err := service.Evaluate(context.Background(), ruleTestBankroll(), sessionCheck("60.00", "60.00"))
+
+		var violation *RuleViolation
+		require.ErrorAs(t, err, &violation)
+		assert.ErrorIs(t, err, ErrRuleViolation)
+		assert.Equal(t, uint(2), violation.Breach.RuleID)
+		assert.Equal(t, "50.00", violation.Breach.Limit.String())
+		assert.Equal(t, "60.00", violation.Breach.Actual.String())
+	})
+
+	t.Run("soft rule returns warning", func(t *testing.T) {
+		mockRepo := new(MockRuleRepository)
+		service := NewRuleService(mockRepo, new(MockBankrollRepository), noLimits(), slog.Default())
+
+		mockRepo.On("ListByBankrollID", mock.Anything, uint(1), uint(1)).Return([]*BankrollRule{
+			testRule(2, RuleTypeMaxBuyInPercent, "10", RuleSeverityHard),
+			testRule(3, RuleTypeMinBuyIns, "40", RuleSeveritySoft),
+		}, nil).Once()
+
+		warnings, err := service.Evaluate(context.Background(), ruleTestBankroll(), sessionCheck("50.00", "50.00"))
+
+		require.NoError(t, err)
+		require.Len(t, warnings, 1)
+		assert.Equal(t, uint(3), warnings[0].RuleID)
+		assert.Equal(t, RuleSeveritySoft, warnings[0].Severity)
+		assert.Equal(t, "20.00", warnings[0].Actual.String())
+	})
+
+	t.Run("scoped rule only applies to its stake level", func(t *testing.T) {
+		mockRepo := new(MockRuleRepository)
+		service := NewRuleService(mockRepo, new(MockBankrollRepository), noLimits(), slog.Default())
+
+		scoped := testRule(3, RuleTypeMinBuyIns, "40", RuleSeverityHard)
+		scoped.GameType = "nlhe"
+		scoped.Stakes = "NL100"
+		mockRepo.On("ListByBankrollID", mock.Anything, uint(1), uint(1)).Return([]*BankrollRule{scoped}, nil).Once()
+
+		warnings, err := service.Evaluate(context.Background(), ruleTestBankroll(), sessionCheck("50.00", "50.00"))
+
+		require.NoError(t, err)
+		assert.Empty(t, warnings)
+	})
+
+	t.Run("max daily loss reached", func(t *testing.T) {
+		mockRepo := new(MockRuleRepository)
+		service := NewRuleService(mockRepo, new(MockBankrollRepository), noLimits(), slog.Default())
+
+		mockRepo.On("ListByBankrollID", mock.Anything, uint(1), uint(1)).
+			Return([]*BankrollRule{testRule(5, RuleTypeMaxDailyLoss, "100.00", RuleSeverityHard)}, nil).Once()
+		mockRepo.On("RealizedResult", mock.Anything, uint(1), time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)).
+			Return(domain.MustParseDecimal("-100.00"), nil).Once()
+
+		_, err := service.Evaluate(context.Background(), ruleTestBankroll(), sessionCheck("10.00", "10.00"))
+
+		var violation *RuleViolation
+		require.ErrorAs(t, err, &violation)
+		assert.Equal(t, "100.00", violation.Breach.Actual.String())
+	})
+
+	t.Run("max daily loss counts the day in the bankroll time zone", func(t *testing.T) {
+		mockRepo := new(MockRuleRepository)
+		mockLimits := new(MockLimitChecker)
+		service := NewRuleService(mockRepo, new(MockBankrollRepository), mockLimits, slog.Default())
+
+		mockLimits.On("LockStatus", mock.Anything, mock.Anything, mock.Anything).Return(&LimitLockOutput{}, nil)
+		mockRepo.On("ListByBankrollID", mock.Anything, uint(1), uint(1)).
+			Return([]*BankrollRule{testRule(5, RuleTypeMaxDailyLoss, "100.00", RuleSeverityHard)}, nil).Once()
+		mockRepo.On("RealizedResult", mock.Anything, uint(1), mock.MatchedBy(func(since time.Time) bool {
+			return since.Equal(time.Date(2026, 3, 1, 3, 0, 0, 0, time.UTC))
+		})).Return(domain.MustParseDecimal("-20.00"), nil).Once()
+
+		bankroll := ruleTestBankroll()
+		bankroll.Timezone = "America/Sao_Paulo"
+		_, err := service.Evaluate(context.Background(), bankroll, sessionCheck("10.00", "10.00"))
+		require.NoError(t, err)
+
+		check := sessionCheck("10.00", "0")
+		endedAt := time.Date(2026, 3, 1, 2, 0, 0, 0, time.UTC)
+		check.EndedAt = &endedAt
+		_, err = service.Evaluate(context.Background(), bankroll, check)
+		require.NoError(t, err)
+		mockRepo.AssertNumberOfCalls(t, "ListByBankrollID", 1)
+	})
+
+	t.Run("max open exposure counts in play and open bets", func(t *testing.T) {
+		mockRepo := new(MockRuleRepository)
+		service := NewRuleService(mockRepo, new(MockBankrollRepository), noLimits(), slog.Default())
+
+		mockRepo.On("ListByBankrollID", mock.Anything, uint(1), uint(1)).
+			Return([]*BankrollRule{testRule(6, RuleTypeMaxOpenExposure, "200.00", RuleSeverityHard)}, nil).Twice()
+		mockRepo.On("OpenBetLiability", mock.Anything, uint(1)).Return(domain.MustParseDecimal("60.00"), nil).Twice()
+
+		_, err := service.Evaluate(context.Background(), ruleTestBankroll(), sessionCheck("40.00", "40.00"))
+		require.NoError(t, err)
+
+		_, err = service.Evaluate(context.Background(), ruleTestBankroll(), sessionCheck("40.01", "40.01"))
+		var violation *RuleViolation
+		require.ErrorAs(t, err, &violation)
+		assert.Equal(t, "200.01", violation.Breach.Actual.String())
+	})
+
+	t.Run("bets skip buy-in count and scoped rules", func(t *testing.T) {
+		mockRepo := new(MockRuleRepository)
+		service := NewRuleService(mockRepo, new(MockBankrollRepository), noLimits(), slog.Default())
+
+		scoped := testRule(4, RuleTypeMaxBuyInPercent, "1", RuleSeverityHard)
+		scoped.GameType = "mtt"
+		mockRepo.On("ListByBankrollID", mock.Anything, uint(1), uint(1)).Return([]*BankrollRule{
+			testRule(3, RuleTypeMinBuyIns, "100", RuleSeverityHard),
+			scoped,
+		}, nil).Once()
+
+		check := RuleCheck{Activity: RuleActivityBet, Amount: domain.MustParseDecimal("90.00"), Exposure: domain.MustParseDecimal("90.00"), At: now}
+		warnings, err := service.Evaluate(context.Background(), ruleTestBankroll(), check)
+
+		require.NoError(t, err)
+		assert.Empty(t, warnings)
+	})
+
+	t.Run("stop limit locks the bankroll", func(t *testing.T) {
+		mockRepo := new(MockRuleRepository)
+		mockLimits := new(MockLimitChecker)
+		service := NewRuleService(mockRepo, new(MockBankrollRepository), mockLimits, slog.Default())
+
+		until := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+		mockLimits.On("LockStatus", mock.Anything, mock.Anything, now).
+			Return(&LimitLockOutput{Locked: true, Reason: LimitReasonDailyStopWin, LockedUntil: &until}, nil).Once()
+
+		_, err := service.Evaluate(context.Background(), ruleTestBankroll(), sessionCheck("10.00", "10.00"))
+
+		var limitErr *LimitReachedError
+		require.ErrorAs(t, err, &limitErr)
+		assert.ErrorIs(t, err, ErrLimitReached)
+		assert.Equal(t, LimitReasonDailyStopWin, limitErr.Lock.Reason)
+		mockRepo.AssertNotCalled(t, "ListByBankrollID", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("sessions that ended before today are not evaluated", func(t *testing.T) {
+		mockRepo := new(MockRuleRepository)
+		mockLimits := new(MockLimitChecker)
+		service := NewRuleService(mockRepo, new(MockBankrollRepository), mockLimits, slog.Default())
+
+		check := sessionCheck("500.00", "0")
+		endedAt := time.Date(2026, 2, 28, 23, 59, 0, 0, time.UTC)
+		check.EndedAt = &endedAt
+
+		warnings, err := service.Evaluate(context.Background(), ruleTestBankroll(), check)
+
+		require.NoError(t, err)
+		assert.Empty(t, warnings)
+		mockLimits.AssertNotCalled(t, "LockStatus", mock.Anything, mock.Anything, mock.Anything)
+		mockRepo.AssertNotCalled(t, "ListByBankrollID", mock.Anything, mock.Anything, mock.Anything)
+
+		endedToday := time.Date(2026, 3, 1, 0, 30, 0, 0, time.UTC)
+		check.EndedAt = &endedToday
+		mockLimits.On("LockStatus", mock.Anything, mock.Anything, now).Return(&LimitLockOutput{}, nil).Once()
+		mockRepo.On("ListByBankrollID", mock.Anything, uint(1), uint(1)).
+			Return([]*BankrollRule{testRule(2, RuleTypeMaxBuyInPercent, "5", RuleSeverityHard)}, nil).Once()
+
+		_, err = service.Evaluate(context.Background(), ruleTestBankroll(), check)
+
+		assert.ErrorIs(t, err, ErrRuleViolation)
+	})
+
+	t.Run("database error", func(t *testing.T) {
+		mockRepo := new(MockRuleRepository)
+		service := NewRuleService(mockRepo, new(MockBankrollRepository), noLimits(), slog.Default())
+
+		mockRepo.On("ListByBankrollID", mock.Anything, uint(1), uint(1)).
+			Return(nil, WrapError(ErrDatabaseError, "connection refused")).Once()
+
+		_, err := service.Evaluate(context.Background(), ruleTestBankroll(), sessionCheck("10.00", "10.00"))
+
+		assert.ErrorIs(t, err, ErrDatabaseError)
+		assert.False(t, errors.Is(err, ErrRuleViolation))
+	})
+}
@@ -2,9 +2,12 @@ package bankroll
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	"github.com/go-playground/validator/v10"
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
+	"github.com/opinedajr/micro-stakes-api/internal/shared/pagination"
 	customValidator "github.com/opinedajr/micro-stakes-api/internal/shared/validator"
 	"log/slog"
 )
@@ -12,24 +15,37 @@ import (
 type BankrollService interface {
 	CreateBankroll(ctx context.Context, userID uint, input CreateBankrollInput) (*BankrollOutput, error)
 	UpdateBankroll(ctx context.Context, userID uint, bankrollID uint, input UpdateBankrollInput) (*BankrollOutput, error)
-	ListBankrolls(ctx context.Context, userID uint) ([]*BankrollOutput, error)
+	PatchBankroll(ctx context.Context, userID uint, bankrollID uint, input PatchBankrollInput) (*BankrollOutput, error)
+	ListBankrolls(ctx context.Context, userID uint, input ListBankrollsInput) (*BankrollPageOutput, error)
 	GetBankroll(ctx context.Context, userID uint, bankrollID uint) (*BankrollOutput, error)
-	ResetBankroll(ctx context.Context, userID uint, bankrollID uint) (*BankrollOutput, error)
+	ResetBankroll(ctx context.Context, userID uint, bankrollID uint, input ResetBankrollInput) (*BankrollOutput, error)
+	DeleteBankroll(ctx context.Context, userID uint, bankrollID uint) error
+	ArchiveBankroll(ctx context.Context, userID uint, bankrollID uint) (*BankrollOutput, error)
+	RestoreBankroll(ctx context.Context, userID uint, bankrollID uint) (*BankrollOutput, error)
 }
 
 type bankrollService struct {
 	repo      BankrollRepository
+	limits    LimitChecker
 	logger    *slog.Logger
 	validator *validator.Validate
+	// bindingRules checks inputs against the binding tags the handlers
+	// validate request bodies with.
+	bindingRules *validator.Validate
 }
 
-func NewBankrollService(repo BankrollRepository, logger *slog.Logger) BankrollService {
+func NewBankrollService(repo BankrollRepository, limits LimitChecker, logger *slog.Logger) BankrollService {
 	v := validator.New()
 	_ = customValidator.RegisterCustomValidators(v)
+	rules := validator.New()
+	rules.SetTagName("binding")
+	_ = customValidator.RegisterCustomValidators(rules)
 	return &bankrollService{
-		repo:      repo,
-		logger:    logger,
-		validator: v,
+		repo:         repo,
+		limits:       limits,
+		logger:       logger,
+		validator:    v,
+		bindingRules: rules,
 	}
 }
 
@@ -39,34 +55,41 @@ func (s *bankrollService) CreateBankroll(ctx context.Context, userID uint, input
 		return nil, WrapError(ErrValidationFailed, err.Error())
 	}
 
-	if input.InitialBalance < 0 {
+	if input.InitialBalance.IsNegative() {
 		s.logger.Error("negative balance", "initial_balance", input.InitialBalance, "user_id", userID)
 		return nil, ErrNegativeBalance
 	}
 
-	if input.CommissionPercentage < 0 || input.CommissionPercentage > 100 {
+	if !validCommission(input.CommissionPercentage) {
 		s.logger.Error("invalid commission", "commission_percentage", input.CommissionPercentage, "user_id", userID)
 		return nil, ErrInvalidCommission
 	}
 
-	validCurrencies := map[Currency]bool{
-		CurrencyBRL: true,
-		CurrencyUSD: true,
-		CurrencyEUR: true,
-		CurrencyBTC: true,
-	}
-
-	if !validCurrencies[input.Currency] {
+	if !input.Currency.IsValid() {
 		s.logger.Error("invalid currency", "currency", input.Currency, "user_id", userID)
 		return nil, ErrInvalidCurrency
 	}
 
+	if !input.Currency.Fits(input.InitialBalance) {
+		s.logger.Error("initial balance exceeds currency precision", "initial_balance", input.InitialBalance, "currency", input.Currency, "user_id", userID)
+		return nil, ErrInvalidPrecision
+	}
+
 	startDate, err := parseDate(input.StartDate)
 	if err != nil {
 		s.logger.Error("failed to parse start date", "error", err, "user_id", userID, "start_date", input.StartDate)
 		return nil, WrapError(ErrValidationFailed, "invalid date format")
 	}
 
+	timezone := input.Timezone
+	if timezone == "" {
+		timezone = "UTC"
+	}
+	if !validTimezone(timezone) {
+		s.logger.Error("invalid timezone", "timezone", timezone, "user_id", userID)
+		return nil, ErrInvalidTimezone
+	}
+
 	bankroll := &Bankroll{
 		UserID:               userID,
 		Name:                 input.Name,
@@ -75,6 +98,7 @@ func (s *bankrollService) CreateBankroll(ctx context.Context, userID uint, input
 		CurrentBalance:       input.InitialBalance,
 		StartDate:            startDate,
 		CommissionPercentage: input.CommissionPercentage,
+		Timezone:             timezone,
 	}
 
 	if err := s.repo.Create(ctx, bankroll); err != nil {
@@ -93,19 +117,12 @@ func (s *bankrollService) UpdateBankroll(ctx context.Context, userID uint, bankr
 		return nil, WrapError(ErrValidationFailed, err.Error())
 	}
 
-	if input.CommissionPercentage < 0 || input.CommissionPercentage > 100 {
+	if !validCommission(input.CommissionPercentage) {
 		s.logger.Error("invalid commission", "commission_percentage", input.CommissionPercentage, "user_id", userID, "bankroll_id", bankrollID)
 		return nil, ErrInvalidCommission
 	}
 
-	validCurrencies := map[Currency]bool{
-		CurrencyBRL: true,
-		CurrencyUSD: true,
-		CurrencyEUR: true,
-		CurrencyBTC: true,
-	}
-
-	if !validCurrencies[input.Currency] {
+	if !input.Currency.IsValid() {
 		s.logger.Error("invalid currency", "currency", input.Currency, "user_id", userID, "bankroll_id", bankrollID)
 		return nil, ErrInvalidCurrency
 	}
@@ -122,6 +139,21 @@ func (s *bankrollService) UpdateBankroll(ctx context.Context, userID uint, bankr
 		return nil, err
 	}
 
+	if input.Currency != existingBankroll.Currency && !input.Currency.Fits(existingBankroll.CurrentBalance) {
+		s.logger.Error("balance exceeds new currency precision", "current_balance", existingBankroll.CurrentBalance, "currency", input.Currency, "user_id", userID, "bankroll_id", bankrollID)
+		return nil, ErrInvalidPrecision
+	}
+
+	// A body without a time zone keeps the current one.
+	timezone := input.Timezone
+	if timezone == "" {
+		timezone = existingBankroll.Timezone
+	}
+	if !validTimezone(timezone) {
+		s.logger.Error("invalid timezone", "timezone", timezone, "user_id", userID, "bankroll_id", bankrollID)
+		return nil, ErrInvalidTimezone
+	}
+
 	bankroll := &Bankroll{
 		ID:                   bankrollID,
 		UserID:               userID,
@@ -129,8 +161,10 @@ func (s *bankrollService) UpdateBankroll(ctx context.Context, userID uint, bankr
 		Currency:             input.Currency,
 		StartDate:            startDate,
 		CommissionPercentage: input.CommissionPercentage,
+		Timezone:             timezone,
 		InitialBalance:       existingBankroll.InitialBalance,
 		CurrentBalance:       existingBankroll.CurrentBalance,
+		Version:              input.ExpectedVersion,
 	}
 
 	if err := s.repo.Update(ctx, bankroll); err != nil {
@@ -146,24 +180,152 @@ func (s *bankrollService) UpdateBankroll(ctx context.Context, userID uint, bankr
 
 	s.logger.Info("bankroll updated", "user_id", userID, "bankroll_id", bankrollID, "name", input.Name, "currency", input.Currency, "commission_percentage", input.CommissionPercentage)
 
-	return toBankrollOutput(updated), nil
+	return s.withLock(ctx, updated), nil
 }
 
-func (s *bankrollService) ListBankrolls(ctx context.Context, userID uint) ([]*BankrollOutput, error) {
-	bankrolls, err := s.repo.ListByUserID(ctx, userID)
+// PatchBankroll merges the patch into the stored bankroll and applies it
+// through UpdateBankroll so PUT and PATCH share the same rules. The patched
+// fields are checked against the binding rules PUT validates its body with. Without an
+// If-Match header the version read here guards the write, so a concurrent
+// change is reported instead of being overwritten by stale values.
+func (s *bankrollService) PatchBankroll(ctx context.Context, userID uint, bankrollID uint, input PatchBankrollInput) (*BankrollOutput, error) {
+	existingBankroll, err := s.repo.FindByID(ctx, bankrollID, userID)
+	if err != nil {
+		s.logger.Error("bankroll not found", "error", err, "user_id", userID, "bankroll_id", bankrollID)
+		return nil, err
+	}
+
+	if input.ExpectedVersion != 0 && input.ExpectedVersion != existingBankroll.Version {
+		s.logger.Error("bankroll version mismatch", "user_id", userID, "bankroll_id", bankrollID, "expected_version", input.ExpectedVersion, "version", existingBankroll.Version)
+		return nil, ErrVersionConflict
+	}
+
+	if input.IsEmpty() {
+		return s.withLock(ctx, existingBankroll), nil
+	}
+
+	merged := UpdateBankrollInput{
+		Name:                 existingBankroll.Name,
+		Currency:             existingBankroll.Currency,
+		StartDate:            existingBankroll.StartDate.Format("2006-01-02"),
+		CommissionPercentage: existingBankroll.CommissionPercentage,
+		Timezone:             existingBankroll.Timezone,
+		ExpectedVersion:      existingBankroll.Version,
+	}
+	var patched []string
+	if input.Name != nil {
+		merged.Name = *input.Name
+		patched = append(patched, "Name")
+	}
+	if input.Currency != nil {
+		merged.Currency = *input.Currency
+		patched = append(patched, "Currency")
+	}
+	if input.StartDate != nil {
+		merged.StartDate = *input.StartDate
+		patched = append(patched, "StartDate")
+	}
+	if input.CommissionPercentage != nil {
+		merged.CommissionPercentage = *input.CommissionPercentage
+		patched = append(patched, "CommissionPercentage")
+	}
+	if input.Timezone != nil {
+		merged.Timezone = *input.Timezone
+		patched = append(patched, "Timezone")
+	}
+	if err := s.bindingRules.StructPartial(merged, patched...); err != nil {
+		s.logger.Error("validation failed", "error", err, "user_id", userID, "bankroll_id", bankrollID)
+		return nil, WrapError(ErrValidationFailed, err.Error())
+	}
+
+	return s.UpdateBankroll(ctx, userID, bankrollID, merged)
+}
+
+func (s *bankrollService) ListBankrolls(ctx context.Context, userID uint, input ListBankrollsInput) (*BankrollPageOutput, error) {
+	filter, params, err := listFilter(input)
+	if err != nil {
+		s.logger.Error("invalid list parameters", "error", err, "user_id", userID)
+		return nil, err
+	}
+
+	bankrolls, total, err := s.repo.List(ctx, userID, filter)
 	if err != nil {
 		s.logger.Error("failed to list bankrolls", "error", err, "user_id", userID)
 		return nil, err
 	}
 
+	bankrolls, more := pagination.Trim(bankrolls, params.Limit)
+	nextCursor := ""
+	if more {
+		last := bankrolls[len(bankrolls)-1]
+		nextCursor = pagination.EncodeCursor(string(filter.Sort), filter.Descending, filter.Sort.CursorValue(last), last.ID)
+	}
+
+	locks, err := s.limits.LockStatuses(ctx, bankrolls, time.Now())
+	if err != nil {
+		s.logger.Error("failed to compute limit locks", "error", err, "user_id", userID)
+	}
+
 	outputs := make([]*BankrollOutput, len(bankrolls))
 	for i, b := range bankrolls {
 		outputs[i] = toBankrollOutput(b)
+		outputs[i].Lock = locks[b.ID]
+	}
+
+	s.logger.Info("bankrolls listed", "user_id", userID, "count", len(outputs), "total", total)
+
+	return pagination.NewPage(outputs, total, params, nextCursor), nil
+}
+
+// listFilter validates the list query. One extra row is requested so the
+// service can tell whether another page follows.
+func listFilter(input ListBankrollsInput) (BankrollListFilter, pagination.Params, error) {
+	params, err := input.Params.Normalize()
+	if err != nil {
+		return BankrollListFilter{}, params, WrapError(ErrValidationFailed, err.Error())
+	}
+
+	if input.Currency != "" && !input.Currency.IsValid() {
+		return BankrollListFilter{}, params, ErrInvalidCurrency
 	}
 
-	s.logger.Info("bankrolls listed", "user_id", userID, "count", len(outputs))
+	sort := input.Sort
+	if sort == "" {
+		sort = BankrollSortCreatedAt
+	}
+	if !sort.IsValid() {
+		return BankrollListFilter{}, params, WrapError(ErrValidationFailed, "invalid sort field")
+	}
+
+	filter := BankrollListFilter{
+		Currency:        input.Currency,
+		Name:            strings.TrimSpace(input.Name),
+		IncludeArchived: input.IncludeArchived,
+		Sort:            sort,
+		Descending:      input.Order == "desc",
+		Limit:           params.Limit + 1,
+		Offset:          params.Offset,
+	}
+
+	if input.CreatedAfter != "" {
+		createdAfter, err := parseTimestamp(input.CreatedAfter)
+		if err != nil {
+			return BankrollListFilter{}, params, WrapError(ErrValidationFailed, "invalid created_after format")
+		}
+		filter.CreatedAfter = &createdAfter
+	}
+
+	if params.Cursor != "" {
+		cursor, err := pagination.DecodeCursor(params.Cursor, string(sort), filter.Descending)
+		if err == nil {
+			filter.After, err = sort.ParseCursor(cursor)
+		}
+		if err != nil {
+			return BankrollListFilter{}, params, WrapError(ErrValidationFailed, err.Error())
+		}
+	}
 
-	return outputs, nil
+	return filter, params, nil
 }
 
 func (s *bankrollService) GetBankroll(ctx context.Context, userID uint, bankrollID uint) (*BankrollOutput, error) {
@@ -175,17 +337,28 @@ func (s *bankrollService) GetBankroll(ctx context.Context, userID uint, bankroll
 
 	s.logger.Info("bankroll retrieved", "user_id", userID, "bankroll_id", bankrollID, "name", bankroll.Name)
 
-	return toBankrollOutput(bankroll), nil
+	return s.withLock(ctx, bankroll), nil
 }
 
-func (s *bankrollService) ResetBankroll(ctx context.Context, userID uint, bankrollID uint) (*BankrollOutput, error) {
+func (s *bankrollService) ResetBankroll(ctx context.Context, userID uint, bankrollID uint, input ResetBankrollInput) (*BankrollOutput, error) {
 	existingBankroll, err := s.repo.FindByID(ctx, bankrollID, userID)
 	if err != nil {
 		s.logger.Error("bankroll not found", "error", err, "user_id", userID, "bankroll_id", bankrollID)
 		return nil, err
 	}
 
-	if err := s.repo.Reset(ctx, bankrollID, userID); err != nil {
+	if input.Amount.IsNegative() {
+		s.logger.Error("negative balance", "amount", input.Amount, "user_id", userID)
+		return nil, ErrNegativeBalance
+	}
+
+	if !existingBankroll.Currency.Fits(input.Amount) {
+		s.logger.Error("amount exceeds currency precision", "amount", input.Amount, "currency", existingBankroll.Currency)
+		return nil, ErrInvalidPrecision
+	}
+
+	period, err := s.repo.Reset(ctx, bankrollID, userID, input.Amount, input.ExpectedVersion)
+	if err != nil {
 		s.logger.Error("failed to reset bankroll", "error", err, "user_id", userID, "bankroll_id", bankrollID)
 		return nil, err
 	}
@@ -196,9 +369,94 @@ func (s *bankrollService) ResetBankroll(ctx context.Context, userID uint, bankro
 		return nil, err
 	}
 
-	s.logger.Info("bankroll reset", "user_id", userID, "bankroll_id", bankrollID, "name", existingBankroll.Name)
+	s.logger.Info("bankroll reset", "user_id", userID, "bankroll_id", bankrollID, "name", existingBankroll.Name, "period_id", period.ID)
 
-	return toBankrollOutput(resetBankroll), nil
+	return s.withLock(ctx, resetBankroll), nil
+}
+
+func (s *bankrollService) DeleteBankroll(ctx context.Context, userID uint, bankrollID uint) error {
+	bankroll, err := s.repo.FindByID(ctx, bankrollID, userID)
+	if err != nil {
+		s.logger.Error("bankroll not found", "error", err, "user_id", userID, "bankroll_id", bankrollID)
+		return err
+	}
+
+	if bankroll.InPlay.IsPositive() {
+		s.logger.Error("bankroll has money in play", "user_id", userID, "bankroll_id", bankrollID, "in_play", bankroll.InPlay)
+		return ErrBankrollInPlay
+	}
+
+	if err := s.repo.Delete(ctx, bankrollID, userID); err != nil {
+		s.logger.Error("failed to delete bankroll", "error", err, "user_id", userID, "bankroll_id", bankrollID)
+		return err
+	}
+
+	s.logger.Info("bankroll deleted", "user_id", userID, "bankroll_id", bankrollID, "name", bankroll.Name)
+
+	return nil
+}
+
+func (s *bankrollService) ArchiveBankroll(ctx context.Context, userID uint, bankrollID uint) (*BankrollOutput, error) {
+	bankroll, err := s.repo.FindByID(ctx, bankrollID, userID)
+	if err != nil {
+		s.logger.Error("bankroll not found", "error", err, "user_id", userID, "bankroll_id", bankrollID)
+		return nil, err
+	}
+
+	if bankroll.IsArchived() {
+		return nil, ErrBankrollArchived
+	}
+
+	if bankroll.InPlay.IsPositive() {
+		s.logger.Error("bankroll has money in play", "user_id", userID, "bankroll_id", bankrollID, "in_play", bankroll.InPlay)
+		return nil, ErrBankrollInPlay
+	}
+
+	if err := s.repo.Archive(ctx, bankrollID, userID); err != nil {
+		s.logger.Error("failed to archive bankroll", "error", err, "user_id", userID, "bankroll_id", bankrollID)
+		return nil, err
+	}
+
+	archivedBankroll, err := s.repo.FindByID(ctx, bankrollID, userID)
+	if err != nil {
+		s.logger.Error("failed to retrieve archived bankroll", "error", err, "user_id", userID, "bankroll_id", bankrollID)
+		return nil, err
+	}
+
+	s.logger.Info("bankroll archived", "user_id", userID, "bankroll_id", bankrollID, "name", bankroll.Name)
+
+	return s.withLock(ctx, archivedBankroll), nil
+}
+
+func (s *bankrollService) RestoreBankroll(ctx context.Context, userID uint, bankrollID uint) (*BankrollOutput, error) {
+	if err := s.repo.Restore(ctx, bankrollID, userID); err != nil {
+		s.logger.Error("failed to restore bankroll", "error", err, "user_id", userID, "bankroll_id", bankrollID)
+		return nil, err
+	}
+
+	restoredBankroll, err := s.repo.FindByID(ctx, bankrollID, userID)
+	if err != nil {
+		s.logger.Error("failed to retrieve restored bankroll", "error", err, "user_id", userID, "bankroll_id", bankrollID)
+		return nil, err
+	}
+
+	s.logger.Info("bankroll restored", "user_id", userID, "bankroll_id", bankrollID, "name", restoredBankroll.Name)
+
+	return s.withLock(ctx, restoredBankroll), nil
+}
+
+// withLock fills in the bankroll's stop-limit lock. The lock only informs the
+// client, so failing to compute it leaves the field out instead of failing
+// the request.
+func (s *bankrollService) withLock(ctx context.Context, bankroll *Bankroll) *BankrollOutput {
+	output := toBankrollOutput(bankroll)
+	lock, err := s.limits.LockStatus(ctx, bankroll, time.Now())
+	if err != nil {
+		s.logger.Error("failed to compute limit lock", "error", err, "bankroll_id", bankroll.ID)
+		return output
+	}
+	output.Lock = lock
+	return output
 }
 
 func toBankrollOutput(bankroll *Bankroll) *BankrollOutput {
@@ -206,15 +464,40 @@ func toBankrollOutput(bankroll *Bankroll) *BankrollOutput {
 		ID:                   bankroll.ID,
 		Name:                 bankroll.Name,
 		Currency:             bankroll.Currency,
-		InitialBalance:       bankroll.InitialBalance,
-		CurrentBalance:       bankroll.CurrentBalance,
+		InitialBalance:       bankroll.Currency.Round(bankroll.InitialBalance),
+		CurrentBalance:       bankroll.Currency.Round(bankroll.CurrentBalance),
+		InPlay:               bankroll.Currency.Round(bankroll.InPlay),
 		StartDate:            bankroll.StartDate.Format("2006-01-02"),
-		CommissionPercentage: bankroll.CommissionPercentage,
+		CommissionPercentage: bankroll.CommissionPercentage.Round(2),
+		Timezone:             bankroll.Location().String(),
+		Archived:             bankroll.IsArchived(),
+		ArchivedAt:           bankroll.ArchivedAt,
+		Version:              bankroll.Version,
 		CreatedAt:            bankroll.CreatedAt,
 		UpdatedAt:            bankroll.UpdatedAt,
 	}
 }
 
+var maxCommission = domain.NewDecimalFromInt(100)
+
+func validCommission(commission domain.Decimal) bool {
+	return !commission.IsNegative() && commission.LessThanOrEqual(maxCommission) && commission.FitsScale(2)
+}
+
+// validTimezone reports whether name is an IANA time zone this host can load.
+func validTimezone(name string) bool {
+	_, err := time.LoadLocation(name)
+	return err == nil
+}
+
 func parseDate(dateStr string) (time.Time, error) {
 	return time.Parse("2006-01-02", dateStr)
 }
+
+// parseTimestamp accepts either an RFC 3339 timestamp or a plain date.
+func parseTimestamp(value string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	return parseDate(value)
+}
@@ -2,11 +2,15 @@ package bankroll
 
 import (
 	"context"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
+	"github.com/opinedajr/micro-stakes-api/internal/shared/pagination"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 	"log/slog"
 )
 
@@ -32,6 +36,14 @@ func (m *MockBankrollRepository) ListByUserID(ctx context.Context, userID uint)
 	return args.Get(0).([]*Bankroll), args.Error(1)
 }
 
+func (m *MockBankrollRepository) List(ctx context.Context, userID uint, filter BankrollListFilter) ([]*Bankroll, int64, error) {
+	args := m.Called(ctx, userID, filter)
+	if args.Get(0) == nil {
+		return nil, 0, args.Error(2)
+	}
+	return args.Get(0).([]*Bankroll), args.Get(1).(int64), args.Error(2)
+}
+
 func (m *MockBankrollRepository) FindByID(ctx context.Context, id uint, userID uint) (*Bankroll, error) {
 	args := m.Called(ctx, id, userID)
 	if args.Get(0) == nil {
@@ -40,7 +52,25 @@ func (m *MockBankrollRepository) FindByID(ctx context.Context, id uint, userID u
 	return args.Get(0).(*Bankroll), args.Error(1)
 }
 
-func (m *MockBankrollRepository) Reset(ctx context.Context, id uint, userID uint) error {
+func (m *MockBankrollRepository) Reset(ctx context.Context, id uint, userID uint, amount domain.Decimal, expectedVersion uint) (*BankrollPeriod, error) {
+	args := m.Called(ctx, id, userID, amount, expectedVersion)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*BankrollPeriod), args.Error(1)
+}
+
+func (m *MockBankrollRepository) Delete(ctx context.Context, id uint, userID uint) error {
+	args := m.Called(ctx, id, userID)
+	return args.Error(0)
+}
+
+func (m *MockBankrollRepository) Archive(ctx context.Context, id uint, userID uint) error {
+	args := m.Called(ctx, id, userID)
+	return args.Error(0)
+}
+
+func (m *MockBankrollRepository) Restore(ctx context.Context, id uint, userID uint) error {
 	args := m.Called(ctx, id, userID)
 	return args.Error(0)
 }
@@ -49,16 +79,16 @@ func TestCreateBankroll(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		mockRepo := new(MockBankrollRepository)
 		logger := slog.Default()
-		service := NewBankrollService(mockRepo, logger)
+		service := NewBankrollService(mockRepo, noLimits(), logger)
 
 		ctx := context.Background()
 		userID := uint(1)
 		input := CreateBankrollInput{
 			Name:                 "Main Bankroll",
 			Currency:             CurrencyBRL,
-			InitialBalance:       1000.00,
+			InitialBalance:       domain.MustParseDecimal("1000.00"),
 			StartDate:            "2026-02-01",
-			CommissionPercentage: 5.0,
+			CommissionPercentage: domain.MustParseDecimal("5.0"),
 		}
 
 		mockRepo.On("Create", ctx, mock.AnythingOfType("*bankroll.Bankroll")).Return(nil).Once()
@@ -69,25 +99,55 @@ func TestCreateBankroll(t *testing.T) {
 		assert.NotNil(t, output)
 		assert.Equal(t, "Main Bankroll", output.Name)
 		assert.Equal(t, CurrencyBRL, output.Currency)
-		assert.Equal(t, 1000.00, output.InitialBalance)
-		assert.Equal(t, 1000.00, output.CurrentBalance)
-		assert.Equal(t, 5.0, output.CommissionPercentage)
+		assert.Equal(t, 1000.00, output.InitialBalance.Float64())
+		assert.Equal(t, 1000.00, output.CurrentBalance.Float64())
+		assert.Equal(t, 5.0, output.CommissionPercentage.Float64())
+		assert.Equal(t, "UTC", output.Timezone)
 		mockRepo.AssertExpectations(t)
 	})
 
+	t.Run("success - currency added to the registry", func(t *testing.T) {
+		domain.RegisterCurrencies(append(domain.DefaultCurrencies, domain.CurrencyInfo{Code: "USDT", Name: "Tether", Decimals: 6, IsCrypto: true}))
+		defer domain.RegisterCurrencies(domain.DefaultCurrencies)
+
+		mockRepo := new(MockBankrollRepository)
+		service := NewBankrollService(mockRepo, noLimits(), slog.Default())
+		ctx := context.Background()
+
+		mockRepo.On("Create", ctx, mock.AnythingOfType("*bankroll.Bankroll")).Return(nil).Once()
+
+		output, err := service.CreateBankroll(ctx, 1, CreateBankrollInput{
+			Name:           "Stablecoin",
+			Currency:       "USDT",
+			InitialBalance: domain.MustParseDecimal("250.123456"),
+			StartDate:      "2026-02-01",
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, Currency("USDT"), output.Currency)
+
+		_, err = service.CreateBankroll(ctx, 1, CreateBankrollInput{
+			Name:           "Stablecoin",
+			Currency:       "USDT",
+			InitialBalance: domain.MustParseDecimal("250.1234567"),
+			StartDate:      "2026-02-01",
+		})
+		assert.ErrorIs(t, err, ErrInvalidPrecision)
+	})
+
 	t.Run("validation error - invalid currency", func(t *testing.T) {
 		mockRepo := new(MockBankrollRepository)
 		logger := slog.Default()
-		service := NewBankrollService(mockRepo, logger)
+		service := NewBankrollService(mockRepo, noLimits(), logger)
 
 		ctx := context.Background()
 		userID := uint(1)
 		input := CreateBankrollInput{
 			Name:                 "Main Bankroll",
 			Currency:             "INVALID",
-			InitialBalance:       1000.00,
+			InitialBalance:       domain.MustParseDecimal("1000.00"),
 			StartDate:            "2026-02-01",
-			CommissionPercentage: 5.0,
+			CommissionPercentage: domain.MustParseDecimal("5.0"),
 		}
 
 		output, err := service.CreateBankroll(ctx, userID, input)
@@ -101,16 +161,16 @@ func TestCreateBankroll(t *testing.T) {
 	t.Run("validation error - negative balance", func(t *testing.T) {
 		mockRepo := new(MockBankrollRepository)
 		logger := slog.Default()
-		service := NewBankrollService(mockRepo, logger)
+		service := NewBankrollService(mockRepo, noLimits(), logger)
 
 		ctx := context.Background()
 		userID := uint(1)
 		input := CreateBankrollInput{
 			Name:                 "Main Bankroll",
 			Currency:             CurrencyBRL,
-			InitialBalance:       -100.00,
+			InitialBalance:       domain.MustParseDecimal("-100.00"),
 			StartDate:            "2026-02-01",
-			CommissionPercentage: 5.0,
+			CommissionPercentage: domain.MustParseDecimal("5.0"),
 		}
 
 		output, err := service.CreateBankroll(ctx, userID, input)
@@ -121,19 +181,67 @@ func TestCreateBankroll(t *testing.T) {
 		mockRepo.AssertNotCalled(t, "Create")
 	})
 
+	t.Run("validation error - balance exceeds currency precision", func(t *testing.T) {
+		mockRepo := new(MockBankrollRepository)
+		logger := slog.Default()
+		service := NewBankrollService(mockRepo, noLimits(), logger)
+
+		ctx := context.Background()
+		userID := uint(1)
+		input := CreateBankrollInput{
+			Name:                 "Main Bankroll",
+			Currency:             CurrencyBRL,
+			InitialBalance:       domain.MustParseDecimal("1000.001"),
+			StartDate:            "2026-02-01",
+			CommissionPercentage: domain.MustParseDecimal("5.0"),
+		}
+
+		output, err := service.CreateBankroll(ctx, userID, input)
+
+		assert.Error(t, err)
+		assert.Nil(t, output)
+		assert.ErrorIs(t, err, ErrInvalidPrecision)
+		mockRepo.AssertNotCalled(t, "Create")
+	})
+
+	t.Run("success - bitcoin keeps satoshi precision", func(t *testing.T) {
+		mockRepo := new(MockBankrollRepository)
+		logger := slog.Default()
+		service := NewBankrollService(mockRepo, noLimits(), logger)
+
+		ctx := context.Background()
+		userID := uint(1)
+		input := CreateBankrollInput{
+			Name:                 "BTC Bankroll",
+			Currency:             CurrencyBTC,
+			InitialBalance:       domain.MustParseDecimal("0.12345678"),
+			StartDate:            "2026-02-01",
+			CommissionPercentage: domain.MustParseDecimal("2"),
+		}
+
+		mockRepo.On("Create", ctx, mock.AnythingOfType("*bankroll.Bankroll")).Return(nil).Once()
+
+		output, err := service.CreateBankroll(ctx, userID, input)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "0.12345678", output.InitialBalance.String())
+		assert.Equal(t, "0.12345678", output.CurrentBalance.String())
+		mockRepo.AssertExpectations(t)
+	})
+
 	t.Run("validation error - invalid commission", func(t *testing.T) {
 		mockRepo := new(MockBankrollRepository)
 		logger := slog.Default()
-		service := NewBankrollService(mockRepo, logger)
+		service := NewBankrollService(mockRepo, noLimits(), logger)
 
 		ctx := context.Background()
 		userID := uint(1)
 		input := CreateBankrollInput{
 			Name:                 "Main Bankroll",
 			Currency:             CurrencyBRL,
-			InitialBalance:       1000.00,
+			InitialBalance:       domain.MustParseDecimal("1000.00"),
 			StartDate:            "2026-02-01",
-			CommissionPercentage: 150.0,
+			CommissionPercentage: domain.MustParseDecimal("150.0"),
 		}
 
 		output, err := service.CreateBankroll(ctx, userID, input)
@@ -144,19 +252,42 @@ func TestCreateBankroll(t *testing.T) {
 		mockRepo.AssertNotCalled(t, "Create")
 	})
 
+	t.Run("validation error - invalid timezone", func(t *testing.T) {
+		mockRepo := new(MockBankrollRepository)
+		logger := slog.Default()
+		service := NewBankrollService(mockRepo, noLimits(), logger)
+
+		ctx := context.Background()
+		userID := uint(1)
+		input := CreateBankrollInput{
+			Name:                 "Main Bankroll",
+			Currency:             CurrencyBRL,
+			InitialBalance:       domain.MustParseDecimal("1000.00"),
+			StartDate:            "2026-02-01",
+			CommissionPercentage: domain.MustParseDecimal("5.0"),
+			Timezone:             "Mars/Olympus",
+		}
+
+		output, err := service.CreateBankroll(ctx, userID, input)
+
+		assert.Nil(t, output)
+		assert.ErrorIs(t, err, ErrInvalidTimezone)
+		mockRepo.AssertNotCalled(t, "Create")
+	})
+
 	t.Run("validation error - invalid date format", func(t *testing.T) {
 		mockRepo := new(MockBankrollRepository)
 		logger := slog.Default()
-		service := NewBankrollService(mockRepo, logger)
+		service := NewBankrollService(mockRepo, noLimits(), logger)
 
 		ctx := context.Background()
 		userID := uint(1)
 		input := CreateBankrollInput{
 			Name:                 "Main Bankroll",
 			Currency:             CurrencyBRL,
-			InitialBalance:       1000.00,
+			InitialBalance:       domain.MustParseDecimal("1000.00"),
 			StartDate:            "invalid-date",
-			CommissionPercentage: 5.0,
+			CommissionPercentage: domain.MustParseDecimal("5.0"),
 		}
 
 		output, err := service.CreateBankroll(ctx, userID, input)
@@ -170,16 +301,16 @@ func TestCreateBankroll(t *testing.T) {
 	t.Run("repository error - duplicate name", func(t *testing.T) {
 		mockRepo := new(MockBankrollRepository)
 		logger := slog.Default()
-		service := NewBankrollService(mockRepo, logger)
+		service := NewBankrollService(mockRepo, noLimits(), logger)
 
 		ctx := context.Background()
 		userID := uint(1)
 		input := CreateBankrollInput{
 			Name:                 "Main Bankroll",
 			Currency:             CurrencyBRL,
-			InitialBalance:       1000.00,
+			InitialBalance:       domain.MustParseDecimal("1000.00"),
 			StartDate:            "2026-02-01",
-			CommissionPercentage: 5.0,
+			CommissionPercentage: domain.MustParseDecimal("5.0"),
 		}
 
 		mockRepo.On("Create", ctx, mock.AnythingOfType("*bankroll.Bankroll")).Return(ErrBankrollNameExists).Once()
@@ -195,16 +326,16 @@ func TestCreateBankroll(t *testing.T) {
 	t.Run("repository error - database error", func(t *testing.T) {
 		mockRepo := new(MockBankrollRepository)
 		logger := slog.Default()
-		service := NewBankrollService(mockRepo, logger)
+		service := NewBankrollService(mockRepo, noLimits(), logger)
 
 		ctx := context.Background()
 		userID := uint(1)
 		input := CreateBankrollInput{
 			Name:                 "Main Bankroll",
 			Currency:             CurrencyBRL,
-			InitialBalance:       1000.00,
+			InitialBalance:       domain.MustParseDecimal("1000.00"),
 			StartDate:            "2026-02-01",
-			CommissionPercentage: 5.0,
+			CommissionPercentage: domain.MustParseDecimal("5.0"),
 		}
 
 		mockRepo.On("Create", ctx, mock.AnythingOfType("*bankroll.Bankroll")).Return(ErrDatabaseError).Once()
@@ -222,7 +353,7 @@ func TestUpdateBankroll(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		mockRepo := new(MockBankrollRepository)
 		logger := slog.Default()
-		service := NewBankrollService(mockRepo, logger)
+		service := NewBankrollService(mockRepo, noLimits(), logger)
 
 		ctx := context.Background()
 		userID := uint(1)
@@ -233,9 +364,10 @@ func TestUpdateBankroll(t *testing.T) {
 			UserID:               userID,
 			Name:                 "Old Name",
 			Currency:             CurrencyBRL,
-			InitialBalance:       1000.00,
-			CurrentBalance:       1000.00,
-			CommissionPercentage: 5.0,
+			InitialBalance:       domain.MustParseDecimal("1000.00"),
+			CurrentBalance:       domain.MustParseDecimal("1000.00"),
+			CommissionPercentage: domain.MustParseDecimal("5.0"),
+			Timezone:             "America/Sao_Paulo",
 		}
 
 		updatedBankroll := &Bankroll{
@@ -243,9 +375,9 @@ func TestUpdateBankroll(t *testing.T) {
 			UserID:               userID,
 			Name:                 "Updated Name",
 			Currency:             CurrencyUSD,
-			InitialBalance:       1000.00,
-			CurrentBalance:       1000.00,
-			CommissionPercentage: 3.0,
+			InitialBalance:       domain.MustParseDecimal("1000.00"),
+			CurrentBalance:       domain.MustParseDecimal("1000.00"),
+			CommissionPercentage: domain.MustParseDecimal("3.0"),
 		}
 
 		mockRepo.On("FindByID", ctx, bankrollID, userID).Return(existingBankroll, nil).Once()
@@ -254,9 +386,10 @@ func TestUpdateBankroll(t *testing.T) {
 				b.UserID == userID &&
 				b.Name == "Updated Name" &&
 				b.Currency == CurrencyUSD &&
-				b.CommissionPercentage == 3.0 &&
-				b.InitialBalance == 1000.00 &&
-				b.CurrentBalance == 1000.00
+				b.CommissionPercentage.Float64() == 3.0 &&
+				b.Timezone == "America/Sao_Paulo" &&
+				b.InitialBalance.Float64() == 1000.00 &&
+				b.CurrentBalance.Float64() == 1000.00
 		})).Return(nil).Once()
 		mockRepo.On("FindByID", ctx, bankrollID, userID).Return(updatedBankroll, nil).Once()
 
@@ -264,7 +397,7 @@ func TestUpdateBankroll(t *testing.T) {
 			Name:                 "Updated Name",
 			Currency:             CurrencyUSD,
 			StartDate:            "2026-02-01",
-			CommissionPercentage: 3.0,
+			CommissionPercentage: domain.MustParseDecimal("3.0"),
 		}
 
 		output, err := service.UpdateBankroll(ctx, userID, bankrollID, input)
@@ -273,16 +406,16 @@ func TestUpdateBankroll(t *testing.T) {
 		assert.NotNil(t, output)
 		assert.Equal(t, "Updated Name", output.Name)
 		assert.Equal(t, CurrencyUSD, output.Currency)
-		assert.Equal(t, 3.0, output.CommissionPercentage)
-		assert.Equal(t, 1000.00, output.InitialBalance)
-		assert.Equal(t, 1000.00, output.CurrentBalance)
+		assert.Equal(t, 3.0, output.CommissionPercentage.Float64())
+		assert.Equal(t, 1000.00, output.InitialBalance.Float64())
+		assert.Equal(t, 1000.00, output.CurrentBalance.Float64())
 		mockRepo.AssertExpectations(t)
 	})
 
 	t.Run("validation error - invalid currency", func(t *testing.T) {
 		mockRepo := new(MockBankrollRepository)
 		logger := slog.Default()
-		service := NewBankrollService(mockRepo, logger)
+		service := NewBankrollService(mockRepo, noLimits(), logger)
 
 		ctx := context.Background()
 		userID := uint(1)
@@ -292,7 +425,7 @@ func TestUpdateBankroll(t *testing.T) {
 			Name:                 "Updated Name",
 			Currency:             "INVALID",
 			StartDate:            "2026-02-01",
-			CommissionPercentage: 3.0,
+			CommissionPercentage: domain.MustParseDecimal("3.0"),
 		}
 
 		output, err := service.UpdateBankroll(ctx, userID, bankrollID, input)
@@ -307,7 +440,7 @@ func TestUpdateBankroll(t *testing.T) {
 	t.Run("validation error - invalid commission", func(t *testing.T) {
 		mockRepo := new(MockBankrollRepository)
 		logger := slog.Default()
-		service := NewBankrollService(mockRepo, logger)
+		service := NewBankrollService(mockRepo, noLimits(), logger)
 
 		ctx := context.Background()
 		userID := uint(1)
@@ -317,7 +450,7 @@ func TestUpdateBankroll(t *testing.T) {
 			Name:                 "Updated Name",
 			Currency:             CurrencyBRL,
 			StartDate:            "2026-02-01",
-			CommissionPercentage: 150.0,
+			CommissionPercentage: domain.MustParseDecimal("150.0"),
 		}
 
 		output, err := service.UpdateBankroll(ctx, userID, bankrollID, input)
@@ -332,7 +465,7 @@ func TestUpdateBankroll(t *testing.T) {
 	t.Run("validation error - invalid date format", func(t *testing.T) {
 		mockRepo := new(MockBankrollRepository)
 		logger := slog.Default()
-		service := NewBankrollService(mockRepo, logger)
+		service := NewBankrollService(mockRepo, noLimits(), logger)
 
 		ctx := context.Background()
 		userID := uint(1)
@@ -342,7 +475,7 @@ func TestUpdateBankroll(t *testing.T) {
 			Name:                 "Updated Name",
 			Currency:             CurrencyBRL,
 			StartDate:            "invalid-date",
-			CommissionPercentage: 3.0,
+			CommissionPercentage: domain.MustParseDecimal("3.0"),
 		}
 
 		output, err := service.UpdateBankroll(ctx, userID, bankrollID, input)
@@ -357,7 +490,7 @@ func TestUpdateBankroll(t *testing.T) {
 	t.Run("bankroll not found", func(t *testing.T) {
 		mockRepo := new(MockBankrollRepository)
 		logger := slog.Default()
-		service := NewBankrollService(mockRepo, logger)
+		service := NewBankrollService(mockRepo, noLimits(), logger)
 
 		ctx := context.Background()
 		userID := uint(1)
@@ -369,7 +502,7 @@ func TestUpdateBankroll(t *testing.T) {
 			Name:                 "Updated Name",
 			Currency:             CurrencyBRL,
 			StartDate:            "2026-02-01",
-			CommissionPercentage: 3.0,
+			CommissionPercentage: domain.MustParseDecimal("3.0"),
 		}
 
 		output, err := service.UpdateBankroll(ctx, userID, bankrollID, input)
@@ -384,7 +517,7 @@ func TestUpdateBankroll(t *testing.T) {
 	t.Run("unauthorized - different user", func(t *testing.T) {
 		mockRepo := new(MockBankrollRepository)
 		logger := slog.Default()
-		service := NewBankrollService(mockRepo, logger)
+		service := NewBankrollService(mockRepo, noLimits(), logger)
 
 		ctx := context.Background()
 		userID := uint(2)
@@ -396,7 +529,7 @@ func TestUpdateBankroll(t *testing.T) {
 			Name:                 "Updated Name",
 			Currency:             CurrencyBRL,
 			StartDate:            "2026-02-01",
-			CommissionPercentage: 3.0,
+			CommissionPercentage: domain.MustParseDecimal("3.0"),
 		}
 
 		output, err := service.UpdateBankroll(ctx, userID, bankrollID, input)
@@ -411,7 +544,7 @@ func TestUpdateBankroll(t *testing.T) {
 	t.Run("repository error - duplicate name", func(t *testing.T) {
 		mockRepo := new(MockBankrollRepository)
 		logger := slog.Default()
-		service := NewBankrollService(mockRepo, logger)
+		service := NewBankrollService(mockRepo, noLimits(), logger)
 
 		ctx := context.Background()
 		userID := uint(1)
@@ -422,9 +555,9 @@ func TestUpdateBankroll(t *testing.T) {
 			UserID:               userID,
 			Name:                 "Old Name",
 			Currency:             CurrencyBRL,
-			InitialBalance:       1000.00,
-			CurrentBalance:       1000.00,
-			CommissionPercentage: 5.0,
+			InitialBalance:       domain.MustParseDecimal("1000.00"),
+			CurrentBalance:       domain.MustParseDecimal("1000.00"),
+			CommissionPercentage: domain.MustParseDecimal("5.0"),
 		}
 
 		mockRepo.On("FindByID", ctx, bankrollID, userID).Return(existingBankroll, nil).Once()
@@ -434,7 +567,7 @@ func TestUpdateBankroll(t *testing.T) {
 			Name:                 "Updated Name",
 			Currency:             CurrencyBRL,
 			StartDate:            "2026-02-01",
-			CommissionPercentage: 3.0,
+			CommissionPercentage: domain.MustParseDecimal("3.0"),
 		}
 
 		output, err := service.UpdateBankroll(ctx, userID, bankrollID, input)
@@ -448,7 +581,7 @@ func TestUpdateBankroll(t *testing.T) {
 	t.Run("repository error - database error", func(t *testing.T) {
 		mockRepo := new(MockBankrollRepository)
 		logger := slog.Default()
-		service := NewBankrollService(mockRepo, logger)
+		service := NewBankrollService(mockRepo, noLimits(), logger)
 
 		ctx := context.Background()
 		userID := uint(1)
@@ -459,9 +592,9 @@ func TestUpdateBankroll(t *testing.T) {
 			UserID:               userID,
 			Name:                 "Old Name",
 			Currency:             CurrencyBRL,
-			InitialBalance:       1000.00,
-			CurrentBalance:       1000.00,
-			CommissionPercentage: 5.0,
+			InitialBalance:       domain.MustParseDecimal("1000.00"),
+			CurrentBalance:       domain.MustParseDecimal("1000.00"),
+			CommissionPercentage: domain.MustParseDecimal("5.0"),
 		}
 
 		mockRepo.On("FindByID", ctx, bankrollID, userID).Return(existingBankroll, nil).Once()
@@ -471,7 +604,7 @@ func TestUpdateBankroll(t *testing.T) {
 			Name:                 "Updated Name",
 			Currency:             CurrencyBRL,
 			StartDate:            "2026-02-01",
-			CommissionPercentage: 3.0,
+			CommissionPercentage: domain.MustParseDecimal("3.0"),
 		}
 
 		output, err := service.UpdateBankroll(ctx, userID, bankrollID, input)
@@ -507,7 +640,7 @@ func TestListBankrolls(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		mockRepo := new(MockBankrollRepository)
 		logger := slog.Default()
-		service := NewBankrollService(mockRepo, logger)
+		service := NewBankrollService(mockRepo, noLimits(), logger)
 
 		ctx := context.Background()
 		userID := uint(1)
@@ -518,73 +651,150 @@ func TestListBankrolls(t *testing.T) {
 				UserID:               userID,
 				Name:                 "Bankroll 1",
 				Currency:             CurrencyBRL,
-				InitialBalance:       1000.00,
-				CurrentBalance:       1000.00,
-				CommissionPercentage: 5.0,
+				InitialBalance:       domain.MustParseDecimal("1000.00"),
+				CurrentBalance:       domain.MustParseDecimal("1000.00"),
+				CommissionPercentage: domain.MustParseDecimal("5.0"),
 			},
 			{
 				ID:                   2,
 				UserID:               userID,
 				Name:                 "Bankroll 2",
 				Currency:             CurrencyUSD,
-				InitialBalance:       500.00,
-				CurrentBalance:       500.00,
-				CommissionPercentage: 3.0,
+				InitialBalance:       domain.MustParseDecimal("500.00"),
+				CurrentBalance:       domain.MustParseDecimal("500.00"),
+				CommissionPercentage: domain.MustParseDecimal("3.0"),
 			},
 		}
 
-		mockRepo.On("ListByUserID", ctx, userID).Return(bankrolls, nil).Once()
+		mockRepo.On("List", ctx, userID, BankrollListFilter{
+			Sort:  BankrollSortCreatedAt,
+			Limit: pagination.DefaultLimit + 1,
+		}).Return(bankrolls, int64(2), nil).Once()
 
-		outputs, err := service.ListBankrolls(ctx, userID)
+		page, err := service.ListBankrolls(ctx, userID, ListBankrollsInput{})
 
 		assert.NoError(t, err)
-		assert.Len(t, outputs, 2)
-		assert.Equal(t, "Bankroll 1", outputs[0].Name)
-		assert.Equal(t, "Bankroll 2", outputs[1].Name)
+		assert.Len(t, page.Items, 2)
+		assert.Equal(t, int64(2), page.Total)
+		assert.Equal(t, "Bankroll 1", page.Items[0].Name)
+		assert.Equal(t, "Bankroll 2", page.Items[1].Name)
+		assert.Empty(t, page.NextCursor)
 		mockRepo.AssertExpectations(t)
 	})
 
 	t.Run("empty list", func(t *testing.T) {
 		mockRepo := new(MockBankrollRepository)
 		logger := slog.Default()
-		service := NewBankrollService(mockRepo, logger)
+		service := NewBankrollService(mockRepo, noLimits(), logger)
 
 		ctx := context.Background()
 		userID := uint(1)
 
-		mockRepo.On("ListByUserID", ctx, userID).Return([]*Bankroll{}, nil).Once()
+		mockRepo.On("List", ctx, userID, mock.Anything).Return([]*Bankroll{}, int64(0), nil).Once()
 
-		outputs, err := service.ListBankrolls(ctx, userID)
+		page, err := service.ListBankrolls(ctx, userID, ListBankrollsInput{})
 
 		assert.NoError(t, err)
-		assert.Empty(t, outputs)
+		assert.Empty(t, page.Items)
 		mockRepo.AssertExpectations(t)
 	})
 
 	t.Run("repository error", func(t *testing.T) {
 		mockRepo := new(MockBankrollRepository)
 		logger := slog.Default()
-		service := NewBankrollService(mockRepo, logger)
+		service := NewBankrollService(mockRepo, noLimits(), logger)
 
 		ctx := context.Background()
 		userID := uint(1)
 
-		mockRepo.On("ListByUserID", ctx, userID).Return(nil, ErrDatabaseError).Once()
+		mockRepo.On("List", ctx, userID, mock.Anything).Return(nil, int64(0), ErrDatabaseError).Once()
 
-		outputs, err := service.ListBankrolls(ctx, userID)
+		page, err := service.ListBankrolls(ctx, userID, ListBankrollsInput{})
 
 		assert.Error(t, err)
-		assert.Nil(t, outputs)
+		assert.Nil(t, page)
 		assert.ErrorIs(t, err, ErrDatabaseError)
 		mockRepo.AssertExpectations(t)
 	})
+
+	t.Run("next cursor when more rows follow", func(t *testing.T) {
+		mockRepo := new(MockBankrollRepository)
+		service := NewBankrollService(mockRepo, noLimits(), slog.Default())
+		ctx := context.Background()
+
+		bankrolls := []*Bankroll{
+			{ID: 1, Name: "Alpha", Currency: CurrencyBRL},
+			{ID: 2, Name: "Beta", Currency: CurrencyBRL},
+			{ID: 3, Name: "Gamma", Currency: CurrencyBRL},
+		}
+		mockRepo.On("List", ctx, uint(1), mock.MatchedBy(func(filter BankrollListFilter) bool {
+			return filter.Limit == 3 && filter.Sort == BankrollSortName && filter.Name == "a" && filter.After == nil
+		})).Return(bankrolls, int64(5), nil).Once()
+
+		page, err := service.ListBankrolls(ctx, 1, ListBankrollsInput{
+			Name:   " a ",
+			Sort:   BankrollSortName,
+			Params: pagination.Params{Limit: 2},
+		})
+
+		require.NoError(t, err)
+		assert.Len(t, page.Items, 2)
+		assert.Equal(t, int64(5), page.Total)
+		assert.Equal(t, pagination.EncodeCursor("name", false, "Beta", 2), page.NextCursor)
+	})
+
+	t.Run("cursor is decoded for the sort field", func(t *testing.T) {
+		mockRepo := new(MockBankrollRepository)
+		service := NewBankrollService(mockRepo, noLimits(), slog.Default())
+
+		mockRepo.On("List", mock.Anything, uint(1), mock.MatchedBy(func(filter BankrollListFilter) bool {
+			return filter.After != nil && filter.After.ID == 7 &&
+				filter.After.Value.(domain.Decimal).Equal(domain.MustParseDecimal("250.5")) && filter.Descending
+		})).Return([]*Bankroll{}, int64(0), nil).Once()
+
+		_, err := service.ListBankrolls(context.Background(), 1, ListBankrollsInput{
+			Sort:   BankrollSortCurrentBalance,
+			Order:  "desc",
+			Params: pagination.Params{Cursor: pagination.EncodeCursor("current_balance", true, "250.5", 7)},
+		})
+
+		require.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("invalid parameters", func(t *testing.T) {
+		service := NewBankrollService(new(MockBankrollRepository), noLimits(), slog.Default())
+		ctx := context.Background()
+
+		_, err := service.ListBankrolls(ctx, 1, ListBankrollsInput{Sort: "owner"})
+		assert.ErrorIs(t, err, ErrValidationFailed)
+
+		_, err = service.ListBankrolls(ctx, 1, ListBankrollsInput{Params: pagination.Params{Limit: 500}})
+		assert.ErrorIs(t, err, ErrValidationFailed)
+
+		_, err = service.ListBankrolls(ctx, 1, ListBankrollsInput{Params: pagination.Params{Cursor: pagination.EncodeCursor("created_at", false, "yesterday", 3)}})
+		assert.ErrorIs(t, err, ErrValidationFailed)
+
+		_, err = service.ListBankrolls(ctx, 1, ListBankrollsInput{
+			Sort:   BankrollSortCurrentBalance,
+			Order:  "desc",
+			Params: pagination.Params{Cursor: pagination.EncodeCursor("name", false, "Beta", 2)},
+		})
+		assert.ErrorIs(t, err, ErrValidationFailed, "a cursor only pages the sort it was issued for")
+
+		_, err = service.ListBankrolls(ctx, 1, ListBankrollsInput{CreatedAfter: "March"})
+		assert.ErrorIs(t, err, ErrValidationFailed)
+
+		_, err = service.ListBankrolls(ctx, 1, ListBankrollsInput{Currency: "XYZ"})
+		assert.ErrorIs(t, err, ErrInvalidCurrency)
+	})
 }
 
 func TestGetBankroll(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		mockRepo := new(MockBankrollRepository)
 		logger := slog.Default()
-		service := NewBankrollService(mockRepo, logger)
+		service := NewBankrollService(mockRepo, noLimits(), logger)
 
 		ctx := context.Background()
 		userID := uint(1)
@@ -595,9 +805,9 @@ func TestGetBankroll(t *testing.T) {
 			UserID:               userID,
 			Name:                 "Main Bankroll",
 			Currency:             CurrencyBRL,
-			InitialBalance:       1000.00,
-			CurrentBalance:       1000.00,
-			CommissionPercentage: 5.0,
+			InitialBalance:       domain.MustParseDecimal("1000.00"),
+			CurrentBalance:       domain.MustParseDecimal("1000.00"),
+			CommissionPercentage: domain.MustParseDecimal("5.0"),
 		}
 
 		mockRepo.On("FindByID", ctx, bankrollID, userID).Return(bankroll, nil).Once()
@@ -608,15 +818,37 @@ func TestGetBankroll(t *testing.T) {
 		assert.NotNil(t, output)
 		assert.Equal(t, "Main Bankroll", output.Name)
 		assert.Equal(t, CurrencyBRL, output.Currency)
-		assert.Equal(t, 1000.00, output.InitialBalance)
-		assert.Equal(t, 1000.00, output.CurrentBalance)
+		assert.Equal(t, 1000.00, output.InitialBalance.Float64())
+		assert.Equal(t, 1000.00, output.CurrentBalance.Float64())
 		mockRepo.AssertExpectations(t)
 	})
 
+	t.Run("locked by stop limit", func(t *testing.T) {
+		mockRepo := new(MockBankrollRepository)
+		mockLimits := new(MockLimitChecker)
+		service := NewBankrollService(mockRepo, mockLimits, slog.Default())
+
+		ctx := context.Background()
+		bankroll := &Bankroll{ID: 1, UserID: 1, Name: "Main Bankroll", Currency: CurrencyBRL}
+		until := time.Date(2026, 3, 2, 3, 0, 0, 0, time.UTC)
+		lock := &LimitLockOutput{Locked: true, Reason: LimitReasonDailyStopLoss, LockedUntil: &until}
+
+		mockRepo.On("FindByID", ctx, uint(1), uint(1)).Return(bankroll, nil).Once()
+		mockLimits.On("LockStatus", ctx, bankroll, mock.AnythingOfType("time.Time")).Return(lock, nil).Once()
+
+		output, err := service.GetBankroll(ctx, 1, 1)
+
+		require.NoError(t, err)
+		require.NotNil(t, output.Lock)
+		assert.True(t, output.Lock.Locked)
+		assert.Equal(t, LimitReasonDailyStopLoss, output.Lock.Reason)
+		mockLimits.AssertExpectations(t)
+	})
+
 	t.Run("bankroll not found", func(t *testing.T) {
 		mockRepo := new(MockBankrollRepository)
 		logger := slog.Default()
-		service := NewBankrollService(mockRepo, logger)
+		service := NewBankrollService(mockRepo, noLimits(), logger)
 
 		ctx := context.Background()
 		userID := uint(1)
@@ -635,7 +867,7 @@ func TestGetBankroll(t *testing.T) {
 	t.Run("unauthorized - different user", func(t *testing.T) {
 		mockRepo := new(MockBankrollRepository)
 		logger := slog.Default()
-		service := NewBankrollService(mockRepo, logger)
+		service := NewBankrollService(mockRepo, noLimits(), logger)
 
 		ctx := context.Background()
 		userID := uint(2)
@@ -654,7 +886,7 @@ func TestGetBankroll(t *testing.T) {
 	t.Run("repository error", func(t *testing.T) {
 		mockRepo := new(MockBankrollRepository)
 		logger := slog.Default()
-		service := NewBankrollService(mockRepo, logger)
+		service := NewBankrollService(mockRepo, noLimits(), logger)
 
 		ctx := context.Background()
 		userID := uint(1)
@@ -675,7 +907,7 @@ func TestResetBankroll(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		mockRepo := new(MockBankrollRepository)
 		logger := slog.Default()
-		service := NewBankrollService(mockRepo, logger)
+		service := NewBankrollService(mockRepo, noLimits(), logger)
 
 		ctx := context.Background()
 		userID := uint(1)
@@ -686,9 +918,9 @@ func TestResetBankroll(t *testing.T) {
 			UserID:               userID,
 			Name:                 "Main Bankroll",
 			Currency:             CurrencyBRL,
-			InitialBalance:       1000.00,
-			CurrentBalance:       1000.00,
-			CommissionPercentage: 5.0,
+			InitialBalance:       domain.MustParseDecimal("1000.00"),
+			CurrentBalance:       domain.MustParseDecimal("1000.00"),
+			CommissionPercentage: domain.MustParseDecimal("5.0"),
 		}
 
 		afterReset := &Bankroll{
@@ -696,30 +928,30 @@ func TestResetBankroll(t *testing.T) {
 			UserID:               userID,
 			Name:                 "Main Bankroll",
 			Currency:             CurrencyBRL,
-			InitialBalance:       0.0,
-			CurrentBalance:       0.0,
-			CommissionPercentage: 5.0,
+			InitialBalance:       domain.MustParseDecimal("0.0"),
+			CurrentBalance:       domain.MustParseDecimal("0.0"),
+			CommissionPercentage: domain.MustParseDecimal("5.0"),
 		}
 
 		mockRepo.On("FindByID", ctx, bankrollID, userID).Return(beforeReset, nil).Once()
-		mockRepo.On("Reset", ctx, bankrollID, userID).Return(nil).Once()
+		mockRepo.On("Reset", ctx, bankrollID, userID, domain.Zero, uint(0)).Return(&BankrollPeriod{ID: 1}, nil).Once()
 		mockRepo.On("FindByID", ctx, bankrollID, userID).Return(afterReset, nil).Once()
 
-		output, err := service.ResetBankroll(ctx, userID, bankrollID)
+		output, err := service.ResetBankroll(ctx, userID, bankrollID, ResetBankrollInput{})
 
 		assert.NoError(t, err)
 		assert.NotNil(t, output)
 		assert.Equal(t, "Main Bankroll", output.Name)
 		assert.Equal(t, CurrencyBRL, output.Currency)
-		assert.Equal(t, 0.0, output.InitialBalance)
-		assert.Equal(t, 0.0, output.CurrentBalance)
+		assert.Equal(t, 0.0, output.InitialBalance.Float64())
+		assert.Equal(t, 0.0, output.CurrentBalance.Float64())
 		mockRepo.AssertExpectations(t)
 	})
 
 	t.Run("bankroll not found", func(t *testing.T) {
 		mockRepo := new(MockBankrollRepository)
 		logger := slog.Default()
-		service := NewBankrollService(mockRepo, logger)
+		service := NewBankrollService(mockRepo, noLimits(), logger)
 
 		ctx := context.Background()
 		userID := uint(1)
@@ -727,7 +959,7 @@ func TestResetBankroll(t *testing.T) {
 
 		mockRepo.On("FindByID", ctx, bankrollID, userID).Return(nil, ErrBankrollNotFound).Once()
 
-		output, err := service.ResetBankroll(ctx, userID, bankrollID)
+		output, err := service.ResetBankroll(ctx, userID, bankrollID, ResetBankrollInput{})
 
 		assert.Error(t, err)
 		assert.Nil(t, output)
@@ -739,7 +971,7 @@ func TestResetBankroll(t *testing.T) {
 	t.Run("unauthorized - different user", func(t *testing.T) {
 		mockRepo := new(MockBankrollRepository)
 		logger := slog.Default()
-		service := NewBankrollService(mockRepo, logger)
+		service := NewBankrollService(mockRepo, noLimits(), logger)
 
 		ctx := context.Background()
 		userID := uint(2)
@@ -747,7 +979,7 @@ func TestResetBankroll(t *testing.T) {
 
 		mockRepo.On("FindByID", ctx, bankrollID, userID).Return(nil, ErrBankrollNotFound).Once()
 
-		output, err := service.ResetBankroll(ctx, userID, bankrollID)
+		output, err := service.ResetBankroll(ctx, userID, bankrollID, ResetBankrollInput{})
 
 		assert.Error(t, err)
 		assert.Nil(t, output)
@@ -759,7 +991,7 @@ func TestResetBankroll(t *testing.T) {
 	t.Run("repository error", func(t *testing.T) {
 		mockRepo := new(MockBankrollRepository)
 		logger := slog.Default()
-		service := NewBankrollService(mockRepo, logger)
+		service := NewBankrollService(mockRepo, noLimits(), logger)
 
 		ctx := context.Background()
 		userID := uint(1)
@@ -770,19 +1002,285 @@ func TestResetBankroll(t *testing.T) {
 			UserID:               userID,
 			Name:                 "Main Bankroll",
 			Currency:             CurrencyBRL,
-			InitialBalance:       1000.00,
-			CurrentBalance:       1000.00,
-			CommissionPercentage: 5.0,
+			InitialBalance:       domain.MustParseDecimal("1000.00"),
+			CurrentBalance:       domain.MustParseDecimal("1000.00"),
+			CommissionPercentage: domain.MustParseDecimal("5.0"),
 		}
 
 		mockRepo.On("FindByID", ctx, bankrollID, userID).Return(bankroll, nil).Once()
-		mockRepo.On("Reset", ctx, bankrollID, userID).Return(ErrDatabaseError).Once()
+		mockRepo.On("Reset", ctx, bankrollID, userID, domain.Zero, uint(0)).Return(nil, ErrDatabaseError).Once()
 
-		output, err := service.ResetBankroll(ctx, userID, bankrollID)
+		output, err := service.ResetBankroll(ctx, userID, bankrollID, ResetBankrollInput{})
 
 		assert.Error(t, err)
 		assert.Nil(t, output)
 		assert.ErrorIs(t, err, ErrDatabaseError)
 		mockRepo.AssertExpectations(t)
 	})
+
+	t.Run("new starting amount", func(t *testing.T) {
+		mockRepo := new(MockBankrollRepository)
+		service := NewBankrollService(mockRepo, noLimits(), slog.Default())
+		ctx := context.Background()
+
+		amount := domain.MustParseDecimal("250.50")
+		mockRepo.On("FindByID", ctx, uint(1), uint(1)).
+			Return(&Bankroll{ID: 1, UserID: 1, Currency: CurrencyBRL}, nil).Once()
+		mockRepo.On("Reset", ctx, uint(1), uint(1), amount, uint(0)).Return(&BankrollPeriod{ID: 3}, nil).Once()
+		mockRepo.On("FindByID", ctx, uint(1), uint(1)).
+			Return(&Bankroll{ID: 1, UserID: 1, Currency: CurrencyBRL, InitialBalance: amount, CurrentBalance: amount}, nil).Once()
+
+		output, err := service.ResetBankroll(ctx, 1, 1, ResetBankrollInput{Amount: amount})
+
+		require.NoError(t, err)
+		assert.Equal(t, "250.50", output.InitialBalance.String())
+		assert.Equal(t, "250.50", output.CurrentBalance.String())
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("invalid amount", func(t *testing.T) {
+		mockRepo := new(MockBankrollRepository)
+		service := NewBankrollService(mockRepo, noLimits(), slog.Default())
+		ctx := context.Background()
+
+		mockRepo.On("FindByID", ctx, uint(1), uint(1)).Return(&Bankroll{ID: 1, UserID: 1, Currency: CurrencyBRL}, nil)
+
+		_, err := service.ResetBankroll(ctx, 1, 1, ResetBankrollInput{Amount: domain.MustParseDecimal("-1")})
+		assert.ErrorIs(t, err, ErrNegativeBalance)
+
+		_, err = service.ResetBankroll(ctx, 1, 1, ResetBankrollInput{Amount: domain.MustParseDecimal("10.001")})
+		assert.ErrorIs(t, err, ErrInvalidPrecision)
+
+		mockRepo.AssertNotCalled(t, "Reset", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestDeleteBankroll(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		mockRepo := new(MockBankrollRepository)
+		service := NewBankrollService(mockRepo, noLimits(), slog.Default())
+		ctx := context.Background()
+
+		mockRepo.On("FindByID", ctx, uint(1), uint(1)).Return(&Bankroll{ID: 1, UserID: 1}, nil).Once()
+		mockRepo.On("Delete", ctx, uint(1), uint(1)).Return(nil).Once()
+
+		err := service.DeleteBankroll(ctx, 1, 1)
+
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("money in play", func(t *testing.T) {
+		mockRepo := new(MockBankrollRepository)
+		service := NewBankrollService(mockRepo, noLimits(), slog.Default())
+		ctx := context.Background()
+
+		mockRepo.On("FindByID", ctx, uint(1), uint(1)).
+			Return(&Bankroll{ID: 1, UserID: 1, InPlay: domain.MustParseDecimal("50")}, nil).Once()
+
+		err := service.DeleteBankroll(ctx, 1, 1)
+
+		assert.ErrorIs(t, err, ErrBankrollInPlay)
+		mockRepo.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		mockRepo := new(MockBankrollRepository)
+		service := NewBankrollService(mockRepo, noLimits(), slog.Default())
+		ctx := context.Background()
+
+		mockRepo.On("FindByID", ctx, uint(9), uint(1)).Return(nil, ErrBankrollNotFound).Once()
+
+		err := service.DeleteBankroll(ctx, 1, 9)
+
+		assert.ErrorIs(t, err, ErrBankrollNotFound)
+	})
+}
+
+func TestArchiveBankroll(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		mockRepo := new(MockBankrollRepository)
+		service := NewBankrollService(mockRepo, noLimits(), slog.Default())
+		ctx := context.Background()
+
+		archivedAt := time.Now()
+		mockRepo.On("FindByID", ctx, uint(1), uint(1)).Return(&Bankroll{ID: 1, UserID: 1}, nil).Once()
+		mockRepo.On("Archive", ctx, uint(1), uint(1)).Return(nil).Once()
+		mockRepo.On("FindByID", ctx, uint(1), uint(1)).Return(&Bankroll{ID: 1, UserID: 1, ArchivedAt: &archivedAt}, nil).Once()
+
+		output, err := service.ArchiveBankroll(ctx, 1, 1)
+
+		require.NoError(t, err)
+		assert.True(t, output.Archived)
+		assert.Equal(t, &archivedAt, output.ArchivedAt)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("already archived", func(t *testing.T) {
+		mockRepo := new(MockBankrollRepository)
+		service := NewBankrollService(mockRepo, noLimits(), slog.Default())
+		ctx := context.Background()
+
+		archivedAt := time.Now()
+		mockRepo.On("FindByID", ctx, uint(1), uint(1)).Return(&Bankroll{ID: 1, UserID: 1, ArchivedAt: &archivedAt}, nil).Once()
+
+		_, err := service.ArchiveBankroll(ctx, 1, 1)
+
+		assert.ErrorIs(t, err, ErrBankrollArchived)
+		mockRepo.AssertNotCalled(t, "Archive", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("money in play", func(t *testing.T) {
+		mockRepo := new(MockBankrollRepository)
+		service := NewBankrollService(mockRepo, noLimits(), slog.Default())
+		ctx := context.Background()
+
+		mockRepo.On("FindByID", ctx, uint(1), uint(1)).
+			Return(&Bankroll{ID: 1, UserID: 1, InPlay: domain.MustParseDecimal("10")}, nil).Once()
+
+		_, err := service.ArchiveBankroll(ctx, 1, 1)
+
+		assert.ErrorIs(t, err, ErrBankrollInPlay)
+	})
+}
+
+func TestRestoreBankroll(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		mockRepo := new(MockBankrollRepository)
+		service := NewBankrollService(mockRepo, noLimits(), slog.Default())
+		ctx := context.Background()
+
+		mockRepo.On("Restore", ctx, uint(1), uint(1)).Return(nil).Once()
+		mockRepo.On("FindByID", ctx, uint(1), uint(1)).Return(&Bankroll{ID: 1, UserID: 1, Name: "Main"}, nil).Once()
+
+		output, err := service.RestoreBankroll(ctx, 1, 1)
+
+		require.NoError(t, err)
+		assert.False(t, output.Archived)
+		assert.Equal(t, "Main", output.Name)
+	})
+
+	t.Run("not archived", func(t *testing.T) {
+		mockRepo := new(MockBankrollRepository)
+		service := NewBankrollService(mockRepo, noLimits(), slog.Default())
+		ctx := context.Background()
+
+		mockRepo.On("Restore", ctx, uint(1), uint(1)).Return(ErrBankrollNotArchived).Once()
+
+		output, err := service.RestoreBankroll(ctx, 1, 1)
+
+		assert.ErrorIs(t, err, ErrBankrollNotArchived)
+		assert.Nil(t, output)
+	})
+}
+
+func TestPatchBankroll(t *testing.T) {
+	existing := func() *Bankroll {
+		return &Bankroll{
+			ID:                   1,
+			UserID:               1,
+			Name:                 "Main",
+			Currency:             CurrencyBRL,
+			InitialBalance:       domain.MustParseDecimal("100.00"),
+			CurrentBalance:       domain.MustParseDecimal("100.00"),
+			StartDate:            time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC),
+			CommissionPercentage: domain.MustParseDecimal("5.00"),
+			Version:              3,
+		}
+	}
+
+	t.Run("only supplied fields change", func(t *testing.T) {
+		mockRepo := new(MockBankrollRepository)
+		service := NewBankrollService(mockRepo, noLimits(), slog.Default())
+		ctx := context.Background()
+
+		mockRepo.On("FindByID", ctx, uint(1), uint(1)).Return(existing(), nil).Twice()
+		mockRepo.On("Update", ctx, mock.MatchedBy(func(b *Bankroll) bool {
+			return b.Name == "Renamed" &&
+				b.Currency == CurrencyBRL &&
+				b.StartDate.Format("2006-01-02") == "2026-02-01" &&
+				b.CommissionPercentage.Equal(domain.MustParseDecimal("5")) &&
+				b.Version == 3
+		})).Return(nil).Once()
+		renamed := existing()
+		renamed.Name = "Renamed"
+		renamed.Version = 4
+		mockRepo.On("FindByID", ctx, uint(1), uint(1)).Return(renamed, nil).Once()
+
+		name := "Renamed"
+		output, err := service.PatchBankroll(ctx, 1, 1, PatchBankrollInput{Name: &name})
+
+		require.NoError(t, err)
+		assert.Equal(t, "Renamed", output.Name)
+		assert.Equal(t, uint(4), output.Version)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("shares the update rules", func(t *testing.T) {
+		mockRepo := new(MockBankrollRepository)
+		service := NewBankrollService(mockRepo, noLimits(), slog.Default())
+		ctx := context.Background()
+
+		mockRepo.On("FindByID", ctx, uint(1), uint(1)).Return(existing(), nil)
+
+		commission := domain.MustParseDecimal("150")
+		_, err := service.PatchBankroll(ctx, 1, 1, PatchBankrollInput{CommissionPercentage: &commission})
+		assert.ErrorIs(t, err, ErrValidationFailed, "rejected by the lte=100 binding rule, as PUT does")
+
+		currency := Currency("XYZ")
+		_, err = service.PatchBankroll(ctx, 1, 1, PatchBankrollInput{Currency: &currency})
+		assert.ErrorIs(t, err, ErrInvalidCurrency)
+
+		empty := ""
+		_, err = service.PatchBankroll(ctx, 1, 1, PatchBankrollInput{Name: &empty})
+		assert.ErrorIs(t, err, ErrValidationFailed)
+
+		long := strings.Repeat("é", 101)
+		_, err = service.PatchBankroll(ctx, 1, 1, PatchBankrollInput{Name: &long})
+		assert.ErrorIs(t, err, ErrValidationFailed)
+
+		mockRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+
+	t.Run("name length counts characters like PUT", func(t *testing.T) {
+		mockRepo := new(MockBankrollRepository)
+		service := NewBankrollService(mockRepo, noLimits(), slog.Default())
+		ctx := context.Background()
+
+		name := strings.Repeat("ã", 60)
+		mockRepo.On("FindByID", ctx, uint(1), uint(1)).Return(existing(), nil)
+		mockRepo.On("Update", ctx, mock.MatchedBy(func(b *Bankroll) bool { return b.Name == name })).Return(nil).Once()
+
+		_, err := service.PatchBankroll(ctx, 1, 1, PatchBankrollInput{Name: &name})
+
+		require.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("empty patch returns the bankroll unchanged", func(t *testing.T) {
+		mockRepo := new(MockBankrollRepository)
+		service := NewBankrollService(mockRepo, noLimits(), slog.Default())
+		ctx := context.Background()
+
+		mockRepo.On("FindByID", ctx, uint(1), uint(1)).Return(existing(), nil).Once()
+
+		output, err := service.PatchBankroll(ctx, 1, 1, PatchBankrollInput{})
+
+		require.NoError(t, err)
+		assert.Equal(t, "Main", output.Name)
+		mockRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+
+	t.Run("stale If-Match", func(t *testing.T) {
+		mockRepo := new(MockBankrollRepository)
+		service := NewBankrollService(mockRepo, noLimits(), slog.Default())
+		ctx := context.Background()
+
+		mockRepo.On("FindByID", ctx, uint(1), uint(1)).Return(existing(), nil).Once()
+
+		name := "Renamed"
+		_, err := service.PatchBankroll(ctx, 1, 1, PatchBankrollInput{Name: &name, ExpectedVersion: 2})
+
+		assert.ErrorIs(t, err, ErrVersionConflict)
+	})
 }
@@ -0,0 +1,157 @@
+package bankroll
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"log/slog"
+
+	"github.com/gin-gonic/gin"
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type MockStakeService struct {
+	mock.Mock
+}
+
+func (m *MockStakeService) ListLadders(ctx context.Context, userID uint) ([]*StakeLadderOutput, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*StakeLadderOutput), args.Error(1)
+}
+
+func (m *MockStakeService) GetLadder(ctx context.Context, userID uint, gameType string) (*StakeLadderOutput, error) {
+	args := m.Called(ctx, userID, gameType)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*StakeLadderOutput), args.Error(1)
+}
+
+func (m *MockStakeService) SetLadder(ctx context.Context, userID uint, gameType string, input StakeLadderInput) (*StakeLadderOutput, error) {
+	args := m.Called(ctx, userID, gameType, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*StakeLadderOutput), args.Error(1)
+}
+
+func (m *MockStakeService) ResetLadder(ctx context.Context, userID uint, gameType string) (*StakeLadderOutput, error) {
+	args := m.Called(ctx, userID, gameType)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*StakeLadderOutput), args.Error(1)
+}
+
+func (m *MockStakeService) GetAdvice(ctx context.Context, userID uint, bankrollID uint, input StakeAdviceInput) (*StakeAdviceOutput, error) {
+	args := m.Called(ctx, userID, bankrollID, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*StakeAdviceOutput), args.Error(1)
+}
+
+func TestGetStakeAdviceHandler(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		mockService := new(MockStakeService)
+		handler := NewStakeHandler(mockService, slog.Default())
+
+		mockService.On("GetAdvice", mock.Anything, uint(1), uint(2), StakeAdviceInput{GameType: "nlhe"}).Return(&StakeAdviceOutput{
+			BankrollID: 2,
+			Currency:   CurrencyUSD,
+			Balance:    domain.MustParseDecimal("900.00"),
+			Games: []GameStakeAdviceOutput{{
+				GameType:       "nlhe",
+				RequiredBuyIns: domain.NewDecimalFromInt(40),
+				BuyInsSource:   "default",
+				Recommended:    "NL10",
+				MoveUp:         &MoveUpAdviceOutput{Stakes: "NL25", ShotAllowed: true},
+			}},
+		}, nil).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodGet, "/bankrolls/2/stake-advice?game_type=nlhe", nil)
+		c.Params = gin.Params{{Key: "bankrollId", Value: "2"}}
+		c.Set("userID", "1")
+
+		handler.GetAdvice(c)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		var response StakeAdviceOutput
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		require.Len(t, response.Games, 1)
+		assert.Equal(t, "NL10", response.Games[0].Recommended)
+		assert.True(t, response.Games[0].MoveUp.ShotAllowed)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("invalid game type", func(t *testing.T) {
+		mockService := new(MockStakeService)
+		handler := NewStakeHandler(mockService, slog.Default())
+
+		mockService.On("GetAdvice", mock.Anything, uint(1), uint(2), StakeAdviceInput{GameType: "stud"}).
+			Return(nil, ErrInvalidGameType).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodGet, "/bankrolls/2/stake-advice?game_type=stud", nil)
+		c.Params = gin.Params{{Key: "bankrollId", Value: "2"}}
+		c.Set("userID", "1")
+
+		handler.GetAdvice(c)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		var response ErrorOutput
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, "INVALID_GAME_TYPE", response.Code)
+	})
+}
+
+func TestSetStakeLadderHandler(t *testing.T) {
+	t.Run("invalid ladder", func(t *testing.T) {
+		mockService := new(MockStakeService)
+		handler := NewStakeHandler(mockService, slog.Default())
+
+		mockService.On("SetLadder", mock.Anything, uint(1), "nlhe", mock.AnythingOfType("bankroll.StakeLadderInput")).
+			Return(nil, ErrInvalidStakeLadder).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = newRuleRequest(t, http.MethodPut, "/stake-ladders/nlhe", `{"levels":[{"stakes":"NL2","buy_in":"2"},{"stakes":"NL2","buy_in":"5"}]}`)
+		c.Params = gin.Params{{Key: "gameType", Value: "nlhe"}}
+		c.Set("userID", "1")
+
+		handler.SetLadder(c)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		var response ErrorOutput
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, "INVALID_STAKE_LADDER", response.Code)
+	})
+
+	t.Run("missing levels", func(t *testing.T) {
+		mockService := new(MockStakeService)
+		handler := NewStakeHandler(mockService, slog.Default())
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = newRuleRequest(t, http.MethodPut, "/stake-ladders/nlhe", `{"levels":[]}`)
+		c.Params = gin.Params{{Key: "gameType", Value: "nlhe"}}
+		c.Set("userID", "1")
+
+		handler.SetLadder(c)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		mockService.AssertNotCalled(t, "SetLadder", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,89 @@
+package bankroll
+
+import (
+	"time"
+
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
+)
+
+// stakeGameTypes mirrors the session game types, in the order advice is
+// reported. The session package depends on this one, so they cannot be
+// shared.
+var stakeGameTypes = []string{"nlhe", "plo", "mtt", "sng", "spin"}
+
+func isStakeGameType(gameType string) bool {
+	for _, g := range stakeGameTypes {
+		if g == gameType {
+			return true
+		}
+	}
+	return false
+}
+
+// defaultRequiredBuyIns is the bankroll, in buy-ins, a stake level needs when
+// the bankroll has no min_buy_ins rule for the game: 40 for cash games, 100
+// for tournaments.
+var defaultRequiredBuyIns = map[string]domain.Decimal{
+	"nlhe": domain.NewDecimalFromInt(40),
+	"plo":  domain.NewDecimalFromInt(40),
+	"mtt":  domain.NewDecimalFromInt(100),
+	"sng":  domain.NewDecimalFromInt(100),
+	"spin": domain.NewDecimalFromInt(100),
+}
+
+// shotShare is the part of the next level's required balance a bankroll
+// needs before a move-up shot is allowed.
+var shotShare = domain.MustParseDecimal("0.5")
+
+type defaultStakeLevel struct {
+	stakes string
+	buyIn  string
+}
+
+// defaultStakeLadderCurrency is the currency of the default ladders and of
+// custom ones saved without a currency.
+const defaultStakeLadderCurrency = CurrencyUSD
+
+// defaultStakeLadders are used for every game type a user has not
+// customized. Cash buy-ins are 100 big blinds.
+var defaultStakeLadders = map[string][]defaultStakeLevel{
+	"nlhe": {{"NL2", "2"}, {"NL5", "5"}, {"NL10", "10"}, {"NL25", "25"}, {"NL50", "50"}, {"NL100", "100"}, {"NL200", "200"}, {"NL500", "500"}},
+	"plo":  {{"PLO2", "2"}, {"PLO5", "5"}, {"PLO10", "10"}, {"PLO25", "25"}, {"PLO50", "50"}, {"PLO100", "100"}, {"PLO200", "200"}, {"PLO500", "500"}},
+	"mtt":  {{"MTT1", "1.10"}, {"MTT2", "2.20"}, {"MTT5", "5.50"}, {"MTT11", "11"}, {"MTT22", "22"}, {"MTT55", "55"}, {"MTT109", "109"}, {"MTT215", "215"}},
+	"sng":  {{"SNG1", "1.50"}, {"SNG3", "3.50"}, {"SNG7", "7.50"}, {"SNG15", "15"}, {"SNG30", "30"}, {"SNG60", "60"}},
+	"spin": {{"SPIN0.25", "0.25"}, {"SPIN1", "1"}, {"SPIN3", "3"}, {"SPIN5", "5"}, {"SPIN10", "10"}, {"SPIN25", "25"}},
+}
+
+func defaultStakeLadder(gameType string) []*StakeLevel {
+	defaults := defaultStakeLadders[gameType]
+	levels := make([]*StakeLevel, len(defaults))
+	for i, d := range defaults {
+		levels[i] = &StakeLevel{
+			GameType: gameType,
+			Currency: defaultStakeLadderCurrency,
+			Stakes:   d.stakes,
+			BuyIn:    domain.MustParseDecimal(d.buyIn),
+			Position: i,
+		}
+	}
+	return levels
+}
+
+// StakeLevel is one rung of a user's stake ladder for a game type. Levels are
+// ordered by Position, which follows increasing buy-ins, and all levels of a
+// ladder share the Currency their buy-ins are in.
+type StakeLevel struct {
+	ID        uint           `gorm:"primaryKey;autoIncrement"`
+	UserID    uint           `gorm:"not null;index:idx_stake_levels_user_game"`
+	GameType  string         `gorm:"type:varchar(10);not null;index:idx_stake_levels_user_game"`
+	Currency  Currency       `gorm:"type:varchar(10);not null;default:USD"`
+	Stakes    string         `gorm:"type:varchar(50);not null"`
+	BuyIn     domain.Decimal `gorm:"type:decimal(27,8);not null"`
+	Position  int            `gorm:"not null"`
+	CreatedAt time.Time      `gorm:"autoCreateTime"`
+	UpdatedAt time.Time      `gorm:"autoUpdateTime"`
+}
+
+func (StakeLevel) TableName() string {
+	return "stake_levels"
+}
@@ -0,0 +1,67 @@
+package bankroll
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+)
+
+type postgresStakeRepository struct {
+	db *gorm.DB
+}
+
+func NewPostgresStakeRepository(db *gorm.DB) StakeRepository {
+	return &postgresStakeRepository{
+		db: db,
+	}
+}
+
+func (r *postgresStakeRepository) ListByUserID(ctx context.Context, userID uint) ([]*StakeLevel, error) {
+	var levels []*StakeLevel
+	err := r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Order("game_type, position").
+		Find(&levels).Error
+	if err != nil {
+		return nil, WrapError(ErrDatabaseError, err.Error())
+	}
+	return levels, nil
+}
+
+func (r *postgresStakeRepository) ListByGameType(ctx context.Context, userID uint, gameType string) ([]*StakeLevel, error) {
+	var levels []*StakeLevel
+	err := r.db.WithContext(ctx).
+		Where("user_id = ? AND game_type = ?", userID, gameType).
+		Order("position").
+		Find(&levels).Error
+	if err != nil {
+		return nil, WrapError(ErrDatabaseError, err.Error())
+	}
+	return levels, nil
+}
+
+// ReplaceLadder swaps the user's ladder for the game type with levels in one
+// transaction.
+func (r *postgresStakeRepository) ReplaceLadder(ctx context.Context, userID uint, gameType string, levels []*StakeLevel) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Where("user_id = ? AND game_type = ?", userID, gameType).Delete(&StakeLevel{}).Error
+		if err != nil {
+			return err
+		}
+		return tx.Create(&levels).Error
+	})
+	if err != nil {
+		return WrapError(ErrDatabaseError, err.Error())
+	}
+	return nil
+}
+
+func (r *postgresStakeRepository) DeleteLadder(ctx context.Context, userID uint, gameType string) error {
+	err := r.db.WithContext(ctx).
+		Where("user_id = ? AND game_type = ?", userID, gameType).
+		Delete(&StakeLevel{}).Error
+	if err != nil {
+		return WrapError(ErrDatabaseError, err.Error())
+	}
+	return nil
+}
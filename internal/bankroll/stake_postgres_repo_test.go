@@ -0,0 +1,48 @@
+package bankroll
+
+import (
+	"context"
+	"testing"
+
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostgresStakeRepository_ReplaceAndDelete(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewPostgresStakeRepository(db)
+	ctx := context.Background()
+
+	ladder := func(stakes ...string) []*StakeLevel {
+		levels := make([]*StakeLevel, len(stakes))
+		for i, s := range stakes {
+			levels[i] = &StakeLevel{UserID: 1, GameType: "nlhe", Stakes: s, BuyIn: domain.NewDecimalFromInt(int64(i + 1)), Position: i}
+		}
+		return levels
+	}
+
+	require.NoError(t, repo.ReplaceLadder(ctx, 1, "nlhe", ladder("A", "B", "C")))
+	require.NoError(t, repo.ReplaceLadder(ctx, 1, "nlhe", ladder("X", "Y")))
+	require.NoError(t, repo.ReplaceLadder(ctx, 2, "nlhe", []*StakeLevel{{UserID: 2, GameType: "nlhe", Stakes: "Z", BuyIn: domain.NewDecimalFromInt(1)}}))
+
+	levels, err := repo.ListByGameType(ctx, 1, "nlhe")
+	require.NoError(t, err)
+	require.Len(t, levels, 2)
+	assert.Equal(t, "X", levels[0].Stakes)
+	assert.Equal(t, "Y", levels[1].Stakes)
+	assert.Equal(t, CurrencyUSD, levels[0].Currency, "ladders saved without a currency are in USD")
+
+	all, err := repo.ListByUserID(ctx, 1)
+	require.NoError(t, err)
+	assert.Len(t, all, 2)
+
+	require.NoError(t, repo.DeleteLadder(ctx, 1, "nlhe"))
+	levels, err = repo.ListByGameType(ctx, 1, "nlhe")
+	require.NoError(t, err)
+	assert.Empty(t, levels)
+
+	levels, err = repo.ListByGameType(ctx, 2, "nlhe")
+	require.NoError(t, err)
+	assert.Len(t, levels, 1, "other users' ladders are untouched")
+}
@@ -0,0 +1,10 @@
+package bankroll
+
+import "context"
+
+type StakeRepository interface {
+	ListByUserID(ctx context.Context, userID uint) ([]*StakeLevel, error)
+	ListByGameType(ctx context.Context, userID uint, gameType string) ([]*StakeLevel, error)
+	ReplaceLadder(ctx context.Context, userID uint, gameType string, levels []*StakeLevel) error
+	DeleteLadder(ctx context.Context, userID uint, gameType string) error
+}
@@ -0,0 +1,320 @@
+package bankroll
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
+	"github.com/opinedajr/micro-stakes-api/internal/fx"
+)
+
+const stakeBuyInScale int32 = 2
+
+type StakeService interface {
+	ListLadders(ctx context.Context, userID uint) ([]*StakeLadderOutput, error)
+	GetLadder(ctx context.Context, userID uint, gameType string) (*StakeLadderOutput, error)
+	SetLadder(ctx context.Context, userID uint, gameType string, input StakeLadderInput) (*StakeLadderOutput, error)
+	ResetLadder(ctx context.Context, userID uint, gameType string) (*StakeLadderOutput, error)
+	GetAdvice(ctx context.Context, userID uint, bankrollID uint, input StakeAdviceInput) (*StakeAdviceOutput, error)
+}
+
+type stakeService struct {
+	repo         StakeRepository
+	bankrollRepo BankrollRepository
+	ruleRepo     RuleRepository
+	rates        fx.Converter
+	logger       *slog.Logger
+}
+
+func NewStakeService(repo StakeRepository, bankrollRepo BankrollRepository, ruleRepo RuleRepository, rates fx.Converter, logger *slog.Logger) StakeService {
+	return &stakeService{
+		repo:         repo,
+		bankrollRepo: bankrollRepo,
+		ruleRepo:     ruleRepo,
+		rates:        rates,
+		logger:       logger,
+	}
+}
+
+func (s *stakeService) ListLadders(ctx context.Context, userID uint) ([]*StakeLadderOutput, error) {
+	ladders, err := s.ladders(ctx, userID)
+	if err != nil {
+		s.logger.Error("failed to list stake ladders", "error", err, "user_id", userID)
+		return nil, err
+	}
+
+	outputs := make([]*StakeLadderOutput, len(stakeGameTypes))
+	for i, gameType := range stakeGameTypes {
+		outputs[i] = toStakeLadderOutput(gameType, ladders[gameType])
+	}
+	return outputs, nil
+}
+
+func (s *stakeService) GetLadder(ctx context.Context, userID uint, gameType string) (*StakeLadderOutput, error) {
+	if !isStakeGameType(gameType) {
+		return nil, ErrInvalidGameType
+	}
+
+	levels, err := s.repo.ListByGameType(ctx, userID, gameType)
+	if err != nil {
+		s.logger.Error("failed to get stake ladder", "error", err, "user_id", userID, "game_type", gameType)
+		return nil, err
+	}
+	return toStakeLadderOutput(gameType, levels), nil
+}
+
+func (s *stakeService) SetLadder(ctx context.Context, userID uint, gameType string, input StakeLadderInput) (*StakeLadderOutput, error) {
+	if !isStakeGameType(gameType) {
+		s.logger.Error("invalid game type", "game_type", gameType, "user_id", userID)
+		return nil, ErrInvalidGameType
+	}
+
+	levels, err := buildStakeLadder(userID, gameType, input)
+	if err != nil {
+		s.logger.Error("invalid stake ladder", "error", err, "user_id", userID, "game_type", gameType)
+		return nil, err
+	}
+
+	if err := s.repo.ReplaceLadder(ctx, userID, gameType, levels); err != nil {
+		s.logger.Error("failed to save stake ladder", "error", err, "user_id", userID, "game_type", gameType)
+		return nil, err
+	}
+
+	s.logger.Info("stake ladder set", "user_id", userID, "game_type", gameType, "levels", len(levels))
+
+	return toStakeLadderOutput(gameType, levels), nil
+}
+
+func (s *stakeService) ResetLadder(ctx context.Context, userID uint, gameType string) (*StakeLadderOutput, error) {
+	if !isStakeGameType(gameType) {
+		s.logger.Error("invalid game type", "game_type", gameType, "user_id", userID)
+		return nil, ErrInvalidGameType
+	}
+
+	if err := s.repo.DeleteLadder(ctx, userID, gameType); err != nil {
+		s.logger.Error("failed to reset stake ladder", "error", err, "user_id", userID, "game_type", gameType)
+		return nil, err
+	}
+
+	s.logger.Info("stake ladder reset", "user_id", userID, "game_type", gameType)
+
+	return toStakeLadderOutput(gameType, nil), nil
+}
+
+// GetAdvice measures the bankroll's current balance against every level of
+// the ladder of each game type, or only of input.GameType when set. Ladders in
+// another currency are converted at today's rate.
+func (s *stakeService) GetAdvice(ctx context.Context, userID uint, bankrollID uint, input StakeAdviceInput) (*StakeAdviceOutput, error) {
+	gameTypes := stakeGameTypes
+	if input.GameType != "" {
+		if !isStakeGameType(input.GameType) {
+			s.logger.Error("invalid game type", "game_type", input.GameType, "user_id", userID)
+			return nil, ErrInvalidGameType
+		}
+		gameTypes = []string{input.GameType}
+	}
+
+	bankroll, err := s.bankrollRepo.FindByID(ctx, bankrollID, userID)
+	if err != nil {
+		s.logger.Error("bankroll not found", "error", err, "user_id", userID, "bankroll_id", bankrollID)
+		return nil, err
+	}
+
+	ladders, err := s.ladders(ctx, userID)
+	if err != nil {
+		s.logger.Error("failed to list stake ladders", "error", err, "user_id", userID)
+		return nil, err
+	}
+
+	rules, err := s.ruleRepo.ListByBankrollID(ctx, bankrollID, userID)
+	if err != nil {
+		s.logger.Error("failed to list rules", "error", err, "user_id", userID, "bankroll_id", bankrollID)
+		return nil, err
+	}
+
+	output := &StakeAdviceOutput{
+		BankrollID: bankroll.ID,
+		Currency:   bankroll.Currency,
+		Balance:    bankroll.Currency.Round(bankroll.CurrentBalance),
+		Games:      make([]GameStakeAdviceOutput, 0, len(gameTypes)),
+	}
+	rates := make(map[Currency]domain.Decimal)
+	for _, gameType := range gameTypes {
+		levels := ladders[gameType]
+		if len(levels) == 0 {
+			levels = defaultStakeLadder(gameType)
+		}
+		from := levels[0].Currency
+		rate, ok := rates[from]
+		if !ok {
+			rate, err = s.rate(ctx, from, bankroll.Currency)
+			if err != nil {
+				s.logger.Error("exchange rate not available", "error", err, "from", from, "to", bankroll.Currency, "user_id", userID)
+				return nil, err
+			}
+			rates[from] = rate
+		}
+		buyIns, source := requiredBuyIns(rules, gameType)
+		output.Games = append(output.Games, adviseStakes(bankroll, gameType, levels, rate, buyIns, source))
+	}
+
+	return output, nil
+}
+
+// rate converts ladder buy-ins in from into the bankroll's currency.
+func (s *stakeService) rate(ctx context.Context, from Currency, to Currency) (domain.Decimal, error) {
+	if from == to {
+		return domain.NewDecimalFromInt(1), nil
+	}
+	rate, err := s.rates.Rate(ctx, from, to, time.Now().UTC())
+	switch {
+	case err == nil:
+		return rate, nil
+	case errors.Is(err, fx.ErrRateNotFound):
+		return domain.Zero, ErrExchangeRateNotFound
+	case errors.Is(err, fx.ErrInvalidRate):
+		return domain.Zero, ErrInvalidExchangeRate
+	default:
+		return domain.Zero, WrapError(ErrDatabaseError, err.Error())
+	}
+}
+
+// ladders returns the user's custom ladders keyed by game type.
+func (s *stakeService) ladders(ctx context.Context, userID uint) (map[string][]*StakeLevel, error) {
+	levels, err := s.repo.ListByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	ladders := make(map[string][]*StakeLevel)
+	for _, level := range levels {
+		ladders[level.GameType] = append(ladders[level.GameType], level)
+	}
+	return ladders, nil
+}
+
+func buildStakeLadder(userID uint, gameType string, input StakeLadderInput) ([]*StakeLevel, error) {
+	if len(input.Levels) == 0 {
+		return nil, WrapError(ErrInvalidStakeLadder, "at least one stake level is required")
+	}
+	currency := input.Currency
+	if currency == "" {
+		currency = defaultStakeLadderCurrency
+	}
+	if !currency.IsValid() {
+		return nil, ErrInvalidCurrency
+	}
+
+	levels := make([]*StakeLevel, len(input.Levels))
+	names := make(map[string]bool, len(input.Levels))
+	for i, in := range input.Levels {
+		stakes := strings.TrimSpace(in.Stakes)
+		if stakes == "" || names[stakes] {
+			return nil, WrapError(ErrInvalidStakeLadder, "stake names must be unique and not empty")
+		}
+		names[stakes] = true
+		if !in.BuyIn.IsPositive() || !in.BuyIn.FitsScale(stakeBuyInScale) {
+			return nil, WrapError(ErrInvalidStakeLadder, "buy-ins must be positive with at most 2 decimal places")
+		}
+		levels[i] = &StakeLevel{UserID: userID, GameType: gameType, Currency: currency, Stakes: stakes, BuyIn: in.BuyIn}
+	}
+
+	sort.SliceStable(levels, func(i, j int) bool {
+		return levels[i].BuyIn.LessThan(levels[j].BuyIn)
+	})
+	for i, level := range levels {
+		if i > 0 && !level.BuyIn.GreaterThan(levels[i-1].BuyIn) {
+			return nil, WrapError(ErrInvalidStakeLadder, "buy-ins must be distinct")
+		}
+		level.Position = i
+	}
+	return levels, nil
+}
+
+// requiredBuyIns picks the min_buy_ins rule scoped to the game type, then an
+// unscoped one, and falls back to the game's default. Rules narrowed to a
+// single stake level do not describe the whole ladder and are ignored.
+func requiredBuyIns(rules []*BankrollRule, gameType string) (domain.Decimal, string) {
+	var unscoped *BankrollRule
+	for _, rule := range rules {
+		if rule.Type != RuleTypeMinBuyIns || rule.Stakes != "" {
+			continue
+		}
+		if rule.GameType == gameType {
+			return rule.Value, "rule"
+		}
+		if rule.GameType == "" && unscoped == nil {
+			unscoped = rule
+		}
+	}
+	if unscoped != nil {
+		return unscoped.Value, "rule"
+	}
+	return defaultRequiredBuyIns[gameType], "default"
+}
+
+// adviseStakes recommends the highest level the balance covers with buyIns
+// buy-ins, each converted at rate. A shot at the next level is allowed from
+// shotShare of its required balance, and dropping below the recommended
+// level's requirement makes a move down mandatory.
+func adviseStakes(bankroll *Bankroll, gameType string, levels []*StakeLevel, rate domain.Decimal, buyIns domain.Decimal, source string) GameStakeAdviceOutput {
+	currency := bankroll.Currency
+	balance := bankroll.CurrentBalance
+
+	advice := GameStakeAdviceOutput{
+		GameType:       gameType,
+		LadderCurrency: levels[0].Currency,
+		ExchangeRate:   rate,
+		RequiredBuyIns: buyIns,
+		BuyInsSource:   source,
+		Levels:         make([]StakeLevelAdviceOutput, len(levels)),
+	}
+	current := -1
+	for i, level := range levels {
+		required := currency.Round(level.BuyIn.Mul(rate).Mul(buyIns))
+		playable := balance.GreaterThanOrEqual(required)
+		if playable {
+			current = i
+		}
+		advice.Levels[i] = StakeLevelAdviceOutput{
+			Stakes:          level.Stakes,
+			BuyIn:           level.BuyIn,
+			RequiredBalance: required,
+			Playable:        playable,
+		}
+	}
+
+	if current >= 0 {
+		advice.Recommended = advice.Levels[current].Stakes
+		advice.MoveDown = &MoveDownAdviceOutput{Balance: advice.Levels[current].RequiredBalance}
+		if current > 0 {
+			advice.MoveDown.Stakes = advice.Levels[current-1].Stakes
+		}
+	}
+	if next := current + 1; next < len(advice.Levels) {
+		shot := currency.Round(advice.Levels[next].RequiredBalance.Mul(shotShare))
+		advice.MoveUp = &MoveUpAdviceOutput{
+			Stakes:          advice.Levels[next].Stakes,
+			RequiredBalance: advice.Levels[next].RequiredBalance,
+			ShotBalance:     shot,
+			ShotAllowed:     balance.GreaterThanOrEqual(shot),
+		}
+	}
+	return advice
+}
+
+func toStakeLadderOutput(gameType string, levels []*StakeLevel) *StakeLadderOutput {
+	output := &StakeLadderOutput{GameType: gameType, Custom: len(levels) > 0}
+	if !output.Custom {
+		levels = defaultStakeLadder(gameType)
+	}
+	output.Currency = levels[0].Currency
+	output.Levels = make([]StakeLevelOutput, len(levels))
+	for i, level := range levels {
+		output.Levels[i] = StakeLevelOutput{Stakes: level.Stakes, BuyIn: level.BuyIn}
+	}
+	return output
+}
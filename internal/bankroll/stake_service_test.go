@@ -0,0 +1,265 @@
+package bankroll
+
+import (
+	"context"
+	"testing"
+
+	"log/slog"
+
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
+	"github.com/opinedajr/micro-stakes-api/internal/fx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type MockStakeRepository struct {
+	mock.Mock
+}
+
+func (m *MockStakeRepository) ListByUserID(ctx context.Context, userID uint) ([]*StakeLevel, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*StakeLevel), args.Error(1)
+}
+
+func (m *MockStakeRepository) ListByGameType(ctx context.Context, userID uint, gameType string) ([]*StakeLevel, error) {
+	args := m.Called(ctx, userID, gameType)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*StakeLevel), args.Error(1)
+}
+
+func (m *MockStakeRepository) ReplaceLadder(ctx context.Context, userID uint, gameType string, levels []*StakeLevel) error {
+	args := m.Called(ctx, userID, gameType, levels)
+	return args.Error(0)
+}
+
+func (m *MockStakeRepository) DeleteLadder(ctx context.Context, userID uint, gameType string) error {
+	args := m.Called(ctx, userID, gameType)
+	return args.Error(0)
+}
+
+func newStakeServiceForTest() (StakeService, *MockStakeRepository, *MockBankrollRepository, *MockRuleRepository) {
+	repo := new(MockStakeRepository)
+	bankrollRepo := new(MockBankrollRepository)
+	ruleRepo := new(MockRuleRepository)
+	return NewStakeService(repo, bankrollRepo, ruleRepo, new(MockConverter), slog.Default()), repo, bankrollRepo, ruleRepo
+}
+
+func stakeTestBankroll() *Bankroll {
+	bankroll := ruleTestBankroll()
+	bankroll.Currency = CurrencyUSD
+	return bankroll
+}
+
+func TestGetStakeAdvice(t *testing.T) {
+	t.Run("default ladder and buy-ins", func(t *testing.T) {
+		service, repo, bankrollRepo, ruleRepo := newStakeServiceForTest()
+
+		bankrollRepo.On("FindByID", mock.Anything, uint(1), uint(1)).Return(stakeTestBankroll(), nil).Once()
+		repo.On("ListByUserID", mock.Anything, uint(1)).Return([]*StakeLevel{}, nil).Once()
+		ruleRepo.On("ListByBankrollID", mock.Anything, uint(1), uint(1)).Return([]*BankrollRule{}, nil).Once()
+
+		output, err := service.GetAdvice(context.Background(), 1, 1, StakeAdviceInput{GameType: "nlhe"})
+
+		require.NoError(t, err)
+		assert.Equal(t, "900.00", output.Balance.String())
+		require.Len(t, output.Games, 1)
+		advice := output.Games[0]
+		assert.Equal(t, "40", advice.RequiredBuyIns.String())
+		assert.Equal(t, "default", advice.BuyInsSource)
+		assert.Equal(t, "NL10", advice.Recommended)
+		assert.True(t, advice.Levels[2].Playable)
+		assert.False(t, advice.Levels[3].Playable)
+		require.NotNil(t, advice.MoveDown)
+		assert.Equal(t, "400.00", advice.MoveDown.Balance.String())
+		assert.Equal(t, "NL5", advice.MoveDown.Stakes)
+		require.NotNil(t, advice.MoveUp)
+		assert.Equal(t, "NL25", advice.MoveUp.Stakes)
+		assert.Equal(t, "1000.00", advice.MoveUp.RequiredBalance.String())
+		assert.Equal(t, "500.00", advice.MoveUp.ShotBalance.String())
+		assert.True(t, advice.MoveUp.ShotAllowed)
+	})
+
+	t.Run("game scoped min buy-ins rule wins", func(t *testing.T) {
+		service, repo, bankrollRepo, ruleRepo := newStakeServiceForTest()
+
+		scoped := testRule(3, RuleTypeMinBuyIns, "200", RuleSeverityHard)
+		scoped.GameType = "mtt"
+		bankrollRepo.On("FindByID", mock.Anything, uint(1), uint(1)).Return(stakeTestBankroll(), nil).Once()
+		repo.On("ListByUserID", mock.Anything, uint(1)).Return([]*StakeLevel{}, nil).Once()
+		ruleRepo.On("ListByBankrollID", mock.Anything, uint(1), uint(1)).Return([]*BankrollRule{
+			testRule(2, RuleTypeMinBuyIns, "60", RuleSeveritySoft),
+			scoped,
+		}, nil).Once()
+
+		output, err := service.GetAdvice(context.Background(), 1, 1, StakeAdviceInput{})
+
+		require.NoError(t, err)
+		require.Len(t, output.Games, len(stakeGameTypes))
+		nlhe, mtt := output.Games[0], output.Games[2]
+		assert.Equal(t, "60", nlhe.RequiredBuyIns.String())
+		assert.Equal(t, "rule", nlhe.BuyInsSource)
+		assert.Equal(t, "mtt", mtt.GameType)
+		assert.Equal(t, "200", mtt.RequiredBuyIns.String())
+		assert.Equal(t, "MTT2", mtt.Recommended)
+		assert.Equal(t, "440.00", mtt.MoveDown.Balance.String())
+		assert.Equal(t, "550.00", mtt.MoveUp.ShotBalance.String())
+	})
+
+	t.Run("custom ladder with nothing playable", func(t *testing.T) {
+		service, repo, bankrollRepo, ruleRepo := newStakeServiceForTest()
+
+		bankrollRepo.On("FindByID", mock.Anything, uint(1), uint(1)).Return(stakeTestBankroll(), nil).Once()
+		repo.On("ListByUserID", mock.Anything, uint(1)).Return([]*StakeLevel{
+			{UserID: 1, GameType: "nlhe", Currency: CurrencyUSD, Stakes: "NL50", BuyIn: domain.MustParseDecimal("50"), Position: 0},
+			{UserID: 1, GameType: "nlhe", Currency: CurrencyUSD, Stakes: "NL100", BuyIn: domain.MustParseDecimal("100"), Position: 1},
+		}, nil).Once()
+		ruleRepo.On("ListByBankrollID", mock.Anything, uint(1), uint(1)).Return([]*BankrollRule{}, nil).Once()
+
+		output, err := service.GetAdvice(context.Background(), 1, 1, StakeAdviceInput{GameType: "nlhe"})
+
+		require.NoError(t, err)
+		advice := output.Games[0]
+		assert.Len(t, advice.Levels, 2)
+		assert.Empty(t, advice.Recommended)
+		assert.Nil(t, advice.MoveDown)
+		require.NotNil(t, advice.MoveUp)
+		assert.Equal(t, "NL50", advice.MoveUp.Stakes)
+		assert.False(t, advice.MoveUp.ShotAllowed)
+	})
+
+	t.Run("ladders are converted into the bankroll currency", func(t *testing.T) {
+		repo := new(MockStakeRepository)
+		bankrollRepo := new(MockBankrollRepository)
+		ruleRepo := new(MockRuleRepository)
+		rates := new(MockConverter)
+		service := NewStakeService(repo, bankrollRepo, ruleRepo, rates, slog.Default())
+
+		bankrollRepo.On("FindByID", mock.Anything, uint(1), uint(1)).Return(ruleTestBankroll(), nil).Once()
+		repo.On("ListByUserID", mock.Anything, uint(1)).Return([]*StakeLevel{
+			{UserID: 1, GameType: "plo", Currency: CurrencyBRL, Stakes: "PLO10", BuyIn: domain.MustParseDecimal("10"), Position: 0},
+			{UserID: 1, GameType: "plo", Currency: CurrencyBRL, Stakes: "PLO25", BuyIn: domain.MustParseDecimal("25"), Position: 1},
+		}, nil).Once()
+		ruleRepo.On("ListByBankrollID", mock.Anything, uint(1), uint(1)).Return([]*BankrollRule{}, nil).Once()
+		rates.On("Rate", mock.Anything, CurrencyUSD, CurrencyBRL, mock.Anything).Return(domain.MustParseDecimal("5"), nil).Once()
+
+		output, err := service.GetAdvice(context.Background(), 1, 1, StakeAdviceInput{})
+
+		require.NoError(t, err)
+		nlhe, plo := output.Games[0], output.Games[1]
+		assert.Equal(t, CurrencyUSD, nlhe.LadderCurrency)
+		assert.Equal(t, "5", nlhe.ExchangeRate.String())
+		assert.Equal(t, "2", nlhe.Levels[0].BuyIn.String())
+		assert.Equal(t, "400.00", nlhe.Levels[0].RequiredBalance.String())
+		assert.Equal(t, "NL2", nlhe.Recommended)
+		assert.Equal(t, "1000.00", nlhe.MoveUp.RequiredBalance.String())
+		assert.Equal(t, CurrencyBRL, plo.LadderCurrency)
+		assert.Equal(t, "PLO10", plo.Recommended)
+		rates.AssertExpectations(t)
+	})
+
+	t.Run("missing exchange rate", func(t *testing.T) {
+		repo := new(MockStakeRepository)
+		bankrollRepo := new(MockBankrollRepository)
+		ruleRepo := new(MockRuleRepository)
+		rates := new(MockConverter)
+		service := NewStakeService(repo, bankrollRepo, ruleRepo, rates, slog.Default())
+
+		bankrollRepo.On("FindByID", mock.Anything, uint(1), uint(1)).Return(ruleTestBankroll(), nil).Once()
+		repo.On("ListByUserID", mock.Anything, uint(1)).Return([]*StakeLevel{}, nil).Once()
+		ruleRepo.On("ListByBankrollID", mock.Anything, uint(1), uint(1)).Return([]*BankrollRule{}, nil).Once()
+		rates.On("Rate", mock.Anything, CurrencyUSD, CurrencyBRL, mock.Anything).Return(domain.Zero, fx.ErrRateNotFound).Once()
+
+		_, err := service.GetAdvice(context.Background(), 1, 1, StakeAdviceInput{GameType: "mtt"})
+
+		assert.ErrorIs(t, err, ErrExchangeRateNotFound)
+	})
+
+	t.Run("invalid game type", func(t *testing.T) {
+		service, _, bankrollRepo, _ := newStakeServiceForTest()
+
+		_, err := service.GetAdvice(context.Background(), 1, 1, StakeAdviceInput{GameType: "stud"})
+
+		assert.ErrorIs(t, err, ErrInvalidGameType)
+		bankrollRepo.AssertNotCalled(t, "FindByID", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestSetStakeLadder(t *testing.T) {
+	t.Run("levels are ordered by buy-in", func(t *testing.T) {
+		service, repo, _, _ := newStakeServiceForTest()
+
+		repo.On("ReplaceLadder", mock.Anything, uint(1), "spin", mock.MatchedBy(func(levels []*StakeLevel) bool {
+			return len(levels) == 2 && levels[0].Stakes == "S1" && levels[0].Position == 0 && levels[1].Position == 1 &&
+				levels[0].Currency == CurrencyEUR && levels[1].Currency == CurrencyEUR
+		})).Return(nil).Once()
+
+		output, err := service.SetLadder(context.Background(), 1, "spin", StakeLadderInput{Currency: CurrencyEUR, Levels: []StakeLevelInput{
+			{Stakes: "S5", BuyIn: domain.MustParseDecimal("5")},
+			{Stakes: " S1 ", BuyIn: domain.MustParseDecimal("1")},
+		}})
+
+		require.NoError(t, err)
+		assert.True(t, output.Custom)
+		assert.Equal(t, CurrencyEUR, output.Currency)
+		assert.Equal(t, "S1", output.Levels[0].Stakes)
+		repo.AssertExpectations(t)
+	})
+
+	tests := []struct {
+		name   string
+		levels []StakeLevelInput
+	}{
+		{"duplicate stakes", []StakeLevelInput{{Stakes: "NL2", BuyIn: domain.MustParseDecimal("2")}, {Stakes: "NL2", BuyIn: domain.MustParseDecimal("5")}}},
+		{"duplicate buy-ins", []StakeLevelInput{{Stakes: "A", BuyIn: domain.MustParseDecimal("2")}, {Stakes: "B", BuyIn: domain.MustParseDecimal("2.00")}}},
+		{"non positive buy-in", []StakeLevelInput{{Stakes: "A", BuyIn: domain.Zero}}},
+		{"too many decimals", []StakeLevelInput{{Stakes: "A", BuyIn: domain.MustParseDecimal("0.125")}}},
+		{"empty", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, repo, _, _ := newStakeServiceForTest()
+
+			_, err := service.SetLadder(context.Background(), 1, "nlhe", StakeLadderInput{Levels: tt.levels})
+
+			assert.ErrorIs(t, err, ErrInvalidStakeLadder)
+			repo.AssertNotCalled(t, "ReplaceLadder", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+
+	t.Run("defaults to USD and rejects unknown currencies", func(t *testing.T) {
+		service, repo, _, _ := newStakeServiceForTest()
+		levels := []StakeLevelInput{{Stakes: "NL2", BuyIn: domain.MustParseDecimal("2")}}
+
+		repo.On("ReplaceLadder", mock.Anything, uint(1), "nlhe", mock.MatchedBy(func(levels []*StakeLevel) bool {
+			return levels[0].Currency == CurrencyUSD
+		})).Return(nil).Once()
+
+		output, err := service.SetLadder(context.Background(), 1, "nlhe", StakeLadderInput{Levels: levels})
+		require.NoError(t, err)
+		assert.Equal(t, CurrencyUSD, output.Currency)
+
+		_, err = service.SetLadder(context.Background(), 1, "nlhe", StakeLadderInput{Currency: "XYZ", Levels: levels})
+		assert.ErrorIs(t, err, ErrInvalidCurrency)
+		repo.AssertNumberOfCalls(t, "ReplaceLadder", 1)
+	})
+}
+
+func TestResetStakeLadder(t *testing.T) {
+	service, repo, _, _ := newStakeServiceForTest()
+
+	repo.On("DeleteLadder", mock.Anything, uint(1), "plo").Return(nil).Once()
+
+	output, err := service.ResetLadder(context.Background(), 1, "plo")
+
+	require.NoError(t, err)
+	assert.False(t, output.Custom)
+	assert.Equal(t, CurrencyUSD, output.Currency)
+	assert.Equal(t, "PLO2", output.Levels[0].Stakes)
+	repo.AssertExpectations(t)
+}
@@ -0,0 +1,45 @@
+package bankroll
+
+import (
+	"net/http"
+	"strconv"
+
+	"log/slog"
+
+	"github.com/gin-gonic/gin"
+)
+
+type StatsHandler struct {
+	service StatsService
+	logger  *slog.Logger
+}
+
+func NewStatsHandler(service StatsService, logger *slog.Logger) *StatsHandler {
+	return &StatsHandler{
+		service: service,
+		logger:  logger,
+	}
+}
+
+func (h *StatsHandler) GetStats(c *gin.Context) {
+	userID, err := getUserID(c)
+	if err != nil {
+		handleError(c, h.logger, err)
+		return
+	}
+
+	bankrollIDStr := c.Param("bankrollId")
+	bankrollID, err := strconv.ParseUint(bankrollIDStr, 10, 32)
+	if err != nil {
+		handleError(c, h.logger, ErrUnauthorized)
+		return
+	}
+
+	output, err := h.service.GetStats(c.Request.Context(), userID, uint(bankrollID))
+	if err != nil {
+		handleError(c, h.logger, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
@@ -0,0 +1,76 @@
+package bankroll
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"log/slog"
+
+	"github.com/gin-gonic/gin"
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type MockStatsService struct {
+	mock.Mock
+}
+
+func (m *MockStatsService) GetStats(ctx context.Context, userID uint, bankrollID uint) (*StatsOutput, error) {
+	args := m.Called(ctx, userID, bankrollID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*StatsOutput), args.Error(1)
+}
+
+func TestGetStatsHandler(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		mockService := new(MockStatsService)
+		handler := NewStatsHandler(mockService, slog.Default())
+
+		mockService.On("GetStats", mock.Anything, uint(1), uint(2)).Return(&StatsOutput{
+			BankrollID:         2,
+			MaxDrawdown:        domain.MustParseDecimal("120.00"),
+			MaxDrawdownPercent: domain.MustParseDecimal("10.91"),
+			CurrentStreak:      -2,
+		}, nil).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodGet, "/bankrolls/2/stats", nil)
+		c.Params = gin.Params{{Key: "bankrollId", Value: "2"}}
+		c.Set("userID", "1")
+
+		handler.GetStats(c)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		var response map[string]interface{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, "10.91", response["max_drawdown_percent"])
+		assert.Equal(t, -2.0, response["current_streak"])
+		assert.Nil(t, response["last_recovery_days"])
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("bankroll not found", func(t *testing.T) {
+		mockService := new(MockStatsService)
+		handler := NewStatsHandler(mockService, slog.Default())
+
+		mockService.On("GetStats", mock.Anything, uint(1), uint(2)).Return(nil, ErrBankrollNotFound).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodGet, "/bankrolls/2/stats", nil)
+		c.Params = gin.Params{{Key: "bankrollId", Value: "2"}}
+		c.Set("userID", "1")
+
+		handler.GetStats(c)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
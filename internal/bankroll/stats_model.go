@@ -0,0 +1,273 @@
+package bankroll
+
+import (
+	"time"
+
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
+)
+
+// BankrollStats is the running state of a bankroll's drawdown, downswing and
+// streak statistics over its realized result curve, in the order results were
+// played. The curve restarts at SeriesStart, when the bankroll was last reset.
+// The ledger is append-only and every entry up to LastTransactionID has been
+// counted, so a refresh resumes after it, unless a session counted before was
+// edited or deleted since, or a new result was played before LastResultAt.
+type BankrollStats struct {
+	ID                      uint           `gorm:"primaryKey;autoIncrement"`
+	BankrollID              uint           `gorm:"not null;uniqueIndex"`
+	UserID                  uint           `gorm:"not null;index"`
+	LastTransactionID       uint           `gorm:"not null;default:0"`
+	Results                 int            `gorm:"not null;default:0"`
+	NetResult               domain.Decimal `gorm:"type:decimal(27,8);not null"`
+	Peak                    domain.Decimal `gorm:"type:decimal(27,8);not null"`
+	PeakBalance             domain.Decimal `gorm:"type:decimal(27,8);not null"`
+	PeakAt                  time.Time      `gorm:"not null"`
+	DownswingResults        int            `gorm:"not null;default:0"`
+	MaxDrawdown             domain.Decimal `gorm:"type:decimal(27,8);not null"`
+	MaxDrawdownPercent      domain.Decimal `gorm:"type:decimal(10,2);not null"`
+	LongestDownswingResults int            `gorm:"not null;default:0"`
+	LongestDownswingDays    int            `gorm:"not null;default:0"`
+	CurrentStreak           int            `gorm:"not null;default:0"`
+	LongestWinningStreak    int            `gorm:"not null;default:0"`
+	LongestLosingStreak     int            `gorm:"not null;default:0"`
+	LastRecoveryDays        *int
+	LongestRecoveryDays     *int
+	SeriesStart             *time.Time
+	LastResultAt            *time.Time
+	CreatedAt               time.Time `gorm:"autoCreateTime"`
+	UpdatedAt               time.Time `gorm:"autoUpdateTime"`
+}
+
+func (BankrollStats) TableName() string {
+	return "bankroll_stats"
+}
+
+// newBankrollStats starts the curve at zero on the bankroll's start date,
+// which a reset moves to the start of the new run.
+func newBankrollStats(bankroll *Bankroll, seriesStart *time.Time) *BankrollStats {
+	return &BankrollStats{
+		BankrollID:         bankroll.ID,
+		UserID:             bankroll.UserID,
+		SeriesStart:        seriesStart,
+		NetResult:          domain.Zero,
+		Peak:               domain.Zero,
+		PeakBalance:        bankroll.InitialBalance,
+		PeakAt:             bankroll.StartDate,
+		MaxDrawdown:        domain.Zero,
+		MaxDrawdownPercent: domain.Zero,
+	}
+}
+
+// StatsEntry is a result ledger entry placed at the moment it happened.
+// SessionOpen is set for entries of a session that is still being played and
+// SessionDeleted for those of a deleted one. SessionFirstEntryID is the first
+// result entry of the entry's session.
+type StatsEntry struct {
+	ID                  uint
+	Amount              domain.Decimal
+	BalanceAfter        domain.Decimal
+	ReferenceType       string
+	ReferenceID         *uint
+	At                  time.Time
+	SessionOpen         bool
+	SessionDeleted      bool
+	SessionFirstEntryID uint
+}
+
+func (e StatsEntry) sessionID() (uint, bool) {
+	if e.ReferenceType != "session" || e.ReferenceID == nil {
+		return 0, false
+	}
+	return *e.ReferenceID, true
+}
+
+// statsResult is one point of the result curve: a session, a bet settlement
+// or an adjustment. Adjustments move the curve but do not count as plays.
+type statsResult struct {
+	amount       domain.Decimal
+	balanceAfter domain.Decimal
+	at           time.Time
+	play         bool
+}
+
+// statsResults folds new entries, in play order, into results, merging the
+// entries of each session into one result placed at its last entry. Deleted
+// sessions net to zero and are left out. It stops before the first entry of a
+// live session, before any session whose entries straddle that point and
+// before any entry with a higher ID than one held back, since the next
+// refresh resumes by ID. It returns the highest ID consumed.
+func statsResults(entries []StatsEntry, lastID uint) ([]statsResult, uint) {
+	cut := len(entries)
+	for i, entry := range entries {
+		if entry.SessionOpen {
+			cut = i
+			break
+		}
+	}
+	for {
+		first := make(map[uint]int)
+		moved := false
+		for i, entry := range entries {
+			id, ok := entry.sessionID()
+			if !ok {
+				continue
+			}
+			if _, seen := first[id]; !seen {
+				first[id] = i
+			}
+			if i >= cut && first[id] < cut {
+				cut = first[id]
+				moved = true
+			}
+		}
+		if cut < len(entries) {
+			heldBack := entries[cut].ID
+			for _, entry := range entries[cut:] {
+				heldBack = min(heldBack, entry.ID)
+			}
+			for i, entry := range entries[:cut] {
+				if entry.ID > heldBack {
+					cut = i
+					moved = true
+					break
+				}
+			}
+		}
+		if !moved {
+			break
+		}
+	}
+
+	last := make(map[uint]int)
+	for i, entry := range entries[:cut] {
+		if id, ok := entry.sessionID(); ok {
+			last[id] = i
+		}
+	}
+
+	var results []statsResult
+	sums := make(map[uint]domain.Decimal)
+	for i, entry := range entries[:cut] {
+		lastID = max(lastID, entry.ID)
+		id, ok := entry.sessionID()
+		if !ok {
+			results = append(results, statsResult{
+				amount:       entry.Amount,
+				balanceAfter: entry.BalanceAfter,
+				at:           entry.At,
+				play:         entry.ReferenceType == "bet",
+			})
+			continue
+		}
+		if entry.SessionDeleted {
+			continue
+		}
+		sums[id] = sums[id].Add(entry.Amount)
+		if last[id] == i {
+			results = append(results, statsResult{amount: sums[id], balanceAfter: entry.BalanceAfter, at: entry.At, play: true})
+		}
+	}
+	return results, lastID
+}
+
+// revisits reports whether entries change a session whose earlier entries
+// were already folded in up to lastID. The curve then has to be rebuilt,
+// since the session's place in it and its effect on streaks have changed.
+func revisits(entries []StatsEntry, lastID uint) bool {
+	for _, entry := range entries {
+		if _, ok := entry.sessionID(); ok && entry.SessionFirstEntryID != 0 && entry.SessionFirstEntryID <= lastID {
+			return true
+		}
+	}
+	return false
+}
+
+// backdated reports whether entries place a result before the last one
+// counted. The curve then has to be rebuilt in play order.
+func backdated(entries []StatsEntry, lastResultAt *time.Time) bool {
+	if lastResultAt == nil {
+		return false
+	}
+	for _, entry := range entries {
+		if !entry.SessionDeleted && entry.At.Before(*lastResultAt) {
+			return true
+		}
+	}
+	return false
+}
+
+// apply moves the curve by one result. A downswing runs from a peak until
+// the curve climbs back to it; the time that took is a recovery.
+func (s *BankrollStats) apply(result statsResult) {
+	wasDown := s.NetResult.LessThan(s.Peak)
+	s.NetResult = s.NetResult.Add(result.amount)
+	at := result.at
+	s.LastResultAt = &at
+
+	if result.play {
+		s.Results++
+		switch {
+		case result.amount.IsPositive():
+			s.CurrentStreak = max(s.CurrentStreak, 0) + 1
+			s.LongestWinningStreak = max(s.LongestWinningStreak, s.CurrentStreak)
+		case result.amount.IsNegative():
+			s.CurrentStreak = min(s.CurrentStreak, 0) - 1
+			s.LongestLosingStreak = max(s.LongestLosingStreak, -s.CurrentStreak)
+		default:
+			s.CurrentStreak = 0
+		}
+	}
+
+	if !s.NetResult.LessThan(s.Peak) {
+		if wasDown {
+			days := statsDays(s.PeakAt, result.at)
+			s.LongestDownswingDays = max(s.LongestDownswingDays, days)
+			s.LastRecoveryDays = &days
+			if s.LongestRecoveryDays == nil || days > *s.LongestRecoveryDays {
+				longest := days
+				s.LongestRecoveryDays = &longest
+			}
+		}
+		if wasDown || s.NetResult.GreaterThan(s.Peak) {
+			s.Peak = s.NetResult
+			s.PeakBalance = result.balanceAfter
+			s.PeakAt = result.at
+			s.DownswingResults = 0
+		}
+		return
+	}
+
+	if result.play {
+		s.DownswingResults++
+		s.LongestDownswingResults = max(s.LongestDownswingResults, s.DownswingResults)
+	}
+	drawdown := s.Peak.Sub(s.NetResult)
+	if drawdown.GreaterThan(s.MaxDrawdown) {
+		s.MaxDrawdown = drawdown
+	}
+	if percent := drawdownPercent(drawdown, s.PeakBalance); percent.GreaterThan(s.MaxDrawdownPercent) {
+		s.MaxDrawdownPercent = percent
+	}
+}
+
+// drawdownPercent measures a drawdown against the balance held at the peak.
+func drawdownPercent(drawdown, peakBalance domain.Decimal) domain.Decimal {
+	if !peakBalance.IsPositive() {
+		return domain.Zero
+	}
+	return drawdown.Div(peakBalance).Mul(hundred).Round(2)
+}
+
+func sameInstant(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
+}
+
+func statsDays(from, to time.Time) int {
+	if !to.After(from) {
+		return 0
+	}
+	return int(to.Sub(from).Hours() / 24)
+}
@@ -0,0 +1,129 @@
+package bankroll
+
+import (
+	"context"
+	"time"
+
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Results are placed and read in the order they were played, like in the
+// balance history, ties broken by ledger order. Only entries played since the
+// bankroll was last reset are read. Each session entry carries the ID of the session's first result entry, so
+// a refresh can tell when a session it already counted has changed.
+const statsEntriesQuery = `
+SELECT t.id AS id, t.amount AS amount, t.balance_after AS balance_after, t.reference_type AS reference_type,
+t.reference_id AS reference_id, t.created_at AS created_at, s.id AS session_id, s.ended_at AS session_ended_at,
+b.settled_at AS bet_settled_at, COALESCE(s.status, '') AS session_status,
+(SELECT MIN(f.id) FROM bankroll_transactions f
+WHERE f.bankroll_id = t.bankroll_id AND f.reference_type = 'session' AND f.reference_id = t.reference_id AND f.type IN ?
+) AS session_first_entry_id
+FROM bankroll_transactions t
+LEFT JOIN poker_sessions s ON t.reference_type = 'session' AND s.id = t.reference_id AND s.deleted_at IS NULL
+LEFT JOIN bets b ON t.reference_type = 'bet' AND b.id = t.reference_id
+WHERE t.bankroll_id = ? AND t.id > ? AND t.type IN ? AND ` + ledgerEntryAt + ` >= ?
+ORDER BY ` + ledgerEntryAt + `, t.id`
+
+type statsEntryRow struct {
+	ID                  uint
+	Amount              domain.Decimal
+	BalanceAfter        domain.Decimal
+	ReferenceType       string
+	ReferenceID         *uint
+	CreatedAt           time.Time
+	SessionID           *uint
+	SessionEndedAt      *time.Time
+	BetSettledAt        *time.Time
+	SessionStatus       string
+	SessionFirstEntryID *uint
+}
+
+type postgresStatsRepository struct {
+	db *gorm.DB
+}
+
+func NewPostgresStatsRepository(db *gorm.DB) StatsRepository {
+	return &postgresStatsRepository{
+		db: db,
+	}
+}
+
+func (r *postgresStatsRepository) FindByBankrollID(ctx context.Context, bankrollID uint) (*BankrollStats, error) {
+	var stats BankrollStats
+	err := r.db.WithContext(ctx).
+		Where("bankroll_id = ?", bankrollID).
+		First(&stats).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, ErrStatsNotFound
+		}
+		return nil, WrapError(ErrDatabaseError, err.Error())
+	}
+	return &stats, nil
+}
+
+// Save writes the stats, replacing the row of a refresh that ran
+// concurrently from the same starting point.
+func (r *postgresStatsRepository) Save(ctx context.Context, stats *BankrollStats) error {
+	err := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "bankroll_id"}},
+		UpdateAll: true,
+	}).Create(stats).Error
+	if err != nil {
+		return WrapError(ErrDatabaseError, err.Error())
+	}
+	return nil
+}
+
+// SeriesStart returns when the bankroll's current run began, which is when
+// its last period was archived, or nil when it was never reset.
+func (r *postgresStatsRepository) SeriesStart(ctx context.Context, bankrollID uint) (*time.Time, error) {
+	var periods []BankrollPeriod
+	err := r.db.WithContext(ctx).
+		Where("bankroll_id = ?", bankrollID).
+		Order("ended_at DESC, id DESC").
+		Limit(1).
+		Find(&periods).Error
+	if err != nil {
+		return nil, WrapError(ErrDatabaseError, err.Error())
+	}
+	if len(periods) == 0 {
+		return nil, nil
+	}
+	return &periods[0].EndedAt, nil
+}
+
+func (r *postgresStatsRepository) ListEntriesAfter(ctx context.Context, bankrollID uint, afterID uint, since time.Time) ([]StatsEntry, error) {
+	var rows []statsEntryRow
+	err := r.db.WithContext(ctx).Raw(statsEntriesQuery, PlayTransactionTypes, bankrollID, afterID, PlayTransactionTypes, since).Scan(&rows).Error
+	if err != nil {
+		return nil, WrapError(ErrDatabaseError, err.Error())
+	}
+
+	entries := make([]StatsEntry, len(rows))
+	for i, row := range rows {
+		at := row.CreatedAt
+		switch {
+		case row.SessionEndedAt != nil:
+			at = *row.SessionEndedAt
+		case row.BetSettledAt != nil:
+			at = *row.BetSettledAt
+		}
+		entries[i] = StatsEntry{
+			ID:             row.ID,
+			Amount:         row.Amount,
+			BalanceAfter:   row.BalanceAfter,
+			ReferenceType:  row.ReferenceType,
+			ReferenceID:    row.ReferenceID,
+			At:             at,
+			SessionOpen:    row.SessionStatus == "open",
+			SessionDeleted: row.ReferenceType == "session" && row.ReferenceID != nil && row.SessionID == nil,
+		}
+		if row.SessionFirstEntryID != nil {
+			entries[i].SessionFirstEntryID = *row.SessionFirstEntryID
+		}
+	}
+	return entries, nil
+}
@@ -0,0 +1,148 @@
+package bankroll
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+type statsTestSession struct {
+	ID        uint `gorm:"primaryKey"`
+	Status    string
+	EndedAt   *time.Time
+	DeletedAt gorm.DeletedAt
+}
+
+func (statsTestSession) TableName() string {
+	return "poker_sessions"
+}
+
+func TestPostgresStatsRepository_ListEntriesAfter(t *testing.T) {
+	db := setupTestDB(t)
+	require.NoError(t, db.AutoMigrate(&statsTestSession{}, &historyTestBet{}))
+	repo := NewPostgresStatsRepository(db)
+	ctx := context.Background()
+	bankroll := createTestBankroll(t, db, 1, "1000.00")
+
+	endedAt := time.Date(2026, 3, 1, 23, 0, 0, 0, time.UTC)
+	require.NoError(t, db.Create(&statsTestSession{ID: 7, Status: "settled", EndedAt: &endedAt}).Error)
+	require.NoError(t, db.Create(&statsTestSession{ID: 8, Status: "open"}).Error)
+
+	settledID, openID := uint(7), uint(8)
+	postHistoryEntry(t, db, bankroll, &Transaction{Type: TransactionTypeAdjustment, Amount: domain.MustParseDecimal("-5.00")})
+	postHistoryEntry(t, db, bankroll, &Transaction{Type: TransactionTypeDeposit, Amount: domain.MustParseDecimal("200.00")})
+	postHistoryEntry(t, db, bankroll, &Transaction{
+		Type: TransactionTypeSessionResult, Amount: domain.MustParseDecimal("45.00"),
+		ReferenceType: "session", ReferenceID: &settledID,
+	})
+	postHistoryEntry(t, db, bankroll, &Transaction{
+		Type: TransactionTypeSessionBuyIn, Amount: domain.MustParseDecimal("-20.00"),
+		ReferenceType: "session", ReferenceID: &openID,
+	})
+
+	entries, err := repo.ListEntriesAfter(ctx, bankroll.ID, 0, time.Time{})
+	require.NoError(t, err)
+	require.Len(t, entries, 2, "deposits and adjustments are not play")
+	assert.True(t, entries[0].At.Equal(endedAt))
+	assert.Equal(t, "1240.00", entries[0].BalanceAfter.Round(2).String())
+	assert.False(t, entries[0].SessionOpen)
+	assert.True(t, entries[1].SessionOpen)
+	first := entries[0].ID
+	assert.Equal(t, first, entries[0].SessionFirstEntryID)
+
+	entries, err = repo.ListEntriesAfter(ctx, bankroll.ID, entries[0].ID, time.Time{})
+	require.NoError(t, err)
+	assert.Len(t, entries, 1)
+
+	postHistoryEntry(t, db, bankroll, &Transaction{
+		Type: TransactionTypeSessionResult, Amount: domain.MustParseDecimal("-45.00"),
+		ReferenceType: "session", ReferenceID: &settledID,
+	})
+	require.NoError(t, db.Delete(&statsTestSession{ID: 7}).Error)
+
+	entries, err = repo.ListEntriesAfter(ctx, bankroll.ID, entries[0].ID, time.Time{})
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, first, entries[0].SessionFirstEntryID, "entries point back to the session's first result")
+	assert.True(t, entries[0].SessionDeleted)
+	assert.False(t, entries[0].At.Equal(endedAt), "deleted sessions are not joined")
+}
+
+func TestPostgresStatsRepository_PlayOrderAndSeries(t *testing.T) {
+	db := setupTestDB(t)
+	require.NoError(t, db.AutoMigrate(&statsTestSession{}, &historyTestBet{}))
+	repo := NewPostgresStatsRepository(db)
+	ctx := context.Background()
+	bankroll := createTestBankroll(t, db, 1, "1000.00")
+
+	start, err := repo.SeriesStart(ctx, bankroll.ID)
+	require.NoError(t, err)
+	assert.Nil(t, start, "a bankroll never reset has no series start")
+
+	late := time.Date(2026, 3, 5, 12, 0, 0, 0, time.UTC)
+	early := time.Date(2026, 3, 2, 12, 0, 0, 0, time.UTC)
+	lateID, earlyID := uint(1), uint(2)
+	require.NoError(t, db.Create(&historyTestBet{ID: lateID, SettledAt: &late}).Error)
+	require.NoError(t, db.Create(&historyTestBet{ID: earlyID, SettledAt: &early}).Error)
+	postHistoryEntry(t, db, bankroll, &Transaction{
+		Type: TransactionTypeBetSettlement, Amount: domain.MustParseDecimal("30.00"),
+		ReferenceType: "bet", ReferenceID: &lateID,
+	})
+	postHistoryEntry(t, db, bankroll, &Transaction{
+		Type: TransactionTypeBetSettlement, Amount: domain.MustParseDecimal("-10.00"),
+		ReferenceType: "bet", ReferenceID: &earlyID,
+	})
+
+	entries, err := repo.ListEntriesAfter(ctx, bankroll.ID, 0, time.Time{})
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	assert.True(t, entries[0].At.Equal(early), "entries are read in play order")
+	assert.True(t, entries[1].At.Equal(late))
+
+	resetAt := time.Date(2026, 3, 4, 0, 0, 0, 0, time.UTC)
+	require.NoError(t, db.Create(&BankrollPeriod{
+		BankrollID: bankroll.ID, UserID: 1, StartDate: bankroll.StartDate, EndDate: resetAt,
+		StartedAt: bankroll.CreatedAt, EndedAt: resetAt,
+	}).Error)
+
+	start, err = repo.SeriesStart(ctx, bankroll.ID)
+	require.NoError(t, err)
+	require.NotNil(t, start)
+	assert.True(t, start.Equal(resetAt))
+
+	entries, err = repo.ListEntriesAfter(ctx, bankroll.ID, 0, *start)
+	require.NoError(t, err)
+	require.Len(t, entries, 1, "results played before the reset belong to the archived run")
+	assert.True(t, entries[0].At.Equal(late))
+}
+
+func TestPostgresStatsRepository_SaveAndFind(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewPostgresStatsRepository(db)
+	ctx := context.Background()
+	bankroll := createTestBankroll(t, db, 1, "1000.00")
+
+	_, err := repo.FindByBankrollID(ctx, bankroll.ID)
+	assert.ErrorIs(t, err, ErrStatsNotFound)
+
+	stats := newBankrollStats(bankroll, nil)
+	stats.LastTransactionID = 4
+	stats.NetResult = domain.MustParseDecimal("25.00")
+	require.NoError(t, repo.Save(ctx, stats))
+
+	stats.LastTransactionID = 9
+	stats.LongestLosingStreak = 3
+	require.NoError(t, repo.Save(ctx, stats))
+
+	found, err := repo.FindByBankrollID(ctx, bankroll.ID)
+	require.NoError(t, err)
+	assert.Equal(t, uint(9), found.LastTransactionID)
+	assert.Equal(t, 3, found.LongestLosingStreak)
+	assert.Equal(t, 25.0, found.NetResult.Float64())
+	assert.Nil(t, found.LastRecoveryDays)
+}
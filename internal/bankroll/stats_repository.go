@@ -0,0 +1,13 @@
+package bankroll
+
+import (
+	"context"
+	"time"
+)
+
+type StatsRepository interface {
+	FindByBankrollID(ctx context.Context, bankrollID uint) (*BankrollStats, error)
+	Save(ctx context.Context, stats *BankrollStats) error
+	SeriesStart(ctx context.Context, bankrollID uint) (*time.Time, error)
+	ListEntriesAfter(ctx context.Context, bankrollID uint, afterID uint, since time.Time) ([]StatsEntry, error)
+}
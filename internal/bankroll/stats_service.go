@@ -0,0 +1,136 @@
+package bankroll
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+)
+
+type StatsService interface {
+	GetStats(ctx context.Context, userID uint, bankrollID uint) (*StatsOutput, error)
+}
+
+type statsService struct {
+	repo         StatsRepository
+	bankrollRepo BankrollRepository
+	logger       *slog.Logger
+}
+
+func NewStatsService(repo StatsRepository, bankrollRepo BankrollRepository, logger *slog.Logger) StatsService {
+	return &statsService{
+		repo:         repo,
+		bankrollRepo: bankrollRepo,
+		logger:       logger,
+	}
+}
+
+// GetStats brings the stored stats up to date with the ledger entries posted
+// since the last request and saves them before answering. The stats are
+// rebuilt from the start of the current run when the bankroll was reset, when
+// a session already counted was edited or deleted, or when a new result was
+// played before the last one counted.
+func (s *statsService) GetStats(ctx context.Context, userID uint, bankrollID uint) (*StatsOutput, error) {
+	bankroll, err := s.bankrollRepo.FindByID(ctx, bankrollID, userID)
+	if err != nil {
+		s.logger.Error("bankroll not found", "error", err, "user_id", userID, "bankroll_id", bankrollID)
+		return nil, err
+	}
+
+	seriesStart, err := s.repo.SeriesStart(ctx, bankrollID)
+	if err != nil {
+		s.logger.Error("failed to get stats series start", "error", err, "bankroll_id", bankrollID)
+		return nil, err
+	}
+	var since time.Time
+	if seriesStart != nil {
+		since = *seriesStart
+	}
+
+	stats, err := s.repo.FindByBankrollID(ctx, bankrollID)
+	if err != nil {
+		if !errors.Is(err, ErrStatsNotFound) {
+			s.logger.Error("failed to get stats", "error", err, "bankroll_id", bankrollID)
+			return nil, err
+		}
+		stats = newBankrollStats(bankroll, seriesStart)
+	}
+
+	var entries []StatsEntry
+	reason := ""
+	if !sameInstant(stats.SeriesStart, seriesStart) {
+		reason = "bankroll was reset"
+	} else {
+		entries, err = s.repo.ListEntriesAfter(ctx, bankrollID, stats.LastTransactionID, since)
+		if err != nil {
+			s.logger.Error("failed to list result entries", "error", err, "bankroll_id", bankrollID)
+			return nil, err
+		}
+		switch {
+		case revisits(entries, stats.LastTransactionID):
+			reason = "counted session changed"
+		case backdated(entries, stats.LastResultAt):
+			reason = "result played before the last one counted"
+		}
+	}
+
+	rebuilt := reason != ""
+	if rebuilt {
+		s.logger.Info("rebuilding stats", "reason", reason, "user_id", userID, "bankroll_id", bankrollID)
+		fresh := newBankrollStats(bankroll, seriesStart)
+		fresh.ID = stats.ID
+		fresh.CreatedAt = stats.CreatedAt
+		stats = fresh
+		entries, err = s.repo.ListEntriesAfter(ctx, bankrollID, 0, since)
+		if err != nil {
+			s.logger.Error("failed to list result entries", "error", err, "bankroll_id", bankrollID)
+			return nil, err
+		}
+	}
+
+	results, lastID := statsResults(entries, stats.LastTransactionID)
+	if rebuilt || lastID != stats.LastTransactionID {
+		for _, result := range results {
+			stats.apply(result)
+		}
+		stats.LastTransactionID = lastID
+		if err := s.repo.Save(ctx, stats); err != nil {
+			s.logger.Error("failed to save stats", "error", err, "bankroll_id", bankrollID)
+			return nil, err
+		}
+		s.logger.Info("stats refreshed", "user_id", userID, "bankroll_id", bankrollID, "results", len(results), "last_transaction_id", lastID)
+	}
+
+	return toStatsOutput(bankroll, stats, time.Now()), nil
+}
+
+func toStatsOutput(bankroll *Bankroll, stats *BankrollStats, now time.Time) *StatsOutput {
+	currency := bankroll.Currency
+	drawdown := stats.Peak.Sub(stats.NetResult)
+	currentDays := 0
+	if drawdown.IsPositive() {
+		currentDays = statsDays(stats.PeakAt, now)
+	}
+
+	return &StatsOutput{
+		BankrollID:              bankroll.ID,
+		Currency:                currency,
+		Results:                 stats.Results,
+		NetResult:               currency.Round(stats.NetResult),
+		PeakResult:              currency.Round(stats.Peak),
+		MaxDrawdown:             currency.Round(stats.MaxDrawdown),
+		MaxDrawdownPercent:      stats.MaxDrawdownPercent.Round(2),
+		CurrentDrawdown:         currency.Round(drawdown),
+		CurrentDrawdownPercent:  drawdownPercent(drawdown, stats.PeakBalance),
+		CurrentDownswingResults: stats.DownswingResults,
+		CurrentDownswingDays:    currentDays,
+		LongestDownswingResults: stats.LongestDownswingResults,
+		LongestDownswingDays:    max(stats.LongestDownswingDays, currentDays),
+		CurrentStreak:           stats.CurrentStreak,
+		LongestWinningStreak:    stats.LongestWinningStreak,
+		LongestLosingStreak:     stats.LongestLosingStreak,
+		LastRecoveryDays:        stats.LastRecoveryDays,
+		LongestRecoveryDays:     stats.LongestRecoveryDays,
+		UpdatedAt:               stats.UpdatedAt,
+	}
+}
@@ -0,0 +1,325 @@
+package bankroll
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"log/slog"
+
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type MockStatsRepository struct {
+	mock.Mock
+}
+
+func (m *MockStatsRepository) FindByBankrollID(ctx context.Context, bankrollID uint) (*BankrollStats, error) {
+	args := m.Called(ctx, bankrollID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*BankrollStats), args.Error(1)
+}
+
+func (m *MockStatsRepository) Save(ctx context.Context, stats *BankrollStats) error {
+	args := m.Called(ctx, stats)
+	return args.Error(0)
+}
+
+func (m *MockStatsRepository) SeriesStart(ctx context.Context, bankrollID uint) (*time.Time, error) {
+	args := m.Called(ctx, bankrollID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*time.Time), args.Error(1)
+}
+
+func (m *MockStatsRepository) ListEntriesAfter(ctx context.Context, bankrollID uint, afterID uint, since time.Time) ([]StatsEntry, error) {
+	args := m.Called(ctx, bankrollID, afterID, since)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]StatsEntry), args.Error(1)
+}
+
+func statsTestBankroll() *Bankroll {
+	return &Bankroll{
+		ID:             1,
+		UserID:         1,
+		Currency:       CurrencyUSD,
+		InitialBalance: domain.MustParseDecimal("1000.00"),
+		StartDate:      time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC),
+	}
+}
+
+func statsEntry(id uint, amount string, balance string, referenceType string, referenceID uint, at time.Time) StatsEntry {
+	entry := StatsEntry{
+		ID:            id,
+		Amount:        domain.MustParseDecimal(amount),
+		BalanceAfter:  domain.MustParseDecimal(balance),
+		ReferenceType: referenceType,
+		At:            at,
+	}
+	if referenceID != 0 {
+		entry.ReferenceID = &referenceID
+	}
+	return entry
+}
+
+func day(d int, hour int) time.Time {
+	return time.Date(2026, 3, d, hour, 0, 0, 0, time.UTC)
+}
+
+func TestGetStats(t *testing.T) {
+	mockRepo := new(MockStatsRepository)
+	mockBankrollRepo := new(MockBankrollRepository)
+	service := NewStatsService(mockRepo, mockBankrollRepo, slog.Default())
+	ctx := context.Background()
+
+	mockBankrollRepo.On("FindByID", ctx, uint(1), uint(1)).Return(statsTestBankroll(), nil)
+	mockRepo.On("SeriesStart", ctx, uint(1)).Return(nil, nil)
+
+	// A win, a losing bet during a losing session, an adjustment and a
+	// recovering win.
+	mockRepo.On("FindByBankrollID", ctx, uint(1)).Return(nil, ErrStatsNotFound).Once()
+	mockRepo.On("ListEntriesAfter", ctx, uint(1), uint(0), time.Time{}).Return([]StatsEntry{
+		statsEntry(1, "100.00", "1100.00", "bet", 1, day(2, 12)),
+		statsEntry(3, "-30.00", "1020.00", "bet", 2, day(3, 10)),
+		statsEntry(2, "-50.00", "1050.00", "session", 5, day(3, 22)),
+		statsEntry(4, "20.00", "1040.00", "session", 5, day(3, 22)),
+		statsEntry(5, "-60.00", "980.00", "", 0, day(5, 9)),
+		statsEntry(6, "200.00", "1180.00", "bet", 3, day(10, 12)),
+	}, nil).Once()
+	var saved *BankrollStats
+	mockRepo.On("Save", ctx, mock.AnythingOfType("*bankroll.BankrollStats")).Run(func(args mock.Arguments) {
+		saved = args.Get(1).(*BankrollStats)
+	}).Return(nil)
+
+	output, err := service.GetStats(ctx, 1, 1)
+
+	require.NoError(t, err)
+	assert.Equal(t, 4, output.Results)
+	assert.Equal(t, "180.00", output.NetResult.String())
+	assert.Equal(t, "120.00", output.MaxDrawdown.String())
+	assert.Equal(t, "10.91", output.MaxDrawdownPercent.String())
+	assert.True(t, output.CurrentDrawdown.IsZero())
+	assert.Equal(t, 2, output.LongestDownswingResults)
+	assert.Equal(t, 8, output.LongestDownswingDays)
+	assert.Equal(t, 1, output.CurrentStreak)
+	assert.Equal(t, 1, output.LongestWinningStreak)
+	assert.Equal(t, 2, output.LongestLosingStreak)
+	require.NotNil(t, output.LastRecoveryDays)
+	assert.Equal(t, 8, *output.LastRecoveryDays)
+	require.NotNil(t, saved)
+	assert.Equal(t, uint(6), saved.LastTransactionID)
+
+	t.Run("live session holds back later entries", func(t *testing.T) {
+		mockRepo.On("FindByBankrollID", ctx, uint(1)).Return(saved, nil).Once()
+		open := statsEntry(7, "-100.00", "1080.00", "session", 9, day(11, 20))
+		open.SessionOpen = true
+		mockRepo.On("ListEntriesAfter", ctx, uint(1), uint(6), time.Time{}).Return([]StatsEntry{
+			open,
+			statsEntry(8, "-50.00", "1030.00", "bet", 4, day(11, 21)),
+		}, nil).Once()
+
+		output, err := service.GetStats(ctx, 1, 1)
+
+		require.NoError(t, err)
+		assert.Equal(t, "180.00", output.NetResult.String())
+		mockRepo.AssertNumberOfCalls(t, "Save", 1)
+	})
+
+	t.Run("resumes from the last entry", func(t *testing.T) {
+		mockRepo.On("FindByBankrollID", ctx, uint(1)).Return(saved, nil).Once()
+		mockRepo.On("ListEntriesAfter", ctx, uint(1), uint(6), time.Time{}).Return([]StatsEntry{
+			statsEntry(8, "-50.00", "1030.00", "bet", 4, day(11, 21)),
+			statsEntry(7, "-100.00", "1080.00", "session", 9, day(12, 2)),
+			statsEntry(9, "40.00", "1070.00", "session", 9, day(12, 2)),
+		}, nil).Once()
+
+		output, err := service.GetStats(ctx, 1, 1)
+
+		require.NoError(t, err)
+		assert.Equal(t, 6, output.Results)
+		assert.Equal(t, "70.00", output.NetResult.String())
+		assert.Equal(t, "110.00", output.CurrentDrawdown.String())
+		assert.Equal(t, "9.32", output.CurrentDrawdownPercent.String())
+		assert.Equal(t, "120.00", output.MaxDrawdown.String(), "the deepest drawdown is kept")
+		assert.Equal(t, -2, output.CurrentStreak)
+		assert.Equal(t, 2, output.CurrentDownswingResults)
+		assert.Positive(t, output.CurrentDownswingDays)
+		assert.Equal(t, uint(9), saved.LastTransactionID)
+	})
+}
+
+func TestGetStatsRebuildsChangedSessions(t *testing.T) {
+	mockRepo := new(MockStatsRepository)
+	mockBankrollRepo := new(MockBankrollRepository)
+	service := NewStatsService(mockRepo, mockBankrollRepo, slog.Default())
+	ctx := context.Background()
+
+	mockBankrollRepo.On("FindByID", ctx, uint(1), uint(1)).Return(statsTestBankroll(), nil)
+	mockRepo.On("SeriesStart", ctx, uint(1)).Return(nil, nil)
+	var saved *BankrollStats
+	mockRepo.On("Save", ctx, mock.AnythingOfType("*bankroll.BankrollStats")).Run(func(args mock.Arguments) {
+		saved = args.Get(1).(*BankrollStats)
+	}).Return(nil)
+
+	sessionEntry := func(id uint, amount string, balance string) StatsEntry {
+		entry := statsEntry(id, amount, balance, "session", 5, day(2, 22))
+		entry.SessionFirstEntryID = 1
+		return entry
+	}
+	counted := []StatsEntry{
+		sessionEntry(1, "-50.00", "950.00"),
+		statsEntry(2, "100.00", "1050.00", "bet", 1, day(3, 12)),
+	}
+	mockRepo.On("FindByBankrollID", ctx, uint(1)).Return(nil, ErrStatsNotFound).Once()
+	mockRepo.On("ListEntriesAfter", ctx, uint(1), uint(0), time.Time{}).Return(counted, nil).Once()
+
+	output, err := service.GetStats(ctx, 1, 1)
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, output.Results)
+	assert.Equal(t, 1, output.LongestLosingStreak)
+	assert.Equal(t, "50.00", output.MaxDrawdown.String())
+
+	t.Run("edited session replaces its counted result", func(t *testing.T) {
+		adjusted := sessionEntry(3, "80.00", "1130.00")
+		mockRepo.On("FindByBankrollID", ctx, uint(1)).Return(saved, nil).Once()
+		mockRepo.On("ListEntriesAfter", ctx, uint(1), uint(2), time.Time{}).Return([]StatsEntry{adjusted}, nil).Once()
+		mockRepo.On("ListEntriesAfter", ctx, uint(1), uint(0), time.Time{}).Return(append(counted, adjusted), nil).Once()
+
+		output, err := service.GetStats(ctx, 1, 1)
+
+		require.NoError(t, err)
+		assert.Equal(t, 2, output.Results, "the edit is not a new play")
+		assert.Equal(t, "130.00", output.NetResult.String())
+		assert.Equal(t, 0, output.LongestLosingStreak)
+		assert.True(t, output.MaxDrawdown.IsZero())
+		assert.Equal(t, 2, output.LongestWinningStreak)
+		assert.Equal(t, uint(3), saved.LastTransactionID)
+	})
+
+	t.Run("deleted session leaves the curve", func(t *testing.T) {
+		deleted := []StatsEntry{
+			sessionEntry(1, "-50.00", "950.00"),
+			counted[1],
+			sessionEntry(3, "80.00", "1130.00"),
+			sessionEntry(4, "-30.00", "1100.00"),
+		}
+		for i := range deleted {
+			deleted[i].SessionDeleted = deleted[i].ReferenceType == "session"
+		}
+		mockRepo.On("FindByBankrollID", ctx, uint(1)).Return(saved, nil).Once()
+		mockRepo.On("ListEntriesAfter", ctx, uint(1), uint(3), time.Time{}).Return(deleted[3:], nil).Once()
+		mockRepo.On("ListEntriesAfter", ctx, uint(1), uint(0), time.Time{}).Return(deleted, nil).Once()
+
+		output, err := service.GetStats(ctx, 1, 1)
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, output.Results)
+		assert.Equal(t, "100.00", output.NetResult.String())
+		assert.Equal(t, 1, output.LongestWinningStreak)
+		assert.Equal(t, uint(4), saved.LastTransactionID)
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestGetStatsRebuildsInPlayOrder(t *testing.T) {
+	mockRepo := new(MockStatsRepository)
+	mockBankrollRepo := new(MockBankrollRepository)
+	service := NewStatsService(mockRepo, mockBankrollRepo, slog.Default())
+	ctx := context.Background()
+
+	mockBankrollRepo.On("FindByID", ctx, uint(1), uint(1)).Return(statsTestBankroll(), nil)
+	var saved *BankrollStats
+	mockRepo.On("Save", ctx, mock.AnythingOfType("*bankroll.BankrollStats")).Run(func(args mock.Arguments) {
+		saved = args.Get(1).(*BankrollStats)
+	}).Return(nil)
+
+	counted := []StatsEntry{
+		statsEntry(1, "-50.00", "950.00", "bet", 1, day(2, 12)),
+		statsEntry(2, "100.00", "1050.00", "bet", 2, day(4, 12)),
+	}
+	mockRepo.On("SeriesStart", ctx, uint(1)).Return(nil, nil).Once()
+	mockRepo.On("FindByBankrollID", ctx, uint(1)).Return(nil, ErrStatsNotFound).Once()
+	mockRepo.On("ListEntriesAfter", ctx, uint(1), uint(0), time.Time{}).Return(counted, nil).Once()
+
+	_, err := service.GetStats(ctx, 1, 1)
+	require.NoError(t, err)
+	require.NotNil(t, saved.LastResultAt)
+	assert.True(t, saved.LastResultAt.Equal(day(4, 12)))
+
+	t.Run("result played before the last counted one", func(t *testing.T) {
+		backdated := statsEntry(3, "-20.00", "1030.00", "bet", 3, day(3, 12))
+		mockRepo.On("SeriesStart", ctx, uint(1)).Return(nil, nil).Once()
+		mockRepo.On("FindByBankrollID", ctx, uint(1)).Return(saved, nil).Once()
+		mockRepo.On("ListEntriesAfter", ctx, uint(1), uint(2), time.Time{}).Return([]StatsEntry{backdated}, nil).Once()
+		mockRepo.On("ListEntriesAfter", ctx, uint(1), uint(0), time.Time{}).
+			Return([]StatsEntry{counted[0], backdated, counted[1]}, nil).Once()
+
+		output, err := service.GetStats(ctx, 1, 1)
+
+		require.NoError(t, err)
+		assert.Equal(t, 3, output.Results)
+		assert.Equal(t, 2, output.LongestLosingStreak, "the backdated loss extends the streak it was played in")
+		assert.Equal(t, 1, output.CurrentStreak)
+		assert.Equal(t, uint(3), saved.LastTransactionID)
+	})
+
+	t.Run("reset restarts the series", func(t *testing.T) {
+		resetAt := day(5, 0)
+		after := statsEntry(4, "40.00", "540.00", "bet", 4, day(6, 12))
+		mockRepo.On("SeriesStart", ctx, uint(1)).Return(&resetAt, nil).Once()
+		mockRepo.On("FindByBankrollID", ctx, uint(1)).Return(saved, nil).Once()
+		mockRepo.On("ListEntriesAfter", ctx, uint(1), uint(0), resetAt).Return([]StatsEntry{after}, nil).Once()
+
+		output, err := service.GetStats(ctx, 1, 1)
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, output.Results)
+		assert.Equal(t, "40.00", output.NetResult.String())
+		assert.Equal(t, 0, output.LongestLosingStreak)
+		assert.True(t, output.MaxDrawdown.IsZero())
+		require.NotNil(t, saved.SeriesStart)
+		assert.True(t, saved.SeriesStart.Equal(resetAt))
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestStatsResults(t *testing.T) {
+	open := statsEntry(3, "-10.00", "0", "session", 2, day(1, 3))
+	open.SessionOpen = true
+	entries := []StatsEntry{
+		statsEntry(2, "5.00", "0", "bet", 1, day(1, 2)),
+		open,
+		statsEntry(1, "-50.00", "0", "session", 1, day(1, 5)),
+		statsEntry(4, "80.00", "0", "session", 1, day(1, 5)),
+	}
+
+	results, lastID := statsResults(entries, 0)
+
+	assert.Empty(t, results, "nothing past an entry held back is counted, since refreshes resume by ID")
+	assert.Equal(t, uint(0), lastID)
+
+	results, lastID = statsResults([]StatsEntry{entries[0], open, statsEntry(5, "7.00", "0", "bet", 2, day(1, 4))}, 0)
+
+	require.Len(t, results, 1, "a live session holds back what was played after it")
+	assert.Equal(t, uint(2), lastID)
+
+	open.SessionOpen = false
+	entries[1] = open
+	results, lastID = statsResults(entries, 0)
+
+	require.Len(t, results, 3)
+	assert.Equal(t, uint(4), lastID)
+	assert.Equal(t, "5.00", results[0].amount.String())
+	assert.Equal(t, "-10.00", results[1].amount.String())
+	assert.Equal(t, "30.00", results[2].amount.String(), "session entries are merged at the last one")
+	assert.True(t, results[2].play)
+}
@@ -0,0 +1,196 @@
+package bankroll
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"log/slog"
+
+	"github.com/gin-gonic/gin"
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
+	"github.com/opinedajr/micro-stakes-api/internal/shared/pagination"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type MockTransactionServiceForHandler struct {
+	mock.Mock
+}
+
+func (m *MockTransactionServiceForHandler) CreateTransaction(ctx context.Context, userID uint, bankrollID uint, input CreateTransactionInput) (*TransactionOutput, error) {
+	args := m.Called(ctx, userID, bankrollID, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*TransactionOutput), args.Error(1)
+}
+
+func (m *MockTransactionServiceForHandler) ListTransactions(ctx context.Context, userID uint, bankrollID uint, input ListTransactionsInput) (*TransactionPageOutput, error) {
+	args := m.Called(ctx, userID, bankrollID, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*TransactionPageOutput), args.Error(1)
+}
+
+func newTransactionRequest(t *testing.T, body interface{}) *http.Request {
+	bodyBytes, err := json.Marshal(body)
+	require.NoError(t, err)
+
+	req, err := http.NewRequest(http.MethodPost, "/bankrolls/1/transactions", bytes.NewBuffer(bodyBytes))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+	return req
+}
+
+func TestCreateTransactionHandler(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		mockService := new(MockTransactionServiceForHandler)
+		handler := NewTransactionHandler(mockService, slog.Default())
+
+		expectedOutput := &TransactionOutput{
+			ID:           1,
+			BankrollID:   1,
+			Type:         TransactionTypeDeposit,
+			Amount:       domain.MustParseDecimal("100.00"),
+			BalanceAfter: domain.MustParseDecimal("1100.00"),
+		}
+
+		mockService.On("CreateTransaction", mock.Anything, uint(1), uint(1), CreateTransactionInput{
+			Type:   TransactionTypeDeposit,
+			Amount: domain.MustParseDecimal("100.00"),
+		}).Return(expectedOutput, nil).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = newTransactionRequest(t, CreateTransactionInput{Type: TransactionTypeDeposit, Amount: domain.MustParseDecimal("100.00")})
+		c.Params = gin.Params{{Key: "bankrollId", Value: "1"}}
+		c.Set("userID", "1")
+
+		handler.CreateTransaction(c)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+
+		var response TransactionOutput
+		err := json.Unmarshal(w.Body.Bytes(), &response)
+		require.NoError(t, err)
+
+		assert.Equal(t, uint(1), response.ID)
+		assert.Equal(t, 1100.00, response.BalanceAfter.Float64())
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("validation error - missing amount", func(t *testing.T) {
+		mockService := new(MockTransactionServiceForHandler)
+		handler := NewTransactionHandler(mockService, slog.Default())
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = newTransactionRequest(t, map[string]interface{}{"type": "deposit"})
+		c.Params = gin.Params{{Key: "bankrollId", Value: "1"}}
+		c.Set("userID", "1")
+
+		handler.CreateTransaction(c)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		mockService.AssertNotCalled(t, "CreateTransaction")
+	})
+
+	t.Run("service error - insufficient funds", func(t *testing.T) {
+		mockService := new(MockTransactionServiceForHandler)
+		handler := NewTransactionHandler(mockService, slog.Default())
+
+		mockService.On("CreateTransaction", mock.Anything, uint(1), uint(1), mock.AnythingOfType("bankroll.CreateTransactionInput")).Return(nil, ErrInsufficientFunds).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = newTransactionRequest(t, CreateTransactionInput{Type: TransactionTypeWithdrawal, Amount: domain.MustParseDecimal("5000.00")})
+		c.Params = gin.Params{{Key: "bankrollId", Value: "1"}}
+		c.Set("userID", "1")
+
+		handler.CreateTransaction(c)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+
+		var response ErrorOutput
+		err := json.Unmarshal(w.Body.Bytes(), &response)
+		require.NoError(t, err)
+
+		assert.Equal(t, "INSUFFICIENT_FUNDS", response.Code)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("service error - bankroll not found", func(t *testing.T) {
+		mockService := new(MockTransactionServiceForHandler)
+		handler := NewTransactionHandler(mockService, slog.Default())
+
+		mockService.On("CreateTransaction", mock.Anything, uint(1), uint(1), mock.AnythingOfType("bankroll.CreateTransactionInput")).Return(nil, ErrBankrollNotFound).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = newTransactionRequest(t, CreateTransactionInput{Type: TransactionTypeDeposit, Amount: domain.MustParseDecimal("10.00")})
+		c.Params = gin.Params{{Key: "bankrollId", Value: "1"}}
+		c.Set("userID", "1")
+
+		handler.CreateTransaction(c)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		mockService.AssertExpectations(t)
+	})
+}
+
+func TestListTransactionsHandler(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		mockService := new(MockTransactionServiceForHandler)
+		handler := NewTransactionHandler(mockService, slog.Default())
+
+		input := ListTransactionsInput{Params: pagination.Params{Limit: 10}}
+		mockService.On("ListTransactions", mock.Anything, uint(1), uint(1), input).Return(pagination.NewPage([]*TransactionOutput{
+			{ID: 1, BankrollID: 1, Type: TransactionTypeDeposit, Amount: domain.MustParseDecimal("100.00"), BalanceAfter: domain.MustParseDecimal("1100.00")},
+		}, 1, input.Params, ""), nil).Once()
+
+		req, err := http.NewRequest(http.MethodGet, "/bankrolls/1/transactions?limit=10", nil)
+		require.NoError(t, err)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = req
+		c.Params = gin.Params{{Key: "bankrollId", Value: "1"}}
+		c.Set("userID", "1")
+
+		handler.ListTransactions(c)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var response pagination.Page[TransactionOutput]
+		err = json.Unmarshal(w.Body.Bytes(), &response)
+		require.NoError(t, err)
+
+		assert.Len(t, response.Items, 1)
+		assert.Equal(t, int64(1), response.Total)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("unauthorized - missing userID", func(t *testing.T) {
+		mockService := new(MockTransactionServiceForHandler)
+		handler := NewTransactionHandler(mockService, slog.Default())
+
+		req, err := http.NewRequest(http.MethodGet, "/bankrolls/1/transactions", nil)
+		require.NoError(t, err)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = req
+		c.Params = gin.Params{{Key: "bankrollId", Value: "1"}}
+
+		handler.ListTransactions(c)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+		mockService.AssertNotCalled(t, "ListTransactions")
+	})
+}
@@ -0,0 +1,67 @@
+package bankroll
+
+import (
+	"time"
+
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
+)
+
+type TransactionType string
+
+const (
+	TransactionTypeDeposit        TransactionType = "deposit"
+	TransactionTypeWithdrawal     TransactionType = "withdrawal"
+	TransactionTypeAdjustment     TransactionType = "adjustment"
+	TransactionTypeTransferIn     TransactionType = "transfer_in"
+	TransactionTypeTransferOut    TransactionType = "transfer_out"
+	TransactionTypeBetSettlement  TransactionType = "bet_settlement"
+	TransactionTypeSessionResult  TransactionType = "session_result"
+	TransactionTypeSessionBuyIn   TransactionType = "session_buy_in"
+	TransactionTypeSessionCashOut TransactionType = "session_cash_out"
+	TransactionTypeReset          TransactionType = "reset"
+	// TransactionTypeRakeback credits a rakeback deal's payout for a period.
+	TransactionTypeRakeback TransactionType = "rakeback"
+)
+
+// PlayTransactionTypes are the ledger entries produced by play. Stop limits,
+// rules, goals and statistics only count these, so a manual adjustment can
+// neither lift a lock nor reach a goal.
+var PlayTransactionTypes = []TransactionType{
+	TransactionTypeBetSettlement,
+	TransactionTypeSessionResult,
+	TransactionTypeSessionBuyIn,
+	TransactionTypeSessionCashOut,
+}
+
+// ResultTransactionTypes are the ledger entries that make up a period's
+// profit: play plus manual adjustments, but not money moving in or out of the
+// bankroll.
+var ResultTransactionTypes = append([]TransactionType{TransactionTypeAdjustment}, PlayTransactionTypes...)
+
+// Transaction is a ledger entry. Amount is signed: credits are positive and
+// debits negative, so the bankroll balance is its initial balance plus the sum
+// of the ledger.
+type Transaction struct {
+	ID            uint            `gorm:"primaryKey;autoIncrement"`
+	BankrollID    uint            `gorm:"not null;index"`
+	Type          TransactionType `gorm:"type:varchar(20);not null"`
+	Amount        domain.Decimal  `gorm:"type:decimal(27,8);not null"`
+	BalanceAfter  domain.Decimal  `gorm:"type:decimal(27,8);not null"`
+	Description   string          `gorm:"type:varchar(255)"`
+	TransferID    *uint           `gorm:"index"`
+	ReferenceType string          `gorm:"type:varchar(30)"`
+	ReferenceID   *uint
+	CreatedAt     time.Time `gorm:"autoCreateTime;index"`
+}
+
+func (Transaction) TableName() string {
+	return "bankroll_transactions"
+}
+
+// TransactionListFilter selects a page of a bankroll's ledger, newest first.
+// After, when set, replaces Offset with keyset paging.
+type TransactionListFilter struct {
+	After  *BankrollCursor
+	Limit  int
+	Offset int
+}
@@ -0,0 +1,154 @@
+package bankroll
+
+import (
+	"context"
+	"strings"
+
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
+	"github.com/opinedajr/micro-stakes-api/internal/shared/pagination"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type postgresTransactionRepository struct {
+	db *gorm.DB
+}
+
+func NewPostgresTransactionRepository(db *gorm.DB) TransactionRepository {
+	return &postgresTransactionRepository{
+		db: db,
+	}
+}
+
+func (r *postgresTransactionRepository) Create(ctx context.Context, transaction *Transaction, userID uint, expectedVersion uint) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := checkVersion(tx, transaction.BankrollID, userID, expectedVersion); err != nil {
+			return err
+		}
+		return ApplyTransaction(tx, transaction, userID)
+	})
+}
+
+// ListByBankrollID returns a page of the ledger newest first together with
+// the total number of entries of the bankroll.
+func (r *postgresTransactionRepository) ListByBankrollID(ctx context.Context, bankrollID uint, userID uint, filter TransactionListFilter) ([]*Transaction, int64, error) {
+	query := r.db.WithContext(ctx).Model(&Transaction{}).
+		Where("bankroll_id = ? AND EXISTS (SELECT 1 FROM bankrolls WHERE bankrolls.id = bankroll_transactions.bankroll_id AND bankrolls.user_id = ?)", bankrollID, userID)
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, WrapError(ErrDatabaseError, err.Error())
+	}
+
+	if filter.After != nil {
+		query = query.Where(pagination.KeysetCondition("created_at", true),
+			filter.After.Value, filter.After.Value, filter.After.ID)
+	} else if filter.Offset > 0 {
+		query = query.Offset(filter.Offset)
+	}
+
+	var transactions []*Transaction
+	err := query.Order(pagination.OrderClause("created_at", true)).
+		Limit(filter.Limit).
+		Find(&transactions).Error
+	if err != nil {
+		return nil, 0, WrapError(ErrDatabaseError, err.Error())
+	}
+	return transactions, total, nil
+}
+
+// ApplyTransaction appends a ledger entry and moves the bankroll balance by its
+// amount, then advances the bankroll's goals. It must run inside a database
+// transaction; the bankroll row is locked so concurrent entries are serialized.
+func ApplyTransaction(tx *gorm.DB, transaction *Transaction, userID uint) error {
+	var bankroll Bankroll
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ? AND user_id = ?", transaction.BankrollID, userID).
+		First(&bankroll).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return ErrBankrollNotFound
+		}
+		return WrapError(ErrDatabaseError, err.Error())
+	}
+
+	newBalance := bankroll.CurrentBalance.Add(transaction.Amount)
+	if newBalance.IsNegative() {
+		return ErrInsufficientFunds
+	}
+	transaction.BalanceAfter = newBalance
+
+	if err := tx.Create(transaction).Error; err != nil {
+		return WrapError(ErrDatabaseError, err.Error())
+	}
+
+	if err := tx.Model(&bankroll).Updates(map[string]interface{}{
+		"current_balance": newBalance,
+		"version":         gorm.Expr("version + 1"),
+	}).Error; err != nil {
+		if isBalanceConstraintViolation(err) {
+			return ErrInsufficientFunds
+		}
+		return WrapError(ErrDatabaseError, err.Error())
+	}
+	return reachGoals(tx, &bankroll, transaction, userID)
+}
+
+// AdjustInPlay moves the amount held in live sessions. Money put into play is
+// debited from the balance through the ledger first, so the in-play amount is
+// tracked separately from CurrentBalance. It must run inside a transaction.
+func AdjustInPlay(tx *gorm.DB, bankrollID uint, userID uint, delta domain.Decimal) error {
+	var bankroll Bankroll
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ? AND user_id = ?", bankrollID, userID).
+		First(&bankroll).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return ErrBankrollNotFound
+		}
+		return WrapError(ErrDatabaseError, err.Error())
+	}
+
+	inPlay := bankroll.InPlay.Add(delta)
+	if inPlay.IsNegative() {
+		return WrapError(ErrDatabaseError, "in-play amount cannot be negative")
+	}
+
+	if err := tx.Model(&bankroll).Updates(map[string]interface{}{
+		"in_play": inPlay,
+		"version": gorm.Expr("version + 1"),
+	}).Error; err != nil {
+		return WrapError(ErrDatabaseError, err.Error())
+	}
+	return nil
+}
+
+// checkVersion locks the bankroll row and fails with ErrVersionConflict when
+// its version no longer matches the one the client read. An expected version
+// of zero skips the check.
+func checkVersion(tx *gorm.DB, bankrollID uint, userID uint, expectedVersion uint) error {
+	if expectedVersion == 0 {
+		return nil
+	}
+
+	var bankroll Bankroll
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Select("id", "version").
+		Where("id = ? AND user_id = ?", bankrollID, userID).
+		First(&bankroll).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return ErrBankrollNotFound
+		}
+		return WrapError(ErrDatabaseError, err.Error())
+	}
+
+	if bankroll.Version != expectedVersion {
+		return ErrVersionConflict
+	}
+	return nil
+}
+
+func isBalanceConstraintViolation(err error) bool {
+	return strings.Contains(err.Error(), "ck_current_balance_nonnegative")
+}
@@ -0,0 +1,192 @@
+package bankroll
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func createTestBankroll(t *testing.T, db *gorm.DB, userID uint, balance string) *Bankroll {
+	startDate, err := time.Parse("2006-01-02", "2026-02-01")
+	require.NoError(t, err)
+
+	bankroll := &Bankroll{
+		UserID:               userID,
+		Name:                 "Main Bankroll",
+		Currency:             CurrencyBRL,
+		InitialBalance:       domain.MustParseDecimal(balance),
+		CurrentBalance:       domain.MustParseDecimal(balance),
+		StartDate:            startDate,
+		CommissionPercentage: domain.MustParseDecimal("5.0"),
+	}
+
+	err = NewPostgresBankrollRepository(db).Create(context.Background(), bankroll)
+	require.NoError(t, err)
+
+	return bankroll
+}
+
+func TestPostgresTransactionRepository_Create(t *testing.T) {
+	t.Run("deposit increases balance", func(t *testing.T) {
+		db := setupTestDB(t)
+		repo := NewPostgresTransactionRepository(db)
+		ctx := context.Background()
+		bankroll := createTestBankroll(t, db, 1, "1000.00")
+
+		transaction := &Transaction{
+			BankrollID: bankroll.ID,
+			Type:       TransactionTypeDeposit,
+			Amount:     domain.MustParseDecimal("250.00"),
+		}
+
+		err := repo.Create(ctx, transaction, 1, 0)
+
+		assert.NoError(t, err)
+		assert.NotZero(t, transaction.ID)
+		assert.Equal(t, 1250.00, transaction.BalanceAfter.Float64())
+
+		updated, err := NewPostgresBankrollRepository(db).FindByID(ctx, bankroll.ID, 1)
+		require.NoError(t, err)
+		assert.Equal(t, 1250.00, updated.CurrentBalance.Float64())
+		assert.Equal(t, 1000.00, updated.InitialBalance.Float64())
+	})
+
+	t.Run("withdrawal decreases balance", func(t *testing.T) {
+		db := setupTestDB(t)
+		repo := NewPostgresTransactionRepository(db)
+		ctx := context.Background()
+		bankroll := createTestBankroll(t, db, 1, "1000.00")
+
+		transaction := &Transaction{
+			BankrollID: bankroll.ID,
+			Type:       TransactionTypeWithdrawal,
+			Amount:     domain.MustParseDecimal("-400.00"),
+		}
+
+		err := repo.Create(ctx, transaction, 1, 0)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 600.00, transaction.BalanceAfter.Float64())
+
+		updated, err := NewPostgresBankrollRepository(db).FindByID(ctx, bankroll.ID, 1)
+		require.NoError(t, err)
+		assert.Equal(t, 600.00, updated.CurrentBalance.Float64())
+	})
+
+	t.Run("insufficient funds", func(t *testing.T) {
+		db := setupTestDB(t)
+		repo := NewPostgresTransactionRepository(db)
+		ctx := context.Background()
+		bankroll := createTestBankroll(t, db, 1, "100.00")
+
+		transaction := &Transaction{
+			BankrollID: bankroll.ID,
+			Type:       TransactionTypeWithdrawal,
+			Amount:     domain.MustParseDecimal("-100.01"),
+		}
+
+		err := repo.Create(ctx, transaction, 1, 0)
+
+		assert.Error(t, err)
+		assert.ErrorIs(t, err, ErrInsufficientFunds)
+
+		updated, err := NewPostgresBankrollRepository(db).FindByID(ctx, bankroll.ID, 1)
+		require.NoError(t, err)
+		assert.Equal(t, 100.00, updated.CurrentBalance.Float64())
+
+		var count int64
+		db.Model(&Transaction{}).Count(&count)
+		assert.Equal(t, int64(0), count)
+	})
+
+	t.Run("bankroll of different user", func(t *testing.T) {
+		db := setupTestDB(t)
+		repo := NewPostgresTransactionRepository(db)
+		ctx := context.Background()
+		bankroll := createTestBankroll(t, db, 1, "1000.00")
+
+		transaction := &Transaction{
+			BankrollID: bankroll.ID,
+			Type:       TransactionTypeDeposit,
+			Amount:     domain.MustParseDecimal("100.00"),
+		}
+
+		err := repo.Create(ctx, transaction, 2, 0)
+
+		assert.Error(t, err)
+		assert.ErrorIs(t, err, ErrBankrollNotFound)
+	})
+}
+
+func TestPostgresTransactionRepository_ListByBankrollID(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		db := setupTestDB(t)
+		repo := NewPostgresTransactionRepository(db)
+		ctx := context.Background()
+		bankroll := createTestBankroll(t, db, 1, "1000.00")
+
+		err := repo.Create(ctx, &Transaction{BankrollID: bankroll.ID, Type: TransactionTypeDeposit, Amount: domain.MustParseDecimal("100.00")}, 1, 0)
+		require.NoError(t, err)
+		err = repo.Create(ctx, &Transaction{BankrollID: bankroll.ID, Type: TransactionTypeAdjustment, Amount: domain.MustParseDecimal("-50.00")}, 1, 0)
+		require.NoError(t, err)
+
+		transactions, total, err := repo.ListByBankrollID(ctx, bankroll.ID, 1, TransactionListFilter{Limit: 10})
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), total)
+		require.Len(t, transactions, 2)
+		assert.Equal(t, TransactionTypeAdjustment, transactions[0].Type)
+		assert.Equal(t, 1050.00, transactions[0].BalanceAfter.Float64())
+		assert.Equal(t, TransactionTypeDeposit, transactions[1].Type)
+	})
+
+	t.Run("pages by offset and cursor", func(t *testing.T) {
+		db := setupTestDB(t)
+		repo := NewPostgresTransactionRepository(db)
+		ctx := context.Background()
+		bankroll := createTestBankroll(t, db, 1, "1000.00")
+
+		createdAt := time.Date(2026, 5, 1, 10, 0, 0, 0, time.UTC)
+		for i := 0; i < 3; i++ {
+			postHistoryEntry(t, db, bankroll, &Transaction{Type: TransactionTypeDeposit, Amount: domain.MustParseDecimal("10.00"), CreatedAt: createdAt})
+		}
+
+		first, total, err := repo.ListByBankrollID(ctx, bankroll.ID, 1, TransactionListFilter{Limit: 2})
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), total)
+		require.Len(t, first, 2)
+
+		rest, _, err := repo.ListByBankrollID(ctx, bankroll.ID, 1, TransactionListFilter{
+			Limit: 2,
+			After: &BankrollCursor{Value: first[1].CreatedAt, ID: first[1].ID},
+		})
+		require.NoError(t, err)
+		require.Len(t, rest, 1, "equal timestamps are split by id")
+		assert.Less(t, rest[0].ID, first[1].ID)
+
+		skipped, _, err := repo.ListByBankrollID(ctx, bankroll.ID, 1, TransactionListFilter{Limit: 2, Offset: 2})
+		require.NoError(t, err)
+		require.Len(t, skipped, 1)
+		assert.Equal(t, rest[0].ID, skipped[0].ID)
+	})
+
+	t.Run("different user gets empty list", func(t *testing.T) {
+		db := setupTestDB(t)
+		repo := NewPostgresTransactionRepository(db)
+		ctx := context.Background()
+		bankroll := createTestBankroll(t, db, 1, "1000.00")
+
+		err := repo.Create(ctx, &Transaction{BankrollID: bankroll.ID, Type: TransactionTypeDeposit, Amount: domain.MustParseDecimal("100.00")}, 1, 0)
+		require.NoError(t, err)
+
+		transactions, _, err := repo.ListByBankrollID(ctx, bankroll.ID, 2, TransactionListFilter{Limit: 10})
+
+		assert.NoError(t, err)
+		assert.Empty(t, transactions)
+	})
+}
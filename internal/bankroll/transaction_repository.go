@@ -0,0 +1,10 @@
+package bankroll
+
+import (
+	"context"
+)
+
+type TransactionRepository interface {
+	Create(ctx context.Context, transaction *Transaction, userID uint, expectedVersion uint) error
+	ListByBankrollID(ctx context.Context, bankrollID uint, userID uint, filter TransactionListFilter) ([]*Transaction, int64, error)
+}
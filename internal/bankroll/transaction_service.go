@@ -0,0 +1,156 @@
+package bankroll
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
+	"github.com/opinedajr/micro-stakes-api/internal/shared/pagination"
+)
+
+type TransactionService interface {
+	CreateTransaction(ctx context.Context, userID uint, bankrollID uint, input CreateTransactionInput) (*TransactionOutput, error)
+	ListTransactions(ctx context.Context, userID uint, bankrollID uint, input ListTransactionsInput) (*TransactionPageOutput, error)
+}
+
+type transactionService struct {
+	repo         TransactionRepository
+	bankrollRepo BankrollRepository
+	logger       *slog.Logger
+}
+
+func NewTransactionService(repo TransactionRepository, bankrollRepo BankrollRepository, logger *slog.Logger) TransactionService {
+	return &transactionService{
+		repo:         repo,
+		bankrollRepo: bankrollRepo,
+		logger:       logger,
+	}
+}
+
+func (s *transactionService) CreateTransaction(ctx context.Context, userID uint, bankrollID uint, input CreateTransactionInput) (*TransactionOutput, error) {
+	amount, err := signedAmount(input.Type, input.Amount)
+	if err != nil {
+		s.logger.Error("invalid transaction", "error", err, "user_id", userID, "bankroll_id", bankrollID, "type", input.Type, "amount", input.Amount)
+		return nil, err
+	}
+
+	bankroll, err := s.bankrollRepo.FindByID(ctx, bankrollID, userID)
+	if err != nil {
+		s.logger.Error("bankroll not found", "error", err, "user_id", userID, "bankroll_id", bankrollID)
+		return nil, err
+	}
+
+	if bankroll.IsArchived() {
+		s.logger.Error("bankroll is archived", "user_id", userID, "bankroll_id", bankrollID)
+		return nil, ErrBankrollArchived
+	}
+
+	if !bankroll.Currency.Fits(amount) {
+		s.logger.Error("amount exceeds currency precision", "amount", amount, "currency", bankroll.Currency, "user_id", userID, "bankroll_id", bankrollID)
+		return nil, ErrInvalidPrecision
+	}
+
+	transaction := &Transaction{
+		BankrollID:  bankrollID,
+		Type:        input.Type,
+		Amount:      amount,
+		Description: input.Description,
+	}
+
+	if err := s.repo.Create(ctx, transaction, userID, input.ExpectedVersion); err != nil {
+		s.logger.Error("failed to create transaction", "error", err, "user_id", userID, "bankroll_id", bankrollID, "type", input.Type, "amount", amount)
+		return nil, err
+	}
+
+	s.logger.Info("transaction created", "user_id", userID, "bankroll_id", bankrollID, "transaction_id", transaction.ID, "type", input.Type, "amount", amount, "balance_after", transaction.BalanceAfter)
+
+	return toTransactionOutput(transaction), nil
+}
+
+func (s *transactionService) ListTransactions(ctx context.Context, userID uint, bankrollID uint, input ListTransactionsInput) (*TransactionPageOutput, error) {
+	params, err := input.Params.Normalize()
+	if err != nil {
+		s.logger.Error("invalid list parameters", "error", err, "user_id", userID)
+		return nil, WrapError(ErrValidationFailed, err.Error())
+	}
+
+	filter := TransactionListFilter{
+		Limit:  params.Limit + 1,
+		Offset: params.Offset,
+	}
+	if params.Cursor != "" {
+		cursor, err := pagination.DecodeCursor(params.Cursor, string(BankrollSortCreatedAt), true)
+		if err == nil {
+			filter.After, err = BankrollSortCreatedAt.ParseCursor(cursor)
+		}
+		if err != nil {
+			s.logger.Error("invalid cursor", "error", err, "user_id", userID)
+			return nil, WrapError(ErrValidationFailed, err.Error())
+		}
+	}
+
+	if _, err := s.bankrollRepo.FindByID(ctx, bankrollID, userID); err != nil {
+		s.logger.Error("bankroll not found", "error", err, "user_id", userID, "bankroll_id", bankrollID)
+		return nil, err
+	}
+
+	transactions, total, err := s.repo.ListByBankrollID(ctx, bankrollID, userID, filter)
+	if err != nil {
+		s.logger.Error("failed to list transactions", "error", err, "user_id", userID, "bankroll_id", bankrollID)
+		return nil, err
+	}
+
+	transactions, more := pagination.Trim(transactions, params.Limit)
+	nextCursor := ""
+	if more {
+		last := transactions[len(transactions)-1]
+		nextCursor = pagination.EncodeCursor(string(BankrollSortCreatedAt), true, last.CreatedAt.UTC().Format(time.RFC3339Nano), last.ID)
+	}
+
+	outputs := make([]*TransactionOutput, len(transactions))
+	for i, t := range transactions {
+		outputs[i] = toTransactionOutput(t)
+	}
+
+	s.logger.Info("transactions listed", "user_id", userID, "bankroll_id", bankrollID, "count", len(outputs), "total", total)
+
+	return pagination.NewPage(outputs, total, params, nextCursor), nil
+}
+
+func signedAmount(transactionType TransactionType, amount domain.Decimal) (domain.Decimal, error) {
+	switch transactionType {
+	case TransactionTypeDeposit:
+		if !amount.IsPositive() {
+			return domain.Zero, ErrInvalidAmount
+		}
+		return amount, nil
+	case TransactionTypeWithdrawal:
+		if !amount.IsPositive() {
+			return domain.Zero, ErrInvalidAmount
+		}
+		return amount.Neg(), nil
+	case TransactionTypeAdjustment:
+		if amount.IsZero() {
+			return domain.Zero, ErrInvalidAmount
+		}
+		return amount, nil
+	default:
+		return domain.Zero, ErrInvalidTransactionType
+	}
+}
+
+func toTransactionOutput(transaction *Transaction) *TransactionOutput {
+	return &TransactionOutput{
+		ID:            transaction.ID,
+		BankrollID:    transaction.BankrollID,
+		Type:          transaction.Type,
+		Amount:        transaction.Amount,
+		BalanceAfter:  transaction.BalanceAfter,
+		Description:   transaction.Description,
+		TransferID:    transaction.TransferID,
+		ReferenceType: transaction.ReferenceType,
+		ReferenceID:   transaction.ReferenceID,
+		CreatedAt:     transaction.CreatedAt,
+	}
+}
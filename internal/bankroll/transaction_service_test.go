@@ -0,0 +1,249 @@
+package bankroll
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
+	"github.com/opinedajr/micro-stakes-api/internal/shared/pagination"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"log/slog"
+)
+
+type MockTransactionRepository struct {
+	mock.Mock
+}
+
+func (m *MockTransactionRepository) Create(ctx context.Context, transaction *Transaction, userID uint, expectedVersion uint) error {
+	args := m.Called(ctx, transaction, userID, expectedVersion)
+	return args.Error(0)
+}
+
+func (m *MockTransactionRepository) ListByBankrollID(ctx context.Context, bankrollID uint, userID uint, filter TransactionListFilter) ([]*Transaction, int64, error) {
+	args := m.Called(ctx, bankrollID, userID, filter)
+	if args.Get(0) == nil {
+		return nil, 0, args.Error(2)
+	}
+	return args.Get(0).([]*Transaction), args.Get(1).(int64), args.Error(2)
+}
+
+func TestCreateTransaction(t *testing.T) {
+	t.Run("success - deposit", func(t *testing.T) {
+		mockRepo := new(MockTransactionRepository)
+		mockBankrollRepo := new(MockBankrollRepository)
+		service := NewTransactionService(mockRepo, mockBankrollRepo, slog.Default())
+
+		ctx := context.Background()
+		input := CreateTransactionInput{
+			Type:        TransactionTypeDeposit,
+			Amount:      domain.MustParseDecimal("200.00"),
+			Description: "Top up",
+		}
+
+		mockBankrollRepo.On("FindByID", ctx, uint(1), uint(1)).Return(&Bankroll{ID: 1, UserID: 1, Currency: CurrencyBRL}, nil).Once()
+		mockRepo.On("Create", ctx, mock.MatchedBy(func(transaction *Transaction) bool {
+			return transaction.BankrollID == 1 && transaction.Amount.Float64() == 200.00 && transaction.Type == TransactionTypeDeposit
+		}), uint(1), uint(0)).Run(func(args mock.Arguments) {
+			transaction := args.Get(1).(*Transaction)
+			transaction.ID = 10
+			transaction.BalanceAfter = domain.MustParseDecimal("1200.00")
+		}).Return(nil).Once()
+
+		output, err := service.CreateTransaction(ctx, 1, 1, input)
+
+		assert.NoError(t, err)
+		assert.NotNil(t, output)
+		assert.Equal(t, uint(10), output.ID)
+		assert.Equal(t, 200.00, output.Amount.Float64())
+		assert.Equal(t, 1200.00, output.BalanceAfter.Float64())
+		assert.Equal(t, "Top up", output.Description)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("success - withdrawal is stored as debit", func(t *testing.T) {
+		mockRepo := new(MockTransactionRepository)
+		mockBankrollRepo := new(MockBankrollRepository)
+		service := NewTransactionService(mockRepo, mockBankrollRepo, slog.Default())
+
+		ctx := context.Background()
+		input := CreateTransactionInput{
+			Type:   TransactionTypeWithdrawal,
+			Amount: domain.MustParseDecimal("300.00"),
+		}
+
+		mockBankrollRepo.On("FindByID", ctx, uint(1), uint(1)).Return(&Bankroll{ID: 1, UserID: 1, Currency: CurrencyBRL}, nil).Once()
+		mockRepo.On("Create", ctx, mock.MatchedBy(func(transaction *Transaction) bool {
+			return transaction.Amount.Float64() == -300.00
+		}), uint(1), uint(0)).Return(nil).Once()
+
+		output, err := service.CreateTransaction(ctx, 1, 1, input)
+
+		assert.NoError(t, err)
+		assert.Equal(t, -300.00, output.Amount.Float64())
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("success - negative adjustment", func(t *testing.T) {
+		mockRepo := new(MockTransactionRepository)
+		mockBankrollRepo := new(MockBankrollRepository)
+		service := NewTransactionService(mockRepo, mockBankrollRepo, slog.Default())
+
+		ctx := context.Background()
+		input := CreateTransactionInput{
+			Type:   TransactionTypeAdjustment,
+			Amount: domain.MustParseDecimal("-12.50"),
+		}
+
+		mockBankrollRepo.On("FindByID", ctx, uint(1), uint(1)).Return(&Bankroll{ID: 1, UserID: 1, Currency: CurrencyBRL}, nil).Once()
+		mockRepo.On("Create", ctx, mock.MatchedBy(func(transaction *Transaction) bool {
+			return transaction.Amount.Float64() == -12.50
+		}), uint(1), uint(0)).Return(nil).Once()
+
+		output, err := service.CreateTransaction(ctx, 1, 1, input)
+
+		assert.NoError(t, err)
+		assert.Equal(t, -12.50, output.Amount.Float64())
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("validation error - negative deposit", func(t *testing.T) {
+		mockRepo := new(MockTransactionRepository)
+		mockBankrollRepo := new(MockBankrollRepository)
+		service := NewTransactionService(mockRepo, mockBankrollRepo, slog.Default())
+
+		output, err := service.CreateTransaction(context.Background(), 1, 1, CreateTransactionInput{
+			Type:   TransactionTypeDeposit,
+			Amount: domain.MustParseDecimal("-10.00"),
+		})
+
+		assert.Nil(t, output)
+		assert.ErrorIs(t, err, ErrInvalidAmount)
+		mockRepo.AssertNotCalled(t, "Create")
+	})
+
+	t.Run("validation error - invalid type", func(t *testing.T) {
+		mockRepo := new(MockTransactionRepository)
+		mockBankrollRepo := new(MockBankrollRepository)
+		service := NewTransactionService(mockRepo, mockBankrollRepo, slog.Default())
+
+		output, err := service.CreateTransaction(context.Background(), 1, 1, CreateTransactionInput{
+			Type:   "bonus",
+			Amount: domain.MustParseDecimal("10.00"),
+		})
+
+		assert.Nil(t, output)
+		assert.ErrorIs(t, err, ErrInvalidTransactionType)
+		mockRepo.AssertNotCalled(t, "Create")
+	})
+
+	t.Run("validation error - amount exceeds currency precision", func(t *testing.T) {
+		mockRepo := new(MockTransactionRepository)
+		mockBankrollRepo := new(MockBankrollRepository)
+		service := NewTransactionService(mockRepo, mockBankrollRepo, slog.Default())
+
+		ctx := context.Background()
+		mockBankrollRepo.On("FindByID", ctx, uint(1), uint(1)).Return(&Bankroll{ID: 1, UserID: 1, Currency: CurrencyUSD}, nil).Once()
+
+		output, err := service.CreateTransaction(ctx, 1, 1, CreateTransactionInput{
+			Type:   TransactionTypeDeposit,
+			Amount: domain.MustParseDecimal("10.005"),
+		})
+
+		assert.Nil(t, output)
+		assert.ErrorIs(t, err, ErrInvalidPrecision)
+		mockRepo.AssertNotCalled(t, "Create")
+	})
+
+	t.Run("error - bankroll archived", func(t *testing.T) {
+		mockRepo := new(MockTransactionRepository)
+		mockBankrollRepo := new(MockBankrollRepository)
+		service := NewTransactionService(mockRepo, mockBankrollRepo, slog.Default())
+
+		ctx := context.Background()
+		archivedAt := time.Now()
+		mockBankrollRepo.On("FindByID", ctx, uint(1), uint(1)).Return(&Bankroll{ID: 1, UserID: 1, Currency: CurrencyBRL, ArchivedAt: &archivedAt}, nil).Once()
+
+		output, err := service.CreateTransaction(ctx, 1, 1, CreateTransactionInput{
+			Type:   TransactionTypeDeposit,
+			Amount: domain.MustParseDecimal("100.00"),
+		})
+
+		assert.Nil(t, output)
+		assert.ErrorIs(t, err, ErrBankrollArchived)
+		mockRepo.AssertNotCalled(t, "Create")
+	})
+
+	t.Run("repository error - insufficient funds", func(t *testing.T) {
+		mockRepo := new(MockTransactionRepository)
+		mockBankrollRepo := new(MockBankrollRepository)
+		service := NewTransactionService(mockRepo, mockBankrollRepo, slog.Default())
+
+		ctx := context.Background()
+		mockBankrollRepo.On("FindByID", ctx, uint(1), uint(1)).Return(&Bankroll{ID: 1, UserID: 1, Currency: CurrencyBRL}, nil).Once()
+		mockRepo.On("Create", ctx, mock.AnythingOfType("*bankroll.Transaction"), uint(1), uint(0)).Return(ErrInsufficientFunds).Once()
+
+		output, err := service.CreateTransaction(ctx, 1, 1, CreateTransactionInput{
+			Type:   TransactionTypeWithdrawal,
+			Amount: domain.MustParseDecimal("5000.00"),
+		})
+
+		assert.Nil(t, output)
+		assert.ErrorIs(t, err, ErrInsufficientFunds)
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestListTransactions(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		mockRepo := new(MockTransactionRepository)
+		mockBankrollRepo := new(MockBankrollRepository)
+		service := NewTransactionService(mockRepo, mockBankrollRepo, slog.Default())
+
+		ctx := context.Background()
+		mockBankrollRepo.On("FindByID", ctx, uint(1), uint(1)).Return(&Bankroll{ID: 1, UserID: 1}, nil).Once()
+		createdAt := time.Date(2026, 5, 1, 10, 0, 0, 0, time.UTC)
+		mockRepo.On("ListByBankrollID", ctx, uint(1), uint(1), TransactionListFilter{Limit: 2}).Return([]*Transaction{
+			{ID: 2, BankrollID: 1, Type: TransactionTypeWithdrawal, Amount: domain.MustParseDecimal("-50.00"), BalanceAfter: domain.MustParseDecimal("1050.00"), CreatedAt: createdAt},
+			{ID: 1, BankrollID: 1, Type: TransactionTypeDeposit, Amount: domain.MustParseDecimal("100.00"), BalanceAfter: domain.MustParseDecimal("1100.00"), CreatedAt: createdAt.Add(-time.Hour)},
+		}, int64(2), nil).Once()
+
+		page, err := service.ListTransactions(ctx, 1, 1, ListTransactionsInput{Params: pagination.Params{Limit: 1}})
+
+		assert.NoError(t, err)
+		require.Len(t, page.Items, 1)
+		assert.Equal(t, uint(2), page.Items[0].ID)
+		assert.Equal(t, int64(2), page.Total)
+		assert.Equal(t, pagination.EncodeCursor("created_at", true, createdAt.Format(time.RFC3339Nano), 2), page.NextCursor)
+		mockRepo.AssertExpectations(t)
+		mockBankrollRepo.AssertExpectations(t)
+	})
+
+	t.Run("invalid parameters", func(t *testing.T) {
+		service := NewTransactionService(new(MockTransactionRepository), new(MockBankrollRepository), slog.Default())
+		ctx := context.Background()
+
+		_, err := service.ListTransactions(ctx, 1, 1, ListTransactionsInput{Params: pagination.Params{Limit: 101}})
+		assert.ErrorIs(t, err, ErrValidationFailed)
+
+		_, err = service.ListTransactions(ctx, 1, 1, ListTransactionsInput{Params: pagination.Params{Cursor: pagination.EncodeCursor("name", false, "Main", 1)}})
+		assert.ErrorIs(t, err, ErrValidationFailed)
+	})
+
+	t.Run("bankroll not found", func(t *testing.T) {
+		mockRepo := new(MockTransactionRepository)
+		mockBankrollRepo := new(MockBankrollRepository)
+		service := NewTransactionService(mockRepo, mockBankrollRepo, slog.Default())
+
+		ctx := context.Background()
+		mockBankrollRepo.On("FindByID", ctx, uint(1), uint(2)).Return(nil, ErrBankrollNotFound).Once()
+
+		page, err := service.ListTransactions(ctx, 2, 1, ListTransactionsInput{})
+
+		assert.Nil(t, page)
+		assert.ErrorIs(t, err, ErrBankrollNotFound)
+		mockRepo.AssertNotCalled(t, "ListByBankrollID")
+	})
+}
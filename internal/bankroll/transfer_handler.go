@@ -0,0 +1,48 @@
+package bankroll
+
+import (
+	"net/http"
+
+	"log/slog"
+
+	"github.com/gin-gonic/gin"
+)
+
+type TransferHandler struct {
+	service TransferService
+	logger  *slog.Logger
+}
+
+func NewTransferHandler(service TransferService, logger *slog.Logger) *TransferHandler {
+	return &TransferHandler{
+		service: service,
+		logger:  logger,
+	}
+}
+
+func (h *TransferHandler) CreateTransfer(c *gin.Context) {
+	var input CreateTransferInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		h.logger.Error("invalid request body", "error", err)
+		c.JSON(http.StatusBadRequest, ErrorOutput{
+			Error:   "Invalid request body",
+			Code:    "VALIDATION_ERROR",
+			Details: nil,
+		})
+		return
+	}
+
+	userID, err := getUserID(c)
+	if err != nil {
+		handleError(c, h.logger, err)
+		return
+	}
+
+	output, err := h.service.CreateTransfer(c.Request.Context(), userID, input)
+	if err != nil {
+		handleError(c, h.logger, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, output)
+}
@@ -0,0 +1,122 @@
+package bankroll
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"log/slog"
+
+	"github.com/gin-gonic/gin"
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type MockTransferServiceForHandler struct {
+	mock.Mock
+}
+
+func (m *MockTransferServiceForHandler) CreateTransfer(ctx context.Context, userID uint, input CreateTransferInput) (*TransferOutput, error) {
+	args := m.Called(ctx, userID, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*TransferOutput), args.Error(1)
+}
+
+func TestCreateTransferHandler(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		mockService := new(MockTransferServiceForHandler)
+		handler := NewTransferHandler(mockService, slog.Default())
+
+		input := CreateTransferInput{FromBankrollID: 1, ToBankrollID: 2, Amount: domain.MustParseDecimal("100.00"), ExchangeRate: domain.MustParseDecimal("5.0")}
+		mockService.On("CreateTransfer", mock.Anything, uint(1), input).Return(&TransferOutput{
+			ID:              1,
+			FromBankrollID:  1,
+			ToBankrollID:    2,
+			Amount:          domain.MustParseDecimal("100.00"),
+			ConvertedAmount: domain.MustParseDecimal("500.00"),
+			ExchangeRate:    domain.MustParseDecimal("5.0"),
+		}, nil).Once()
+
+		bodyBytes, err := json.Marshal(input)
+		require.NoError(t, err)
+
+		req, err := http.NewRequest(http.MethodPost, "/bankrolls/transfers", bytes.NewBuffer(bodyBytes))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = req
+		c.Set("userID", "1")
+
+		handler.CreateTransfer(c)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+
+		var response TransferOutput
+		err = json.Unmarshal(w.Body.Bytes(), &response)
+		require.NoError(t, err)
+
+		assert.Equal(t, 500.00, response.ConvertedAmount.Float64())
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("validation error - non-positive amount", func(t *testing.T) {
+		mockService := new(MockTransferServiceForHandler)
+		handler := NewTransferHandler(mockService, slog.Default())
+
+		bodyBytes, err := json.Marshal(map[string]interface{}{"from_bankroll_id": 1, "to_bankroll_id": 2, "amount": -5})
+		require.NoError(t, err)
+
+		req, err := http.NewRequest(http.MethodPost, "/bankrolls/transfers", bytes.NewBuffer(bodyBytes))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = req
+		c.Set("userID", "1")
+
+		handler.CreateTransfer(c)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		mockService.AssertNotCalled(t, "CreateTransfer")
+	})
+
+	t.Run("service error - exchange rate not found", func(t *testing.T) {
+		mockService := new(MockTransferServiceForHandler)
+		handler := NewTransferHandler(mockService, slog.Default())
+
+		mockService.On("CreateTransfer", mock.Anything, uint(1), mock.AnythingOfType("bankroll.CreateTransferInput")).Return(nil, ErrExchangeRateNotFound).Once()
+
+		bodyBytes, err := json.Marshal(CreateTransferInput{FromBankrollID: 1, ToBankrollID: 2, Amount: domain.MustParseDecimal("100.00")})
+		require.NoError(t, err)
+
+		req, err := http.NewRequest(http.MethodPost, "/bankrolls/transfers", bytes.NewBuffer(bodyBytes))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = req
+		c.Set("userID", "1")
+
+		handler.CreateTransfer(c)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+
+		var response ErrorOutput
+		err = json.Unmarshal(w.Body.Bytes(), &response)
+		require.NoError(t, err)
+
+		assert.Equal(t, "EXCHANGE_RATE_NOT_FOUND", response.Code)
+		mockService.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,25 @@
+package bankroll
+
+import (
+	"time"
+
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
+)
+
+type Transfer struct {
+	ID              uint           `gorm:"primaryKey;autoIncrement"`
+	UserID          uint           `gorm:"not null;index"`
+	FromBankrollID  uint           `gorm:"not null;index"`
+	ToBankrollID    uint           `gorm:"not null;index"`
+	Amount          domain.Decimal `gorm:"type:decimal(27,8);not null"`
+	ConvertedAmount domain.Decimal `gorm:"type:decimal(27,8);not null"`
+	ExchangeRate    domain.Decimal `gorm:"type:decimal(19,8);not null"`
+	Description     string         `gorm:"type:varchar(255)"`
+	CreatedAt       time.Time      `gorm:"autoCreateTime"`
+	Debit           Transaction    `gorm:"-"`
+	Credit          Transaction    `gorm:"-"`
+}
+
+func (Transfer) TableName() string {
+	return "bankroll_transfers"
+}
@@ -0,0 +1,54 @@
+package bankroll
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+)
+
+type postgresTransferRepository struct {
+	db *gorm.DB
+}
+
+func NewPostgresTransferRepository(db *gorm.DB) TransferRepository {
+	return &postgresTransferRepository{
+		db: db,
+	}
+}
+
+func (r *postgresTransferRepository) Create(ctx context.Context, transfer *Transfer) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(transfer).Error; err != nil {
+			return WrapError(ErrDatabaseError, err.Error())
+		}
+
+		transferID := transfer.ID
+		transfer.Debit = Transaction{
+			BankrollID:  transfer.FromBankrollID,
+			Type:        TransactionTypeTransferOut,
+			Amount:      transfer.Amount.Neg(),
+			Description: transfer.Description,
+			TransferID:  &transferID,
+		}
+		transfer.Credit = Transaction{
+			BankrollID:  transfer.ToBankrollID,
+			Type:        TransactionTypeTransferIn,
+			Amount:      transfer.ConvertedAmount,
+			Description: transfer.Description,
+			TransferID:  &transferID,
+		}
+
+		// Lock bankrolls in id order so opposite transfers cannot deadlock.
+		legs := []*Transaction{&transfer.Debit, &transfer.Credit}
+		if transfer.ToBankrollID < transfer.FromBankrollID {
+			legs[0], legs[1] = legs[1], legs[0]
+		}
+
+		for _, leg := range legs {
+			if err := ApplyTransaction(tx, leg, transfer.UserID); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
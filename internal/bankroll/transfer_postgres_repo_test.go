@@ -0,0 +1,110 @@
+package bankroll
+
+import (
+	"context"
+	"testing"
+
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostgresTransferRepository_Create(t *testing.T) {
+	t.Run("success - both legs are recorded and linked", func(t *testing.T) {
+		db := setupTestDB(t)
+		repo := NewPostgresTransferRepository(db)
+		bankrollRepo := NewPostgresBankrollRepository(db)
+		ctx := context.Background()
+
+		from := createTestBankroll(t, db, 1, "1000.00")
+		to := &Bankroll{UserID: 1, Name: "USD Bankroll", Currency: CurrencyUSD, InitialBalance: domain.MustParseDecimal("100.00"), CurrentBalance: domain.MustParseDecimal("100.00"), StartDate: from.StartDate}
+		require.NoError(t, bankrollRepo.Create(ctx, to))
+
+		transfer := &Transfer{
+			UserID:          1,
+			FromBankrollID:  from.ID,
+			ToBankrollID:    to.ID,
+			Amount:          domain.MustParseDecimal("500.00"),
+			ConvertedAmount: domain.MustParseDecimal("100.00"),
+			ExchangeRate:    domain.MustParseDecimal("0.2"),
+		}
+
+		err := repo.Create(ctx, transfer)
+
+		assert.NoError(t, err)
+		assert.NotZero(t, transfer.ID)
+		assert.Equal(t, -500.00, transfer.Debit.Amount.Float64())
+		assert.Equal(t, 500.00, transfer.Debit.BalanceAfter.Float64())
+		assert.Equal(t, 100.00, transfer.Credit.Amount.Float64())
+		assert.Equal(t, 200.00, transfer.Credit.BalanceAfter.Float64())
+		require.NotNil(t, transfer.Debit.TransferID)
+		require.NotNil(t, transfer.Credit.TransferID)
+		assert.Equal(t, transfer.ID, *transfer.Debit.TransferID)
+		assert.Equal(t, transfer.ID, *transfer.Credit.TransferID)
+
+		updatedFrom, err := bankrollRepo.FindByID(ctx, from.ID, 1)
+		require.NoError(t, err)
+		assert.Equal(t, 500.00, updatedFrom.CurrentBalance.Float64())
+
+		updatedTo, err := bankrollRepo.FindByID(ctx, to.ID, 1)
+		require.NoError(t, err)
+		assert.Equal(t, 200.00, updatedTo.CurrentBalance.Float64())
+	})
+
+	t.Run("insufficient funds rolls back", func(t *testing.T) {
+		db := setupTestDB(t)
+		repo := NewPostgresTransferRepository(db)
+		bankrollRepo := NewPostgresBankrollRepository(db)
+		ctx := context.Background()
+
+		from := createTestBankroll(t, db, 1, "100.00")
+		to := &Bankroll{UserID: 1, Name: "Second", Currency: CurrencyBRL, StartDate: from.StartDate}
+		require.NoError(t, bankrollRepo.Create(ctx, to))
+
+		err := repo.Create(ctx, &Transfer{
+			UserID:          1,
+			FromBankrollID:  from.ID,
+			ToBankrollID:    to.ID,
+			Amount:          domain.MustParseDecimal("150.00"),
+			ConvertedAmount: domain.MustParseDecimal("150.00"),
+			ExchangeRate:    domain.MustParseDecimal("1"),
+		})
+
+		assert.ErrorIs(t, err, ErrInsufficientFunds)
+
+		var transfers, transactions int64
+		db.Model(&Transfer{}).Count(&transfers)
+		db.Model(&Transaction{}).Count(&transactions)
+		assert.Equal(t, int64(0), transfers)
+		assert.Equal(t, int64(0), transactions)
+
+		updatedTo, err := bankrollRepo.FindByID(ctx, to.ID, 1)
+		require.NoError(t, err)
+		assert.Equal(t, 0.0, updatedTo.CurrentBalance.Float64())
+	})
+
+	t.Run("target owned by another user rolls back", func(t *testing.T) {
+		db := setupTestDB(t)
+		repo := NewPostgresTransferRepository(db)
+		bankrollRepo := NewPostgresBankrollRepository(db)
+		ctx := context.Background()
+
+		from := createTestBankroll(t, db, 1, "1000.00")
+		to := createTestBankroll(t, db, 2, "0")
+
+		err := repo.Create(ctx, &Transfer{
+			UserID:          1,
+			FromBankrollID:  from.ID,
+			ToBankrollID:    to.ID,
+			Amount:          domain.MustParseDecimal("100.00"),
+			ConvertedAmount: domain.MustParseDecimal("100.00"),
+			ExchangeRate:    domain.MustParseDecimal("1"),
+		})
+
+		assert.ErrorIs(t, err, ErrBankrollNotFound)
+
+		updatedFrom, err := bankrollRepo.FindByID(ctx, from.ID, 1)
+		require.NoError(t, err)
+		assert.Equal(t, 1000.00, updatedFrom.CurrentBalance.Float64())
+	})
+}
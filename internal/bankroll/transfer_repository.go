@@ -0,0 +1,9 @@
+package bankroll
+
+import (
+	"context"
+)
+
+type TransferRepository interface {
+	Create(ctx context.Context, transfer *Transfer) error
+}
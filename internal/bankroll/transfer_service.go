@@ -0,0 +1,141 @@
+package bankroll
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
+	"github.com/opinedajr/micro-stakes-api/internal/fx"
+)
+
+type TransferService interface {
+	CreateTransfer(ctx context.Context, userID uint, input CreateTransferInput) (*TransferOutput, error)
+}
+
+type transferService struct {
+	repo         TransferRepository
+	bankrollRepo BankrollRepository
+	rates        fx.Converter
+	logger       *slog.Logger
+}
+
+func NewTransferService(repo TransferRepository, bankrollRepo BankrollRepository, rates fx.Converter, logger *slog.Logger) TransferService {
+	return &transferService{
+		repo:         repo,
+		bankrollRepo: bankrollRepo,
+		rates:        rates,
+		logger:       logger,
+	}
+}
+
+func (s *transferService) CreateTransfer(ctx context.Context, userID uint, input CreateTransferInput) (*TransferOutput, error) {
+	if input.FromBankrollID == input.ToBankrollID {
+		s.logger.Error("transfer to same bankroll", "user_id", userID, "bankroll_id", input.FromBankrollID)
+		return nil, ErrSameBankrollTransfer
+	}
+
+	if !input.Amount.IsPositive() {
+		s.logger.Error("invalid transfer amount", "amount", input.Amount, "user_id", userID)
+		return nil, ErrInvalidAmount
+	}
+
+	from, err := s.bankrollRepo.FindByID(ctx, input.FromBankrollID, userID)
+	if err != nil {
+		s.logger.Error("source bankroll not found", "error", err, "user_id", userID, "bankroll_id", input.FromBankrollID)
+		return nil, err
+	}
+
+	to, err := s.bankrollRepo.FindByID(ctx, input.ToBankrollID, userID)
+	if err != nil {
+		s.logger.Error("target bankroll not found", "error", err, "user_id", userID, "bankroll_id", input.ToBankrollID)
+		return nil, err
+	}
+
+	if from.IsArchived() || to.IsArchived() {
+		s.logger.Error("bankroll is archived", "user_id", userID, "from_bankroll_id", from.ID, "to_bankroll_id", to.ID)
+		return nil, ErrBankrollArchived
+	}
+
+	if !from.Currency.Fits(input.Amount) {
+		s.logger.Error("amount exceeds currency precision", "amount", input.Amount, "currency", from.Currency, "user_id", userID)
+		return nil, ErrInvalidPrecision
+	}
+
+	rate, err := s.resolveRate(ctx, from.Currency, to.Currency, input.ExchangeRate)
+	if err != nil {
+		s.logger.Error("failed to resolve exchange rate", "error", err, "user_id", userID, "from_currency", from.Currency, "to_currency", to.Currency)
+		return nil, err
+	}
+
+	transfer := &Transfer{
+		UserID:          userID,
+		FromBankrollID:  from.ID,
+		ToBankrollID:    to.ID,
+		Amount:          input.Amount,
+		ConvertedAmount: to.Currency.Round(input.Amount.Mul(rate)),
+		ExchangeRate:    rate,
+		Description:     input.Description,
+	}
+
+	if !transfer.ConvertedAmount.IsPositive() {
+		s.logger.Error("converted amount rounds to zero", "amount", input.Amount, "exchange_rate", rate, "user_id", userID)
+		return nil, ErrInvalidAmount
+	}
+
+	if err := s.repo.Create(ctx, transfer); err != nil {
+		s.logger.Error("failed to create transfer", "error", err, "user_id", userID, "from_bankroll_id", from.ID, "to_bankroll_id", to.ID, "amount", input.Amount)
+		return nil, err
+	}
+
+	s.logger.Info("transfer created", "user_id", userID, "transfer_id", transfer.ID, "from_bankroll_id", from.ID, "to_bankroll_id", to.ID, "amount", transfer.Amount, "converted_amount", transfer.ConvertedAmount, "exchange_rate", rate)
+
+	return toTransferOutput(transfer), nil
+}
+
+func (s *transferService) resolveRate(ctx context.Context, from Currency, to Currency, explicitRate domain.Decimal) (domain.Decimal, error) {
+	one := domain.NewDecimalFromInt(1)
+
+	if explicitRate.IsNegative() || !explicitRate.FitsScale(domain.MaxScale) {
+		return domain.Zero, ErrInvalidExchangeRate
+	}
+
+	if from == to {
+		if !explicitRate.IsZero() && !explicitRate.Equal(one) {
+			return domain.Zero, ErrInvalidExchangeRate
+		}
+		return one, nil
+	}
+
+	if explicitRate.IsPositive() {
+		return explicitRate, nil
+	}
+
+	rate, err := s.rates.Rate(ctx, from, to, time.Now().UTC())
+	switch {
+	case err == nil:
+		return rate, nil
+	case errors.Is(err, fx.ErrRateNotFound):
+		return domain.Zero, ErrExchangeRateNotFound
+	case errors.Is(err, fx.ErrInvalidRate):
+		return domain.Zero, ErrInvalidExchangeRate
+	default:
+		return domain.Zero, WrapError(ErrDatabaseError, err.Error())
+	}
+}
+
+func toTransferOutput(transfer *Transfer) *TransferOutput {
+	return &TransferOutput{
+		ID:              transfer.ID,
+		FromBankrollID:  transfer.FromBankrollID,
+		ToBankrollID:    transfer.ToBankrollID,
+		Amount:          transfer.Amount,
+		ConvertedAmount: transfer.ConvertedAmount,
+		ExchangeRate:    transfer.ExchangeRate,
+		Description:     transfer.Description,
+		Debit:           *toTransactionOutput(&transfer.Debit),
+		Credit:          *toTransactionOutput(&transfer.Credit),
+		CreatedAt:       transfer.CreatedAt,
+	}
+}
@@ -0,0 +1,178 @@
+package bankroll
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
+	"github.com/opinedajr/micro-stakes-api/internal/fx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"log/slog"
+)
+
+type MockTransferRepository struct {
+	mock.Mock
+}
+
+func (m *MockTransferRepository) Create(ctx context.Context, transfer *Transfer) error {
+	args := m.Called(ctx, transfer)
+	return args.Error(0)
+}
+
+type MockConverter struct {
+	mock.Mock
+}
+
+func (m *MockConverter) Rate(ctx context.Context, from Currency, to Currency, at time.Time) (domain.Decimal, error) {
+	args := m.Called(ctx, from, to, at)
+	return args.Get(0).(domain.Decimal), args.Error(1)
+}
+
+func (m *MockConverter) Convert(ctx context.Context, amount domain.Decimal, from Currency, to Currency, at time.Time) (domain.Decimal, error) {
+	args := m.Called(ctx, amount, from, to, at)
+	return args.Get(0).(domain.Decimal), args.Error(1)
+}
+
+func TestCreateTransfer(t *testing.T) {
+	t.Run("success - same currency", func(t *testing.T) {
+		mockRepo := new(MockTransferRepository)
+		mockBankrollRepo := new(MockBankrollRepository)
+		mockRates := new(MockConverter)
+		service := NewTransferService(mockRepo, mockBankrollRepo, mockRates, slog.Default())
+
+		ctx := context.Background()
+		mockBankrollRepo.On("FindByID", ctx, uint(1), uint(1)).Return(&Bankroll{ID: 1, UserID: 1, Currency: CurrencyBRL}, nil).Once()
+		mockBankrollRepo.On("FindByID", ctx, uint(2), uint(1)).Return(&Bankroll{ID: 2, UserID: 1, Currency: CurrencyBRL}, nil).Once()
+		mockRepo.On("Create", ctx, mock.MatchedBy(func(transfer *Transfer) bool {
+			return transfer.Amount.Float64() == 100.00 && transfer.ConvertedAmount.Float64() == 100.00 && transfer.ExchangeRate.Float64() == 1
+		})).Return(nil).Once()
+
+		output, err := service.CreateTransfer(ctx, 1, CreateTransferInput{FromBankrollID: 1, ToBankrollID: 2, Amount: domain.MustParseDecimal("100.00")})
+
+		assert.NoError(t, err)
+		assert.Equal(t, 100.00, output.ConvertedAmount.Float64())
+		mockRepo.AssertExpectations(t)
+		mockRates.AssertNotCalled(t, "Rate")
+	})
+
+	t.Run("success - explicit exchange rate", func(t *testing.T) {
+		mockRepo := new(MockTransferRepository)
+		mockBankrollRepo := new(MockBankrollRepository)
+		mockRates := new(MockConverter)
+		service := NewTransferService(mockRepo, mockBankrollRepo, mockRates, slog.Default())
+
+		ctx := context.Background()
+		mockBankrollRepo.On("FindByID", ctx, uint(1), uint(1)).Return(&Bankroll{ID: 1, UserID: 1, Currency: CurrencyUSD}, nil).Once()
+		mockBankrollRepo.On("FindByID", ctx, uint(2), uint(1)).Return(&Bankroll{ID: 2, UserID: 1, Currency: CurrencyBRL}, nil).Once()
+		mockRepo.On("Create", ctx, mock.MatchedBy(func(transfer *Transfer) bool {
+			return transfer.ConvertedAmount.Float64() == 512.34 && transfer.ExchangeRate.Float64() == 5.1234
+		})).Return(nil).Once()
+
+		output, err := service.CreateTransfer(ctx, 1, CreateTransferInput{FromBankrollID: 1, ToBankrollID: 2, Amount: domain.MustParseDecimal("100.00"), ExchangeRate: domain.MustParseDecimal("5.1234")})
+
+		assert.NoError(t, err)
+		assert.Equal(t, 512.34, output.ConvertedAmount.Float64())
+		mockRates.AssertNotCalled(t, "Rate")
+	})
+
+	t.Run("success - stored exchange rate", func(t *testing.T) {
+		mockRepo := new(MockTransferRepository)
+		mockBankrollRepo := new(MockBankrollRepository)
+		mockRates := new(MockConverter)
+		service := NewTransferService(mockRepo, mockBankrollRepo, mockRates, slog.Default())
+
+		ctx := context.Background()
+		mockBankrollRepo.On("FindByID", ctx, uint(1), uint(1)).Return(&Bankroll{ID: 1, UserID: 1, Currency: CurrencyBRL}, nil).Once()
+		mockBankrollRepo.On("FindByID", ctx, uint(2), uint(1)).Return(&Bankroll{ID: 2, UserID: 1, Currency: CurrencyUSD}, nil).Once()
+		mockRates.On("Rate", ctx, CurrencyBRL, CurrencyUSD, mock.AnythingOfType("time.Time")).Return(domain.MustParseDecimal("0.2"), nil).Once()
+		mockRepo.On("Create", ctx, mock.AnythingOfType("*bankroll.Transfer")).Return(nil).Once()
+
+		output, err := service.CreateTransfer(ctx, 1, CreateTransferInput{FromBankrollID: 1, ToBankrollID: 2, Amount: domain.MustParseDecimal("500.00")})
+
+		assert.NoError(t, err)
+		assert.Equal(t, 100.00, output.ConvertedAmount.Float64())
+		assert.Equal(t, 0.2, output.ExchangeRate.Float64())
+		mockRates.AssertExpectations(t)
+	})
+
+	t.Run("error - same bankroll", func(t *testing.T) {
+		mockRepo := new(MockTransferRepository)
+		mockBankrollRepo := new(MockBankrollRepository)
+		service := NewTransferService(mockRepo, mockBankrollRepo, new(MockConverter), slog.Default())
+
+		output, err := service.CreateTransfer(context.Background(), 1, CreateTransferInput{FromBankrollID: 1, ToBankrollID: 1, Amount: domain.MustParseDecimal("10.00")})
+
+		assert.Nil(t, output)
+		assert.ErrorIs(t, err, ErrSameBankrollTransfer)
+		mockBankrollRepo.AssertNotCalled(t, "FindByID")
+	})
+
+	t.Run("error - target not owned by caller", func(t *testing.T) {
+		mockRepo := new(MockTransferRepository)
+		mockBankrollRepo := new(MockBankrollRepository)
+		service := NewTransferService(mockRepo, mockBankrollRepo, new(MockConverter), slog.Default())
+
+		ctx := context.Background()
+		mockBankrollRepo.On("FindByID", ctx, uint(1), uint(1)).Return(&Bankroll{ID: 1, UserID: 1, Currency: CurrencyBRL}, nil).Once()
+		mockBankrollRepo.On("FindByID", ctx, uint(2), uint(1)).Return(nil, ErrBankrollNotFound).Once()
+
+		output, err := service.CreateTransfer(ctx, 1, CreateTransferInput{FromBankrollID: 1, ToBankrollID: 2, Amount: domain.MustParseDecimal("10.00")})
+
+		assert.Nil(t, output)
+		assert.ErrorIs(t, err, ErrBankrollNotFound)
+		mockRepo.AssertNotCalled(t, "Create")
+	})
+
+	t.Run("error - target archived", func(t *testing.T) {
+		mockRepo := new(MockTransferRepository)
+		mockBankrollRepo := new(MockBankrollRepository)
+		service := NewTransferService(mockRepo, mockBankrollRepo, new(MockConverter), slog.Default())
+
+		ctx := context.Background()
+		archivedAt := time.Now()
+		mockBankrollRepo.On("FindByID", ctx, uint(1), uint(1)).Return(&Bankroll{ID: 1, UserID: 1, Currency: CurrencyBRL}, nil).Once()
+		mockBankrollRepo.On("FindByID", ctx, uint(2), uint(1)).Return(&Bankroll{ID: 2, UserID: 1, Currency: CurrencyBRL, ArchivedAt: &archivedAt}, nil).Once()
+
+		output, err := service.CreateTransfer(ctx, 1, CreateTransferInput{FromBankrollID: 1, ToBankrollID: 2, Amount: domain.MustParseDecimal("10.00")})
+
+		assert.Nil(t, output)
+		assert.ErrorIs(t, err, ErrBankrollArchived)
+		mockRepo.AssertNotCalled(t, "Create")
+	})
+
+	t.Run("error - rate given for same currency", func(t *testing.T) {
+		mockRepo := new(MockTransferRepository)
+		mockBankrollRepo := new(MockBankrollRepository)
+		service := NewTransferService(mockRepo, mockBankrollRepo, new(MockConverter), slog.Default())
+
+		ctx := context.Background()
+		mockBankrollRepo.On("FindByID", ctx, uint(1), uint(1)).Return(&Bankroll{ID: 1, UserID: 1, Currency: CurrencyEUR}, nil).Once()
+		mockBankrollRepo.On("FindByID", ctx, uint(2), uint(1)).Return(&Bankroll{ID: 2, UserID: 1, Currency: CurrencyEUR}, nil).Once()
+
+		output, err := service.CreateTransfer(ctx, 1, CreateTransferInput{FromBankrollID: 1, ToBankrollID: 2, Amount: domain.MustParseDecimal("10.00"), ExchangeRate: domain.MustParseDecimal("1.5")})
+
+		assert.Nil(t, output)
+		assert.ErrorIs(t, err, ErrInvalidExchangeRate)
+		mockRepo.AssertNotCalled(t, "Create")
+	})
+
+	t.Run("error - no stored rate", func(t *testing.T) {
+		mockRepo := new(MockTransferRepository)
+		mockBankrollRepo := new(MockBankrollRepository)
+		mockRates := new(MockConverter)
+		service := NewTransferService(mockRepo, mockBankrollRepo, mockRates, slog.Default())
+
+		ctx := context.Background()
+		mockBankrollRepo.On("FindByID", ctx, uint(1), uint(1)).Return(&Bankroll{ID: 1, UserID: 1, Currency: CurrencyBTC}, nil).Once()
+		mockBankrollRepo.On("FindByID", ctx, uint(2), uint(1)).Return(&Bankroll{ID: 2, UserID: 1, Currency: CurrencyEUR}, nil).Once()
+		mockRates.On("Rate", ctx, CurrencyBTC, CurrencyEUR, mock.AnythingOfType("time.Time")).Return(domain.Zero, fx.ErrRateNotFound).Once()
+
+		output, err := service.CreateTransfer(ctx, 1, CreateTransferInput{FromBankrollID: 1, ToBankrollID: 2, Amount: domain.MustParseDecimal("0.5")})
+
+		assert.Nil(t, output)
+		assert.ErrorIs(t, err, ErrExchangeRateNotFound)
+		mockRepo.AssertNotCalled(t, "Create")
+	})
+}
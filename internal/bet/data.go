@@ -0,0 +1,66 @@
+package bet
+
+import (
+	"time"
+
+	"github.com/opinedajr/micro-stakes-api/internal/bankroll"
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
+	"github.com/opinedajr/micro-stakes-api/internal/shared/pagination"
+)
+
+type RegisterBetInput struct {
+	Type       BetType        `json:"type" binding:"required"`
+	Event      string         `json:"event" binding:"required,min=1,max=200"`
+	Market     string         `json:"market" binding:"required,min=1,max=100"`
+	Selection  string         `json:"selection" binding:"required,min=1,max=100"`
+	Odds       domain.Decimal `json:"odds" binding:"decimal_required,decimal_gt=1"`
+	Stake      domain.Decimal `json:"stake" binding:"decimal_required,decimal_gt=0"`
+	PlacedAt   string         `json:"placed_at"`
+	StrategyID *uint          `json:"strategy_id"`
+}
+
+type SettleBetInput struct {
+	Result        BetStatus       `json:"result" binding:"required"`
+	CashOutProfit *domain.Decimal `json:"cash_out_profit"`
+}
+
+type AssignStrategyInput struct {
+	StrategyID *uint `json:"strategy_id"`
+}
+
+type ListBetsInput struct {
+	pagination.Params
+}
+
+type BetOutput struct {
+	ID          uint           `json:"id"`
+	BankrollID  uint           `json:"bankroll_id"`
+	StrategyID  *uint          `json:"strategy_id"`
+	Type        BetType        `json:"type"`
+	Event       string         `json:"event"`
+	Market      string         `json:"market"`
+	Selection   string         `json:"selection"`
+	Odds        domain.Decimal `json:"odds"`
+	Stake       domain.Decimal `json:"stake"`
+	Liability   domain.Decimal `json:"liability"`
+	Status      BetStatus      `json:"status"`
+	GrossProfit domain.Decimal `json:"gross_profit"`
+	Commission  domain.Decimal `json:"commission"`
+	NetProfit   domain.Decimal `json:"net_profit"`
+	PlacedAt    time.Time      `json:"placed_at"`
+	SettledAt   *time.Time     `json:"settled_at,omitempty"`
+	CreatedAt   time.Time      `json:"created_at"`
+	UpdatedAt   time.Time      `json:"updated_at"`
+	// Warnings lists the soft bankroll rules the bet broke when registered.
+	Warnings []bankroll.RuleBreachOutput `json:"warnings,omitempty"`
+}
+
+type BetPageOutput = pagination.Page[*BetOutput]
+
+type ErrorOutput struct {
+	Error   string                     `json:"error"`
+	Code    string                     `json:"code"`
+	Details map[string][]string        `json:"details,omitempty"`
+	Rule    *bankroll.RuleBreachOutput `json:"rule,omitempty"`
+	Lock    *bankroll.LimitLockOutput  `json:"lock,omitempty"`
+}
@@ -0,0 +1,28 @@
+package bet
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrBetNotFound       = errors.New("bet not found")
+	ErrValidationFailed  = errors.New("validation failed")
+	ErrDatabaseError     = errors.New("database error")
+	ErrUnauthorized      = errors.New("unauthorized access to bet")
+	ErrInvalidBetType    = errors.New("invalid bet type")
+	ErrInvalidOdds       = errors.New("odds must be greater than 1 with at most 3 decimal places")
+	ErrInvalidStake      = errors.New("stake must be positive")
+	ErrInvalidResult     = errors.New("invalid bet result")
+	ErrInvalidCashOut    = errors.New("cash-out profit is required and must be within the bet's possible outcomes")
+	ErrBetAlreadySettled = errors.New("bet already settled")
+	ErrBankrollNotFound  = errors.New("bankroll not found")
+	ErrBankrollArchived  = errors.New("bankroll is archived")
+	ErrInsufficientFunds = errors.New("insufficient funds in bankroll")
+	ErrInvalidPrecision  = errors.New("amount exceeds currency precision")
+	ErrStrategyNotFound  = errors.New("strategy not found")
+)
+
+func WrapError(err error, message string) error {
+	return fmt.Errorf("%s: %w", message, err)
+}
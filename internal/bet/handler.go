@@ -0,0 +1,303 @@
+package bet
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"log/slog"
+
+	"github.com/gin-gonic/gin"
+	"github.com/opinedajr/micro-stakes-api/internal/bankroll"
+)
+
+type BetHandler struct {
+	service BetService
+	logger  *slog.Logger
+}
+
+func NewBetHandler(service BetService, logger *slog.Logger) *BetHandler {
+	return &BetHandler{
+		service: service,
+		logger:  logger,
+	}
+}
+
+func (h *BetHandler) RegisterBet(c *gin.Context) {
+	var input RegisterBetInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		h.logger.Error("invalid request body", "error", err)
+		c.JSON(http.StatusBadRequest, ErrorOutput{
+			Error:   "Invalid request body",
+			Code:    "VALIDATION_ERROR",
+			Details: nil,
+		})
+		return
+	}
+
+	userID, err := h.getUserID(c)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	bankrollIDStr := c.Param("bankrollId")
+	bankrollID, err := strconv.ParseUint(bankrollIDStr, 10, 32)
+	if err != nil {
+		h.handleError(c, ErrUnauthorized)
+		return
+	}
+
+	output, err := h.service.RegisterBet(c.Request.Context(), userID, uint(bankrollID), input)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, output)
+}
+
+func (h *BetHandler) ListBets(c *gin.Context) {
+	var input ListBetsInput
+	if err := c.ShouldBindQuery(&input); err != nil {
+		h.logger.Error("invalid query parameters", "error", err)
+		c.JSON(http.StatusBadRequest, ErrorOutput{
+			Error:   "Invalid query parameters",
+			Code:    "VALIDATION_ERROR",
+			Details: nil,
+		})
+		return
+	}
+
+	userID, err := h.getUserID(c)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	bankrollIDStr := c.Param("bankrollId")
+	bankrollID, err := strconv.ParseUint(bankrollIDStr, 10, 32)
+	if err != nil {
+		h.handleError(c, ErrUnauthorized)
+		return
+	}
+
+	output, err := h.service.ListBets(c.Request.Context(), userID, uint(bankrollID), input)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
+
+func (h *BetHandler) GetBet(c *gin.Context) {
+	userID, err := h.getUserID(c)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	betIDStr := c.Param("betId")
+	betID, err := strconv.ParseUint(betIDStr, 10, 32)
+	if err != nil {
+		h.handleError(c, ErrUnauthorized)
+		return
+	}
+
+	output, err := h.service.GetBet(c.Request.Context(), userID, uint(betID))
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
+
+func (h *BetHandler) SettleBet(c *gin.Context) {
+	var input SettleBetInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		h.logger.Error("invalid request body", "error", err)
+		c.JSON(http.StatusBadRequest, ErrorOutput{
+			Error:   "Invalid request body",
+			Code:    "VALIDATION_ERROR",
+			Details: nil,
+		})
+		return
+	}
+
+	userID, err := h.getUserID(c)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	betIDStr := c.Param("betId")
+	betID, err := strconv.ParseUint(betIDStr, 10, 32)
+	if err != nil {
+		h.handleError(c, ErrUnauthorized)
+		return
+	}
+
+	output, err := h.service.SettleBet(c.Request.Context(), userID, uint(betID), input)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
+
+func (h *BetHandler) AssignStrategy(c *gin.Context) {
+	var input AssignStrategyInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		h.logger.Error("invalid request body", "error", err)
+		c.JSON(http.StatusBadRequest, ErrorOutput{
+			Error:   "Invalid request body",
+			Code:    "VALIDATION_ERROR",
+			Details: nil,
+		})
+		return
+	}
+
+	userID, err := h.getUserID(c)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	betIDStr := c.Param("betId")
+	betID, err := strconv.ParseUint(betIDStr, 10, 32)
+	if err != nil {
+		h.handleError(c, ErrUnauthorized)
+		return
+	}
+
+	output, err := h.service.AssignStrategy(c.Request.Context(), userID, uint(betID), input)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
+
+func (h *BetHandler) handleError(c *gin.Context, err error) {
+	var violation *bankroll.RuleViolation
+	var limitReached *bankroll.LimitReachedError
+	switch {
+	case errors.As(err, &violation):
+		c.JSON(http.StatusUnprocessableEntity, ErrorOutput{
+			Error: "Bankroll rule violated",
+			Code:  "RULE_VIOLATION",
+			Rule:  &violation.Breach,
+		})
+	case errors.As(err, &limitReached):
+		c.JSON(http.StatusUnprocessableEntity, ErrorOutput{
+			Error: "Bankroll stop limit reached",
+			Code:  "LIMIT_REACHED",
+			Lock:  &limitReached.Lock,
+		})
+	case errors.Is(err, ErrBetNotFound):
+		c.JSON(http.StatusNotFound, ErrorOutput{
+			Error: "Bet not found",
+			Code:  "BET_NOT_FOUND",
+		})
+	case errors.Is(err, ErrBankrollNotFound):
+		c.JSON(http.StatusNotFound, ErrorOutput{
+			Error: "Bankroll not found",
+			Code:  "BANKROLL_NOT_FOUND",
+		})
+	case errors.Is(err, ErrBankrollArchived):
+		c.JSON(http.StatusConflict, ErrorOutput{
+			Error: "Bankroll is archived",
+			Code:  "BANKROLL_ARCHIVED",
+		})
+	case errors.Is(err, ErrStrategyNotFound):
+		c.JSON(http.StatusNotFound, ErrorOutput{
+			Error: "Strategy not found",
+			Code:  "STRATEGY_NOT_FOUND",
+		})
+	case errors.Is(err, ErrValidationFailed):
+		c.JSON(http.StatusBadRequest, ErrorOutput{
+			Error: err.Error(),
+			Code:  "VALIDATION_ERROR",
+		})
+	case errors.Is(err, ErrDatabaseError):
+		h.logger.Error("database error", "error", err)
+		c.JSON(http.StatusInternalServerError, ErrorOutput{
+			Error: "Database error occurred",
+			Code:  "DATABASE_ERROR",
+		})
+	case errors.Is(err, ErrUnauthorized):
+		c.JSON(http.StatusForbidden, ErrorOutput{
+			Error: "Unauthorized access to bet",
+			Code:  "UNAUTHORIZED",
+		})
+	case errors.Is(err, ErrInvalidBetType):
+		c.JSON(http.StatusBadRequest, ErrorOutput{
+			Error: "Invalid bet type",
+			Code:  "INVALID_BET_TYPE",
+		})
+	case errors.Is(err, ErrInvalidOdds):
+		c.JSON(http.StatusBadRequest, ErrorOutput{
+			Error: "Odds must be greater than 1 with at most 3 decimal places",
+			Code:  "INVALID_ODDS",
+		})
+	case errors.Is(err, ErrInvalidStake):
+		c.JSON(http.StatusBadRequest, ErrorOutput{
+			Error: "Stake must be positive",
+			Code:  "INVALID_STAKE",
+		})
+	case errors.Is(err, ErrInvalidResult):
+		c.JSON(http.StatusBadRequest, ErrorOutput{
+			Error: "Invalid bet result",
+			Code:  "INVALID_RESULT",
+		})
+	case errors.Is(err, ErrInvalidCashOut):
+		c.JSON(http.StatusBadRequest, ErrorOutput{
+			Error: "Cash-out profit is required and must be within the bet's possible outcomes",
+			Code:  "INVALID_CASH_OUT",
+		})
+	case errors.Is(err, ErrInvalidPrecision):
+		c.JSON(http.StatusBadRequest, ErrorOutput{
+			Error: "Amount exceeds currency precision",
+			Code:  "INVALID_PRECISION",
+		})
+	case errors.Is(err, ErrBetAlreadySettled):
+		c.JSON(http.StatusConflict, ErrorOutput{
+			Error: "Bet already settled",
+			Code:  "BET_ALREADY_SETTLED",
+		})
+	case errors.Is(err, ErrInsufficientFunds):
+		c.JSON(http.StatusUnprocessableEntity, ErrorOutput{
+			Error: "Insufficient funds in bankroll",
+			Code:  "INSUFFICIENT_FUNDS",
+		})
+	default:
+		h.logger.Error("unexpected error", "error", err)
+		c.JSON(http.StatusInternalServerError, ErrorOutput{
+			Error: "An unexpected error occurred",
+			Code:  "INTERNAL_ERROR",
+		})
+	}
+}
+
+func (h *BetHandler) getUserID(c *gin.Context) (uint, error) {
+	userIDStr, exists := c.Get("userID")
+	if !exists {
+		return 0, ErrUnauthorized
+	}
+
+	userID, ok := userIDStr.(string)
+	if !ok {
+		return 0, ErrUnauthorized
+	}
+
+	parsedID, err := strconv.ParseUint(userID, 10, 32)
+	if err != nil {
+		return 0, ErrUnauthorized
+	}
+
+	return uint(parsedID), nil
+}
@@ -0,0 +1,367 @@
+package bet
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"log/slog"
+
+	"github.com/gin-gonic/gin"
+	"github.com/opinedajr/micro-stakes-api/internal/bankroll"
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
+	"github.com/opinedajr/micro-stakes-api/internal/shared/pagination"
+	customValidator "github.com/opinedajr/micro-stakes-api/internal/shared/validator"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMain(m *testing.M) {
+	if err := customValidator.RegisterBindingValidators(); err != nil {
+		panic(err)
+	}
+	os.Exit(m.Run())
+}
+
+type MockBetServiceForHandler struct {
+	mock.Mock
+}
+
+func (m *MockBetServiceForHandler) RegisterBet(ctx context.Context, userID uint, bankrollID uint, input RegisterBetInput) (*BetOutput, error) {
+	args := m.Called(ctx, userID, bankrollID, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*BetOutput), args.Error(1)
+}
+
+func (m *MockBetServiceForHandler) ListBets(ctx context.Context, userID uint, bankrollID uint, input ListBetsInput) (*BetPageOutput, error) {
+	args := m.Called(ctx, userID, bankrollID, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*BetPageOutput), args.Error(1)
+}
+
+func (m *MockBetServiceForHandler) GetBet(ctx context.Context, userID uint, betID uint) (*BetOutput, error) {
+	args := m.Called(ctx, userID, betID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*BetOutput), args.Error(1)
+}
+
+func (m *MockBetServiceForHandler) SettleBet(ctx context.Context, userID uint, betID uint, input SettleBetInput) (*BetOutput, error) {
+	args := m.Called(ctx, userID, betID, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*BetOutput), args.Error(1)
+}
+
+func (m *MockBetServiceForHandler) AssignStrategy(ctx context.Context, userID uint, betID uint, input AssignStrategyInput) (*BetOutput, error) {
+	args := m.Called(ctx, userID, betID, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*BetOutput), args.Error(1)
+}
+
+func newBetRequest(t *testing.T, url string, body interface{}) *http.Request {
+	bodyBytes, err := json.Marshal(body)
+	require.NoError(t, err)
+
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewBuffer(bodyBytes))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+	return req
+}
+
+func TestRegisterBetHandler(t *testing.T) {
+	input := RegisterBetInput{
+		Type:      BetTypeBack,
+		Event:     "Flamengo x Palmeiras",
+		Market:    "Match Odds",
+		Selection: "Flamengo",
+		Odds:      domain.MustParseDecimal("2.5"),
+		Stake:     domain.MustParseDecimal("100"),
+	}
+
+	t.Run("success", func(t *testing.T) {
+		mockService := new(MockBetServiceForHandler)
+		handler := NewBetHandler(mockService, slog.Default())
+
+		mockService.On("RegisterBet", mock.Anything, uint(1), uint(1), mock.AnythingOfType("bet.RegisterBetInput")).
+			Return(&BetOutput{ID: 1, BankrollID: 1, Type: BetTypeBack, Status: BetStatusOpen}, nil).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = newBetRequest(t, "/bankrolls/1/bets", input)
+		c.Params = gin.Params{{Key: "bankrollId", Value: "1"}}
+		c.Set("userID", "1")
+
+		handler.RegisterBet(c)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		var response BetOutput
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, uint(1), response.ID)
+		assert.Equal(t, BetStatusOpen, response.Status)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("validation error - odds not above 1", func(t *testing.T) {
+		mockService := new(MockBetServiceForHandler)
+		handler := NewBetHandler(mockService, slog.Default())
+		invalid := input
+		invalid.Odds = domain.MustParseDecimal("1")
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = newBetRequest(t, "/bankrolls/1/bets", invalid)
+		c.Params = gin.Params{{Key: "bankrollId", Value: "1"}}
+		c.Set("userID", "1")
+
+		handler.RegisterBet(c)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		mockService.AssertNotCalled(t, "RegisterBet")
+	})
+
+	t.Run("bankroll not found", func(t *testing.T) {
+		mockService := new(MockBetServiceForHandler)
+		handler := NewBetHandler(mockService, slog.Default())
+
+		mockService.On("RegisterBet", mock.Anything, uint(1), uint(9), mock.AnythingOfType("bet.RegisterBetInput")).
+			Return(nil, ErrBankrollNotFound).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = newBetRequest(t, "/bankrolls/9/bets", input)
+		c.Params = gin.Params{{Key: "bankrollId", Value: "9"}}
+		c.Set("userID", "1")
+
+		handler.RegisterBet(c)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		var response ErrorOutput
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, "BANKROLL_NOT_FOUND", response.Code)
+	})
+
+	t.Run("rule violation", func(t *testing.T) {
+		mockService := new(MockBetServiceForHandler)
+		handler := NewBetHandler(mockService, slog.Default())
+		breach := bankroll.RuleBreachOutput{
+			RuleID:   4,
+			Type:     bankroll.RuleTypeMaxBuyInPercent,
+			Severity: bankroll.RuleSeverityHard,
+			Limit:    domain.MustParseDecimal("50.00"),
+			Actual:   domain.MustParseDecimal("100"),
+		}
+
+		mockService.On("RegisterBet", mock.Anything, uint(1), uint(1), mock.AnythingOfType("bet.RegisterBetInput")).
+			Return(nil, &bankroll.RuleViolation{Breach: breach}).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = newBetRequest(t, "/bankrolls/1/bets", input)
+		c.Params = gin.Params{{Key: "bankrollId", Value: "1"}}
+		c.Set("userID", "1")
+
+		handler.RegisterBet(c)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+		var response ErrorOutput
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, "RULE_VIOLATION", response.Code)
+		require.NotNil(t, response.Rule)
+		assert.Equal(t, uint(4), response.Rule.RuleID)
+		assert.Equal(t, "50.00", response.Rule.Limit.String())
+	})
+
+	t.Run("stop limit reached", func(t *testing.T) {
+		mockService := new(MockBetServiceForHandler)
+		handler := NewBetHandler(mockService, slog.Default())
+		until := time.Date(2026, 3, 5, 0, 0, 0, 0, time.UTC)
+
+		mockService.On("RegisterBet", mock.Anything, uint(1), uint(1), mock.AnythingOfType("bet.RegisterBetInput")).
+			Return(nil, &bankroll.LimitReachedError{Lock: bankroll.LimitLockOutput{Locked: true, Reason: bankroll.LimitReasonDailyStopLoss, LockedUntil: &until}}).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = newBetRequest(t, "/bankrolls/1/bets", input)
+		c.Params = gin.Params{{Key: "bankrollId", Value: "1"}}
+		c.Set("userID", "1")
+
+		handler.RegisterBet(c)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+		var response ErrorOutput
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, "LIMIT_REACHED", response.Code)
+		require.NotNil(t, response.Lock)
+		assert.Equal(t, bankroll.LimitReasonDailyStopLoss, response.Lock.Reason)
+	})
+
+	t.Run("unauthorized - missing user", func(t *testing.T) {
+		mockService := new(MockBetServiceForHandler)
+		handler := NewBetHandler(mockService, slog.Default())
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = newBetRequest(t, "/bankrolls/1/bets", input)
+		c.Params = gin.Params{{Key: "bankrollId", Value: "1"}}
+
+		handler.RegisterBet(c)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+}
+
+func TestListBetsHandler(t *testing.T) {
+	mockService := new(MockBetServiceForHandler)
+	handler := NewBetHandler(mockService, slog.Default())
+
+	t.Run("success", func(t *testing.T) {
+		mockService.On("ListBets", mock.Anything, uint(1), uint(1), ListBetsInput{Params: pagination.Params{Limit: 2}}).
+			Return(&BetPageOutput{Items: []*BetOutput{{ID: 1}, {ID: 2}}, Total: 3, Limit: 2, NextCursor: "next"}, nil).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest(http.MethodGet, "/bankrolls/1/bets?limit=2", nil)
+		c.Params = gin.Params{{Key: "bankrollId", Value: "1"}}
+		c.Set("userID", "1")
+
+		handler.ListBets(c)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		var response BetPageOutput
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Len(t, response.Items, 2)
+		assert.Equal(t, int64(3), response.Total)
+		assert.Equal(t, "next", response.NextCursor)
+	})
+
+	t.Run("invalid query", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest(http.MethodGet, "/bankrolls/1/bets?limit=abc", nil)
+		c.Params = gin.Params{{Key: "bankrollId", Value: "1"}}
+		c.Set("userID", "1")
+
+		handler.ListBets(c)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestGetBetHandler(t *testing.T) {
+	mockService := new(MockBetServiceForHandler)
+	handler := NewBetHandler(mockService, slog.Default())
+
+	mockService.On("GetBet", mock.Anything, uint(1), uint(5)).Return(nil, ErrBetNotFound).Once()
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest(http.MethodGet, "/bets/5", nil)
+	c.Params = gin.Params{{Key: "betId", Value: "5"}}
+	c.Set("userID", "1")
+
+	handler.GetBet(c)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	var response ErrorOutput
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, "BET_NOT_FOUND", response.Code)
+}
+
+func TestSettleBetHandler(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		mockService := new(MockBetServiceForHandler)
+		handler := NewBetHandler(mockService, slog.Default())
+
+		mockService.On("SettleBet", mock.Anything, uint(1), uint(5), SettleBetInput{Result: BetStatusWon}).
+			Return(&BetOutput{ID: 5, Status: BetStatusWon, NetProfit: domain.MustParseDecimal("142.50")}, nil).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = newBetRequest(t, "/bets/5/settle", SettleBetInput{Result: BetStatusWon})
+		c.Params = gin.Params{{Key: "betId", Value: "5"}}
+		c.Set("userID", "1")
+
+		handler.SettleBet(c)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		var response BetOutput
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, 142.50, response.NetProfit.Float64())
+	})
+
+	t.Run("already settled", func(t *testing.T) {
+		mockService := new(MockBetServiceForHandler)
+		handler := NewBetHandler(mockService, slog.Default())
+
+		mockService.On("SettleBet", mock.Anything, uint(1), uint(5), SettleBetInput{Result: BetStatusLost}).
+			Return(nil, ErrBetAlreadySettled).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = newBetRequest(t, "/bets/5/settle", SettleBetInput{Result: BetStatusLost})
+		c.Params = gin.Params{{Key: "betId", Value: "5"}}
+		c.Set("userID", "1")
+
+		handler.SettleBet(c)
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+		var response ErrorOutput
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, "BET_ALREADY_SETTLED", response.Code)
+	})
+
+	t.Run("insufficient funds", func(t *testing.T) {
+		mockService := new(MockBetServiceForHandler)
+		handler := NewBetHandler(mockService, slog.Default())
+
+		mockService.On("SettleBet", mock.Anything, uint(1), uint(5), SettleBetInput{Result: BetStatusLost}).
+			Return(nil, ErrInsufficientFunds).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = newBetRequest(t, "/bets/5/settle", SettleBetInput{Result: BetStatusLost})
+		c.Params = gin.Params{{Key: "betId", Value: "5"}}
+		c.Set("userID", "1")
+
+		handler.SettleBet(c)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	})
+}
+
+func TestAssignStrategyHandler(t *testing.T) {
+	mockService := new(MockBetServiceForHandler)
+	handler := NewBetHandler(mockService, slog.Default())
+	strategyID := uint(4)
+
+	mockService.On("AssignStrategy", mock.Anything, uint(1), uint(5), AssignStrategyInput{StrategyID: &strategyID}).
+		Return(nil, ErrStrategyNotFound).Once()
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = newBetRequest(t, "/bets/5/strategy", AssignStrategyInput{StrategyID: &strategyID})
+	c.Params = gin.Params{{Key: "betId", Value: "5"}}
+	c.Set("userID", "1")
+
+	handler.AssignStrategy(c)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	var response ErrorOutput
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, "STRATEGY_NOT_FOUND", response.Code)
+}
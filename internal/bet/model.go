@@ -0,0 +1,117 @@
+package bet
+
+import (
+	"time"
+
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
+	"gorm.io/gorm"
+)
+
+type BetType string
+
+const (
+	BetTypeBack BetType = "back"
+	BetTypeLay  BetType = "lay"
+)
+
+type BetStatus string
+
+const (
+	BetStatusOpen      BetStatus = "open"
+	BetStatusWon       BetStatus = "won"
+	BetStatusLost      BetStatus = "lost"
+	BetStatusVoid      BetStatus = "void"
+	BetStatusCashedOut BetStatus = "cashed_out"
+)
+
+const OddsScale int32 = 3
+
+// Bet is a single back or lay position on an exchange market. GrossProfit is
+// the result before commission; NetProfit is what was posted to the bankroll.
+type Bet struct {
+	ID          uint           `gorm:"primaryKey;autoIncrement"`
+	UserID      uint           `gorm:"not null;index"`
+	BankrollID  uint           `gorm:"not null;index"`
+	StrategyID  *uint          `gorm:"index"`
+	Type        BetType        `gorm:"type:varchar(10);not null"`
+	Event       string         `gorm:"type:varchar(200);not null"`
+	Market      string         `gorm:"type:varchar(100);not null"`
+	Selection   string         `gorm:"type:varchar(100);not null"`
+	Odds        domain.Decimal `gorm:"type:decimal(10,3);not null"`
+	Stake       domain.Decimal `gorm:"type:decimal(27,8);not null"`
+	Liability   domain.Decimal `gorm:"type:decimal(27,8);not null"`
+	Status      BetStatus      `gorm:"type:varchar(20);not null;index"`
+	GrossProfit domain.Decimal `gorm:"type:decimal(27,8);not null"`
+	Commission  domain.Decimal `gorm:"type:decimal(27,8);not null"`
+	NetProfit   domain.Decimal `gorm:"type:decimal(27,8);not null"`
+	PlacedAt    time.Time      `gorm:"not null"`
+	SettledAt   *time.Time
+	CreatedAt   time.Time      `gorm:"autoCreateTime"`
+	UpdatedAt   time.Time      `gorm:"autoUpdateTime"`
+	DeletedAt   gorm.DeletedAt `gorm:"index"`
+}
+
+func (Bet) TableName() string {
+	return "bets"
+}
+
+func (b *Bet) IsOpen() bool {
+	return b.Status == BetStatusOpen
+}
+
+// ComputeLiability returns the amount at risk: the stake for a back bet and
+// stake * (odds - 1) for a lay bet.
+func ComputeLiability(betType BetType, odds, stake domain.Decimal) domain.Decimal {
+	if betType == BetTypeLay {
+		return stake.Mul(odds.Sub(domain.NewDecimalFromInt(1)))
+	}
+	return stake
+}
+
+// GrossProfitFor returns the pre-commission result of settling the bet with the
+// given status. Cashed-out results are supplied by the caller.
+func (b *Bet) GrossProfitFor(status BetStatus) domain.Decimal {
+	winnings := b.Stake.Mul(b.Odds.Sub(domain.NewDecimalFromInt(1)))
+	switch {
+	case status == BetStatusWon && b.Type == BetTypeBack:
+		return winnings
+	case status == BetStatusLost && b.Type == BetTypeBack:
+		return b.Stake.Neg()
+	case status == BetStatusWon && b.Type == BetTypeLay:
+		return b.Stake
+	case status == BetStatusLost && b.Type == BetTypeLay:
+		return winnings.Neg()
+	default:
+		return domain.Zero
+	}
+}
+
+// BetListFilter selects a page of a bankroll's bets, most recently placed
+// first. After, when set, replaces Offset with keyset paging.
+type BetListFilter struct {
+	After  *BetCursor
+	Limit  int
+	Offset int
+}
+
+type BetCursor struct {
+	PlacedAt time.Time
+	ID       uint
+}
+
+// SettlementResult carries the outcome chosen by the user. Commission and the
+// net amount are resolved by the repository against the whole market.
+type SettlementResult struct {
+	Status      BetStatus
+	GrossProfit domain.Decimal
+	SettledAt   time.Time
+}
+
+// MarketCommission returns the commission owed on a market's net result.
+// Exchanges only charge commission on net winnings, never on losses.
+func MarketCommission(marketProfit, commissionPercentage domain.Decimal) domain.Decimal {
+	if !marketProfit.IsPositive() {
+		return domain.Zero
+	}
+	return marketProfit.Mul(commissionPercentage).Div(domain.NewDecimalFromInt(100))
+}
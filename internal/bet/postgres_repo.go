@@ -0,0 +1,180 @@
+package bet
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/opinedajr/micro-stakes-api/internal/bankroll"
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
+	"github.com/opinedajr/micro-stakes-api/internal/shared/pagination"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const ReferenceTypeBet = "bet"
+
+type postgresBetRepository struct {
+	db *gorm.DB
+}
+
+func NewPostgresBetRepository(db *gorm.DB) BetRepository {
+	return &postgresBetRepository{
+		db: db,
+	}
+}
+
+func (r *postgresBetRepository) Create(ctx context.Context, bet *Bet) error {
+	if err := r.db.WithContext(ctx).Create(bet).Error; err != nil {
+		return WrapError(ErrDatabaseError, err.Error())
+	}
+	return nil
+}
+
+func (r *postgresBetRepository) FindByID(ctx context.Context, id uint, userID uint) (*Bet, error) {
+	var bet Bet
+	err := r.db.WithContext(ctx).Where("id = ? AND user_id = ?", id, userID).First(&bet).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, ErrBetNotFound
+		}
+		return nil, WrapError(ErrDatabaseError, err.Error())
+	}
+	return &bet, nil
+}
+
+// ListByBankrollID returns a page of the bankroll's bets together with the
+// total number of bets.
+func (r *postgresBetRepository) ListByBankrollID(ctx context.Context, bankrollID uint, userID uint, filter BetListFilter) ([]*Bet, int64, error) {
+	query := r.db.WithContext(ctx).Model(&Bet{}).
+		Where("bankroll_id = ? AND user_id = ?", bankrollID, userID)
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, WrapError(ErrDatabaseError, err.Error())
+	}
+
+	if filter.After != nil {
+		query = query.Where(pagination.KeysetCondition("placed_at", true),
+			filter.After.PlacedAt, filter.After.PlacedAt, filter.After.ID)
+	} else if filter.Offset > 0 {
+		query = query.Offset(filter.Offset)
+	}
+
+	var bets []*Bet
+	err := query.Order(pagination.OrderClause("placed_at", true)).
+		Limit(filter.Limit).
+		Find(&bets).Error
+	if err != nil {
+		return nil, 0, WrapError(ErrDatabaseError, err.Error())
+	}
+	return bets, total, nil
+}
+
+func (r *postgresBetRepository) UpdateStrategy(ctx context.Context, id uint, userID uint, strategyID *uint) error {
+	result := r.db.WithContext(ctx).Model(&Bet{}).
+		Where("id = ? AND user_id = ?", id, userID).
+		Update("strategy_id", strategyID)
+	if result.Error != nil {
+		return WrapError(ErrDatabaseError, result.Error.Error())
+	}
+	if result.RowsAffected == 0 {
+		return ErrBetNotFound
+	}
+	return nil
+}
+
+// Settle closes an open bet and posts its net result to the bankroll ledger.
+// Commission is charged on the market's net winnings, so settling one bet may
+// also adjust the commission already taken on other bets of the same market.
+func (r *postgresBetRepository) Settle(ctx context.Context, bet *Bet, result SettlementResult) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var br bankroll.Bankroll
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ? AND user_id = ?", bet.BankrollID, bet.UserID).
+			First(&br).Error
+		if err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return ErrBankrollNotFound
+			}
+			return WrapError(ErrDatabaseError, err.Error())
+		}
+
+		var current Bet
+		err = tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ? AND user_id = ?", bet.ID, bet.UserID).
+			First(&current).Error
+		if err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return ErrBetNotFound
+			}
+			return WrapError(ErrDatabaseError, err.Error())
+		}
+		if !current.IsOpen() {
+			return ErrBetAlreadySettled
+		}
+
+		var settled []*Bet
+		err = tx.Where("bankroll_id = ? AND event = ? AND market = ? AND status <> ? AND id <> ?",
+			current.BankrollID, current.Event, current.Market, BetStatusOpen, current.ID).
+			Find(&settled).Error
+		if err != nil {
+			return WrapError(ErrDatabaseError, err.Error())
+		}
+
+		marketBefore := domain.Zero
+		for _, b := range settled {
+			marketBefore = marketBefore.Add(b.GrossProfit)
+		}
+		marketAfter := marketBefore.Add(result.GrossProfit)
+
+		commission := br.Currency.Round(MarketCommission(marketAfter, br.CommissionPercentage)).
+			Sub(br.Currency.Round(MarketCommission(marketBefore, br.CommissionPercentage)))
+		netProfit := result.GrossProfit.Sub(commission)
+		settledAt := result.SettledAt
+
+		if err := tx.Model(&current).Updates(map[string]interface{}{
+			"status":       result.Status,
+			"gross_profit": result.GrossProfit,
+			"commission":   commission,
+			"net_profit":   netProfit,
+			"settled_at":   settledAt,
+		}).Error; err != nil {
+			return WrapError(ErrDatabaseError, err.Error())
+		}
+
+		if !netProfit.IsZero() {
+			betID := current.ID
+			transaction := &bankroll.Transaction{
+				BankrollID:    current.BankrollID,
+				Type:          bankroll.TransactionTypeBetSettlement,
+				Amount:        netProfit,
+				Description:   fmt.Sprintf("Bet #%d settled as %s", betID, result.Status),
+				ReferenceType: ReferenceTypeBet,
+				ReferenceID:   &betID,
+			}
+			if err := bankroll.ApplyTransaction(tx, transaction, current.UserID); err != nil {
+				return mapBankrollError(err)
+			}
+		}
+
+		bet.Status = result.Status
+		bet.GrossProfit = result.GrossProfit
+		bet.Commission = commission
+		bet.NetProfit = netProfit
+		bet.SettledAt = &settledAt
+		bet.UpdatedAt = current.UpdatedAt
+		return nil
+	})
+}
+
+func mapBankrollError(err error) error {
+	switch {
+	case errors.Is(err, bankroll.ErrBankrollNotFound):
+		return ErrBankrollNotFound
+	case errors.Is(err, bankroll.ErrInsufficientFunds):
+		return ErrInsufficientFunds
+	default:
+		return WrapError(ErrDatabaseError, err.Error())
+	}
+}
@@ -0,0 +1,260 @@
+package bet
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/opinedajr/micro-stakes-api/internal/bankroll"
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+func setupTestDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+
+	db.AutoMigrate(&bankroll.Bankroll{}, &bankroll.Transaction{}, &bankroll.BankrollGoal{}, &bankroll.GoalMilestone{}, &Bet{})
+
+	return db
+}
+
+func createTestBankroll(t *testing.T, db *gorm.DB, userID uint, balance string, commission string) *bankroll.Bankroll {
+	startDate, err := time.Parse("2006-01-02", "2026-02-01")
+	require.NoError(t, err)
+
+	br := &bankroll.Bankroll{
+		UserID:               userID,
+		Name:                 "Exchange",
+		Currency:             bankroll.CurrencyBRL,
+		InitialBalance:       domain.MustParseDecimal(balance),
+		CurrentBalance:       domain.MustParseDecimal(balance),
+		StartDate:            startDate,
+		CommissionPercentage: domain.MustParseDecimal(commission),
+	}
+
+	err = bankroll.NewPostgresBankrollRepository(db).Create(context.Background(), br)
+	require.NoError(t, err)
+
+	return br
+}
+
+func createTestBet(t *testing.T, db *gorm.DB, br *bankroll.Bankroll, betType BetType, selection string, odds string, stake string) *Bet {
+	bet := &Bet{
+		UserID:      br.UserID,
+		BankrollID:  br.ID,
+		Type:        betType,
+		Event:       "Flamengo x Palmeiras",
+		Market:      "Match Odds",
+		Selection:   selection,
+		Odds:        domain.MustParseDecimal(odds),
+		Stake:       domain.MustParseDecimal(stake),
+		Liability:   ComputeLiability(betType, domain.MustParseDecimal(odds), domain.MustParseDecimal(stake)),
+		Status:      BetStatusOpen,
+		GrossProfit: domain.Zero,
+		Commission:  domain.Zero,
+		NetProfit:   domain.Zero,
+		PlacedAt:    time.Now(),
+	}
+
+	err := NewPostgresBetRepository(db).Create(context.Background(), bet)
+	require.NoError(t, err)
+
+	return bet
+}
+
+func TestPostgresBetRepository_FindByID(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		db := setupTestDB(t)
+		repo := NewPostgresBetRepository(db)
+		br := createTestBankroll(t, db, 1, "1000.00", "5.00")
+		bet := createTestBet(t, db, br, BetTypeBack, "Flamengo", "2.500", "100.00")
+
+		found, err := repo.FindByID(context.Background(), bet.ID, 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, bet.ID, found.ID)
+		assert.Equal(t, 2.5, found.Odds.Float64())
+		assert.Equal(t, BetStatusOpen, found.Status)
+	})
+
+	t.Run("not found for another user", func(t *testing.T) {
+		db := setupTestDB(t)
+		repo := NewPostgresBetRepository(db)
+		br := createTestBankroll(t, db, 1, "1000.00", "5.00")
+		bet := createTestBet(t, db, br, BetTypeBack, "Flamengo", "2.500", "100.00")
+
+		found, err := repo.FindByID(context.Background(), bet.ID, 2)
+
+		assert.Nil(t, found)
+		assert.ErrorIs(t, err, ErrBetNotFound)
+	})
+}
+
+func TestPostgresBetRepository_ListByBankrollID(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewPostgresBetRepository(db)
+	br := createTestBankroll(t, db, 1, "1000.00", "5.00")
+	ctx := context.Background()
+	placedAt := time.Date(2026, 5, 1, 10, 0, 0, 0, time.UTC)
+	for i := 0; i < 3; i++ {
+		bet := createTestBet(t, db, br, BetTypeBack, "Flamengo", "2.500", "100.00")
+		require.NoError(t, db.Model(bet).Update("placed_at", placedAt).Error)
+	}
+
+	first, total, err := repo.ListByBankrollID(ctx, br.ID, 1, BetListFilter{Limit: 2})
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), total)
+	require.Len(t, first, 2)
+	assert.Greater(t, first[0].ID, first[1].ID)
+
+	rest, _, err := repo.ListByBankrollID(ctx, br.ID, 1, BetListFilter{
+		Limit: 2,
+		After: &BetCursor{PlacedAt: first[1].PlacedAt, ID: first[1].ID},
+	})
+	require.NoError(t, err)
+	require.Len(t, rest, 1, "equal timestamps are split by id")
+	assert.Less(t, rest[0].ID, first[1].ID)
+
+	skipped, _, err := repo.ListByBankrollID(ctx, br.ID, 1, BetListFilter{Limit: 2, Offset: 2})
+	require.NoError(t, err)
+	require.Len(t, skipped, 1)
+	assert.Equal(t, rest[0].ID, skipped[0].ID)
+
+	bets, total, err := repo.ListByBankrollID(ctx, br.ID, 2, BetListFilter{Limit: 10})
+	assert.NoError(t, err)
+	assert.Zero(t, total)
+	assert.Empty(t, bets)
+}
+
+func TestPostgresBetRepository_Settle(t *testing.T) {
+	t.Run("back bet won pays winnings minus commission", func(t *testing.T) {
+		db := setupTestDB(t)
+		repo := NewPostgresBetRepository(db)
+		ctx := context.Background()
+		br := createTestBankroll(t, db, 1, "1000.00", "5.00")
+		bet := createTestBet(t, db, br, BetTypeBack, "Flamengo", "2.500", "100.00")
+
+		err := repo.Settle(ctx, bet, SettlementResult{
+			Status:      BetStatusWon,
+			GrossProfit: domain.MustParseDecimal("150.00"),
+			SettledAt:   time.Now(),
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, BetStatusWon, bet.Status)
+		assert.Equal(t, 7.5, bet.Commission.Float64())
+		assert.Equal(t, 142.5, bet.NetProfit.Float64())
+		assert.NotNil(t, bet.SettledAt)
+
+		updated, err := bankroll.NewPostgresBankrollRepository(db).FindByID(ctx, br.ID, 1)
+		require.NoError(t, err)
+		assert.Equal(t, 1142.5, updated.CurrentBalance.Float64())
+
+		var transaction bankroll.Transaction
+		require.NoError(t, db.Where("reference_type = ? AND reference_id = ?", ReferenceTypeBet, bet.ID).First(&transaction).Error)
+		assert.Equal(t, bankroll.TransactionTypeBetSettlement, transaction.Type)
+		assert.Equal(t, 142.5, transaction.Amount.Float64())
+	})
+
+	t.Run("lost bet debits without commission", func(t *testing.T) {
+		db := setupTestDB(t)
+		repo := NewPostgresBetRepository(db)
+		ctx := context.Background()
+		br := createTestBankroll(t, db, 1, "1000.00", "5.00")
+		bet := createTestBet(t, db, br, BetTypeLay, "Palmeiras", "3.000", "50.00")
+
+		err := repo.Settle(ctx, bet, SettlementResult{
+			Status:      BetStatusLost,
+			GrossProfit: domain.MustParseDecimal("-100.00"),
+			SettledAt:   time.Now(),
+		})
+
+		require.NoError(t, err)
+		assert.True(t, bet.Commission.IsZero())
+		assert.Equal(t, -100.00, bet.NetProfit.Float64())
+
+		updated, err := bankroll.NewPostgresBankrollRepository(db).FindByID(ctx, br.ID, 1)
+		require.NoError(t, err)
+		assert.Equal(t, 900.00, updated.CurrentBalance.Float64())
+	})
+
+	t.Run("commission is charged on net market winnings", func(t *testing.T) {
+		db := setupTestDB(t)
+		repo := NewPostgresBetRepository(db)
+		ctx := context.Background()
+		br := createTestBankroll(t, db, 1, "1000.00", "5.00")
+		back := createTestBet(t, db, br, BetTypeBack, "Flamengo", "2.000", "100.00")
+		lay := createTestBet(t, db, br, BetTypeLay, "Flamengo", "1.800", "100.00")
+
+		require.NoError(t, repo.Settle(ctx, back, SettlementResult{
+			Status:      BetStatusWon,
+			GrossProfit: domain.MustParseDecimal("100.00"),
+			SettledAt:   time.Now(),
+		}))
+		assert.Equal(t, 5.00, back.Commission.Float64())
+
+		require.NoError(t, repo.Settle(ctx, lay, SettlementResult{
+			Status:      BetStatusLost,
+			GrossProfit: domain.MustParseDecimal("-80.00"),
+			SettledAt:   time.Now(),
+		}))
+		assert.Equal(t, -4.00, lay.Commission.Float64())
+		assert.Equal(t, -76.00, lay.NetProfit.Float64())
+
+		updated, err := bankroll.NewPostgresBankrollRepository(db).FindByID(ctx, br.ID, 1)
+		require.NoError(t, err)
+		assert.Equal(t, 1019.00, updated.CurrentBalance.Float64())
+	})
+
+	t.Run("void bet posts no ledger entry", func(t *testing.T) {
+		db := setupTestDB(t)
+		repo := NewPostgresBetRepository(db)
+		ctx := context.Background()
+		br := createTestBankroll(t, db, 1, "1000.00", "5.00")
+		bet := createTestBet(t, db, br, BetTypeBack, "Flamengo", "2.500", "100.00")
+
+		err := repo.Settle(ctx, bet, SettlementResult{Status: BetStatusVoid, GrossProfit: domain.Zero, SettledAt: time.Now()})
+
+		require.NoError(t, err)
+		var count int64
+		db.Model(&bankroll.Transaction{}).Count(&count)
+		assert.Zero(t, count)
+	})
+
+	t.Run("already settled", func(t *testing.T) {
+		db := setupTestDB(t)
+		repo := NewPostgresBetRepository(db)
+		ctx := context.Background()
+		br := createTestBankroll(t, db, 1, "1000.00", "5.00")
+		bet := createTestBet(t, db, br, BetTypeBack, "Flamengo", "2.500", "100.00")
+		stale := *bet
+
+		require.NoError(t, repo.Settle(ctx, bet, SettlementResult{Status: BetStatusVoid, GrossProfit: domain.Zero, SettledAt: time.Now()}))
+		err := repo.Settle(ctx, &stale, SettlementResult{Status: BetStatusWon, GrossProfit: domain.MustParseDecimal("150.00"), SettledAt: time.Now()})
+
+		assert.ErrorIs(t, err, ErrBetAlreadySettled)
+	})
+
+	t.Run("insufficient funds rolls back settlement", func(t *testing.T) {
+		db := setupTestDB(t)
+		repo := NewPostgresBetRepository(db)
+		ctx := context.Background()
+		br := createTestBankroll(t, db, 1, "50.00", "5.00")
+		bet := createTestBet(t, db, br, BetTypeLay, "Palmeiras", "3.000", "50.00")
+
+		err := repo.Settle(ctx, bet, SettlementResult{
+			Status:      BetStatusLost,
+			GrossProfit: domain.MustParseDecimal("-100.00"),
+			SettledAt:   time.Now(),
+		})
+
+		assert.ErrorIs(t, err, ErrInsufficientFunds)
+		found, err := repo.FindByID(ctx, bet.ID, 1)
+		require.NoError(t, err)
+		assert.Equal(t, BetStatusOpen, found.Status)
+	})
+}
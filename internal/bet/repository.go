@@ -0,0 +1,13 @@
+package bet
+
+import (
+	"context"
+)
+
+type BetRepository interface {
+	Create(ctx context.Context, bet *Bet) error
+	FindByID(ctx context.Context, id uint, userID uint) (*Bet, error)
+	ListByBankrollID(ctx context.Context, bankrollID uint, userID uint, filter BetListFilter) ([]*Bet, int64, error)
+	Settle(ctx context.Context, bet *Bet, result SettlementResult) error
+	UpdateStrategy(ctx context.Context, id uint, userID uint, strategyID *uint) error
+}
@@ -0,0 +1,348 @@
+package bet
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/opinedajr/micro-stakes-api/internal/bankroll"
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
+	"github.com/opinedajr/micro-stakes-api/internal/shared/pagination"
+	"github.com/opinedajr/micro-stakes-api/internal/strategy"
+)
+
+type BetService interface {
+	RegisterBet(ctx context.Context, userID uint, bankrollID uint, input RegisterBetInput) (*BetOutput, error)
+	ListBets(ctx context.Context, userID uint, bankrollID uint, input ListBetsInput) (*BetPageOutput, error)
+	GetBet(ctx context.Context, userID uint, betID uint) (*BetOutput, error)
+	SettleBet(ctx context.Context, userID uint, betID uint, input SettleBetInput) (*BetOutput, error)
+	AssignStrategy(ctx context.Context, userID uint, betID uint, input AssignStrategyInput) (*BetOutput, error)
+}
+
+type betService struct {
+	repo         BetRepository
+	bankrollRepo bankroll.BankrollRepository
+	strategyRepo strategy.StrategyRepository
+	rules        bankroll.RuleEvaluator
+	logger       *slog.Logger
+}
+
+func NewBetService(repo BetRepository, bankrollRepo bankroll.BankrollRepository, strategyRepo strategy.StrategyRepository, rules bankroll.RuleEvaluator, logger *slog.Logger) BetService {
+	return &betService{
+		repo:         repo,
+		bankrollRepo: bankrollRepo,
+		strategyRepo: strategyRepo,
+		rules:        rules,
+		logger:       logger,
+	}
+}
+
+func (s *betService) RegisterBet(ctx context.Context, userID uint, bankrollID uint, input RegisterBetInput) (*BetOutput, error) {
+	if input.Type != BetTypeBack && input.Type != BetTypeLay {
+		s.logger.Error("invalid bet type", "type", input.Type, "user_id", userID)
+		return nil, ErrInvalidBetType
+	}
+
+	if !validOdds(input.Odds) {
+		s.logger.Error("invalid odds", "odds", input.Odds, "user_id", userID)
+		return nil, ErrInvalidOdds
+	}
+
+	if !input.Stake.IsPositive() {
+		s.logger.Error("invalid stake", "stake", input.Stake, "user_id", userID)
+		return nil, ErrInvalidStake
+	}
+
+	placedAt := time.Now()
+	if input.PlacedAt != "" {
+		parsed, err := time.Parse(time.RFC3339, input.PlacedAt)
+		if err != nil {
+			s.logger.Error("invalid placed_at format", "placed_at", input.PlacedAt, "error", err)
+			return nil, WrapError(ErrValidationFailed, "invalid placed_at format")
+		}
+		placedAt = parsed
+	}
+
+	br, err := s.findActiveBankroll(ctx, bankrollID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !br.Currency.Fits(input.Stake) {
+		s.logger.Error("stake exceeds currency precision", "stake", input.Stake, "currency", br.Currency, "user_id", userID)
+		return nil, ErrInvalidPrecision
+	}
+
+	if err := s.checkStrategy(ctx, input.StrategyID, userID); err != nil {
+		return nil, err
+	}
+
+	liability := br.Currency.Round(ComputeLiability(input.Type, input.Odds, input.Stake))
+	warnings, err := s.checkRules(ctx, br, liability)
+	if err != nil {
+		return nil, err
+	}
+
+	bet := &Bet{
+		UserID:      userID,
+		BankrollID:  bankrollID,
+		StrategyID:  input.StrategyID,
+		Type:        input.Type,
+		Event:       input.Event,
+		Market:      input.Market,
+		Selection:   input.Selection,
+		Odds:        input.Odds,
+		Stake:       input.Stake,
+		Liability:   liability,
+		Status:      BetStatusOpen,
+		GrossProfit: domain.Zero,
+		Commission:  domain.Zero,
+		NetProfit:   domain.Zero,
+		PlacedAt:    placedAt,
+	}
+
+	if err := s.repo.Create(ctx, bet); err != nil {
+		s.logger.Error("failed to create bet", "error", err, "user_id", userID, "bankroll_id", bankrollID)
+		return nil, err
+	}
+
+	s.logger.Info("bet registered", "user_id", userID, "bankroll_id", bankrollID, "bet_id", bet.ID, "type", bet.Type, "odds", bet.Odds, "stake", bet.Stake)
+
+	output := toBetOutput(bet)
+	output.Warnings = warnings
+	return output, nil
+}
+
+func (s *betService) ListBets(ctx context.Context, userID uint, bankrollID uint, input ListBetsInput) (*BetPageOutput, error) {
+	params, err := input.Params.Normalize()
+	if err != nil {
+		s.logger.Error("invalid list parameters", "error", err, "user_id", userID)
+		return nil, WrapError(ErrValidationFailed, err.Error())
+	}
+
+	filter := BetListFilter{
+		Limit:  params.Limit + 1,
+		Offset: params.Offset,
+	}
+	if params.Cursor != "" {
+		cursor, err := pagination.DecodeCursor(params.Cursor, "placed_at", true)
+		if err == nil {
+			var placedAt time.Time
+			placedAt, err = time.Parse(time.RFC3339Nano, cursor.Value)
+			filter.After = &BetCursor{PlacedAt: placedAt, ID: cursor.ID}
+		}
+		if err != nil {
+			s.logger.Error("invalid cursor", "error", err, "user_id", userID)
+			return nil, WrapError(ErrValidationFailed, pagination.ErrInvalidCursor.Error())
+		}
+	}
+
+	if _, err := s.findBankroll(ctx, bankrollID, userID); err != nil {
+		return nil, err
+	}
+
+	bets, total, err := s.repo.ListByBankrollID(ctx, bankrollID, userID, filter)
+	if err != nil {
+		s.logger.Error("failed to list bets", "error", err, "user_id", userID, "bankroll_id", bankrollID)
+		return nil, err
+	}
+
+	bets, more := pagination.Trim(bets, params.Limit)
+	nextCursor := ""
+	if more {
+		last := bets[len(bets)-1]
+		nextCursor = pagination.EncodeCursor("placed_at", true, last.PlacedAt.UTC().Format(time.RFC3339Nano), last.ID)
+	}
+
+	outputs := make([]*BetOutput, len(bets))
+	for i, b := range bets {
+		outputs[i] = toBetOutput(b)
+	}
+
+	s.logger.Info("bets listed", "user_id", userID, "bankroll_id", bankrollID, "count", len(outputs), "total", total)
+
+	return pagination.NewPage(outputs, total, params, nextCursor), nil
+}
+
+func (s *betService) GetBet(ctx context.Context, userID uint, betID uint) (*BetOutput, error) {
+	bet, err := s.repo.FindByID(ctx, betID, userID)
+	if err != nil {
+		s.logger.Error("failed to get bet", "error", err, "user_id", userID, "bet_id", betID)
+		return nil, err
+	}
+
+	return toBetOutput(bet), nil
+}
+
+func (s *betService) SettleBet(ctx context.Context, userID uint, betID uint, input SettleBetInput) (*BetOutput, error) {
+	bet, err := s.repo.FindByID(ctx, betID, userID)
+	if err != nil {
+		s.logger.Error("failed to get bet", "error", err, "user_id", userID, "bet_id", betID)
+		return nil, err
+	}
+
+	if !bet.IsOpen() {
+		s.logger.Error("bet already settled", "user_id", userID, "bet_id", betID, "status", bet.Status)
+		return nil, ErrBetAlreadySettled
+	}
+
+	br, err := s.findActiveBankroll(ctx, bet.BankrollID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	grossProfit, err := settlementProfit(bet, input)
+	if err != nil {
+		s.logger.Error("invalid settlement", "error", err, "user_id", userID, "bet_id", betID, "result", input.Result)
+		return nil, err
+	}
+
+	if input.Result == BetStatusCashedOut && !br.Currency.Fits(grossProfit) {
+		s.logger.Error("cash-out profit exceeds currency precision", "cash_out_profit", grossProfit, "currency", br.Currency)
+		return nil, ErrInvalidPrecision
+	}
+
+	result := SettlementResult{
+		Status:      input.Result,
+		GrossProfit: br.Currency.Round(grossProfit),
+		SettledAt:   time.Now(),
+	}
+
+	if err := s.repo.Settle(ctx, bet, result); err != nil {
+		s.logger.Error("failed to settle bet", "error", err, "user_id", userID, "bet_id", betID)
+		return nil, err
+	}
+
+	s.logger.Info("bet settled", "user_id", userID, "bet_id", betID, "result", bet.Status, "gross_profit", bet.GrossProfit, "commission", bet.Commission, "net_profit", bet.NetProfit)
+
+	return toBetOutput(bet), nil
+}
+
+func (s *betService) AssignStrategy(ctx context.Context, userID uint, betID uint, input AssignStrategyInput) (*BetOutput, error) {
+	bet, err := s.repo.FindByID(ctx, betID, userID)
+	if err != nil {
+		s.logger.Error("failed to get bet", "error", err, "user_id", userID, "bet_id", betID)
+		return nil, err
+	}
+
+	if err := s.checkStrategy(ctx, input.StrategyID, userID); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.UpdateStrategy(ctx, betID, userID, input.StrategyID); err != nil {
+		s.logger.Error("failed to assign strategy", "error", err, "user_id", userID, "bet_id", betID)
+		return nil, err
+	}
+	bet.StrategyID = input.StrategyID
+
+	s.logger.Info("bet strategy assigned", "user_id", userID, "bet_id", betID, "strategy_id", input.StrategyID)
+
+	return toBetOutput(bet), nil
+}
+
+// checkRules evaluates the bankroll's rules against the liability the bet
+// puts at risk. Soft rules that would be broken are returned as warnings.
+func (s *betService) checkRules(ctx context.Context, br *bankroll.Bankroll, liability domain.Decimal) ([]bankroll.RuleBreachOutput, error) {
+	check := bankroll.RuleCheck{
+		Activity: bankroll.RuleActivityBet,
+		Amount:   liability,
+		Exposure: liability,
+		At:       time.Now(),
+	}
+	warnings, err := s.rules.Evaluate(ctx, br, check)
+	if err != nil {
+		if errors.Is(err, bankroll.ErrRuleViolation) || errors.Is(err, bankroll.ErrLimitReached) {
+			return nil, err
+		}
+		return nil, WrapError(ErrDatabaseError, err.Error())
+	}
+	return warnings, nil
+}
+
+func (s *betService) checkStrategy(ctx context.Context, strategyID *uint, userID uint) error {
+	if strategyID == nil {
+		return nil
+	}
+	if _, err := s.strategyRepo.FindByID(ctx, *strategyID, userID); err != nil {
+		s.logger.Error("strategy not found", "error", err, "user_id", userID, "strategy_id", *strategyID)
+		if errors.Is(err, strategy.ErrStrategyNotFound) {
+			return ErrStrategyNotFound
+		}
+		return WrapError(ErrDatabaseError, err.Error())
+	}
+	return nil
+}
+
+func (s *betService) findBankroll(ctx context.Context, bankrollID uint, userID uint) (*bankroll.Bankroll, error) {
+	br, err := s.bankrollRepo.FindByID(ctx, bankrollID, userID)
+	if err != nil {
+		s.logger.Error("bankroll not found", "error", err, "user_id", userID, "bankroll_id", bankrollID)
+		if errors.Is(err, bankroll.ErrBankrollNotFound) {
+			return nil, ErrBankrollNotFound
+		}
+		return nil, WrapError(ErrDatabaseError, err.Error())
+	}
+	return br, nil
+}
+
+// findActiveBankroll loads a bankroll money is about to move on. Archived
+// bankrolls are read-only.
+func (s *betService) findActiveBankroll(ctx context.Context, bankrollID uint, userID uint) (*bankroll.Bankroll, error) {
+	br, err := s.findBankroll(ctx, bankrollID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if br.IsArchived() {
+		s.logger.Error("bankroll is archived", "user_id", userID, "bankroll_id", bankrollID)
+		return nil, ErrBankrollArchived
+	}
+	return br, nil
+}
+
+func validOdds(odds domain.Decimal) bool {
+	return odds.GreaterThan(domain.NewDecimalFromInt(1)) && odds.FitsScale(OddsScale)
+}
+
+func settlementProfit(bet *Bet, input SettleBetInput) (domain.Decimal, error) {
+	switch input.Result {
+	case BetStatusWon, BetStatusLost, BetStatusVoid:
+		return bet.GrossProfitFor(input.Result), nil
+	case BetStatusCashedOut:
+		if input.CashOutProfit == nil {
+			return domain.Zero, ErrInvalidCashOut
+		}
+		profit := *input.CashOutProfit
+		maxProfit := bet.GrossProfitFor(BetStatusWon)
+		maxLoss := bet.GrossProfitFor(BetStatusLost)
+		if profit.GreaterThan(maxProfit) || profit.LessThan(maxLoss) {
+			return domain.Zero, ErrInvalidCashOut
+		}
+		return profit, nil
+	default:
+		return domain.Zero, ErrInvalidResult
+	}
+}
+
+func toBetOutput(bet *Bet) *BetOutput {
+	return &BetOutput{
+		ID:          bet.ID,
+		BankrollID:  bet.BankrollID,
+		StrategyID:  bet.StrategyID,
+		Type:        bet.Type,
+		Event:       bet.Event,
+		Market:      bet.Market,
+		Selection:   bet.Selection,
+		Odds:        bet.Odds,
+		Stake:       bet.Stake,
+		Liability:   bet.Liability,
+		Status:      bet.Status,
+		GrossProfit: bet.GrossProfit,
+		Commission:  bet.Commission,
+		NetProfit:   bet.NetProfit,
+		PlacedAt:    bet.PlacedAt,
+		SettledAt:   bet.SettledAt,
+		CreatedAt:   bet.CreatedAt,
+		UpdatedAt:   bet.UpdatedAt,
+	}
+}
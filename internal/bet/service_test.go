@@ -0,0 +1,574 @@
+package bet
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/opinedajr/micro-stakes-api/internal/bankroll"
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
+	"github.com/opinedajr/micro-stakes-api/internal/shared/pagination"
+	"github.com/opinedajr/micro-stakes-api/internal/strategy"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"log/slog"
+)
+
+type MockBetRepository struct {
+	mock.Mock
+}
+
+func (m *MockBetRepository) Create(ctx context.Context, bet *Bet) error {
+	args := m.Called(ctx, bet)
+	return args.Error(0)
+}
+
+func (m *MockBetRepository) FindByID(ctx context.Context, id uint, userID uint) (*Bet, error) {
+	args := m.Called(ctx, id, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Bet), args.Error(1)
+}
+
+func (m *MockBetRepository) ListByBankrollID(ctx context.Context, bankrollID uint, userID uint, filter BetListFilter) ([]*Bet, int64, error) {
+	args := m.Called(ctx, bankrollID, userID, filter)
+	if args.Get(0) == nil {
+		return nil, 0, args.Error(2)
+	}
+	return args.Get(0).([]*Bet), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockBetRepository) Settle(ctx context.Context, bet *Bet, result SettlementResult) error {
+	args := m.Called(ctx, bet, result)
+	return args.Error(0)
+}
+
+func (m *MockBetRepository) UpdateStrategy(ctx context.Context, id uint, userID uint, strategyID *uint) error {
+	args := m.Called(ctx, id, userID, strategyID)
+	return args.Error(0)
+}
+
+type MockStrategyRepository struct {
+	mock.Mock
+}
+
+func (m *MockStrategyRepository) Create(ctx context.Context, s *strategy.Strategy) error {
+	args := m.Called(ctx, s)
+	return args.Error(0)
+}
+
+func (m *MockStrategyRepository) Update(ctx context.Context, s *strategy.Strategy) error {
+	args := m.Called(ctx, s)
+	return args.Error(0)
+}
+
+func (m *MockStrategyRepository) Delete(ctx context.Context, id uint, userID uint) error {
+	args := m.Called(ctx, id, userID)
+	return args.Error(0)
+}
+
+func (m *MockStrategyRepository) ListByUserID(ctx context.Context, userID uint) ([]*strategy.Strategy, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*strategy.Strategy), args.Error(1)
+}
+
+func (m *MockStrategyRepository) FindByID(ctx context.Context, id uint, userID uint) (*strategy.Strategy, error) {
+	args := m.Called(ctx, id, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*strategy.Strategy), args.Error(1)
+}
+
+func (m *MockStrategyRepository) Performance(ctx context.Context, id uint, userID uint) (*strategy.Performance, error) {
+	args := m.Called(ctx, id, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*strategy.Performance), args.Error(1)
+}
+
+type MockBankrollRepository struct {
+	mock.Mock
+}
+
+func (m *MockBankrollRepository) Create(ctx context.Context, br *bankroll.Bankroll) error {
+	args := m.Called(ctx, br)
+	return args.Error(0)
+}
+
+func (m *MockBankrollRepository) Update(ctx context.Context, br *bankroll.Bankroll) error {
+	args := m.Called(ctx, br)
+	return args.Error(0)
+}
+
+func (m *MockBankrollRepository) ListByUserID(ctx context.Context, userID uint) ([]*bankroll.Bankroll, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*bankroll.Bankroll), args.Error(1)
+}
+
+func (m *MockBankrollRepository) List(ctx context.Context, userID uint, filter bankroll.BankrollListFilter) ([]*bankroll.Bankroll, int64, error) {
+	args := m.Called(ctx, userID, filter)
+	if args.Get(0) == nil {
+		return nil, 0, args.Error(2)
+	}
+	return args.Get(0).([]*bankroll.Bankroll), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockBankrollRepository) FindByID(ctx context.Context, id uint, userID uint) (*bankroll.Bankroll, error) {
+	args := m.Called(ctx, id, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*bankroll.Bankroll), args.Error(1)
+}
+
+func (m *MockBankrollRepository) Reset(ctx context.Context, id uint, userID uint, amount domain.Decimal, expectedVersion uint) (*bankroll.BankrollPeriod, error) {
+	args := m.Called(ctx, id, userID, amount, expectedVersion)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*bankroll.BankrollPeriod), args.Error(1)
+}
+
+func (m *MockBankrollRepository) Delete(ctx context.Context, id uint, userID uint) error {
+	args := m.Called(ctx, id, userID)
+	return args.Error(0)
+}
+
+func (m *MockBankrollRepository) Archive(ctx context.Context, id uint, userID uint) error {
+	args := m.Called(ctx, id, userID)
+	return args.Error(0)
+}
+
+func (m *MockBankrollRepository) Restore(ctx context.Context, id uint, userID uint) error {
+	args := m.Called(ctx, id, userID)
+	return args.Error(0)
+}
+
+type MockRuleEvaluator struct {
+	mock.Mock
+}
+
+func (m *MockRuleEvaluator) Evaluate(ctx context.Context, br *bankroll.Bankroll, check bankroll.RuleCheck) ([]bankroll.RuleBreachOutput, error) {
+	args := m.Called(ctx, br, check)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]bankroll.RuleBreachOutput), args.Error(1)
+}
+
+func noRules() *MockRuleEvaluator {
+	rules := new(MockRuleEvaluator)
+	rules.On("Evaluate", mock.Anything, mock.Anything, mock.Anything).Return(nil, nil)
+	return rules
+}
+
+func testBankroll() *bankroll.Bankroll {
+	return &bankroll.Bankroll{
+		ID:                   1,
+		UserID:               1,
+		Currency:             bankroll.CurrencyBRL,
+		CurrentBalance:       domain.MustParseDecimal("1000.00"),
+		CommissionPercentage: domain.MustParseDecimal("5.00"),
+	}
+}
+
+func openBet(betType BetType, odds string, stake string) *Bet {
+	return &Bet{
+		ID:         7,
+		UserID:     1,
+		BankrollID: 1,
+		Type:       betType,
+		Event:      "Flamengo x Palmeiras",
+		Market:     "Match Odds",
+		Selection:  "Flamengo",
+		Odds:       domain.MustParseDecimal(odds),
+		Stake:      domain.MustParseDecimal(stake),
+		Liability:  ComputeLiability(betType, domain.MustParseDecimal(odds), domain.MustParseDecimal(stake)),
+		Status:     BetStatusOpen,
+	}
+}
+
+func TestRegisterBet(t *testing.T) {
+	validInput := func() RegisterBetInput {
+		return RegisterBetInput{
+			Type:      BetTypeLay,
+			Event:     "Flamengo x Palmeiras",
+			Market:    "Match Odds",
+			Selection: "Palmeiras",
+			Odds:      domain.MustParseDecimal("3.25"),
+			Stake:     domain.MustParseDecimal("40.00"),
+		}
+	}
+
+	t.Run("success - lay bet computes liability", func(t *testing.T) {
+		mockRepo := new(MockBetRepository)
+		mockBankrollRepo := new(MockBankrollRepository)
+		service := NewBetService(mockRepo, mockBankrollRepo, new(MockStrategyRepository), noRules(), slog.Default())
+		ctx := context.Background()
+
+		mockBankrollRepo.On("FindByID", ctx, uint(1), uint(1)).Return(testBankroll(), nil).Once()
+		mockRepo.On("Create", ctx, mock.MatchedBy(func(bet *Bet) bool {
+			return bet.Status == BetStatusOpen && bet.Liability.Float64() == 90.00 && bet.UserID == 1
+		})).Run(func(args mock.Arguments) {
+			args.Get(1).(*Bet).ID = 3
+		}).Return(nil).Once()
+
+		output, err := service.RegisterBet(ctx, 1, 1, validInput())
+
+		assert.NoError(t, err)
+		assert.Equal(t, uint(3), output.ID)
+		assert.Equal(t, 90.00, output.Liability.Float64())
+		assert.Equal(t, BetStatusOpen, output.Status)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("success - soft rule returned as warning", func(t *testing.T) {
+		mockRepo := new(MockBetRepository)
+		mockBankrollRepo := new(MockBankrollRepository)
+		mockRules := new(MockRuleEvaluator)
+		service := NewBetService(mockRepo, mockBankrollRepo, new(MockStrategyRepository), mockRules, slog.Default())
+		ctx := context.Background()
+		warning := bankroll.RuleBreachOutput{RuleID: 2, Type: bankroll.RuleTypeMaxBuyInPercent, Severity: bankroll.RuleSeveritySoft}
+
+		mockBankrollRepo.On("FindByID", ctx, uint(1), uint(1)).Return(testBankroll(), nil).Once()
+		mockRules.On("Evaluate", ctx, mock.Anything, mock.MatchedBy(func(check bankroll.RuleCheck) bool {
+			return check.Activity == bankroll.RuleActivityBet && check.Amount.Float64() == 90.00 && check.Exposure.Float64() == 90.00
+		})).Return([]bankroll.RuleBreachOutput{warning}, nil).Once()
+		mockRepo.On("Create", ctx, mock.Anything).Return(nil).Once()
+
+		output, err := service.RegisterBet(ctx, 1, 1, validInput())
+
+		assert.NoError(t, err)
+		assert.Equal(t, []bankroll.RuleBreachOutput{warning}, output.Warnings)
+		mockRules.AssertExpectations(t)
+	})
+
+	t.Run("error - hard rule violated", func(t *testing.T) {
+		mockRepo := new(MockBetRepository)
+		mockBankrollRepo := new(MockBankrollRepository)
+		mockRules := new(MockRuleEvaluator)
+		service := NewBetService(mockRepo, mockBankrollRepo, new(MockStrategyRepository), mockRules, slog.Default())
+		violation := &bankroll.RuleViolation{Breach: bankroll.RuleBreachOutput{RuleID: 2, Type: bankroll.RuleTypeMaxOpenExposure}}
+
+		mockBankrollRepo.On("FindByID", mock.Anything, uint(1), uint(1)).Return(testBankroll(), nil).Once()
+		mockRules.On("Evaluate", mock.Anything, mock.Anything, mock.Anything).Return(nil, violation).Once()
+
+		output, err := service.RegisterBet(context.Background(), 1, 1, validInput())
+
+		assert.Nil(t, output)
+		assert.ErrorIs(t, err, bankroll.ErrRuleViolation)
+		mockRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("validation error - invalid type", func(t *testing.T) {
+		service := NewBetService(new(MockBetRepository), new(MockBankrollRepository), new(MockStrategyRepository), noRules(), slog.Default())
+		input := validInput()
+		input.Type = "each_way"
+
+		output, err := service.RegisterBet(context.Background(), 1, 1, input)
+
+		assert.Nil(t, output)
+		assert.ErrorIs(t, err, ErrInvalidBetType)
+	})
+
+	t.Run("validation error - odds not above 1", func(t *testing.T) {
+		service := NewBetService(new(MockBetRepository), new(MockBankrollRepository), new(MockStrategyRepository), noRules(), slog.Default())
+		input := validInput()
+		input.Odds = domain.MustParseDecimal("1.00")
+
+		_, err := service.RegisterBet(context.Background(), 1, 1, input)
+
+		assert.ErrorIs(t, err, ErrInvalidOdds)
+	})
+
+	t.Run("validation error - odds with too many decimals", func(t *testing.T) {
+		service := NewBetService(new(MockBetRepository), new(MockBankrollRepository), new(MockStrategyRepository), noRules(), slog.Default())
+		input := validInput()
+		input.Odds = domain.MustParseDecimal("2.1234")
+
+		_, err := service.RegisterBet(context.Background(), 1, 1, input)
+
+		assert.ErrorIs(t, err, ErrInvalidOdds)
+	})
+
+	t.Run("validation error - invalid placed_at", func(t *testing.T) {
+		service := NewBetService(new(MockBetRepository), new(MockBankrollRepository), new(MockStrategyRepository), noRules(), slog.Default())
+		input := validInput()
+		input.PlacedAt = "yesterday"
+
+		_, err := service.RegisterBet(context.Background(), 1, 1, input)
+
+		assert.ErrorIs(t, err, ErrValidationFailed)
+	})
+
+	t.Run("error - stake exceeds currency precision", func(t *testing.T) {
+		mockBankrollRepo := new(MockBankrollRepository)
+		service := NewBetService(new(MockBetRepository), mockBankrollRepo, new(MockStrategyRepository), noRules(), slog.Default())
+		input := validInput()
+		input.Stake = domain.MustParseDecimal("40.001")
+
+		mockBankrollRepo.On("FindByID", mock.Anything, uint(1), uint(1)).Return(testBankroll(), nil).Once()
+
+		_, err := service.RegisterBet(context.Background(), 1, 1, input)
+
+		assert.ErrorIs(t, err, ErrInvalidPrecision)
+	})
+
+	t.Run("error - strategy not owned", func(t *testing.T) {
+		mockBankrollRepo := new(MockBankrollRepository)
+		mockStrategyRepo := new(MockStrategyRepository)
+		service := NewBetService(new(MockBetRepository), mockBankrollRepo, mockStrategyRepo, noRules(), slog.Default())
+		input := validInput()
+		strategyID := uint(4)
+		input.StrategyID = &strategyID
+
+		mockBankrollRepo.On("FindByID", mock.Anything, uint(1), uint(1)).Return(testBankroll(), nil).Once()
+		mockStrategyRepo.On("FindByID", mock.Anything, uint(4), uint(1)).Return(nil, strategy.ErrStrategyNotFound).Once()
+
+		_, err := service.RegisterBet(context.Background(), 1, 1, input)
+
+		assert.ErrorIs(t, err, ErrStrategyNotFound)
+	})
+
+	t.Run("error - bankroll not owned", func(t *testing.T) {
+		mockBankrollRepo := new(MockBankrollRepository)
+		service := NewBetService(new(MockBetRepository), mockBankrollRepo, new(MockStrategyRepository), noRules(), slog.Default())
+
+		mockBankrollRepo.On("FindByID", mock.Anything, uint(1), uint(2)).Return(nil, bankroll.ErrBankrollNotFound).Once()
+
+		_, err := service.RegisterBet(context.Background(), 2, 1, validInput())
+
+		assert.ErrorIs(t, err, ErrBankrollNotFound)
+	})
+
+	t.Run("error - bankroll archived", func(t *testing.T) {
+		mockRepo := new(MockBetRepository)
+		mockBankrollRepo := new(MockBankrollRepository)
+		service := NewBetService(mockRepo, mockBankrollRepo, new(MockStrategyRepository), noRules(), slog.Default())
+		archived := testBankroll()
+		archivedAt := time.Now()
+		archived.ArchivedAt = &archivedAt
+
+		mockBankrollRepo.On("FindByID", mock.Anything, uint(1), uint(1)).Return(archived, nil).Once()
+
+		_, err := service.RegisterBet(context.Background(), 1, 1, validInput())
+
+		assert.ErrorIs(t, err, ErrBankrollArchived)
+		mockRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+}
+
+func TestListBets(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		mockRepo := new(MockBetRepository)
+		mockBankrollRepo := new(MockBankrollRepository)
+		service := NewBetService(mockRepo, mockBankrollRepo, new(MockStrategyRepository), noRules(), slog.Default())
+		ctx := context.Background()
+		placedAt := time.Date(2026, 5, 1, 10, 0, 0, 0, time.UTC)
+
+		mockBankrollRepo.On("FindByID", ctx, uint(1), uint(1)).Return(testBankroll(), nil).Once()
+		mockRepo.On("ListByBankrollID", ctx, uint(1), uint(1), BetListFilter{Limit: 2}).Return([]*Bet{
+			{ID: 4, BankrollID: 1, Status: BetStatusOpen, PlacedAt: placedAt},
+			{ID: 3, BankrollID: 1, Status: BetStatusOpen, PlacedAt: placedAt.Add(-time.Hour)},
+		}, int64(2), nil).Once()
+
+		page, err := service.ListBets(ctx, 1, 1, ListBetsInput{Params: pagination.Params{Limit: 1}})
+
+		assert.NoError(t, err)
+		require.Len(t, page.Items, 1)
+		assert.Equal(t, uint(4), page.Items[0].ID)
+		assert.Equal(t, int64(2), page.Total)
+		assert.Equal(t, pagination.EncodeCursor("placed_at", true, placedAt.Format(time.RFC3339Nano), 4), page.NextCursor)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("cursor resumes after the last bet", func(t *testing.T) {
+		mockRepo := new(MockBetRepository)
+		mockBankrollRepo := new(MockBankrollRepository)
+		service := NewBetService(mockRepo, mockBankrollRepo, new(MockStrategyRepository), noRules(), slog.Default())
+		ctx := context.Background()
+		placedAt := time.Date(2026, 5, 1, 10, 0, 0, 0, time.UTC)
+
+		mockBankrollRepo.On("FindByID", ctx, uint(1), uint(1)).Return(testBankroll(), nil).Once()
+		mockRepo.On("ListByBankrollID", ctx, uint(1), uint(1), mock.MatchedBy(func(filter BetListFilter) bool {
+			return filter.After != nil && filter.After.PlacedAt.Equal(placedAt) && filter.After.ID == 4
+		})).Return([]*Bet{}, int64(2), nil).Once()
+
+		cursor := pagination.EncodeCursor("placed_at", true, placedAt.Format(time.RFC3339Nano), 4)
+		page, err := service.ListBets(ctx, 1, 1, ListBetsInput{Params: pagination.Params{Cursor: cursor}})
+
+		assert.NoError(t, err)
+		assert.Empty(t, page.NextCursor)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("invalid parameters", func(t *testing.T) {
+		service := NewBetService(new(MockBetRepository), new(MockBankrollRepository), new(MockStrategyRepository), noRules(), slog.Default())
+		ctx := context.Background()
+
+		_, err := service.ListBets(ctx, 1, 1, ListBetsInput{Params: pagination.Params{Limit: 101}})
+		assert.ErrorIs(t, err, ErrValidationFailed)
+
+		_, err = service.ListBets(ctx, 1, 1, ListBetsInput{Params: pagination.Params{Cursor: pagination.EncodeCursor("created_at", true, "x", 1)}})
+		assert.ErrorIs(t, err, ErrValidationFailed)
+	})
+}
+
+func TestSettleBet(t *testing.T) {
+	t.Run("success - back bet won", func(t *testing.T) {
+		mockRepo := new(MockBetRepository)
+		mockBankrollRepo := new(MockBankrollRepository)
+		service := NewBetService(mockRepo, mockBankrollRepo, new(MockStrategyRepository), noRules(), slog.Default())
+		ctx := context.Background()
+		bet := openBet(BetTypeBack, "2.555", "10.00")
+
+		mockRepo.On("FindByID", ctx, uint(7), uint(1)).Return(bet, nil).Once()
+		mockBankrollRepo.On("FindByID", ctx, uint(1), uint(1)).Return(testBankroll(), nil).Once()
+		mockRepo.On("Settle", ctx, bet, mock.MatchedBy(func(result SettlementResult) bool {
+			return result.Status == BetStatusWon && result.GrossProfit.Float64() == 15.55
+		})).Run(func(args mock.Arguments) {
+			settled := args.Get(1).(*Bet)
+			settled.Status = BetStatusWon
+			settled.GrossProfit = domain.MustParseDecimal("15.55")
+			settled.Commission = domain.MustParseDecimal("0.78")
+			settled.NetProfit = domain.MustParseDecimal("14.77")
+		}).Return(nil).Once()
+
+		output, err := service.SettleBet(ctx, 1, 7, SettleBetInput{Result: BetStatusWon})
+
+		assert.NoError(t, err)
+		assert.Equal(t, BetStatusWon, output.Status)
+		assert.Equal(t, 14.77, output.NetProfit.Float64())
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("success - cashed out within range", func(t *testing.T) {
+		mockRepo := new(MockBetRepository)
+		mockBankrollRepo := new(MockBankrollRepository)
+		service := NewBetService(mockRepo, mockBankrollRepo, new(MockStrategyRepository), noRules(), slog.Default())
+		ctx := context.Background()
+		bet := openBet(BetTypeLay, "3.00", "50.00")
+		profit := domain.MustParseDecimal("-35.50")
+
+		mockRepo.On("FindByID", ctx, uint(7), uint(1)).Return(bet, nil).Once()
+		mockBankrollRepo.On("FindByID", ctx, uint(1), uint(1)).Return(testBankroll(), nil).Once()
+		mockRepo.On("Settle", ctx, bet, mock.MatchedBy(func(result SettlementResult) bool {
+			return result.Status == BetStatusCashedOut && result.GrossProfit.Float64() == -35.50
+		})).Return(nil).Once()
+
+		_, err := service.SettleBet(ctx, 1, 7, SettleBetInput{Result: BetStatusCashedOut, CashOutProfit: &profit})
+
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("error - cash out beyond liability", func(t *testing.T) {
+		mockRepo := new(MockBetRepository)
+		mockBankrollRepo := new(MockBankrollRepository)
+		service := NewBetService(mockRepo, mockBankrollRepo, new(MockStrategyRepository), noRules(), slog.Default())
+		bet := openBet(BetTypeLay, "3.00", "50.00")
+		profit := domain.MustParseDecimal("-100.01")
+
+		mockRepo.On("FindByID", mock.Anything, uint(7), uint(1)).Return(bet, nil).Once()
+		mockBankrollRepo.On("FindByID", mock.Anything, uint(1), uint(1)).Return(testBankroll(), nil).Once()
+
+		_, err := service.SettleBet(context.Background(), 1, 7, SettleBetInput{Result: BetStatusCashedOut, CashOutProfit: &profit})
+
+		assert.ErrorIs(t, err, ErrInvalidCashOut)
+		mockRepo.AssertNotCalled(t, "Settle", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("error - invalid result", func(t *testing.T) {
+		mockRepo := new(MockBetRepository)
+		mockBankrollRepo := new(MockBankrollRepository)
+		service := NewBetService(mockRepo, mockBankrollRepo, new(MockStrategyRepository), noRules(), slog.Default())
+
+		mockRepo.On("FindByID", mock.Anything, uint(7), uint(1)).Return(openBet(BetTypeBack, "2.00", "10.00"), nil).Once()
+		mockBankrollRepo.On("FindByID", mock.Anything, uint(1), uint(1)).Return(testBankroll(), nil).Once()
+
+		_, err := service.SettleBet(context.Background(), 1, 7, SettleBetInput{Result: BetStatusOpen})
+
+		assert.ErrorIs(t, err, ErrInvalidResult)
+	})
+
+	t.Run("error - already settled", func(t *testing.T) {
+		mockRepo := new(MockBetRepository)
+		service := NewBetService(mockRepo, new(MockBankrollRepository), new(MockStrategyRepository), noRules(), slog.Default())
+		bet := openBet(BetTypeBack, "2.00", "10.00")
+		bet.Status = BetStatusLost
+
+		mockRepo.On("FindByID", mock.Anything, uint(7), uint(1)).Return(bet, nil).Once()
+
+		_, err := service.SettleBet(context.Background(), 1, 7, SettleBetInput{Result: BetStatusWon})
+
+		assert.ErrorIs(t, err, ErrBetAlreadySettled)
+	})
+
+	t.Run("error - bet not found", func(t *testing.T) {
+		mockRepo := new(MockBetRepository)
+		service := NewBetService(mockRepo, new(MockBankrollRepository), new(MockStrategyRepository), noRules(), slog.Default())
+
+		mockRepo.On("FindByID", mock.Anything, uint(7), uint(2)).Return(nil, ErrBetNotFound).Once()
+
+		_, err := service.SettleBet(context.Background(), 2, 7, SettleBetInput{Result: BetStatusWon})
+
+		assert.ErrorIs(t, err, ErrBetNotFound)
+	})
+}
+
+func TestAssignStrategy(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		mockRepo := new(MockBetRepository)
+		mockStrategyRepo := new(MockStrategyRepository)
+		service := NewBetService(mockRepo, new(MockBankrollRepository), mockStrategyRepo, noRules(), slog.Default())
+		ctx := context.Background()
+		strategyID := uint(4)
+
+		mockRepo.On("FindByID", ctx, uint(7), uint(1)).Return(openBet(BetTypeBack, "2.00", "10.00"), nil).Once()
+		mockStrategyRepo.On("FindByID", ctx, uint(4), uint(1)).Return(&strategy.Strategy{ID: 4, UserID: 1}, nil).Once()
+		mockRepo.On("UpdateStrategy", ctx, uint(7), uint(1), &strategyID).Return(nil).Once()
+
+		output, err := service.AssignStrategy(ctx, 1, 7, AssignStrategyInput{StrategyID: &strategyID})
+
+		assert.NoError(t, err)
+		assert.Equal(t, &strategyID, output.StrategyID)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("success - unlink", func(t *testing.T) {
+		mockRepo := new(MockBetRepository)
+		mockStrategyRepo := new(MockStrategyRepository)
+		service := NewBetService(mockRepo, new(MockBankrollRepository), mockStrategyRepo, noRules(), slog.Default())
+		ctx := context.Background()
+
+		mockRepo.On("FindByID", ctx, uint(7), uint(1)).Return(openBet(BetTypeBack, "2.00", "10.00"), nil).Once()
+		mockRepo.On("UpdateStrategy", ctx, uint(7), uint(1), (*uint)(nil)).Return(nil).Once()
+
+		output, err := service.AssignStrategy(ctx, 1, 7, AssignStrategyInput{})
+
+		assert.NoError(t, err)
+		assert.Nil(t, output.StrategyID)
+		mockStrategyRepo.AssertNotCalled(t, "FindByID", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestGrossProfitFor(t *testing.T) {
+	back := openBet(BetTypeBack, "2.50", "100.00")
+	lay := openBet(BetTypeLay, "2.50", "100.00")
+
+	assert.Equal(t, 150.00, back.GrossProfitFor(BetStatusWon).Float64())
+	assert.Equal(t, -100.00, back.GrossProfitFor(BetStatusLost).Float64())
+	assert.Equal(t, 100.00, lay.GrossProfitFor(BetStatusWon).Float64())
+	assert.Equal(t, -150.00, lay.GrossProfitFor(BetStatusLost).Float64())
+	assert.True(t, back.GrossProfitFor(BetStatusVoid).IsZero())
+}
@@ -0,0 +1,24 @@
+package currency
+
+import "github.com/opinedajr/micro-stakes-api/internal/domain"
+
+type UpsertCurrencyInput struct {
+	Name     string `json:"name" binding:"required,min=1,max=100"`
+	Decimals *int32 `json:"decimals" binding:"required"`
+	Symbol   string `json:"symbol" binding:"max=10"`
+	IsCrypto bool   `json:"is_crypto"`
+}
+
+type CurrencyOutput struct {
+	Code     domain.Currency `json:"code"`
+	Name     string          `json:"name"`
+	Decimals int32           `json:"decimals"`
+	Symbol   string          `json:"symbol"`
+	IsCrypto bool            `json:"is_crypto"`
+}
+
+type ErrorOutput struct {
+	Error   string              `json:"error"`
+	Code    string              `json:"code"`
+	Details map[string][]string `json:"details,omitempty"`
+}
@@ -0,0 +1,19 @@
+package currency
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrInvalidCode       = errors.New("invalid currency code")
+	ErrInvalidDecimals   = errors.New("invalid currency decimals")
+	ErrDecimalsReduced   = errors.New("decimals of an existing currency cannot be reduced")
+	ErrValidationFailed  = errors.New("validation failed")
+	ErrDatabaseError     = errors.New("database error")
+	ErrNoCurrenciesFound = errors.New("no currencies configured")
+)
+
+func WrapError(err error, message string) error {
+	return fmt.Errorf("%s: %w", message, err)
+}
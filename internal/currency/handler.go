@@ -0,0 +1,89 @@
+package currency
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type CurrencyHandler struct {
+	service CurrencyService
+	logger  *slog.Logger
+}
+
+func NewCurrencyHandler(service CurrencyService, logger *slog.Logger) *CurrencyHandler {
+	return &CurrencyHandler{
+		service: service,
+		logger:  logger,
+	}
+}
+
+func (h *CurrencyHandler) ListCurrencies(c *gin.Context) {
+	outputs, err := h.service.ListCurrencies(c.Request.Context())
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, outputs)
+}
+
+func (h *CurrencyHandler) UpsertCurrency(c *gin.Context) {
+	var input UpsertCurrencyInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		h.logger.Error("invalid request body", "error", err)
+		c.JSON(http.StatusBadRequest, ErrorOutput{
+			Error:   "Invalid request body",
+			Code:    "VALIDATION_ERROR",
+			Details: nil,
+		})
+		return
+	}
+
+	output, err := h.service.UpsertCurrency(c.Request.Context(), c.Param("code"), input)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
+
+func (h *CurrencyHandler) handleError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, ErrInvalidCode):
+		c.JSON(http.StatusBadRequest, ErrorOutput{
+			Error: "Currency code must be 2 to 10 uppercase letters or digits",
+			Code:  "INVALID_CURRENCY_CODE",
+		})
+	case errors.Is(err, ErrInvalidDecimals):
+		c.JSON(http.StatusBadRequest, ErrorOutput{
+			Error: "Currency decimals must be between 0 and 8",
+			Code:  "INVALID_CURRENCY_DECIMALS",
+		})
+	case errors.Is(err, ErrDecimalsReduced):
+		c.JSON(http.StatusConflict, ErrorOutput{
+			Error: "Decimals of an existing currency cannot be reduced",
+			Code:  "CURRENCY_DECIMALS_REDUCED",
+		})
+	case errors.Is(err, ErrValidationFailed):
+		c.JSON(http.StatusBadRequest, ErrorOutput{
+			Error: err.Error(),
+			Code:  "VALIDATION_ERROR",
+		})
+	case errors.Is(err, ErrDatabaseError):
+		h.logger.Error("database error", "error", err)
+		c.JSON(http.StatusInternalServerError, ErrorOutput{
+			Error: "Database error occurred",
+			Code:  "DATABASE_ERROR",
+		})
+	default:
+		h.logger.Error("unexpected error", "error", err)
+		c.JSON(http.StatusInternalServerError, ErrorOutput{
+			Error: "An unexpected error occurred",
+			Code:  "INTERNAL_ERROR",
+		})
+	}
+}
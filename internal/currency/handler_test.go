@@ -0,0 +1,114 @@
+package currency
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockCurrencyService struct {
+	mock.Mock
+}
+
+func (m *MockCurrencyService) ListCurrencies(ctx context.Context) ([]*CurrencyOutput, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*CurrencyOutput), args.Error(1)
+}
+
+func (m *MockCurrencyService) UpsertCurrency(ctx context.Context, code string, input UpsertCurrencyInput) (*CurrencyOutput, error) {
+	args := m.Called(ctx, code, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*CurrencyOutput), args.Error(1)
+}
+
+func (m *MockCurrencyService) Load(ctx context.Context) error {
+	args := m.Called(ctx)
+	return args.Error(0)
+}
+
+func setupTestRouter(service CurrencyService) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	handler := NewCurrencyHandler(service, slog.Default())
+
+	router := gin.New()
+	router.GET("/currencies", handler.ListCurrencies)
+	router.PUT("/currencies/:code", handler.UpsertCurrency)
+	return router
+}
+
+func TestListCurrenciesHandler(t *testing.T) {
+	mockService := new(MockCurrencyService)
+	router := setupTestRouter(mockService)
+
+	mockService.On("ListCurrencies", mock.Anything).Return([]*CurrencyOutput{
+		{Code: domain.CurrencyBTC, Name: "Bitcoin", Decimals: 8, Symbol: "₿", IsCrypto: true},
+	}, nil).Once()
+
+	req := httptest.NewRequest(http.MethodGet, "/currencies", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `[{"code":"BTC","name":"Bitcoin","decimals":8,"symbol":"₿","is_crypto":true}]`, w.Body.String())
+}
+
+func TestUpsertCurrencyHandler(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		mockService := new(MockCurrencyService)
+		router := setupTestRouter(mockService)
+
+		mockService.On("UpsertCurrency", mock.Anything, "USDT", mock.MatchedBy(func(input UpsertCurrencyInput) bool {
+			return input.Name == "Tether" && *input.Decimals == 6 && input.IsCrypto
+		})).Return(&CurrencyOutput{Code: "USDT", Name: "Tether", Decimals: 6, IsCrypto: true}, nil).Once()
+
+		body := `{"name":"Tether","decimals":6,"symbol":"₮","is_crypto":true}`
+		req := httptest.NewRequest(http.MethodPut, "/currencies/USDT", bytes.NewBufferString(body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("decimals are required", func(t *testing.T) {
+		mockService := new(MockCurrencyService)
+		router := setupTestRouter(mockService)
+
+		req := httptest.NewRequest(http.MethodPut, "/currencies/CAD", bytes.NewBufferString(`{"name":"Canadian Dollar"}`))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		mockService.AssertNotCalled(t, "UpsertCurrency")
+	})
+
+	t.Run("decimals reduced", func(t *testing.T) {
+		mockService := new(MockCurrencyService)
+		router := setupTestRouter(mockService)
+
+		mockService.On("UpsertCurrency", mock.Anything, "BTC", mock.Anything).Return(nil, ErrDecimalsReduced).Once()
+
+		req := httptest.NewRequest(http.MethodPut, "/currencies/BTC", bytes.NewBufferString(`{"name":"Bitcoin","decimals":2}`))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+		assert.Contains(t, w.Body.String(), "CURRENCY_DECIMALS_REDUCED")
+	})
+}
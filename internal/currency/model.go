@@ -0,0 +1,31 @@
+package currency
+
+import (
+	"time"
+
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
+)
+
+type Currency struct {
+	Code      domain.Currency `gorm:"type:varchar(10);primaryKey"`
+	Name      string          `gorm:"type:varchar(100);not null"`
+	Decimals  int32           `gorm:"type:smallint;not null"`
+	Symbol    string          `gorm:"type:varchar(10);not null;default:''"`
+	IsCrypto  bool            `gorm:"not null;default:false"`
+	CreatedAt time.Time       `gorm:"autoCreateTime"`
+	UpdatedAt time.Time       `gorm:"autoUpdateTime"`
+}
+
+func (Currency) TableName() string {
+	return "currencies"
+}
+
+func (c *Currency) Info() domain.CurrencyInfo {
+	return domain.CurrencyInfo{
+		Code:     c.Code,
+		Name:     c.Name,
+		Decimals: c.Decimals,
+		Symbol:   c.Symbol,
+		IsCrypto: c.IsCrypto,
+	}
+}
@@ -0,0 +1,52 @@
+package currency
+
+import (
+	"context"
+	"errors"
+
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type postgresCurrencyRepository struct {
+	db *gorm.DB
+}
+
+func NewPostgresCurrencyRepository(db *gorm.DB) CurrencyRepository {
+	return &postgresCurrencyRepository{
+		db: db,
+	}
+}
+
+func (r *postgresCurrencyRepository) List(ctx context.Context) ([]*Currency, error) {
+	var currencies []*Currency
+	if err := r.db.WithContext(ctx).Order("code").Find(&currencies).Error; err != nil {
+		return nil, WrapError(ErrDatabaseError, err.Error())
+	}
+	return currencies, nil
+}
+
+// FindByCode returns nil without error when the currency does not exist.
+func (r *postgresCurrencyRepository) FindByCode(ctx context.Context, code domain.Currency) (*Currency, error) {
+	var currency Currency
+	err := r.db.WithContext(ctx).Where("code = ?", code).First(&currency).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, WrapError(ErrDatabaseError, err.Error())
+	}
+	return &currency, nil
+}
+
+func (r *postgresCurrencyRepository) Upsert(ctx context.Context, currency *Currency) error {
+	err := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "code"}},
+		DoUpdates: clause.AssignmentColumns([]string{"name", "decimals", "symbol", "is_crypto", "updated_at"}),
+	}).Create(currency).Error
+	if err != nil {
+		return WrapError(ErrDatabaseError, err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+package currency
+
+import (
+	"context"
+	"testing"
+
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+func setupTestDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+
+	require.NoError(t, db.AutoMigrate(&Currency{}))
+
+	return db
+}
+
+func TestPostgresCurrencyRepository(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewPostgresCurrencyRepository(db)
+	ctx := context.Background()
+
+	require.NoError(t, repo.Upsert(ctx, &Currency{Code: "USD", Name: "US Dollar", Decimals: 2, Symbol: "$"}))
+	require.NoError(t, repo.Upsert(ctx, &Currency{Code: "BRL", Name: "Real", Decimals: 2, Symbol: "R$"}))
+	require.NoError(t, repo.Upsert(ctx, &Currency{Code: "BRL", Name: "Brazilian Real", Decimals: 2, Symbol: "R$"}))
+
+	currencies, err := repo.List(ctx)
+	require.NoError(t, err)
+	require.Len(t, currencies, 2)
+	assert.Equal(t, domain.Currency("BRL"), currencies[0].Code)
+	assert.Equal(t, "Brazilian Real", currencies[0].Name)
+
+	found, err := repo.FindByCode(ctx, "USD")
+	require.NoError(t, err)
+	assert.Equal(t, "$", found.Symbol)
+
+	missing, err := repo.FindByCode(ctx, "GBP")
+	require.NoError(t, err)
+	assert.Nil(t, missing)
+}
@@ -0,0 +1,39 @@
+package currency
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+// Refresher reloads the registry periodically so currencies added through
+// another instance become available here too.
+type Refresher struct {
+	service  CurrencyService
+	interval time.Duration
+	logger   *slog.Logger
+}
+
+func NewRefresher(service CurrencyService, interval time.Duration, logger *slog.Logger) *Refresher {
+	return &Refresher{
+		service:  service,
+		interval: interval,
+		logger:   logger,
+	}
+}
+
+func (r *Refresher) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := r.service.Load(ctx); err != nil {
+				r.logger.Error("failed to refresh currencies", "error", err)
+			}
+		}
+	}
+}
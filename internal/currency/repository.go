@@ -0,0 +1,13 @@
+package currency
+
+import (
+	"context"
+
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
+)
+
+type CurrencyRepository interface {
+	List(ctx context.Context) ([]*Currency, error)
+	FindByCode(ctx context.Context, code domain.Currency) (*Currency, error)
+	Upsert(ctx context.Context, currency *Currency) error
+}
@@ -0,0 +1,123 @@
+package currency
+
+import (
+	"context"
+	"log/slog"
+	"regexp"
+	"strings"
+
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
+)
+
+var codePattern = regexp.MustCompile(`^[A-Z0-9]{2,10}$`)
+
+type CurrencyService interface {
+	ListCurrencies(ctx context.Context) ([]*CurrencyOutput, error)
+	UpsertCurrency(ctx context.Context, code string, input UpsertCurrencyInput) (*CurrencyOutput, error)
+	Load(ctx context.Context) error
+}
+
+type currencyService struct {
+	repo   CurrencyRepository
+	logger *slog.Logger
+}
+
+func NewCurrencyService(repo CurrencyRepository, logger *slog.Logger) CurrencyService {
+	return &currencyService{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+func (s *currencyService) ListCurrencies(ctx context.Context) ([]*CurrencyOutput, error) {
+	currencies, err := s.repo.List(ctx)
+	if err != nil {
+		s.logger.Error("failed to list currencies", "error", err)
+		return nil, err
+	}
+
+	outputs := make([]*CurrencyOutput, len(currencies))
+	for i, currency := range currencies {
+		outputs[i] = toCurrencyOutput(currency)
+	}
+	return outputs, nil
+}
+
+// UpsertCurrency adds a currency or updates its details and makes the change
+// effective immediately in this process. Decimals can grow but never shrink,
+// since stored amounts may already use the extra digits.
+func (s *currencyService) UpsertCurrency(ctx context.Context, code string, input UpsertCurrencyInput) (*CurrencyOutput, error) {
+	normalized := domain.Currency(strings.ToUpper(strings.TrimSpace(code)))
+	if !codePattern.MatchString(string(normalized)) {
+		s.logger.Error("invalid currency code", "code", code)
+		return nil, ErrInvalidCode
+	}
+	if input.Decimals == nil || *input.Decimals < 0 || *input.Decimals > domain.MaxScale {
+		s.logger.Error("invalid currency decimals", "code", normalized, "decimals", input.Decimals)
+		return nil, ErrInvalidDecimals
+	}
+	name := strings.TrimSpace(input.Name)
+	if name == "" {
+		return nil, WrapError(ErrValidationFailed, "name is required")
+	}
+
+	existing, err := s.repo.FindByCode(ctx, normalized)
+	if err != nil {
+		s.logger.Error("failed to find currency", "error", err, "code", normalized)
+		return nil, err
+	}
+	if existing != nil && *input.Decimals < existing.Decimals {
+		s.logger.Error("currency decimals reduced", "code", normalized, "decimals", *input.Decimals, "current_decimals", existing.Decimals)
+		return nil, ErrDecimalsReduced
+	}
+
+	currency := &Currency{
+		Code:     normalized,
+		Name:     name,
+		Decimals: *input.Decimals,
+		Symbol:   strings.TrimSpace(input.Symbol),
+		IsCrypto: input.IsCrypto,
+	}
+	if err := s.repo.Upsert(ctx, currency); err != nil {
+		s.logger.Error("failed to upsert currency", "error", err, "code", normalized)
+		return nil, err
+	}
+
+	if err := s.Load(ctx); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("currency upserted", "code", normalized, "decimals", currency.Decimals, "is_crypto", currency.IsCrypto)
+
+	return toCurrencyOutput(currency), nil
+}
+
+// Load replaces the in-memory currency registry with the currencies table.
+func (s *currencyService) Load(ctx context.Context) error {
+	currencies, err := s.repo.List(ctx)
+	if err != nil {
+		s.logger.Error("failed to load currencies", "error", err)
+		return err
+	}
+	if len(currencies) == 0 {
+		s.logger.Error("currencies table is empty, keeping current registry")
+		return ErrNoCurrenciesFound
+	}
+
+	infos := make([]domain.CurrencyInfo, len(currencies))
+	for i, currency := range currencies {
+		infos[i] = currency.Info()
+	}
+	domain.RegisterCurrencies(infos)
+	return nil
+}
+
+func toCurrencyOutput(currency *Currency) *CurrencyOutput {
+	return &CurrencyOutput{
+		Code:     currency.Code,
+		Name:     currency.Name,
+		Decimals: currency.Decimals,
+		Symbol:   currency.Symbol,
+		IsCrypto: currency.IsCrypto,
+	}
+}
@@ -0,0 +1,125 @@
+package currency
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type MockCurrencyRepository struct {
+	mock.Mock
+}
+
+func (m *MockCurrencyRepository) List(ctx context.Context) ([]*Currency, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*Currency), args.Error(1)
+}
+
+func (m *MockCurrencyRepository) FindByCode(ctx context.Context, code domain.Currency) (*Currency, error) {
+	args := m.Called(ctx, code)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Currency), args.Error(1)
+}
+
+func (m *MockCurrencyRepository) Upsert(ctx context.Context, currency *Currency) error {
+	args := m.Called(ctx, currency)
+	return args.Error(0)
+}
+
+func decimals(places int32) *int32 {
+	return &places
+}
+
+func TestLoad(t *testing.T) {
+	defer domain.RegisterCurrencies(domain.DefaultCurrencies)
+
+	t.Run("replaces the registry", func(t *testing.T) {
+		mockRepo := new(MockCurrencyRepository)
+		service := NewCurrencyService(mockRepo, slog.Default())
+		ctx := context.Background()
+
+		mockRepo.On("List", ctx).Return([]*Currency{
+			{Code: "BRL", Name: "Brazilian Real", Decimals: 2},
+			{Code: "USDT", Name: "Tether", Decimals: 6, IsCrypto: true},
+		}, nil).Once()
+
+		require.NoError(t, service.Load(ctx))
+
+		assert.True(t, domain.Currency("USDT").IsValid())
+		assert.Equal(t, int32(6), domain.Currency("USDT").Decimals())
+		assert.False(t, domain.CurrencyBTC.IsValid())
+	})
+
+	t.Run("empty table keeps the registry", func(t *testing.T) {
+		domain.RegisterCurrencies(domain.DefaultCurrencies)
+		mockRepo := new(MockCurrencyRepository)
+		service := NewCurrencyService(mockRepo, slog.Default())
+		ctx := context.Background()
+
+		mockRepo.On("List", ctx).Return([]*Currency{}, nil).Once()
+
+		assert.ErrorIs(t, service.Load(ctx), ErrNoCurrenciesFound)
+		assert.True(t, domain.CurrencyBTC.IsValid())
+	})
+}
+
+func TestUpsertCurrency(t *testing.T) {
+	defer domain.RegisterCurrencies(domain.DefaultCurrencies)
+
+	t.Run("adds a currency and reloads the registry", func(t *testing.T) {
+		mockRepo := new(MockCurrencyRepository)
+		service := NewCurrencyService(mockRepo, slog.Default())
+		ctx := context.Background()
+
+		gbp := &Currency{Code: "GBP", Name: "Pound Sterling", Decimals: 2, Symbol: "£"}
+		mockRepo.On("FindByCode", ctx, domain.Currency("GBP")).Return(nil, nil).Once()
+		mockRepo.On("Upsert", ctx, mock.MatchedBy(func(c *Currency) bool {
+			return c.Code == "GBP" && c.Name == "Pound Sterling" && c.Decimals == 2 && c.Symbol == "£"
+		})).Return(nil).Once()
+		mockRepo.On("List", ctx).Return([]*Currency{gbp, {Code: "BRL", Name: "Brazilian Real", Decimals: 2}}, nil).Once()
+
+		output, err := service.UpsertCurrency(ctx, " gbp ", UpsertCurrencyInput{Name: "Pound Sterling", Decimals: decimals(2), Symbol: "£"})
+
+		require.NoError(t, err)
+		assert.Equal(t, domain.Currency("GBP"), output.Code)
+		assert.True(t, domain.Currency("GBP").IsValid())
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("decimals cannot shrink", func(t *testing.T) {
+		mockRepo := new(MockCurrencyRepository)
+		service := NewCurrencyService(mockRepo, slog.Default())
+		ctx := context.Background()
+
+		mockRepo.On("FindByCode", ctx, domain.CurrencyBTC).Return(&Currency{Code: "BTC", Name: "Bitcoin", Decimals: 8}, nil).Once()
+
+		_, err := service.UpsertCurrency(ctx, "BTC", UpsertCurrencyInput{Name: "Bitcoin", Decimals: decimals(4)})
+
+		assert.ErrorIs(t, err, ErrDecimalsReduced)
+		mockRepo.AssertNotCalled(t, "Upsert", mock.Anything, mock.Anything)
+	})
+
+	t.Run("validation", func(t *testing.T) {
+		service := NewCurrencyService(new(MockCurrencyRepository), slog.Default())
+		ctx := context.Background()
+
+		_, err := service.UpsertCurrency(ctx, "US-D", UpsertCurrencyInput{Name: "Dollar", Decimals: decimals(2)})
+		assert.ErrorIs(t, err, ErrInvalidCode)
+
+		_, err = service.UpsertCurrency(ctx, "CAD", UpsertCurrencyInput{Name: "Canadian Dollar", Decimals: decimals(9)})
+		assert.ErrorIs(t, err, ErrInvalidDecimals)
+
+		_, err = service.UpsertCurrency(ctx, "CAD", UpsertCurrencyInput{Name: "  ", Decimals: decimals(2)})
+		assert.ErrorIs(t, err, ErrValidationFailed)
+	})
+}
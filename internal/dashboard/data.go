@@ -0,0 +1,42 @@
+package dashboard
+
+import (
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
+)
+
+type DashboardInput struct {
+	Currency domain.Currency `form:"currency"`
+}
+
+type SummaryOutput struct {
+	CurrentBalance   domain.Decimal  `json:"current_balance"`
+	InPlay           domain.Decimal  `json:"in_play"`
+	InitialBalance   domain.Decimal  `json:"initial_balance"`
+	Profit           domain.Decimal  `json:"profit"`
+	ROI              *domain.Decimal `json:"roi"`
+	ProfitLast7Days  domain.Decimal  `json:"profit_last_7_days"`
+	ProfitLast30Days domain.Decimal  `json:"profit_last_30_days"`
+	ProfitLast90Days domain.Decimal  `json:"profit_last_90_days"`
+	WinRate          *domain.Decimal `json:"win_rate"`
+	MaxDrawdown      domain.Decimal  `json:"max_drawdown"`
+}
+
+type BankrollSummaryOutput struct {
+	BankrollID   uint            `json:"bankroll_id"`
+	Name         string          `json:"name"`
+	Currency     domain.Currency `json:"currency"`
+	ExchangeRate *domain.Decimal `json:"exchange_rate,omitempty"`
+	SummaryOutput
+}
+
+type DashboardOutput struct {
+	Currency     *domain.Currency         `json:"currency"`
+	Bankrolls    []*BankrollSummaryOutput `json:"bankrolls"`
+	Consolidated *SummaryOutput           `json:"consolidated"`
+}
+
+type ErrorOutput struct {
+	Error   string              `json:"error"`
+	Code    string              `json:"code"`
+	Details map[string][]string `json:"details,omitempty"`
+}
@@ -0,0 +1,18 @@
+package dashboard
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrValidationFailed     = errors.New("validation failed")
+	ErrDatabaseError        = errors.New("database error")
+	ErrUnauthorized         = errors.New("unauthorized access to dashboard")
+	ErrInvalidCurrency      = errors.New("invalid currency")
+	ErrExchangeRateNotFound = errors.New("exchange rate not found")
+)
+
+func WrapError(err error, message string) error {
+	return fmt.Errorf("%s: %w", message, err)
+}
@@ -0,0 +1,106 @@
+package dashboard
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"log/slog"
+
+	"github.com/gin-gonic/gin"
+)
+
+type DashboardHandler struct {
+	service DashboardService
+	logger  *slog.Logger
+}
+
+func NewDashboardHandler(service DashboardService, logger *slog.Logger) *DashboardHandler {
+	return &DashboardHandler{
+		service: service,
+		logger:  logger,
+	}
+}
+
+func (h *DashboardHandler) GetDashboard(c *gin.Context) {
+	var input DashboardInput
+	if err := c.ShouldBindQuery(&input); err != nil {
+		h.logger.Error("invalid query parameters", "error", err)
+		c.JSON(http.StatusBadRequest, ErrorOutput{
+			Error:   "Invalid query parameters",
+			Code:    "VALIDATION_ERROR",
+			Details: nil,
+		})
+		return
+	}
+
+	userID, err := h.getUserID(c)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	output, err := h.service.GetDashboard(c.Request.Context(), userID, input)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
+
+func (h *DashboardHandler) handleError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, ErrInvalidCurrency):
+		c.JSON(http.StatusBadRequest, ErrorOutput{
+			Error: "Invalid currency",
+			Code:  "INVALID_CURRENCY",
+		})
+	case errors.Is(err, ErrExchangeRateNotFound):
+		c.JSON(http.StatusUnprocessableEntity, ErrorOutput{
+			Error: "Exchange rate not found",
+			Code:  "EXCHANGE_RATE_NOT_FOUND",
+		})
+	case errors.Is(err, ErrValidationFailed):
+		c.JSON(http.StatusBadRequest, ErrorOutput{
+			Error: err.Error(),
+			Code:  "VALIDATION_ERROR",
+		})
+	case errors.Is(err, ErrDatabaseError):
+		h.logger.Error("database error", "error", err)
+		c.JSON(http.StatusInternalServerError, ErrorOutput{
+			Error: "Database error occurred",
+			Code:  "DATABASE_ERROR",
+		})
+	case errors.Is(err, ErrUnauthorized):
+		c.JSON(http.StatusForbidden, ErrorOutput{
+			Error: "Unauthorized access to dashboard",
+			Code:  "UNAUTHORIZED",
+		})
+	default:
+		h.logger.Error("unexpected error", "error", err)
+		c.JSON(http.StatusInternalServerError, ErrorOutput{
+			Error: "An unexpected error occurred",
+			Code:  "INTERNAL_ERROR",
+		})
+	}
+}
+
+func (h *DashboardHandler) getUserID(c *gin.Context) (uint, error) {
+	userIDStr, exists := c.Get("userID")
+	if !exists {
+		return 0, ErrUnauthorized
+	}
+
+	userID, ok := userIDStr.(string)
+	if !ok {
+		return 0, ErrUnauthorized
+	}
+
+	parsedID, err := strconv.ParseUint(userID, 10, 32)
+	if err != nil {
+		return 0, ErrUnauthorized
+	}
+
+	return uint(parsedID), nil
+}
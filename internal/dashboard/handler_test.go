@@ -0,0 +1,85 @@
+package dashboard
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"log/slog"
+
+	"github.com/gin-gonic/gin"
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type MockDashboardServiceForHandler struct {
+	mock.Mock
+}
+
+func (m *MockDashboardServiceForHandler) GetDashboard(ctx context.Context, userID uint, input DashboardInput) (*DashboardOutput, error) {
+	args := m.Called(ctx, userID, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*DashboardOutput), args.Error(1)
+}
+
+func TestGetDashboardHandler(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		mockService := new(MockDashboardServiceForHandler)
+		handler := NewDashboardHandler(mockService, slog.Default())
+		currency := domain.CurrencyBRL
+
+		mockService.On("GetDashboard", mock.Anything, uint(1), DashboardInput{Currency: domain.CurrencyBRL}).
+			Return(&DashboardOutput{Currency: &currency, Bankrolls: []*BankrollSummaryOutput{}}, nil).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest(http.MethodGet, "/dashboard?currency=BRL", nil)
+		c.Set("userID", "1")
+
+		handler.GetDashboard(c)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		var response map[string]interface{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, "BRL", response["currency"])
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("missing exchange rate", func(t *testing.T) {
+		mockService := new(MockDashboardServiceForHandler)
+		handler := NewDashboardHandler(mockService, slog.Default())
+
+		mockService.On("GetDashboard", mock.Anything, uint(1), mock.Anything).Return(nil, ErrExchangeRateNotFound).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest(http.MethodGet, "/dashboard?currency=EUR", nil)
+		c.Set("userID", "1")
+
+		handler.GetDashboard(c)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+		var response ErrorOutput
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, "EXCHANGE_RATE_NOT_FOUND", response.Code)
+	})
+
+	t.Run("unauthorized", func(t *testing.T) {
+		mockService := new(MockDashboardServiceForHandler)
+		handler := NewDashboardHandler(mockService, slog.Default())
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest(http.MethodGet, "/dashboard", nil)
+
+		handler.GetDashboard(c)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+}
@@ -0,0 +1,39 @@
+package dashboard
+
+import (
+	"time"
+
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
+)
+
+// ResultPoint is a single realized result on a bankroll's profit curve.
+type ResultPoint struct {
+	At     time.Time
+	Amount domain.Decimal
+}
+
+// Outcomes counts settled bets and sessions and how many of them won.
+type Outcomes struct {
+	Wins  int64
+	Total int64
+}
+
+func (o Outcomes) Add(other Outcomes) Outcomes {
+	return Outcomes{Wins: o.Wins + other.Wins, Total: o.Total + other.Total}
+}
+
+// MaxDrawdown returns the largest peak-to-trough fall of the cumulative
+// profit curve described by points, which must be in chronological order.
+func MaxDrawdown(points []ResultPoint) domain.Decimal {
+	cumulative, peak, drawdown := domain.Zero, domain.Zero, domain.Zero
+	for _, point := range points {
+		cumulative = cumulative.Add(point.Amount)
+		if cumulative.GreaterThan(peak) {
+			peak = cumulative
+		}
+		if fall := peak.Sub(cumulative); fall.GreaterThan(drawdown) {
+			drawdown = fall
+		}
+	}
+	return drawdown
+}
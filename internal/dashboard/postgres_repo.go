@@ -0,0 +1,64 @@
+package dashboard
+
+import (
+	"context"
+	"time"
+
+	"github.com/opinedajr/micro-stakes-api/internal/bankroll"
+	"gorm.io/gorm"
+)
+
+// Entries of live sessions that are still open are held as in play and are
+// not results yet.
+const openSessionEntryClause = `NOT EXISTS (
+    SELECT 1 FROM poker_sessions s
+    WHERE t.reference_type = 'session' AND s.id = t.reference_id AND s.status = 'open'
+)`
+
+const outcomesQuery = `
+SELECT COUNT(*) AS total, COALESCE(SUM(CASE WHEN profit > 0 THEN 1 ELSE 0 END), 0) AS wins
+FROM (
+    SELECT net_profit AS profit FROM bets
+    WHERE bankroll_id = ? AND deleted_at IS NULL
+        AND status IN ('won', 'lost', 'cashed_out') AND settled_at >= ?
+    UNION ALL
+    SELECT profit FROM poker_sessions
+    WHERE bankroll_id = ? AND deleted_at IS NULL
+        AND status = 'settled' AND ended_at >= ?
+) results`
+
+type postgresDashboardRepository struct {
+	db *gorm.DB
+}
+
+func NewPostgresDashboardRepository(db *gorm.DB) DashboardRepository {
+	return &postgresDashboardRepository{
+		db: db,
+	}
+}
+
+func (r *postgresDashboardRepository) ResultSeries(ctx context.Context, bankrollID uint, since time.Time) ([]ResultPoint, error) {
+	var points []ResultPoint
+	err := r.db.WithContext(ctx).
+		Table("bankroll_transactions AS t").
+		Select("t.created_at AS at, t.amount AS amount").
+		Where("t.bankroll_id = ? AND t.created_at >= ? AND t.type IN ?", bankrollID, since, bankroll.PlayTransactionTypes).
+		Where(openSessionEntryClause).
+		Order("t.created_at, t.id").
+		Scan(&points).Error
+	if err != nil {
+		return nil, WrapError(ErrDatabaseError, err.Error())
+	}
+	return points, nil
+}
+
+func (r *postgresDashboardRepository) Outcomes(ctx context.Context, bankrollID uint, since time.Time) (Outcomes, error) {
+	var outcomes Outcomes
+	err := r.db.WithContext(ctx).
+		Raw(outcomesQuery, bankrollID, since, bankrollID, since).
+		Scan(&outcomes).Error
+	if err != nil {
+		return Outcomes{}, WrapError(ErrDatabaseError, err.Error())
+	}
+	return outcomes, nil
+}
@@ -0,0 +1,128 @@
+package dashboard
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/opinedajr/micro-stakes-api/internal/bankroll"
+	"github.com/opinedajr/micro-stakes-api/internal/bet"
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
+	"github.com/opinedajr/micro-stakes-api/internal/session"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+func setupTestDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+
+	db.AutoMigrate(&bankroll.Bankroll{}, &bankroll.Transaction{}, &bankroll.BankrollGoal{}, &bankroll.GoalMilestone{}, &bet.Bet{}, &session.Session{})
+
+	return db
+}
+
+func createTestBankroll(t *testing.T, db *gorm.DB, userID uint, balance string) *bankroll.Bankroll {
+	br := &bankroll.Bankroll{
+		UserID:               userID,
+		Name:                 "Main",
+		Currency:             bankroll.CurrencyUSD,
+		InitialBalance:       domain.MustParseDecimal(balance),
+		CurrentBalance:       domain.MustParseDecimal(balance),
+		StartDate:            time.Now().UTC().AddDate(0, 0, -30),
+		CommissionPercentage: domain.Zero,
+	}
+
+	err := bankroll.NewPostgresBankrollRepository(db).Create(context.Background(), br)
+	require.NoError(t, err)
+
+	return br
+}
+
+func postEntry(t *testing.T, db *gorm.DB, br *bankroll.Bankroll, transactionType bankroll.TransactionType, amount string) {
+	err := db.Transaction(func(tx *gorm.DB) error {
+		return bankroll.ApplyTransaction(tx, &bankroll.Transaction{
+			BankrollID: br.ID,
+			Type:       transactionType,
+			Amount:     domain.MustParseDecimal(amount),
+		}, br.UserID)
+	})
+	require.NoError(t, err)
+}
+
+func createSettledBet(t *testing.T, db *gorm.DB, br *bankroll.Bankroll, status bet.BetStatus, netProfit string) {
+	settledAt := time.Now().UTC()
+	b := &bet.Bet{
+		UserID:      br.UserID,
+		BankrollID:  br.ID,
+		Type:        bet.BetTypeBack,
+		Event:       "Flamengo x Palmeiras",
+		Market:      "Match Odds",
+		Selection:   "Flamengo",
+		Odds:        domain.MustParseDecimal("2.000"),
+		Stake:       domain.MustParseDecimal("10.00"),
+		Liability:   domain.MustParseDecimal("10.00"),
+		Status:      status,
+		GrossProfit: domain.MustParseDecimal(netProfit),
+		Commission:  domain.Zero,
+		NetProfit:   domain.MustParseDecimal(netProfit),
+		PlacedAt:    settledAt,
+		SettledAt:   &settledAt,
+	}
+	require.NoError(t, db.Create(b).Error)
+}
+
+func TestPostgresDashboardRepository_ResultSeries(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewPostgresDashboardRepository(db)
+	ctx := context.Background()
+	br := createTestBankroll(t, db, 1, "1000.00")
+
+	postEntry(t, db, br, bankroll.TransactionTypeDeposit, "500.00")
+	postEntry(t, db, br, bankroll.TransactionTypeBetSettlement, "45.00")
+	postEntry(t, db, br, bankroll.TransactionTypeSessionResult, "-20.00")
+
+	live := &session.Session{
+		UserID:     br.UserID,
+		BankrollID: br.ID,
+		GameType:   session.GameTypeNLHE,
+		Stakes:     "NL50",
+		Venue:      "PokerStars",
+		Table:      "Altair II",
+		Status:     session.SessionStatusOpen,
+		StartedAt:  time.Now().UTC(),
+		BuyIn:      domain.MustParseDecimal("50.00"),
+		Profit:     domain.MustParseDecimal("-50.00"),
+	}
+	require.NoError(t, session.NewPostgresSessionRepository(db).Start(ctx, live))
+
+	points, err := repo.ResultSeries(ctx, br.ID, br.StartDate)
+
+	require.NoError(t, err)
+	require.Len(t, points, 2)
+	assert.Equal(t, 45.00, points[0].Amount.Float64())
+	assert.Equal(t, -20.00, points[1].Amount.Float64())
+	assert.False(t, points[0].At.IsZero())
+
+	points, err = repo.ResultSeries(ctx, br.ID, time.Now().UTC().Add(time.Hour))
+	require.NoError(t, err)
+	assert.Empty(t, points)
+}
+
+func TestPostgresDashboardRepository_Outcomes(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewPostgresDashboardRepository(db)
+	br := createTestBankroll(t, db, 1, "1000.00")
+
+	createSettledBet(t, db, br, bet.BetStatusWon, "10.00")
+	createSettledBet(t, db, br, bet.BetStatusLost, "-10.00")
+	createSettledBet(t, db, br, bet.BetStatusVoid, "0")
+	createSettledBet(t, db, br, bet.BetStatusCashedOut, "3.50")
+
+	outcomes, err := repo.Outcomes(context.Background(), br.ID, br.StartDate)
+
+	require.NoError(t, err)
+	assert.Equal(t, Outcomes{Wins: 2, Total: 3}, outcomes)
+}
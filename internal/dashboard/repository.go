@@ -0,0 +1,11 @@
+package dashboard
+
+import (
+	"context"
+	"time"
+)
+
+type DashboardRepository interface {
+	ResultSeries(ctx context.Context, bankrollID uint, since time.Time) ([]ResultPoint, error)
+	Outcomes(ctx context.Context, bankrollID uint, since time.Time) (Outcomes, error)
+}
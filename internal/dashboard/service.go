@@ -0,0 +1,203 @@
+package dashboard
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sort"
+	"time"
+
+	"github.com/opinedajr/micro-stakes-api/internal/bankroll"
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
+	"github.com/opinedajr/micro-stakes-api/internal/fx"
+)
+
+type DashboardService interface {
+	GetDashboard(ctx context.Context, userID uint, input DashboardInput) (*DashboardOutput, error)
+}
+
+type dashboardService struct {
+	repo         DashboardRepository
+	bankrollRepo bankroll.BankrollRepository
+	rates        fx.Converter
+	logger       *slog.Logger
+}
+
+func NewDashboardService(repo DashboardRepository, bankrollRepo bankroll.BankrollRepository, rates fx.Converter, logger *slog.Logger) DashboardService {
+	return &dashboardService{
+		repo:         repo,
+		bankrollRepo: bankrollRepo,
+		rates:        rates,
+		logger:       logger,
+	}
+}
+
+// figures are the raw amounts behind a summary, all in one currency.
+type figures struct {
+	balance  domain.Decimal
+	inPlay   domain.Decimal
+	initial  domain.Decimal
+	points   []ResultPoint
+	outcomes Outcomes
+}
+
+func (f figures) convert(rate domain.Decimal) figures {
+	points := make([]ResultPoint, len(f.points))
+	for i, point := range f.points {
+		points[i] = ResultPoint{At: point.At, Amount: point.Amount.Mul(rate)}
+	}
+	return figures{
+		balance:  f.balance.Mul(rate),
+		inPlay:   f.inPlay.Mul(rate),
+		initial:  f.initial.Mul(rate),
+		points:   points,
+		outcomes: f.outcomes,
+	}
+}
+
+func (f figures) merge(other figures) figures {
+	points := append(append([]ResultPoint{}, f.points...), other.points...)
+	sort.SliceStable(points, func(i, j int) bool {
+		return points[i].At.Before(points[j].At)
+	})
+	return figures{
+		balance:  f.balance.Add(other.balance),
+		inPlay:   f.inPlay.Add(other.inPlay),
+		initial:  f.initial.Add(other.initial),
+		points:   points,
+		outcomes: f.outcomes.Add(other.outcomes),
+	}
+}
+
+func (s *dashboardService) GetDashboard(ctx context.Context, userID uint, input DashboardInput) (*DashboardOutput, error) {
+	display := input.Currency
+	if display != "" && !display.IsValid() {
+		s.logger.Error("invalid display currency", "currency", display, "user_id", userID)
+		return nil, ErrInvalidCurrency
+	}
+
+	bankrolls, err := s.bankrollRepo.ListByUserID(ctx, userID)
+	if err != nil {
+		s.logger.Error("failed to list bankrolls", "error", err, "user_id", userID)
+		return nil, WrapError(ErrDatabaseError, err.Error())
+	}
+
+	now := time.Now().UTC()
+	output := &DashboardOutput{
+		Bankrolls: make([]*BankrollSummaryOutput, 0, len(bankrolls)),
+	}
+
+	var total figures
+	currencies := make(map[domain.Currency]bool)
+	for _, br := range bankrolls {
+		current, err := s.collect(ctx, br)
+		if err != nil {
+			s.logger.Error("failed to collect bankroll figures", "error", err, "user_id", userID, "bankroll_id", br.ID)
+			return nil, err
+		}
+
+		currency := br.Currency
+		summary := &BankrollSummaryOutput{
+			BankrollID: br.ID,
+			Name:       br.Name,
+			Currency:   br.Currency,
+		}
+		if display != "" && display != br.Currency {
+			rate, err := s.findRate(ctx, br.Currency, display, now)
+			if err != nil {
+				return nil, err
+			}
+			current = current.convert(rate)
+			currency = display
+			summary.ExchangeRate = &rate
+		}
+
+		summary.SummaryOutput = summarize(current, currency, now)
+		output.Bankrolls = append(output.Bankrolls, summary)
+		total = total.merge(current)
+		currencies[currency] = true
+	}
+
+	if len(currencies) == 1 {
+		for currency := range currencies {
+			consolidated := summarize(total, currency, now)
+			output.Currency = &currency
+			output.Consolidated = &consolidated
+		}
+	}
+	if display != "" {
+		output.Currency = &display
+	}
+
+	s.logger.Info("dashboard computed", "user_id", userID, "bankrolls", len(bankrolls), "currency", display)
+
+	return output, nil
+}
+
+func (s *dashboardService) collect(ctx context.Context, br *bankroll.Bankroll) (figures, error) {
+	points, err := s.repo.ResultSeries(ctx, br.ID, br.StartDate)
+	if err != nil {
+		return figures{}, err
+	}
+	outcomes, err := s.repo.Outcomes(ctx, br.ID, br.StartDate)
+	if err != nil {
+		return figures{}, err
+	}
+	return figures{
+		balance:  br.CurrentBalance,
+		inPlay:   br.InPlay,
+		initial:  br.InitialBalance,
+		points:   points,
+		outcomes: outcomes,
+	}, nil
+}
+
+func (s *dashboardService) findRate(ctx context.Context, from domain.Currency, to domain.Currency, at time.Time) (domain.Decimal, error) {
+	rate, err := s.rates.Rate(ctx, from, to, at)
+	if err != nil {
+		s.logger.Error("exchange rate not available", "error", err, "from", from, "to", to)
+		if errors.Is(err, fx.ErrRateNotFound) || errors.Is(err, fx.ErrInvalidRate) {
+			return domain.Zero, ErrExchangeRateNotFound
+		}
+		return domain.Zero, WrapError(ErrDatabaseError, err.Error())
+	}
+	return rate, nil
+}
+
+func summarize(f figures, currency domain.Currency, now time.Time) SummaryOutput {
+	profit := domain.Zero
+	for _, point := range f.points {
+		profit = profit.Add(point.Amount)
+	}
+
+	summary := SummaryOutput{
+		CurrentBalance:   currency.Round(f.balance),
+		InPlay:           currency.Round(f.inPlay),
+		InitialBalance:   currency.Round(f.initial),
+		Profit:           currency.Round(profit),
+		ProfitLast7Days:  currency.Round(profitSince(f.points, now.AddDate(0, 0, -7))),
+		ProfitLast30Days: currency.Round(profitSince(f.points, now.AddDate(0, 0, -30))),
+		ProfitLast90Days: currency.Round(profitSince(f.points, now.AddDate(0, 0, -90))),
+		MaxDrawdown:      currency.Round(MaxDrawdown(f.points)),
+	}
+	if f.initial.IsPositive() {
+		roi := profit.Div(f.initial).Mul(domain.NewDecimalFromInt(100)).Round(2)
+		summary.ROI = &roi
+	}
+	if f.outcomes.Total > 0 {
+		winRate := domain.NewDecimalFromInt(f.outcomes.Wins * 100).
+			Div(domain.NewDecimalFromInt(f.outcomes.Total)).Round(2)
+		summary.WinRate = &winRate
+	}
+	return summary
+}
+
+func profitSince(points []ResultPoint, since time.Time) domain.Decimal {
+	profit := domain.Zero
+	for _, point := range points {
+		if !point.At.Before(since) {
+			profit = profit.Add(point.Amount)
+		}
+	}
+	return profit
+}
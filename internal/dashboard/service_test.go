@@ -0,0 +1,243 @@
+package dashboard
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/opinedajr/micro-stakes-api/internal/bankroll"
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
+	"github.com/opinedajr/micro-stakes-api/internal/fx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type MockDashboardRepository struct {
+	mock.Mock
+}
+
+func (m *MockDashboardRepository) ResultSeries(ctx context.Context, bankrollID uint, since time.Time) ([]ResultPoint, error) {
+	args := m.Called(ctx, bankrollID, since)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]ResultPoint), args.Error(1)
+}
+
+func (m *MockDashboardRepository) Outcomes(ctx context.Context, bankrollID uint, since time.Time) (Outcomes, error) {
+	args := m.Called(ctx, bankrollID, since)
+	return args.Get(0).(Outcomes), args.Error(1)
+}
+
+type MockBankrollRepository struct {
+	mock.Mock
+}
+
+func (m *MockBankrollRepository) Create(ctx context.Context, br *bankroll.Bankroll) error {
+	args := m.Called(ctx, br)
+	return args.Error(0)
+}
+
+func (m *MockBankrollRepository) Update(ctx context.Context, br *bankroll.Bankroll) error {
+	args := m.Called(ctx, br)
+	return args.Error(0)
+}
+
+func (m *MockBankrollRepository) ListByUserID(ctx context.Context, userID uint) ([]*bankroll.Bankroll, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*bankroll.Bankroll), args.Error(1)
+}
+
+func (m *MockBankrollRepository) List(ctx context.Context, userID uint, filter bankroll.BankrollListFilter) ([]*bankroll.Bankroll, int64, error) {
+	args := m.Called(ctx, userID, filter)
+	if args.Get(0) == nil {
+		return nil, 0, args.Error(2)
+	}
+	return args.Get(0).([]*bankroll.Bankroll), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockBankrollRepository) FindByID(ctx context.Context, id uint, userID uint) (*bankroll.Bankroll, error) {
+	args := m.Called(ctx, id, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*bankroll.Bankroll), args.Error(1)
+}
+
+func (m *MockBankrollRepository) Reset(ctx context.Context, id uint, userID uint, amount domain.Decimal, expectedVersion uint) (*bankroll.BankrollPeriod, error) {
+	args := m.Called(ctx, id, userID, amount, expectedVersion)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*bankroll.BankrollPeriod), args.Error(1)
+}
+
+func (m *MockBankrollRepository) Delete(ctx context.Context, id uint, userID uint) error {
+	args := m.Called(ctx, id, userID)
+	return args.Error(0)
+}
+
+func (m *MockBankrollRepository) Archive(ctx context.Context, id uint, userID uint) error {
+	args := m.Called(ctx, id, userID)
+	return args.Error(0)
+}
+
+func (m *MockBankrollRepository) Restore(ctx context.Context, id uint, userID uint) error {
+	args := m.Called(ctx, id, userID)
+	return args.Error(0)
+}
+
+type MockConverter struct {
+	mock.Mock
+}
+
+func (m *MockConverter) Rate(ctx context.Context, from domain.Currency, to domain.Currency, at time.Time) (domain.Decimal, error) {
+	args := m.Called(ctx, from, to, at)
+	return args.Get(0).(domain.Decimal), args.Error(1)
+}
+
+func (m *MockConverter) Convert(ctx context.Context, amount domain.Decimal, from domain.Currency, to domain.Currency, at time.Time) (domain.Decimal, error) {
+	args := m.Called(ctx, amount, from, to, at)
+	return args.Get(0).(domain.Decimal), args.Error(1)
+}
+
+func newTestService() (DashboardService, *MockDashboardRepository, *MockBankrollRepository, *MockConverter) {
+	mockRepo := new(MockDashboardRepository)
+	mockBankrollRepo := new(MockBankrollRepository)
+	mockRates := new(MockConverter)
+	return NewDashboardService(mockRepo, mockBankrollRepo, mockRates, slog.Default()), mockRepo, mockBankrollRepo, mockRates
+}
+
+func testBankroll(id uint, currency bankroll.Currency, initial string, current string) *bankroll.Bankroll {
+	return &bankroll.Bankroll{
+		ID:             id,
+		UserID:         1,
+		Name:           string(currency) + " room",
+		Currency:       currency,
+		InitialBalance: domain.MustParseDecimal(initial),
+		CurrentBalance: domain.MustParseDecimal(current),
+		StartDate:      time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+}
+
+func point(daysAgo int, amount string) ResultPoint {
+	return ResultPoint{
+		At:     time.Now().UTC().AddDate(0, 0, -daysAgo),
+		Amount: domain.MustParseDecimal(amount),
+	}
+}
+
+func TestMaxDrawdown(t *testing.T) {
+	points := []ResultPoint{
+		point(10, "100"), point(9, "-30"), point(8, "-50"), point(7, "60"), point(6, "-20"),
+	}
+
+	assert.Equal(t, 80.0, MaxDrawdown(points).Float64())
+	assert.True(t, MaxDrawdown(nil).IsZero())
+	assert.Equal(t, 40.0, MaxDrawdown([]ResultPoint{point(2, "-40")}).Float64())
+}
+
+func TestGetDashboard(t *testing.T) {
+	t.Run("single currency with consolidated totals", func(t *testing.T) {
+		service, mockRepo, mockBankrollRepo, _ := newTestService()
+		ctx := context.Background()
+		br := testBankroll(1, bankroll.CurrencyUSD, "1000.00", "1150.00")
+
+		mockBankrollRepo.On("ListByUserID", ctx, uint(1)).Return([]*bankroll.Bankroll{br}, nil).Once()
+		mockRepo.On("ResultSeries", ctx, uint(1), br.StartDate).Return([]ResultPoint{
+			point(100, "200.00"), point(40, "-100.00"), point(20, "30.00"), point(3, "20.00"),
+		}, nil).Once()
+		mockRepo.On("Outcomes", ctx, uint(1), br.StartDate).Return(Outcomes{Wins: 3, Total: 4}, nil).Once()
+
+		output, err := service.GetDashboard(ctx, 1, DashboardInput{})
+
+		require.NoError(t, err)
+		require.Len(t, output.Bankrolls, 1)
+		summary := output.Bankrolls[0]
+		assert.Equal(t, "150.00", summary.Profit.String())
+		assert.Equal(t, "15.00", summary.ROI.String())
+		assert.Equal(t, "20.00", summary.ProfitLast7Days.String())
+		assert.Equal(t, "50.00", summary.ProfitLast30Days.String())
+		assert.Equal(t, "-50.00", summary.ProfitLast90Days.String())
+		assert.Equal(t, "75.00", summary.WinRate.String())
+		assert.Equal(t, "100.00", summary.MaxDrawdown.String())
+		assert.Nil(t, summary.ExchangeRate)
+		require.NotNil(t, output.Consolidated)
+		assert.Equal(t, bankroll.CurrencyUSD, *output.Currency)
+		assert.Equal(t, "1150.00", output.Consolidated.CurrentBalance.String())
+	})
+
+	t.Run("mixed currencies without display currency", func(t *testing.T) {
+		service, mockRepo, mockBankrollRepo, _ := newTestService()
+
+		mockBankrollRepo.On("ListByUserID", mock.Anything, uint(1)).Return([]*bankroll.Bankroll{
+			testBankroll(1, bankroll.CurrencyUSD, "1000.00", "1000.00"),
+			testBankroll(2, bankroll.CurrencyBRL, "5000.00", "5000.00"),
+		}, nil).Once()
+		mockRepo.On("ResultSeries", mock.Anything, mock.Anything, mock.Anything).Return([]ResultPoint{}, nil)
+		mockRepo.On("Outcomes", mock.Anything, mock.Anything, mock.Anything).Return(Outcomes{}, nil)
+
+		output, err := service.GetDashboard(context.Background(), 1, DashboardInput{})
+
+		require.NoError(t, err)
+		assert.Len(t, output.Bankrolls, 2)
+		assert.Nil(t, output.Consolidated)
+		assert.Nil(t, output.Currency)
+		assert.Equal(t, "0.00", output.Bankrolls[0].ROI.String())
+		assert.Nil(t, output.Bankrolls[0].WinRate)
+	})
+
+	t.Run("converts to display currency", func(t *testing.T) {
+		service, mockRepo, mockBankrollRepo, mockRates := newTestService()
+		usd := testBankroll(1, bankroll.CurrencyUSD, "1000.00", "1100.00")
+		brl := testBankroll(2, bankroll.CurrencyBRL, "5000.00", "4500.00")
+
+		mockBankrollRepo.On("ListByUserID", mock.Anything, uint(1)).Return([]*bankroll.Bankroll{usd, brl}, nil).Once()
+		mockRepo.On("ResultSeries", mock.Anything, uint(1), mock.Anything).Return([]ResultPoint{point(5, "100.00")}, nil).Once()
+		mockRepo.On("ResultSeries", mock.Anything, uint(2), mock.Anything).Return([]ResultPoint{point(4, "-500.00")}, nil).Once()
+		mockRepo.On("Outcomes", mock.Anything, mock.Anything, mock.Anything).Return(Outcomes{Wins: 1, Total: 2}, nil)
+		mockRates.On("Rate", mock.Anything, bankroll.CurrencyUSD, bankroll.CurrencyBRL, mock.AnythingOfType("time.Time")).
+			Return(domain.MustParseDecimal("5.25"), nil).Once()
+
+		output, err := service.GetDashboard(context.Background(), 1, DashboardInput{Currency: bankroll.CurrencyBRL})
+
+		require.NoError(t, err)
+		assert.Equal(t, "5775.00", output.Bankrolls[0].CurrentBalance.String())
+		assert.Equal(t, "5.25", output.Bankrolls[0].ExchangeRate.String())
+		assert.Nil(t, output.Bankrolls[1].ExchangeRate)
+		require.NotNil(t, output.Consolidated)
+		assert.Equal(t, "10275.00", output.Consolidated.CurrentBalance.String())
+		assert.Equal(t, "25.00", output.Consolidated.Profit.String())
+		assert.Equal(t, "500.00", output.Consolidated.MaxDrawdown.String())
+		assert.Equal(t, "50.00", output.Consolidated.WinRate.String())
+	})
+
+	t.Run("missing exchange rate", func(t *testing.T) {
+		service, mockRepo, mockBankrollRepo, mockRates := newTestService()
+
+		mockBankrollRepo.On("ListByUserID", mock.Anything, uint(1)).Return([]*bankroll.Bankroll{
+			testBankroll(1, bankroll.CurrencyBTC, "1.00", "1.00"),
+		}, nil).Once()
+		mockRepo.On("ResultSeries", mock.Anything, mock.Anything, mock.Anything).Return([]ResultPoint{}, nil)
+		mockRepo.On("Outcomes", mock.Anything, mock.Anything, mock.Anything).Return(Outcomes{}, nil)
+		mockRates.On("Rate", mock.Anything, bankroll.CurrencyBTC, bankroll.CurrencyEUR, mock.AnythingOfType("time.Time")).
+			Return(domain.Zero, fx.ErrRateNotFound).Once()
+
+		_, err := service.GetDashboard(context.Background(), 1, DashboardInput{Currency: bankroll.CurrencyEUR})
+
+		assert.ErrorIs(t, err, ErrExchangeRateNotFound)
+	})
+
+	t.Run("invalid currency", func(t *testing.T) {
+		service, _, _, _ := newTestService()
+
+		_, err := service.GetDashboard(context.Background(), 1, DashboardInput{Currency: "XYZ"})
+
+		assert.ErrorIs(t, err, ErrInvalidCurrency)
+	})
+}
@@ -6,11 +6,18 @@ import (
 
 	"github.com/opinedajr/micro-stakes-api/internal/auth"
 	"github.com/opinedajr/micro-stakes-api/internal/bankroll"
+	"github.com/opinedajr/micro-stakes-api/internal/bet"
+	"github.com/opinedajr/micro-stakes-api/internal/currency"
+	"github.com/opinedajr/micro-stakes-api/internal/dashboard"
+	"github.com/opinedajr/micro-stakes-api/internal/fx"
 	"github.com/opinedajr/micro-stakes-api/internal/healthcheck"
 	"github.com/opinedajr/micro-stakes-api/internal/infrastructure/database"
 	"github.com/opinedajr/micro-stakes-api/internal/infrastructure/identity"
+	"github.com/opinedajr/micro-stakes-api/internal/session"
 	"github.com/opinedajr/micro-stakes-api/internal/shared/config"
 	"github.com/opinedajr/micro-stakes-api/internal/shared/logger"
+	"github.com/opinedajr/micro-stakes-api/internal/shared/middleware"
+	"github.com/opinedajr/micro-stakes-api/internal/strategy"
 	"gorm.io/gorm"
 )
 
@@ -25,20 +32,78 @@ type Container struct {
 }
 
 type RepositoryDependencies struct {
-	userRepository     auth.UserRepository
-	bankrollRepository bankroll.BankrollRepository
+	userRepository        auth.UserRepository
+	bankrollRepository    bankroll.BankrollRepository
+	transactionRepository bankroll.TransactionRepository
+	historyRepository     bankroll.HistoryRepository
+	periodRepository      bankroll.PeriodRepository
+	ruleRepository        bankroll.RuleRepository
+	limitRepository       bankroll.LimitRepository
+	stakeRepository       bankroll.StakeRepository
+	riskRepository        bankroll.RiskRepository
+	statsRepository       bankroll.StatsRepository
+	goalRepository        bankroll.GoalRepository
+	rakebackRepository    bankroll.RakebackRepository
+	transferRepository    bankroll.TransferRepository
+	betRepository         bet.BetRepository
+	strategyRepository    strategy.StrategyRepository
+	sessionRepository     session.SessionRepository
+	dashboardRepository   dashboard.DashboardRepository
+	idempotencyRepository middleware.IdempotencyRepository
+	rateRepository        fx.RateRepository
+	currencyRepository    currency.CurrencyRepository
 }
 
 type HandlerDependencies struct {
 	healthcheckHandler *healthcheck.Handler
 	authHandler        *auth.AuthHandler
 	bankrollHandler    *bankroll.BankrollHandler
+	transactionHandler *bankroll.TransactionHandler
+	historyHandler     *bankroll.HistoryHandler
+	periodHandler      *bankroll.PeriodHandler
+	ruleHandler        *bankroll.RuleHandler
+	limitHandler       *bankroll.LimitHandler
+	stakeHandler       *bankroll.StakeHandler
+	riskHandler        *bankroll.RiskHandler
+	statsHandler       *bankroll.StatsHandler
+	goalHandler        *bankroll.GoalHandler
+	rakebackHandler    *bankroll.RakebackHandler
+	transferHandler    *bankroll.TransferHandler
+	betHandler         *bet.BetHandler
+	strategyHandler    *strategy.StrategyHandler
+	sessionHandler     *session.SessionHandler
+	dashboardHandler   *dashboard.DashboardHandler
+	rateHandler        *fx.RateHandler
+	currencyHandler    *currency.CurrencyHandler
 }
 
 type ServiceDependencies struct {
 	healthcheckService *healthcheck.Service
 	authService        auth.AuthService
 	bankrollService    bankroll.BankrollService
+	transactionService bankroll.TransactionService
+	historyService     bankroll.HistoryService
+	periodService      bankroll.PeriodService
+	ruleService        bankroll.RuleService
+	limitService       bankroll.LimitService
+	stakeService       bankroll.StakeService
+	riskService        bankroll.RiskService
+	statsService       bankroll.StatsService
+	goalService        bankroll.GoalService
+	rakebackService    bankroll.RakebackService
+	rakebackScheduler  *bankroll.RakebackScheduler
+	transferService    bankroll.TransferService
+	betService         bet.BetService
+	strategyService    strategy.StrategyService
+	sessionService     session.SessionService
+	sessionSweeper     *session.Sweeper
+	idempotencyJanitor *middleware.IdempotencyJanitor
+	dashboardService   dashboard.DashboardService
+	rateService        fx.RateService
+	rateFetchStatus    *fx.FetchStatus
+	rateFetcher        *fx.Fetcher
+	currencyService    currency.CurrencyService
+	currencyRefresher  *currency.Refresher
 }
 
 func NewContainer() *Container {
@@ -93,7 +158,9 @@ func (c *Container) IdentityProvider() identity.IdentityProvider {
 
 func (c *Container) HealthCheckService() *healthcheck.Service {
 	if c.services.healthcheckService == nil {
-		c.services.healthcheckService = healthcheck.NewHealthCheckService()
+		c.services.healthcheckService = healthcheck.NewHealthCheckService(
+			c.RateFetchStatus(),
+		)
 	}
 	return c.services.healthcheckService
 }
@@ -144,6 +211,7 @@ func (c *Container) BankrollService() bankroll.BankrollService {
 	if c.services.bankrollService == nil {
 		c.services.bankrollService = bankroll.NewBankrollService(
 			c.BankrollRepository(),
+			c.LimitService(),
 			c.Logger(),
 		)
 	}
@@ -159,3 +227,569 @@ func (c *Container) BankrollHandler() *bankroll.BankrollHandler {
 	}
 	return c.handlers.bankrollHandler
 }
+
+func (c *Container) TransactionRepository() bankroll.TransactionRepository {
+	if c.repositories.transactionRepository == nil {
+		c.repositories.transactionRepository = bankroll.NewPostgresTransactionRepository(c.DB())
+	}
+	return c.repositories.transactionRepository
+}
+
+func (c *Container) TransactionService() bankroll.TransactionService {
+	if c.services.transactionService == nil {
+		c.services.transactionService = bankroll.NewTransactionService(
+			c.TransactionRepository(),
+			c.BankrollRepository(),
+			c.Logger(),
+		)
+	}
+	return c.services.transactionService
+}
+
+func (c *Container) TransactionHandler() *bankroll.TransactionHandler {
+	if c.handlers.transactionHandler == nil {
+		c.handlers.transactionHandler = bankroll.NewTransactionHandler(
+			c.TransactionService(),
+			c.Logger(),
+		)
+	}
+	return c.handlers.transactionHandler
+}
+
+func (c *Container) HistoryRepository() bankroll.HistoryRepository {
+	if c.repositories.historyRepository == nil {
+		c.repositories.historyRepository = bankroll.NewPostgresHistoryRepository(c.DB())
+	}
+	return c.repositories.historyRepository
+}
+
+func (c *Container) HistoryService() bankroll.HistoryService {
+	if c.services.historyService == nil {
+		c.services.historyService = bankroll.NewHistoryService(
+			c.HistoryRepository(),
+			c.BankrollRepository(),
+			c.Logger(),
+		)
+	}
+	return c.services.historyService
+}
+
+func (c *Container) HistoryHandler() *bankroll.HistoryHandler {
+	if c.handlers.historyHandler == nil {
+		c.handlers.historyHandler = bankroll.NewHistoryHandler(
+			c.HistoryService(),
+			c.Logger(),
+		)
+	}
+	return c.handlers.historyHandler
+}
+
+func (c *Container) PeriodRepository() bankroll.PeriodRepository {
+	if c.repositories.periodRepository == nil {
+		c.repositories.periodRepository = bankroll.NewPostgresPeriodRepository(c.DB())
+	}
+	return c.repositories.periodRepository
+}
+
+func (c *Container) PeriodService() bankroll.PeriodService {
+	if c.services.periodService == nil {
+		c.services.periodService = bankroll.NewPeriodService(
+			c.PeriodRepository(),
+			c.BankrollRepository(),
+			c.Logger(),
+		)
+	}
+	return c.services.periodService
+}
+
+func (c *Container) PeriodHandler() *bankroll.PeriodHandler {
+	if c.handlers.periodHandler == nil {
+		c.handlers.periodHandler = bankroll.NewPeriodHandler(
+			c.PeriodService(),
+			c.Logger(),
+		)
+	}
+	return c.handlers.periodHandler
+}
+
+func (c *Container) TransferRepository() bankroll.TransferRepository {
+	if c.repositories.transferRepository == nil {
+		c.repositories.transferRepository = bankroll.NewPostgresTransferRepository(c.DB())
+	}
+	return c.repositories.transferRepository
+}
+
+func (c *Container) TransferService() bankroll.TransferService {
+	if c.services.transferService == nil {
+		c.services.transferService = bankroll.NewTransferService(
+			c.TransferRepository(),
+			c.BankrollRepository(),
+			c.RateService(),
+			c.Logger(),
+		)
+	}
+	return c.services.transferService
+}
+
+func (c *Container) TransferHandler() *bankroll.TransferHandler {
+	if c.handlers.transferHandler == nil {
+		c.handlers.transferHandler = bankroll.NewTransferHandler(
+			c.TransferService(),
+			c.Logger(),
+		)
+	}
+	return c.handlers.transferHandler
+}
+
+func (c *Container) RuleRepository() bankroll.RuleRepository {
+	if c.repositories.ruleRepository == nil {
+		c.repositories.ruleRepository = bankroll.NewPostgresRuleRepository(c.DB())
+	}
+	return c.repositories.ruleRepository
+}
+
+func (c *Container) RuleService() bankroll.RuleService {
+	if c.services.ruleService == nil {
+		c.services.ruleService = bankroll.NewRuleService(
+			c.RuleRepository(),
+			c.BankrollRepository(),
+			c.LimitService(),
+			c.Logger(),
+		)
+	}
+	return c.services.ruleService
+}
+
+func (c *Container) RuleHandler() *bankroll.RuleHandler {
+	if c.handlers.ruleHandler == nil {
+		c.handlers.ruleHandler = bankroll.NewRuleHandler(
+			c.RuleService(),
+			c.Logger(),
+		)
+	}
+	return c.handlers.ruleHandler
+}
+
+func (c *Container) LimitRepository() bankroll.LimitRepository {
+	if c.repositories.limitRepository == nil {
+		c.repositories.limitRepository = bankroll.NewPostgresLimitRepository(c.DB())
+	}
+	return c.repositories.limitRepository
+}
+
+func (c *Container) LimitService() bankroll.LimitService {
+	if c.services.limitService == nil {
+		c.services.limitService = bankroll.NewLimitService(
+			c.LimitRepository(),
+			c.BankrollRepository(),
+			c.Logger(),
+		)
+	}
+	return c.services.limitService
+}
+
+func (c *Container) LimitHandler() *bankroll.LimitHandler {
+	if c.handlers.limitHandler == nil {
+		c.handlers.limitHandler = bankroll.NewLimitHandler(
+			c.LimitService(),
+			c.Logger(),
+		)
+	}
+	return c.handlers.limitHandler
+}
+
+func (c *Container) StakeRepository() bankroll.StakeRepository {
+	if c.repositories.stakeRepository == nil {
+		c.repositories.stakeRepository = bankroll.NewPostgresStakeRepository(c.DB())
+	}
+	return c.repositories.stakeRepository
+}
+
+func (c *Container) StakeService() bankroll.StakeService {
+	if c.services.stakeService == nil {
+		c.services.stakeService = bankroll.NewStakeService(
+			c.StakeRepository(),
+			c.BankrollRepository(),
+			c.RuleRepository(),
+			c.RateService(),
+			c.Logger(),
+		)
+	}
+	return c.services.stakeService
+}
+
+func (c *Container) StakeHandler() *bankroll.StakeHandler {
+	if c.handlers.stakeHandler == nil {
+		c.handlers.stakeHandler = bankroll.NewStakeHandler(
+			c.StakeService(),
+			c.Logger(),
+		)
+	}
+	return c.handlers.stakeHandler
+}
+
+func (c *Container) RiskRepository() bankroll.RiskRepository {
+	if c.repositories.riskRepository == nil {
+		c.repositories.riskRepository = bankroll.NewPostgresRiskRepository(c.DB())
+	}
+	return c.repositories.riskRepository
+}
+
+func (c *Container) RiskService() bankroll.RiskService {
+	if c.services.riskService == nil {
+		c.services.riskService = bankroll.NewRiskService(
+			c.RiskRepository(),
+			c.BankrollRepository(),
+			c.Logger(),
+		)
+	}
+	return c.services.riskService
+}
+
+func (c *Container) RiskHandler() *bankroll.RiskHandler {
+	if c.handlers.riskHandler == nil {
+		c.handlers.riskHandler = bankroll.NewRiskHandler(
+			c.RiskService(),
+			c.Logger(),
+		)
+	}
+	return c.handlers.riskHandler
+}
+
+func (c *Container) StatsRepository() bankroll.StatsRepository {
+	if c.repositories.statsRepository == nil {
+		c.repositories.statsRepository = bankroll.NewPostgresStatsRepository(c.DB())
+	}
+	return c.repositories.statsRepository
+}
+
+func (c *Container) StatsService() bankroll.StatsService {
+	if c.services.statsService == nil {
+		c.services.statsService = bankroll.NewStatsService(
+			c.StatsRepository(),
+			c.BankrollRepository(),
+			c.Logger(),
+		)
+	}
+	return c.services.statsService
+}
+
+func (c *Container) StatsHandler() *bankroll.StatsHandler {
+	if c.handlers.statsHandler == nil {
+		c.handlers.statsHandler = bankroll.NewStatsHandler(
+			c.StatsService(),
+			c.Logger(),
+		)
+	}
+	return c.handlers.statsHandler
+}
+
+func (c *Container) GoalRepository() bankroll.GoalRepository {
+	if c.repositories.goalRepository == nil {
+		c.repositories.goalRepository = bankroll.NewPostgresGoalRepository(c.DB())
+	}
+	return c.repositories.goalRepository
+}
+
+func (c *Container) GoalService() bankroll.GoalService {
+	if c.services.goalService == nil {
+		c.services.goalService = bankroll.NewGoalService(
+			c.GoalRepository(),
+			c.BankrollRepository(),
+			c.Logger(),
+		)
+	}
+	return c.services.goalService
+}
+
+func (c *Container) GoalHandler() *bankroll.GoalHandler {
+	if c.handlers.goalHandler == nil {
+		c.handlers.goalHandler = bankroll.NewGoalHandler(
+			c.GoalService(),
+			c.Logger(),
+		)
+	}
+	return c.handlers.goalHandler
+}
+
+func (c *Container) RakebackRepository() bankroll.RakebackRepository {
+	if c.repositories.rakebackRepository == nil {
+		c.repositories.rakebackRepository = bankroll.NewPostgresRakebackRepository(c.DB())
+	}
+	return c.repositories.rakebackRepository
+}
+
+func (c *Container) RakebackService() bankroll.RakebackService {
+	if c.services.rakebackService == nil {
+		c.services.rakebackService = bankroll.NewRakebackService(
+			c.RakebackRepository(),
+			c.BankrollRepository(),
+			c.Logger(),
+		)
+	}
+	return c.services.rakebackService
+}
+
+func (c *Container) RakebackScheduler() *bankroll.RakebackScheduler {
+	if c.services.rakebackScheduler == nil {
+		c.services.rakebackScheduler = bankroll.NewRakebackScheduler(
+			c.RakebackRepository(),
+			c.BankrollRepository(),
+			c.Config().Rakeback.PayoutInterval,
+			c.Logger(),
+		)
+	}
+	return c.services.rakebackScheduler
+}
+
+func (c *Container) RakebackHandler() *bankroll.RakebackHandler {
+	if c.handlers.rakebackHandler == nil {
+		c.handlers.rakebackHandler = bankroll.NewRakebackHandler(
+			c.RakebackService(),
+			c.Logger(),
+		)
+	}
+	return c.handlers.rakebackHandler
+}
+
+func (c *Container) BetRepository() bet.BetRepository {
+	if c.repositories.betRepository == nil {
+		c.repositories.betRepository = bet.NewPostgresBetRepository(c.DB())
+	}
+	return c.repositories.betRepository
+}
+
+func (c *Container) BetService() bet.BetService {
+	if c.services.betService == nil {
+		c.services.betService = bet.NewBetService(
+			c.BetRepository(),
+			c.BankrollRepository(),
+			c.StrategyRepository(),
+			c.RuleService(),
+			c.Logger(),
+		)
+	}
+	return c.services.betService
+}
+
+func (c *Container) BetHandler() *bet.BetHandler {
+	if c.handlers.betHandler == nil {
+		c.handlers.betHandler = bet.NewBetHandler(
+			c.BetService(),
+			c.Logger(),
+		)
+	}
+	return c.handlers.betHandler
+}
+
+func (c *Container) StrategyRepository() strategy.StrategyRepository {
+	if c.repositories.strategyRepository == nil {
+		c.repositories.strategyRepository = strategy.NewPostgresStrategyRepository(c.DB())
+	}
+	return c.repositories.strategyRepository
+}
+
+func (c *Container) StrategyService() strategy.StrategyService {
+	if c.services.strategyService == nil {
+		c.services.strategyService = strategy.NewStrategyService(
+			c.StrategyRepository(),
+			c.Logger(),
+		)
+	}
+	return c.services.strategyService
+}
+
+func (c *Container) StrategyHandler() *strategy.StrategyHandler {
+	if c.handlers.strategyHandler == nil {
+		c.handlers.strategyHandler = strategy.NewStrategyHandler(
+			c.StrategyService(),
+			c.Logger(),
+		)
+	}
+	return c.handlers.strategyHandler
+}
+
+func (c *Container) SessionRepository() session.SessionRepository {
+	if c.repositories.sessionRepository == nil {
+		c.repositories.sessionRepository = session.NewPostgresSessionRepository(c.DB())
+	}
+	return c.repositories.sessionRepository
+}
+
+func (c *Container) SessionService() session.SessionService {
+	if c.services.sessionService == nil {
+		c.services.sessionService = session.NewSessionService(
+			c.SessionRepository(),
+			c.BankrollRepository(),
+			c.StrategyRepository(),
+			c.RuleService(),
+			c.Logger(),
+		)
+	}
+	return c.services.sessionService
+}
+
+func (c *Container) SessionSweeper() *session.Sweeper {
+	if c.services.sessionSweeper == nil {
+		c.services.sessionSweeper = session.NewSweeper(
+			c.SessionRepository(),
+			c.Config().Sessions.StaleTimeout,
+			c.Config().Sessions.SweepInterval,
+			c.Logger(),
+		)
+	}
+	return c.services.sessionSweeper
+}
+
+func (c *Container) SessionHandler() *session.SessionHandler {
+	if c.handlers.sessionHandler == nil {
+		c.handlers.sessionHandler = session.NewSessionHandler(
+			c.SessionService(),
+			c.Logger(),
+		)
+	}
+	return c.handlers.sessionHandler
+}
+
+func (c *Container) DashboardRepository() dashboard.DashboardRepository {
+	if c.repositories.dashboardRepository == nil {
+		c.repositories.dashboardRepository = dashboard.NewPostgresDashboardRepository(c.DB())
+	}
+	return c.repositories.dashboardRepository
+}
+
+func (c *Container) DashboardService() dashboard.DashboardService {
+	if c.services.dashboardService == nil {
+		c.services.dashboardService = dashboard.NewDashboardService(
+			c.DashboardRepository(),
+			c.BankrollRepository(),
+			c.RateService(),
+			c.Logger(),
+		)
+	}
+	return c.services.dashboardService
+}
+
+func (c *Container) DashboardHandler() *dashboard.DashboardHandler {
+	if c.handlers.dashboardHandler == nil {
+		c.handlers.dashboardHandler = dashboard.NewDashboardHandler(
+			c.DashboardService(),
+			c.Logger(),
+		)
+	}
+	return c.handlers.dashboardHandler
+}
+
+func (c *Container) IdempotencyRepository() middleware.IdempotencyRepository {
+	if c.repositories.idempotencyRepository == nil {
+		c.repositories.idempotencyRepository = middleware.NewPostgresIdempotencyRepository(c.DB())
+	}
+	return c.repositories.idempotencyRepository
+}
+
+func (c *Container) IdempotencyJanitor() *middleware.IdempotencyJanitor {
+	if c.services.idempotencyJanitor == nil {
+		c.services.idempotencyJanitor = middleware.NewIdempotencyJanitor(
+			c.IdempotencyRepository(),
+			c.Config().Idempotency.PurgeInterval,
+			c.Logger(),
+		)
+	}
+	return c.services.idempotencyJanitor
+}
+
+func (c *Container) RateRepository() fx.RateRepository {
+	if c.repositories.rateRepository == nil {
+		c.repositories.rateRepository = fx.NewPostgresRateRepository(c.DB())
+	}
+	return c.repositories.rateRepository
+}
+
+func (c *Container) RateService() fx.RateService {
+	if c.services.rateService == nil {
+		c.services.rateService = fx.NewRateService(
+			c.RateRepository(),
+			c.Logger(),
+		)
+	}
+	return c.services.rateService
+}
+
+func (c *Container) RateHandler() *fx.RateHandler {
+	if c.handlers.rateHandler == nil {
+		c.handlers.rateHandler = fx.NewRateHandler(
+			c.RateService(),
+			c.Logger(),
+		)
+	}
+	return c.handlers.rateHandler
+}
+
+func (c *Container) RateProviders() []fx.RateProvider {
+	var providers []fx.RateProvider
+	if c.Config().FX.ProviderDir != "" {
+		providers = append(providers, fx.NewFileProvider(c.Config().FX.ProviderDir))
+	}
+	if c.Config().FX.ProviderURL != "" {
+		providers = append(providers, fx.NewHTTPProvider(c.Config().FX.ProviderURL, c.Config().FX.FetchTimeout))
+	}
+	return providers
+}
+
+func (c *Container) RateFetchStatus() *fx.FetchStatus {
+	if c.services.rateFetchStatus == nil {
+		c.services.rateFetchStatus = fx.NewFetchStatus()
+	}
+	return c.services.rateFetchStatus
+}
+
+func (c *Container) RateFetcher() *fx.Fetcher {
+	if c.services.rateFetcher == nil {
+		c.services.rateFetcher = fx.NewFetcher(
+			c.RateRepository(),
+			c.RateProviders(),
+			c.RateFetchStatus(),
+			c.Config().FX.FetchInterval,
+			c.Logger(),
+		)
+	}
+	return c.services.rateFetcher
+}
+
+func (c *Container) CurrencyRepository() currency.CurrencyRepository {
+	if c.repositories.currencyRepository == nil {
+		c.repositories.currencyRepository = currency.NewPostgresCurrencyRepository(c.DB())
+	}
+	return c.repositories.currencyRepository
+}
+
+func (c *Container) CurrencyService() currency.CurrencyService {
+	if c.services.currencyService == nil {
+		c.services.currencyService = currency.NewCurrencyService(
+			c.CurrencyRepository(),
+			c.Logger(),
+		)
+	}
+	return c.services.currencyService
+}
+
+func (c *Container) CurrencyRefresher() *currency.Refresher {
+	if c.services.currencyRefresher == nil {
+		c.services.currencyRefresher = currency.NewRefresher(
+			c.CurrencyService(),
+			c.Config().Currencies.RefreshInterval,
+			c.Logger(),
+		)
+	}
+	return c.services.currencyRefresher
+}
+
+func (c *Container) CurrencyHandler() *currency.CurrencyHandler {
+	if c.handlers.currencyHandler == nil {
+		c.handlers.currencyHandler = currency.NewCurrencyHandler(
+			c.CurrencyService(),
+			c.Logger(),
+		)
+	}
+	return c.handlers.currencyHandler
+}
@@ -0,0 +1,114 @@
+package domain
+
+import (
+	"sort"
+	"sync"
+)
+
+type Currency string
+
+// Well-known currency codes. The set of supported currencies is the registry
+// below, not this list.
+const (
+	CurrencyBRL Currency = "BRL"
+	CurrencyUSD Currency = "USD"
+	CurrencyEUR Currency = "EUR"
+	CurrencyBTC Currency = "BTC"
+)
+
+// MaxScale is the number of fractional digits stored for any amount.
+const MaxScale int32 = 8
+
+// CurrencyInfo describes a supported currency.
+type CurrencyInfo struct {
+	Code     Currency
+	Name     string
+	Decimals int32
+	Symbol   string
+	IsCrypto bool
+}
+
+// DefaultCurrencies seed the registry until it is loaded from the currencies
+// table, and match the rows created by its migration.
+var DefaultCurrencies = []CurrencyInfo{
+	{Code: CurrencyBRL, Name: "Brazilian Real", Decimals: 2, Symbol: "R$"},
+	{Code: CurrencyUSD, Name: "US Dollar", Decimals: 2, Symbol: "$"},
+	{Code: CurrencyEUR, Name: "Euro", Decimals: 2, Symbol: "€"},
+	{Code: CurrencyBTC, Name: "Bitcoin", Decimals: 8, Symbol: "₿", IsCrypto: true},
+}
+
+var registry = struct {
+	sync.RWMutex
+	currencies map[Currency]CurrencyInfo
+}{
+	currencies: currencyMap(DefaultCurrencies),
+}
+
+// RegisterCurrencies replaces the set of supported currencies.
+func RegisterCurrencies(currencies []CurrencyInfo) {
+	registered := currencyMap(currencies)
+
+	registry.Lock()
+	defer registry.Unlock()
+	registry.currencies = registered
+}
+
+// Currencies returns the supported currencies ordered by code.
+func Currencies() []CurrencyInfo {
+	registry.RLock()
+	defer registry.RUnlock()
+
+	currencies := make([]CurrencyInfo, 0, len(registry.currencies))
+	for _, info := range registry.currencies {
+		currencies = append(currencies, info)
+	}
+	sort.Slice(currencies, func(i, j int) bool {
+		return currencies[i].Code < currencies[j].Code
+	})
+	return currencies
+}
+
+// Info returns the registry entry of the currency.
+func (c Currency) Info() (CurrencyInfo, bool) {
+	registry.RLock()
+	defer registry.RUnlock()
+
+	info, ok := registry.currencies[c]
+	return info, ok
+}
+
+func (c Currency) IsValid() bool {
+	_, ok := c.Info()
+	return ok
+}
+
+// Decimals returns the number of minor-unit digits of the currency, falling
+// back to MaxScale for unknown codes.
+func (c Currency) Decimals() int32 {
+	if info, ok := c.Info(); ok {
+		return info.Decimals
+	}
+	return MaxScale
+}
+
+// Round rounds an amount to the currency's precision.
+func (c Currency) Round(amount Decimal) Decimal {
+	return amount.Round(c.Decimals())
+}
+
+// Fits reports whether an amount is representable in the currency without
+// rounding.
+func (c Currency) Fits(amount Decimal) bool {
+	return amount.FitsScale(c.Decimals())
+}
+
+func currencyMap(currencies []CurrencyInfo) map[Currency]CurrencyInfo {
+	registered := make(map[Currency]CurrencyInfo, len(currencies))
+	for _, info := range currencies {
+		if info.Decimals < 0 || info.Decimals > MaxScale {
+			info.Decimals = MaxScale
+		}
+		registered[info.Code] = info
+	}
+	return registered
+}
@@ -0,0 +1,50 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCurrency_Decimals(t *testing.T) {
+	assert.Equal(t, int32(2), CurrencyBRL.Decimals())
+	assert.Equal(t, int32(2), CurrencyUSD.Decimals())
+	assert.Equal(t, int32(8), CurrencyBTC.Decimals())
+	assert.Equal(t, MaxScale, Currency("XXX").Decimals())
+}
+
+func TestCurrency_Fits(t *testing.T) {
+	assert.True(t, CurrencyUSD.Fits(MustParseDecimal("10.25")))
+	assert.False(t, CurrencyUSD.Fits(MustParseDecimal("10.255")))
+	assert.True(t, CurrencyBTC.Fits(MustParseDecimal("0.00000001")))
+	assert.False(t, CurrencyBTC.Fits(MustParseDecimal("0.000000001")))
+}
+
+func TestCurrency_Round(t *testing.T) {
+	assert.Equal(t, "10.26", CurrencyEUR.Round(MustParseDecimal("10.255")).String())
+	assert.Equal(t, "1.00000000", CurrencyBTC.Round(NewDecimalFromInt(1)).String())
+}
+
+func TestCurrency_IsValid(t *testing.T) {
+	assert.True(t, CurrencyBRL.IsValid())
+	assert.False(t, Currency("GBP").IsValid())
+}
+
+func TestRegisterCurrencies(t *testing.T) {
+	defer RegisterCurrencies(DefaultCurrencies)
+
+	RegisterCurrencies(append(DefaultCurrencies,
+		CurrencyInfo{Code: "USDT", Name: "Tether", Decimals: 6, Symbol: "₮", IsCrypto: true},
+		CurrencyInfo{Code: "JPY", Name: "Japanese Yen", Decimals: 0, Symbol: "¥"},
+	))
+
+	assert.True(t, Currency("USDT").IsValid())
+	assert.Equal(t, int32(6), Currency("USDT").Decimals())
+	assert.Equal(t, "100", Currency("JPY").Round(MustParseDecimal("99.5")).String())
+	assert.Len(t, Currencies(), 6)
+	assert.Equal(t, CurrencyBRL, Currencies()[0].Code)
+
+	info, ok := Currency("USDT").Info()
+	assert.True(t, ok)
+	assert.True(t, info.IsCrypto)
+}
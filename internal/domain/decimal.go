@@ -0,0 +1,194 @@
+package domain
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+
+	"github.com/shopspring/decimal"
+)
+
+var ErrInvalidDecimal = errors.New("invalid decimal value")
+
+// Decimal is an exact base-10 number used for money, rates and percentages.
+// It serializes to JSON as a string so clients never round-trip through
+// binary floating point, and is stored in NUMERIC columns as text.
+type Decimal struct {
+	value decimal.Decimal
+}
+
+var Zero = Decimal{}
+
+func NewDecimal(value int64, exp int32) Decimal {
+	return Decimal{value: decimal.New(value, exp)}
+}
+
+func NewDecimalFromInt(value int64) Decimal {
+	return Decimal{value: decimal.NewFromInt(value)}
+}
+
+func NewDecimalFromFloat(value float64) Decimal {
+	return Decimal{value: decimal.NewFromFloat(value)}
+}
+
+func ParseDecimal(s string) (Decimal, error) {
+	d, err := decimal.NewFromString(s)
+	if err != nil {
+		return Zero, fmt.Errorf("%w: %q", ErrInvalidDecimal, s)
+	}
+	return Decimal{value: d}, nil
+}
+
+func MustParseDecimal(s string) Decimal {
+	d, err := ParseDecimal(s)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+func SumDecimals(values ...Decimal) Decimal {
+	total := Zero
+	for _, v := range values {
+		total = total.Add(v)
+	}
+	return total
+}
+
+func (d Decimal) Add(other Decimal) Decimal {
+	return Decimal{value: d.value.Add(other.value)}
+}
+
+func (d Decimal) Sub(other Decimal) Decimal {
+	return Decimal{value: d.value.Sub(other.value)}
+}
+
+func (d Decimal) Mul(other Decimal) Decimal {
+	return Decimal{value: d.value.Mul(other.value)}
+}
+
+// Div divides with decimal.DivisionPrecision digits; callers round the result
+// to the precision they need.
+func (d Decimal) Div(other Decimal) Decimal {
+	return Decimal{value: d.value.Div(other.value)}
+}
+
+func (d Decimal) Neg() Decimal {
+	return Decimal{value: d.value.Neg()}
+}
+
+func (d Decimal) Abs() Decimal {
+	return Decimal{value: d.value.Abs()}
+}
+
+func (d Decimal) Round(places int32) Decimal {
+	return Decimal{value: d.value.Round(places)}
+}
+
+func (d Decimal) Cmp(other Decimal) int {
+	return d.value.Cmp(other.value)
+}
+
+func (d Decimal) Equal(other Decimal) bool {
+	return d.value.Equal(other.value)
+}
+
+func (d Decimal) GreaterThan(other Decimal) bool {
+	return d.value.GreaterThan(other.value)
+}
+
+func (d Decimal) GreaterThanOrEqual(other Decimal) bool {
+	return d.value.GreaterThanOrEqual(other.value)
+}
+
+func (d Decimal) LessThan(other Decimal) bool {
+	return d.value.LessThan(other.value)
+}
+
+func (d Decimal) LessThanOrEqual(other Decimal) bool {
+	return d.value.LessThanOrEqual(other.value)
+}
+
+func (d Decimal) Sign() int {
+	return d.value.Sign()
+}
+
+func (d Decimal) IsZero() bool {
+	return d.value.IsZero()
+}
+
+func (d Decimal) IsNegative() bool {
+	return d.value.IsNegative()
+}
+
+func (d Decimal) IsPositive() bool {
+	return d.value.IsPositive()
+}
+
+// FitsScale reports whether d has no significant digits beyond places.
+func (d Decimal) FitsScale(places int32) bool {
+	return d.value.Equal(d.value.Truncate(places))
+}
+
+func (d Decimal) Float64() float64 {
+	f, _ := d.value.Float64()
+	return f
+}
+
+func (d Decimal) String() string {
+	if exp := d.value.Exponent(); exp < 0 {
+		return d.value.StringFixed(-exp)
+	}
+	return d.value.String()
+}
+
+func (d Decimal) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + d.String() + `"`), nil
+}
+
+// UnmarshalJSON accepts both quoted strings and bare JSON numbers.
+func (d *Decimal) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+	parsed, err := ParseDecimal(string(bytes.Trim(data, `"`)))
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
+
+func (d *Decimal) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case nil:
+		*d = Zero
+		return nil
+	case float64:
+		*d = NewDecimalFromFloat(v)
+		return nil
+	case int64:
+		*d = NewDecimalFromInt(v)
+		return nil
+	case []byte:
+		return d.scanString(string(v))
+	case string:
+		return d.scanString(v)
+	default:
+		return fmt.Errorf("%w: cannot scan %T", ErrInvalidDecimal, value)
+	}
+}
+
+func (d *Decimal) scanString(s string) error {
+	parsed, err := ParseDecimal(s)
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
+
+func (d Decimal) Value() (driver.Value, error) {
+	return d.String(), nil
+}
@@ -0,0 +1,115 @@
+package domain
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDecimal(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		d, err := ParseDecimal("1234.5678")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "1234.5678", d.String())
+	})
+
+	t.Run("error - invalid input", func(t *testing.T) {
+		_, err := ParseDecimal("12,34")
+
+		assert.ErrorIs(t, err, ErrInvalidDecimal)
+	})
+}
+
+func TestDecimal_Arithmetic(t *testing.T) {
+	t.Run("no floating point drift", func(t *testing.T) {
+		total := Zero
+		for i := 0; i < 10; i++ {
+			total = total.Add(MustParseDecimal("0.1"))
+		}
+
+		assert.True(t, total.Equal(NewDecimalFromInt(1)))
+	})
+
+	t.Run("sum and negation", func(t *testing.T) {
+		total := SumDecimals(MustParseDecimal("10.50"), MustParseDecimal("-0.25"), MustParseDecimal("0.00000001"))
+
+		assert.Equal(t, "10.25000001", total.String())
+		assert.True(t, total.Neg().IsNegative())
+	})
+
+	t.Run("rounding keeps fixed scale", func(t *testing.T) {
+		assert.Equal(t, "1000.00", NewDecimalFromInt(1000).Round(2).String())
+		assert.Equal(t, "2.35", MustParseDecimal("2.345").Round(2).String())
+	})
+
+	t.Run("fits scale", func(t *testing.T) {
+		assert.True(t, MustParseDecimal("10.10").FitsScale(2))
+		assert.True(t, MustParseDecimal("10.1000").FitsScale(2))
+		assert.False(t, MustParseDecimal("10.101").FitsScale(2))
+	})
+}
+
+func TestDecimal_JSON(t *testing.T) {
+	type payload struct {
+		Amount Decimal `json:"amount"`
+	}
+
+	t.Run("marshals as string", func(t *testing.T) {
+		data, err := json.Marshal(payload{Amount: MustParseDecimal("0.10")})
+
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"amount":"0.10"}`, string(data))
+	})
+
+	t.Run("unmarshals string and number", func(t *testing.T) {
+		var fromString, fromNumber payload
+
+		require.NoError(t, json.Unmarshal([]byte(`{"amount":"12.34"}`), &fromString))
+		require.NoError(t, json.Unmarshal([]byte(`{"amount":12.34}`), &fromNumber))
+
+		assert.True(t, fromString.Amount.Equal(fromNumber.Amount))
+		assert.Equal(t, "12.34", fromNumber.Amount.String())
+	})
+
+	t.Run("rejects invalid string", func(t *testing.T) {
+		var p payload
+
+		err := json.Unmarshal([]byte(`{"amount":"abc"}`), &p)
+
+		assert.ErrorIs(t, err, ErrInvalidDecimal)
+	})
+}
+
+func TestDecimal_ScanValue(t *testing.T) {
+	t.Run("scans driver types", func(t *testing.T) {
+		var d Decimal
+
+		require.NoError(t, d.Scan([]byte("19.9900")))
+		assert.Equal(t, "19.9900", d.String())
+
+		require.NoError(t, d.Scan("0.00000001"))
+		assert.Equal(t, "0.00000001", d.String())
+
+		require.NoError(t, d.Scan(int64(42)))
+		assert.Equal(t, "42", d.String())
+
+		require.NoError(t, d.Scan(nil))
+		assert.True(t, d.IsZero())
+	})
+
+	t.Run("rejects unsupported type", func(t *testing.T) {
+		var d Decimal
+
+		assert.ErrorIs(t, d.Scan(true), ErrInvalidDecimal)
+	})
+
+	t.Run("value is a string", func(t *testing.T) {
+		v, err := MustParseDecimal("1.50").Value()
+
+		assert.NoError(t, err)
+		assert.Equal(t, "1.50", v)
+	})
+}
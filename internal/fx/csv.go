@@ -0,0 +1,113 @@
+package fx
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
+)
+
+var csvColumns = []string{"date", "base", "quote", "rate"}
+
+// ParseRatesCSV reads rates from a CSV document with a header row naming the
+// date, base, quote and rate columns in any order. An optional source column
+// overrides source for individual rows.
+func ParseRatesCSV(r io.Reader, source string) ([]*Rate, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+	reader.Comment = '#'
+
+	header, err := reader.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, WrapError(ErrInvalidRatesFile, "file is empty")
+		}
+		return nil, WrapError(ErrInvalidRatesFile, err.Error())
+	}
+
+	index := make(map[string]int, len(header))
+	for i, name := range header {
+		index[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, column := range csvColumns {
+		if _, ok := index[column]; !ok {
+			return nil, WrapError(ErrInvalidRatesFile, fmt.Sprintf("missing %s column", column))
+		}
+	}
+	sourceColumn, hasSource := index["source"]
+
+	var rates []*Rate
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, WrapError(ErrInvalidRatesFile, err.Error())
+		}
+		line, _ := reader.FieldPos(0)
+
+		rowSource := source
+		if hasSource && strings.TrimSpace(record[sourceColumn]) != "" {
+			rowSource = strings.TrimSpace(record[sourceColumn])
+		}
+
+		rate, err := newRate(
+			strings.TrimSpace(record[index["date"]]),
+			domain.Currency(strings.ToUpper(strings.TrimSpace(record[index["base"]]))),
+			domain.Currency(strings.ToUpper(strings.TrimSpace(record[index["quote"]]))),
+			strings.TrimSpace(record[index["rate"]]),
+			rowSource,
+		)
+		if err != nil {
+			return nil, WrapError(ErrInvalidRatesFile, fmt.Sprintf("line %d: %s", line, err.Error()))
+		}
+		rates = append(rates, rate)
+	}
+
+	if len(rates) == 0 {
+		return nil, WrapError(ErrInvalidRatesFile, "file has no rates")
+	}
+	return rates, nil
+}
+
+func newRate(date string, base domain.Currency, quote domain.Currency, value string, source string) (*Rate, error) {
+	rateDate, err := time.Parse(DateLayout, date)
+	if err != nil {
+		return nil, WrapError(ErrValidationFailed, "invalid date format")
+	}
+	rate, err := domain.ParseDecimal(value)
+	if err != nil {
+		return nil, ErrInvalidRate
+	}
+	return buildRate(rateDate, base, quote, rate, source)
+}
+
+func buildRate(date time.Time, base domain.Currency, quote domain.Currency, rate domain.Decimal, source string) (*Rate, error) {
+	if !base.IsValid() || !quote.IsValid() {
+		return nil, ErrInvalidCurrency
+	}
+	if base == quote {
+		return nil, ErrSameCurrency
+	}
+	if !rate.IsPositive() || !rate.FitsScale(domain.MaxScale) {
+		return nil, ErrInvalidRate
+	}
+	if source == "" {
+		source = SourceManual
+	}
+	if len(source) > 50 {
+		return nil, WrapError(ErrValidationFailed, "source is too long")
+	}
+	return &Rate{
+		BaseCurrency:  base,
+		QuoteCurrency: quote,
+		Rate:          rate,
+		RateDate:      truncateDate(date),
+		Source:        source,
+	}, nil
+}
@@ -0,0 +1,59 @@
+package fx
+
+import (
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
+	"github.com/opinedajr/micro-stakes-api/internal/shared/pagination"
+)
+
+type UpsertRateInput struct {
+	Date   string          `json:"date" binding:"required"`
+	Base   domain.Currency `json:"base" binding:"required"`
+	Quote  domain.Currency `json:"quote" binding:"required"`
+	Rate   domain.Decimal  `json:"rate" binding:"decimal_required"`
+	Source string          `json:"source" binding:"max=50"`
+}
+
+type ListRatesInput struct {
+	pagination.Params
+	Base  domain.Currency `form:"base"`
+	Quote domain.Currency `form:"quote"`
+	From  string          `form:"from"`
+	To    string          `form:"to"`
+}
+
+type ConvertInput struct {
+	Amount string          `form:"amount" binding:"required"`
+	From   domain.Currency `form:"from" binding:"required"`
+	To     domain.Currency `form:"to" binding:"required"`
+	At     string          `form:"at"`
+}
+
+type RateOutput struct {
+	Date   string          `json:"date"`
+	Base   domain.Currency `json:"base"`
+	Quote  domain.Currency `json:"quote"`
+	Rate   domain.Decimal  `json:"rate"`
+	Source string          `json:"source"`
+}
+
+type RatePageOutput = pagination.Page[*RateOutput]
+
+type ConvertOutput struct {
+	Amount    domain.Decimal  `json:"amount"`
+	From      domain.Currency `json:"from"`
+	To        domain.Currency `json:"to"`
+	Rate      domain.Decimal  `json:"rate"`
+	Converted domain.Decimal  `json:"converted"`
+	At        string          `json:"at"`
+}
+
+type ImportOutput struct {
+	Imported int    `json:"imported"`
+	Source   string `json:"source"`
+}
+
+type ErrorOutput struct {
+	Error   string              `json:"error"`
+	Code    string              `json:"code"`
+	Details map[string][]string `json:"details,omitempty"`
+}
@@ -0,0 +1,20 @@
+package fx
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrRateNotFound     = errors.New("exchange rate not found")
+	ErrInvalidRate      = errors.New("invalid exchange rate")
+	ErrInvalidCurrency  = errors.New("invalid currency")
+	ErrSameCurrency     = errors.New("base and quote currency must differ")
+	ErrInvalidRatesFile = errors.New("invalid rates file")
+	ErrValidationFailed = errors.New("validation failed")
+	ErrDatabaseError    = errors.New("database error")
+)
+
+func WrapError(err error, message string) error {
+	return fmt.Errorf("%s: %w", message, err)
+}
@@ -0,0 +1,179 @@
+package fx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/opinedajr/micro-stakes-api/internal/healthcheck"
+)
+
+const HealthServiceName = "fx-rates"
+
+// Fetcher periodically pulls rates from every provider and stores them. A
+// failing provider writes nothing, so the last good rates stay in use, and the
+// failure is recorded in the fetch status reported by the health check.
+type Fetcher struct {
+	repo      RateRepository
+	providers []RateProvider
+	status    *FetchStatus
+	interval  time.Duration
+	logger    *slog.Logger
+}
+
+func NewFetcher(repo RateRepository, providers []RateProvider, status *FetchStatus, interval time.Duration, logger *slog.Logger) *Fetcher {
+	return &Fetcher{
+		repo:      repo,
+		providers: providers,
+		status:    status,
+		interval:  interval,
+		logger:    logger,
+	}
+}
+
+func (f *Fetcher) Run(ctx context.Context) {
+	if len(f.providers) == 0 {
+		return
+	}
+
+	f.Fetch(ctx, time.Now().UTC())
+
+	ticker := time.NewTicker(f.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			f.Fetch(ctx, time.Now().UTC())
+		}
+	}
+}
+
+// Fetch runs every provider once and returns how many rates were stored.
+func (f *Fetcher) Fetch(ctx context.Context, now time.Time) int {
+	stored := 0
+	for _, provider := range f.providers {
+		rates, err := provider.FetchRates(ctx)
+		if err == nil && len(rates) == 0 {
+			err = errors.New("provider returned no rates")
+		}
+		if err == nil {
+			err = f.repo.Upsert(ctx, rates)
+		}
+		if err != nil {
+			f.status.RecordFailure(provider.Name(), err, now)
+			f.logger.Error("failed to fetch exchange rates", "error", err, "provider", provider.Name())
+			continue
+		}
+
+		f.status.RecordSuccess(provider.Name(), len(rates), now)
+		f.logger.Info("exchange rates fetched", "provider", provider.Name(), "count", len(rates))
+		stored += len(rates)
+	}
+	return stored
+}
+
+type ProviderStatus struct {
+	Provider      string
+	LastAttemptAt time.Time
+	LastSuccessAt time.Time
+	LastError     string
+	Failures      int
+	RatesStored   int
+}
+
+// FetchStatus keeps the outcome of the latest fetch per provider. It is safe
+// for concurrent use by the fetcher and the health check.
+type FetchStatus struct {
+	mu        sync.RWMutex
+	providers map[string]*ProviderStatus
+}
+
+func NewFetchStatus() *FetchStatus {
+	return &FetchStatus{
+		providers: make(map[string]*ProviderStatus),
+	}
+}
+
+func (s *FetchStatus) RecordSuccess(provider string, count int, at time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	status := s.provider(provider)
+	status.LastAttemptAt = at
+	status.LastSuccessAt = at
+	status.LastError = ""
+	status.Failures = 0
+	status.RatesStored = count
+}
+
+func (s *FetchStatus) RecordFailure(provider string, err error, at time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	status := s.provider(provider)
+	status.LastAttemptAt = at
+	status.LastError = err.Error()
+	status.Failures++
+}
+
+// Providers returns a snapshot of every provider's status ordered by name.
+func (s *FetchStatus) Providers() []ProviderStatus {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	statuses := make([]ProviderStatus, 0, len(s.providers))
+	for _, status := range s.providers {
+		statuses = append(statuses, *status)
+	}
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].Provider < statuses[j].Provider
+	})
+	return statuses
+}
+
+// Health reports the fetch status as degraded while any provider's latest
+// attempt failed.
+func (s *FetchStatus) Health() healthcheck.Health {
+	var failures []string
+	for _, status := range s.Providers() {
+		if status.Failures == 0 {
+			continue
+		}
+		lastSuccess := "never"
+		if !status.LastSuccessAt.IsZero() {
+			lastSuccess = status.LastSuccessAt.Format(time.RFC3339)
+		}
+		failures = append(failures, fmt.Sprintf("%s: %s (%d consecutive failures, last success %s)",
+			status.Provider, status.LastError, status.Failures, lastSuccess))
+	}
+
+	if len(failures) > 0 {
+		return healthcheck.Health{
+			ServiceName: HealthServiceName,
+			Status:      "degraded",
+			Message:     strings.Join(failures, "; "),
+		}
+	}
+	return healthcheck.Health{
+		ServiceName: HealthServiceName,
+		Status:      "healthy",
+		Message:     "Exchange rates are up to date",
+	}
+}
+
+func (s *FetchStatus) provider(name string) *ProviderStatus {
+	status, ok := s.providers[name]
+	if !ok {
+		status = &ProviderStatus{Provider: name}
+		s.providers[name] = status
+	}
+	return status
+}
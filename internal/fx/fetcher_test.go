@@ -0,0 +1,90 @@
+package fx
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type stubProvider struct {
+	name  string
+	rates []*Rate
+	err   error
+}
+
+func (p *stubProvider) Name() string {
+	return p.name
+}
+
+func (p *stubProvider) FetchRates(ctx context.Context) ([]*Rate, error) {
+	return p.rates, p.err
+}
+
+func TestFetcher_Fetch(t *testing.T) {
+	now := time.Date(2026, 3, 6, 16, 0, 0, 0, time.UTC)
+	rates := []*Rate{testRate(domain.CurrencyEUR, domain.CurrencyUSD, "2026-03-06", "1.08")}
+
+	t.Run("stores rates from every provider", func(t *testing.T) {
+		mockRepo := new(MockRateRepository)
+		status := NewFetchStatus()
+		fetcher := NewFetcher(mockRepo, []RateProvider{
+			&stubProvider{name: SourceFile, rates: rates},
+			&stubProvider{name: SourceHTTP, rates: rates},
+		}, status, time.Hour, slog.Default())
+
+		mockRepo.On("Upsert", mock.Anything, rates).Return(nil).Twice()
+
+		stored := fetcher.Fetch(context.Background(), now)
+
+		assert.Equal(t, 2, stored)
+		assert.Equal(t, "healthy", status.Health().Status)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("failing provider keeps the last good rates and degrades health", func(t *testing.T) {
+		mockRepo := new(MockRateRepository)
+		status := NewFetchStatus()
+		provider := &stubProvider{name: SourceHTTP, rates: rates}
+		fetcher := NewFetcher(mockRepo, []RateProvider{provider}, status, time.Hour, slog.Default())
+
+		mockRepo.On("Upsert", mock.Anything, rates).Return(nil).Once()
+		fetcher.Fetch(context.Background(), now)
+
+		provider.rates, provider.err = nil, errors.New("connection refused")
+		stored := fetcher.Fetch(context.Background(), now.Add(time.Hour))
+		fetcher.Fetch(context.Background(), now.Add(2*time.Hour))
+
+		assert.Equal(t, 0, stored)
+		mockRepo.AssertNumberOfCalls(t, "Upsert", 1)
+
+		health := status.Health()
+		assert.Equal(t, "degraded", health.Status)
+		assert.Contains(t, health.Message, "connection refused")
+		assert.Contains(t, health.Message, "2 consecutive failures")
+		assert.Contains(t, health.Message, "2026-03-06T16:00:00Z")
+
+		provider.rates, provider.err = rates, nil
+		mockRepo.On("Upsert", mock.Anything, rates).Return(nil).Once()
+		fetcher.Fetch(context.Background(), now.Add(3*time.Hour))
+
+		assert.Equal(t, "healthy", status.Health().Status)
+	})
+
+	t.Run("empty result counts as a failure", func(t *testing.T) {
+		mockRepo := new(MockRateRepository)
+		status := NewFetchStatus()
+		fetcher := NewFetcher(mockRepo, []RateProvider{&stubProvider{name: SourceFile}}, status, time.Hour, slog.Default())
+
+		fetcher.Fetch(context.Background(), now)
+
+		mockRepo.AssertNotCalled(t, "Upsert", mock.Anything, mock.Anything)
+		assert.Equal(t, "degraded", status.Health().Status)
+		assert.Contains(t, status.Health().Message, "last success never")
+	})
+}
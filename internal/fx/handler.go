@@ -0,0 +1,177 @@
+package fx
+
+import (
+	"errors"
+	"io"
+	"log/slog"
+	"mime"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// maxImportSize bounds the rates file accepted by ImportRates.
+const maxImportSize = 10 << 20
+
+type RateHandler struct {
+	service RateService
+	logger  *slog.Logger
+}
+
+func NewRateHandler(service RateService, logger *slog.Logger) *RateHandler {
+	return &RateHandler{
+		service: service,
+		logger:  logger,
+	}
+}
+
+func (h *RateHandler) UpsertRate(c *gin.Context) {
+	var input UpsertRateInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		h.logger.Error("invalid request body", "error", err)
+		c.JSON(http.StatusBadRequest, ErrorOutput{
+			Error:   "Invalid request body",
+			Code:    "VALIDATION_ERROR",
+			Details: nil,
+		})
+		return
+	}
+
+	output, err := h.service.UpsertRate(c.Request.Context(), input)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
+
+// ImportRates accepts a CSV document either as the raw request body
+// (text/csv) or as the "file" field of a multipart form.
+func (h *RateHandler) ImportRates(c *gin.Context) {
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxImportSize)
+
+	var body io.Reader
+	mediaType, _, _ := mime.ParseMediaType(c.ContentType())
+	switch mediaType {
+	case "text/csv":
+		body = c.Request.Body
+	case "multipart/form-data":
+		file, err := c.FormFile("file")
+		if err != nil {
+			h.logger.Error("missing rates file", "error", err)
+			h.handleError(c, WrapError(ErrValidationFailed, "file field is required"))
+			return
+		}
+		opened, err := file.Open()
+		if err != nil {
+			h.handleError(c, WrapError(ErrValidationFailed, err.Error()))
+			return
+		}
+		defer opened.Close()
+		body = opened
+	default:
+		c.JSON(http.StatusUnsupportedMediaType, ErrorOutput{
+			Error: "Content-Type must be text/csv or multipart/form-data",
+			Code:  "UNSUPPORTED_MEDIA_TYPE",
+		})
+		return
+	}
+
+	output, err := h.service.ImportRates(c.Request.Context(), body, c.Query("source"))
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
+
+func (h *RateHandler) ListRates(c *gin.Context) {
+	var input ListRatesInput
+	if err := c.ShouldBindQuery(&input); err != nil {
+		h.logger.Error("invalid query parameters", "error", err)
+		c.JSON(http.StatusBadRequest, ErrorOutput{
+			Error:   "Invalid query parameters",
+			Code:    "VALIDATION_ERROR",
+			Details: nil,
+		})
+		return
+	}
+
+	output, err := h.service.ListRates(c.Request.Context(), input)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
+
+func (h *RateHandler) Convert(c *gin.Context) {
+	var input ConvertInput
+	if err := c.ShouldBindQuery(&input); err != nil {
+		h.logger.Error("invalid query parameters", "error", err)
+		c.JSON(http.StatusBadRequest, ErrorOutput{
+			Error:   "Invalid query parameters",
+			Code:    "VALIDATION_ERROR",
+			Details: nil,
+		})
+		return
+	}
+
+	output, err := h.service.ConvertAmount(c.Request.Context(), input)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
+
+func (h *RateHandler) handleError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, ErrRateNotFound):
+		c.JSON(http.StatusNotFound, ErrorOutput{
+			Error: "Exchange rate not found",
+			Code:  "EXCHANGE_RATE_NOT_FOUND",
+		})
+	case errors.Is(err, ErrInvalidRatesFile):
+		c.JSON(http.StatusBadRequest, ErrorOutput{
+			Error: err.Error(),
+			Code:  "INVALID_RATES_FILE",
+		})
+	case errors.Is(err, ErrInvalidRate):
+		c.JSON(http.StatusBadRequest, ErrorOutput{
+			Error: "Exchange rate must be a positive number with at most 8 decimal places",
+			Code:  "INVALID_EXCHANGE_RATE",
+		})
+	case errors.Is(err, ErrInvalidCurrency):
+		c.JSON(http.StatusBadRequest, ErrorOutput{
+			Error: "Invalid currency",
+			Code:  "INVALID_CURRENCY",
+		})
+	case errors.Is(err, ErrSameCurrency):
+		c.JSON(http.StatusBadRequest, ErrorOutput{
+			Error: "Base and quote currency must differ",
+			Code:  "SAME_CURRENCY",
+		})
+	case errors.Is(err, ErrValidationFailed):
+		c.JSON(http.StatusBadRequest, ErrorOutput{
+			Error: err.Error(),
+			Code:  "VALIDATION_ERROR",
+		})
+	case errors.Is(err, ErrDatabaseError):
+		h.logger.Error("database error", "error", err)
+		c.JSON(http.StatusInternalServerError, ErrorOutput{
+			Error: "Database error occurred",
+			Code:  "DATABASE_ERROR",
+		})
+	default:
+		h.logger.Error("unexpected error", "error", err)
+		c.JSON(http.StatusInternalServerError, ErrorOutput{
+			Error: "An unexpected error occurred",
+			Code:  "INTERNAL_ERROR",
+		})
+	}
+}
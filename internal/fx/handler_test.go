@@ -0,0 +1,239 @@
+package fx
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"log/slog"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
+	customValidator "github.com/opinedajr/micro-stakes-api/internal/shared/validator"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMain(m *testing.M) {
+	if err := customValidator.RegisterBindingValidators(); err != nil {
+		panic(err)
+	}
+	os.Exit(m.Run())
+}
+
+type MockRateService struct {
+	mock.Mock
+}
+
+func (m *MockRateService) Rate(ctx context.Context, from domain.Currency, to domain.Currency, at time.Time) (domain.Decimal, error) {
+	args := m.Called(ctx, from, to, at)
+	return args.Get(0).(domain.Decimal), args.Error(1)
+}
+
+func (m *MockRateService) Convert(ctx context.Context, amount domain.Decimal, from domain.Currency, to domain.Currency, at time.Time) (domain.Decimal, error) {
+	args := m.Called(ctx, amount, from, to, at)
+	return args.Get(0).(domain.Decimal), args.Error(1)
+}
+
+func (m *MockRateService) UpsertRate(ctx context.Context, input UpsertRateInput) (*RateOutput, error) {
+	args := m.Called(ctx, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*RateOutput), args.Error(1)
+}
+
+func (m *MockRateService) ImportRates(ctx context.Context, r io.Reader, source string) (*ImportOutput, error) {
+	data, _ := io.ReadAll(r)
+	args := m.Called(ctx, string(data), source)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*ImportOutput), args.Error(1)
+}
+
+func (m *MockRateService) ListRates(ctx context.Context, input ListRatesInput) (*RatePageOutput, error) {
+	args := m.Called(ctx, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*RatePageOutput), args.Error(1)
+}
+
+func (m *MockRateService) ConvertAmount(ctx context.Context, input ConvertInput) (*ConvertOutput, error) {
+	args := m.Called(ctx, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*ConvertOutput), args.Error(1)
+}
+
+func setupTestRouter(service RateService) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	handler := NewRateHandler(service, slog.Default())
+
+	router := gin.New()
+	router.PUT("/fx/rates", handler.UpsertRate)
+	router.POST("/fx/rates/import", handler.ImportRates)
+	router.GET("/fx/rates", handler.ListRates)
+	router.GET("/fx/convert", handler.Convert)
+	return router
+}
+
+func TestUpsertRateHandler(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		mockService := new(MockRateService)
+		router := setupTestRouter(mockService)
+
+		mockService.On("UpsertRate", mock.Anything, mock.MatchedBy(func(input UpsertRateInput) bool {
+			return input.Base == domain.CurrencyUSD && input.Rate.String() == "5.1"
+		})).Return(&RateOutput{Date: "2026-03-01", Base: domain.CurrencyUSD, Quote: domain.CurrencyBRL, Rate: domain.MustParseDecimal("5.1"), Source: SourceManual}, nil).Once()
+
+		body := `{"date":"2026-03-01","base":"USD","quote":"BRL","rate":"5.1"}`
+		req := httptest.NewRequest(http.MethodPut, "/fx/rates", bytes.NewBufferString(body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"rate":"5.1"`)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("invalid rate", func(t *testing.T) {
+		mockService := new(MockRateService)
+		router := setupTestRouter(mockService)
+
+		mockService.On("UpsertRate", mock.Anything, mock.Anything).Return(nil, ErrInvalidRate).Once()
+
+		body := `{"date":"2026-03-01","base":"USD","quote":"BRL","rate":"-5"}`
+		req := httptest.NewRequest(http.MethodPut, "/fx/rates", bytes.NewBufferString(body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), "INVALID_EXCHANGE_RATE")
+	})
+}
+
+func TestImportRatesHandler(t *testing.T) {
+	csv := "date,base,quote,rate\n2026-03-06,USD,BRL,5.12\n"
+
+	t.Run("raw csv body", func(t *testing.T) {
+		mockService := new(MockRateService)
+		router := setupTestRouter(mockService)
+
+		mockService.On("ImportRates", mock.Anything, csv, "ecb").Return(&ImportOutput{Imported: 1, Source: "ecb"}, nil).Once()
+
+		req := httptest.NewRequest(http.MethodPost, "/fx/rates/import?source=ecb", bytes.NewBufferString(csv))
+		req.Header.Set("Content-Type", "text/csv; charset=utf-8")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"imported":1`)
+	})
+
+	t.Run("multipart upload", func(t *testing.T) {
+		mockService := new(MockRateService)
+		router := setupTestRouter(mockService)
+
+		mockService.On("ImportRates", mock.Anything, csv, "").Return(&ImportOutput{Imported: 1, Source: SourceImport}, nil).Once()
+
+		var body bytes.Buffer
+		writer := multipart.NewWriter(&body)
+		part, err := writer.CreateFormFile("file", "rates.csv")
+		require.NoError(t, err)
+		_, err = part.Write([]byte(csv))
+		require.NoError(t, err)
+		require.NoError(t, writer.Close())
+
+		req := httptest.NewRequest(http.MethodPost, "/fx/rates/import", &body)
+		req.Header.Set("Content-Type", writer.FormDataContentType())
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("invalid file", func(t *testing.T) {
+		mockService := new(MockRateService)
+		router := setupTestRouter(mockService)
+
+		mockService.On("ImportRates", mock.Anything, mock.Anything, mock.Anything).
+			Return(nil, WrapError(ErrInvalidRatesFile, "line 2: invalid exchange rate")).Once()
+
+		req := httptest.NewRequest(http.MethodPost, "/fx/rates/import", bytes.NewBufferString("date,base,quote,rate\n2026-03-06,USD,BRL,x\n"))
+		req.Header.Set("Content-Type", "text/csv")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), "INVALID_RATES_FILE")
+		assert.Contains(t, w.Body.String(), "line 2")
+	})
+
+	t.Run("unsupported media type", func(t *testing.T) {
+		mockService := new(MockRateService)
+		router := setupTestRouter(mockService)
+
+		req := httptest.NewRequest(http.MethodPost, "/fx/rates/import", bytes.NewBufferString(`{}`))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusUnsupportedMediaType, w.Code)
+		mockService.AssertNotCalled(t, "ImportRates")
+	})
+}
+
+func TestConvertHandler(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		mockService := new(MockRateService)
+		router := setupTestRouter(mockService)
+
+		mockService.On("ConvertAmount", mock.Anything, ConvertInput{Amount: "100", From: domain.CurrencyUSD, To: domain.CurrencyBRL, At: "2026-03-01"}).
+			Return(&ConvertOutput{Amount: domain.MustParseDecimal("100"), Converted: domain.MustParseDecimal("510.00")}, nil).Once()
+
+		req := httptest.NewRequest(http.MethodGet, "/fx/convert?amount=100&from=USD&to=BRL&at=2026-03-01", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"converted":"510.00"`)
+	})
+
+	t.Run("missing amount", func(t *testing.T) {
+		mockService := new(MockRateService)
+		router := setupTestRouter(mockService)
+
+		req := httptest.NewRequest(http.MethodGet, "/fx/convert?from=USD&to=BRL", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		mockService.AssertNotCalled(t, "ConvertAmount")
+	})
+
+	t.Run("rate not found", func(t *testing.T) {
+		mockService := new(MockRateService)
+		router := setupTestRouter(mockService)
+
+		mockService.On("ConvertAmount", mock.Anything, mock.Anything).Return(nil, ErrRateNotFound).Once()
+
+		req := httptest.NewRequest(http.MethodGet, "/fx/convert?amount=1&from=BTC&to=EUR", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Contains(t, w.Body.String(), "EXCHANGE_RATE_NOT_FOUND")
+	})
+}
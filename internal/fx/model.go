@@ -0,0 +1,50 @@
+package fx
+
+import (
+	"time"
+
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
+)
+
+const DateLayout = "2006-01-02"
+
+const (
+	SourceManual = "manual"
+	SourceImport = "import"
+)
+
+// Rate is the price of one unit of BaseCurrency in QuoteCurrency on RateDate.
+type Rate struct {
+	ID            uint            `gorm:"primaryKey;autoIncrement"`
+	BaseCurrency  domain.Currency `gorm:"type:varchar(10);not null;uniqueIndex:uq_exchange_rate_per_day"`
+	QuoteCurrency domain.Currency `gorm:"type:varchar(10);not null;uniqueIndex:uq_exchange_rate_per_day"`
+	Rate          domain.Decimal  `gorm:"type:decimal(19,8);not null"`
+	RateDate      time.Time       `gorm:"type:date;not null;uniqueIndex:uq_exchange_rate_per_day"`
+	Source        string          `gorm:"type:varchar(50);not null;default:manual"`
+	CreatedAt     time.Time       `gorm:"autoCreateTime"`
+	UpdatedAt     time.Time       `gorm:"autoUpdateTime"`
+}
+
+func (Rate) TableName() string {
+	return "exchange_rates"
+}
+
+type RateListFilter struct {
+	Base   domain.Currency
+	Quote  domain.Currency
+	From   *time.Time
+	To     *time.Time
+	After  *RateCursor
+	Limit  int
+	Offset int
+}
+
+type RateCursor struct {
+	RateDate time.Time
+	ID       uint
+}
+
+// truncateDate drops the time of day, keeping the calendar date of t in UTC.
+func truncateDate(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
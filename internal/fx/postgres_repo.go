@@ -0,0 +1,96 @@
+package fx
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
+	"github.com/opinedajr/micro-stakes-api/internal/shared/pagination"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type postgresRateRepository struct {
+	db *gorm.DB
+}
+
+func NewPostgresRateRepository(db *gorm.DB) RateRepository {
+	return &postgresRateRepository{
+		db: db,
+	}
+}
+
+// Upsert stores the rates in one transaction, replacing the rate and source of
+// any pair already quoted on the same date.
+func (r *postgresRateRepository) Upsert(ctx context.Context, rates []*Rate) error {
+	if len(rates) == 0 {
+		return nil
+	}
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "base_currency"}, {Name: "quote_currency"}, {Name: "rate_date"}},
+			DoUpdates: clause.AssignmentColumns([]string{"rate", "source", "updated_at"}),
+		}).CreateInBatches(rates, 500).Error
+	})
+	if err != nil {
+		return WrapError(ErrDatabaseError, err.Error())
+	}
+	return nil
+}
+
+// FindClosest returns the most recent rate for the pair dated on or before on.
+func (r *postgresRateRepository) FindClosest(ctx context.Context, base domain.Currency, quote domain.Currency, on time.Time) (*Rate, error) {
+	var rate Rate
+	err := r.db.WithContext(ctx).
+		Where("base_currency = ? AND quote_currency = ? AND rate_date <= ?", base, quote, truncateDate(on)).
+		Order("rate_date DESC").
+		First(&rate).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrRateNotFound
+		}
+		return nil, WrapError(ErrDatabaseError, err.Error())
+	}
+	return &rate, nil
+}
+
+// List returns rates newest first together with the number of rates matching
+// the filter.
+func (r *postgresRateRepository) List(ctx context.Context, filter RateListFilter) ([]*Rate, int64, error) {
+	query := r.db.WithContext(ctx).Model(&Rate{})
+	if filter.Base != "" {
+		query = query.Where("base_currency = ?", filter.Base)
+	}
+	if filter.Quote != "" {
+		query = query.Where("quote_currency = ?", filter.Quote)
+	}
+	if filter.From != nil {
+		query = query.Where("rate_date >= ?", *filter.From)
+	}
+	if filter.To != nil {
+		query = query.Where("rate_date <= ?", *filter.To)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, WrapError(ErrDatabaseError, err.Error())
+	}
+
+	if filter.After != nil {
+		query = query.Where(pagination.KeysetCondition("rate_date", true),
+			filter.After.RateDate, filter.After.RateDate, filter.After.ID)
+	} else if filter.Offset > 0 {
+		query = query.Offset(filter.Offset)
+	}
+
+	var rates []*Rate
+	err := query.Order(pagination.OrderClause("rate_date", true)).
+		Limit(filter.Limit).
+		Find(&rates).Error
+	if err != nil {
+		return nil, 0, WrapError(ErrDatabaseError, err.Error())
+	}
+	return rates, total, nil
+}
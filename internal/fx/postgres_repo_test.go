@@ -0,0 +1,125 @@
+package fx
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+func setupTestDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+
+	require.NoError(t, db.AutoMigrate(&Rate{}))
+
+	return db
+}
+
+func testRate(base domain.Currency, quote domain.Currency, date string, rate string) *Rate {
+	rateDate, _ := time.Parse(DateLayout, date)
+	return &Rate{
+		BaseCurrency:  base,
+		QuoteCurrency: quote,
+		Rate:          domain.MustParseDecimal(rate),
+		RateDate:      rateDate,
+		Source:        SourceManual,
+	}
+}
+
+func TestPostgresRateRepository_Upsert(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewPostgresRateRepository(db)
+	ctx := context.Background()
+
+	require.NoError(t, repo.Upsert(ctx, []*Rate{
+		testRate(domain.CurrencyUSD, domain.CurrencyBRL, "2026-01-02", "5.10"),
+		testRate(domain.CurrencyEUR, domain.CurrencyBRL, "2026-01-02", "5.90"),
+	}))
+
+	replacement := testRate(domain.CurrencyUSD, domain.CurrencyBRL, "2026-01-02", "5.20")
+	replacement.Source = SourceImport
+	require.NoError(t, repo.Upsert(ctx, []*Rate{replacement}))
+
+	var rates []Rate
+	require.NoError(t, db.Order("base_currency").Find(&rates).Error)
+	require.Len(t, rates, 2)
+	assert.Equal(t, "5.2", rates[1].Rate.String())
+	assert.Equal(t, SourceImport, rates[1].Source)
+}
+
+func TestPostgresRateRepository_FindClosest(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewPostgresRateRepository(db)
+	ctx := context.Background()
+
+	require.NoError(t, repo.Upsert(ctx, []*Rate{
+		testRate(domain.CurrencyUSD, domain.CurrencyBRL, "2026-01-01", "5.0"),
+		testRate(domain.CurrencyUSD, domain.CurrencyBRL, "2026-02-01", "5.5"),
+	}))
+
+	t.Run("closest prior rate", func(t *testing.T) {
+		rate, err := repo.FindClosest(ctx, domain.CurrencyUSD, domain.CurrencyBRL, time.Date(2026, 1, 20, 15, 0, 0, 0, time.UTC))
+
+		require.NoError(t, err)
+		assert.Equal(t, "5", rate.Rate.String())
+	})
+
+	t.Run("rate on the same day", func(t *testing.T) {
+		rate, err := repo.FindClosest(ctx, domain.CurrencyUSD, domain.CurrencyBRL, time.Date(2026, 2, 1, 23, 0, 0, 0, time.UTC))
+
+		require.NoError(t, err)
+		assert.Equal(t, "5.5", rate.Rate.String())
+	})
+
+	t.Run("nothing before the date", func(t *testing.T) {
+		_, err := repo.FindClosest(ctx, domain.CurrencyUSD, domain.CurrencyBRL, time.Date(2025, 12, 31, 0, 0, 0, 0, time.UTC))
+
+		assert.ErrorIs(t, err, ErrRateNotFound)
+	})
+
+	t.Run("unknown pair", func(t *testing.T) {
+		_, err := repo.FindClosest(ctx, domain.CurrencyEUR, domain.CurrencyBTC, time.Now())
+
+		assert.ErrorIs(t, err, ErrRateNotFound)
+	})
+}
+
+func TestPostgresRateRepository_List(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewPostgresRateRepository(db)
+	ctx := context.Background()
+
+	require.NoError(t, repo.Upsert(ctx, []*Rate{
+		testRate(domain.CurrencyUSD, domain.CurrencyBRL, "2026-01-01", "5.0"),
+		testRate(domain.CurrencyUSD, domain.CurrencyBRL, "2026-01-02", "5.1"),
+		testRate(domain.CurrencyUSD, domain.CurrencyBRL, "2026-01-03", "5.2"),
+		testRate(domain.CurrencyEUR, domain.CurrencyBRL, "2026-01-03", "6.0"),
+	}))
+
+	rates, total, err := repo.List(ctx, RateListFilter{Base: domain.CurrencyUSD, Limit: 2})
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), total)
+	require.Len(t, rates, 2)
+	assert.Equal(t, "2026-01-03", rates[0].RateDate.Format(DateLayout))
+
+	rates, _, err = repo.List(ctx, RateListFilter{
+		Base:  domain.CurrencyUSD,
+		After: &RateCursor{RateDate: rates[1].RateDate, ID: rates[1].ID},
+		Limit: 2,
+	})
+	require.NoError(t, err)
+	require.Len(t, rates, 1)
+	assert.Equal(t, "2026-01-01", rates[0].RateDate.Format(DateLayout))
+
+	from := time.Date(2026, 1, 3, 0, 0, 0, 0, time.UTC)
+	rates, total, err = repo.List(ctx, RateListFilter{From: &from, Limit: 10})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), total)
+	assert.Len(t, rates, 2)
+}
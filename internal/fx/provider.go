@@ -0,0 +1,84 @@
+package fx
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
+)
+
+const (
+	SourceFile = "file"
+	SourceHTTP = "http"
+)
+
+// RateProvider fetches the latest published rates from an external source.
+type RateProvider interface {
+	Name() string
+	FetchRates(ctx context.Context) ([]*Rate, error)
+}
+
+// ecbEnvelope is the layout of the ECB euro foreign exchange reference rates
+// feed: one dated cube per day holding one cube per quoted currency.
+type ecbEnvelope struct {
+	Cube struct {
+		Days []struct {
+			Time  string `xml:"time,attr"`
+			Rates []struct {
+				Currency string `xml:"currency,attr"`
+				Rate     string `xml:"rate,attr"`
+			} `xml:"Cube"`
+		} `xml:"Cube"`
+	} `xml:"Cube"`
+}
+
+// ParseECBXML reads an ECB-style reference rate document. All rates are
+// quoted against EUR; currencies that are not supported are skipped.
+func ParseECBXML(r io.Reader, source string) ([]*Rate, error) {
+	var envelope ecbEnvelope
+	if err := xml.NewDecoder(r).Decode(&envelope); err != nil {
+		return nil, WrapError(ErrInvalidRatesFile, err.Error())
+	}
+
+	var rates []*Rate
+	for _, day := range envelope.Cube.Days {
+		date, err := time.Parse(DateLayout, day.Time)
+		if err != nil {
+			return nil, WrapError(ErrInvalidRatesFile, fmt.Sprintf("invalid date %q", day.Time))
+		}
+		for _, quote := range day.Rates {
+			currency := domain.Currency(strings.ToUpper(quote.Currency))
+			if !currency.IsValid() || currency == domain.CurrencyEUR {
+				continue
+			}
+			value, err := domain.ParseDecimal(quote.Rate)
+			if err != nil {
+				return nil, WrapError(ErrInvalidRatesFile, fmt.Sprintf("invalid %s rate on %s", currency, day.Time))
+			}
+			rate, err := buildRate(date, domain.CurrencyEUR, currency, value, source)
+			if err != nil {
+				return nil, WrapError(ErrInvalidRatesFile, fmt.Sprintf("%s on %s: %s", currency, day.Time, err.Error()))
+			}
+			rates = append(rates, rate)
+		}
+	}
+
+	if len(rates) == 0 {
+		return nil, WrapError(ErrInvalidRatesFile, "document has no supported rates")
+	}
+	return rates, nil
+}
+
+// parseRatesDocument detects whether data is an ECB XML document or a CSV
+// file and parses it accordingly.
+func parseRatesDocument(data []byte, source string) ([]*Rate, error) {
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte("<")) {
+		return ParseECBXML(bytes.NewReader(data), source)
+	}
+	return ParseRatesCSV(bytes.NewReader(data), source)
+}
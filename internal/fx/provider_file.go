@@ -0,0 +1,79 @@
+package fx
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// FileProvider reads rates dropped on disk, either a single file or every
+// .xml and .csv file of a directory, so rates can be loaded without network
+// access.
+type FileProvider struct {
+	path string
+}
+
+func NewFileProvider(path string) *FileProvider {
+	return &FileProvider{
+		path: path,
+	}
+}
+
+func (p *FileProvider) Name() string {
+	return SourceFile
+}
+
+func (p *FileProvider) FetchRates(ctx context.Context) ([]*Rate, error) {
+	files, err := p.files()
+	if err != nil {
+		return nil, err
+	}
+
+	var rates []*Rate
+	for _, file := range files {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("read %s: %w", file, err)
+		}
+		parsed, err := parseRatesDocument(data, SourceFile)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", filepath.Base(file), err)
+		}
+		rates = append(rates, parsed...)
+	}
+	return rates, nil
+}
+
+func (p *FileProvider) files() ([]string, error) {
+	info, err := os.Stat(p.path)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return []string{p.path}, nil
+	}
+
+	entries, err := os.ReadDir(p.path)
+	if err != nil {
+		return nil, err
+	}
+	var files []string
+	for _, entry := range entries {
+		ext := strings.ToLower(filepath.Ext(entry.Name()))
+		if entry.IsDir() || (ext != ".xml" && ext != ".csv") {
+			continue
+		}
+		files = append(files, filepath.Join(p.path, entry.Name()))
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no rate files in %s", p.path)
+	}
+	sort.Strings(files)
+	return files, nil
+}
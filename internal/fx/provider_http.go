@@ -0,0 +1,56 @@
+package fx
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// DailyRatesPath is the document requested from the HTTP provider's base URL,
+// matching the layout of the ECB reference rate feed.
+const DailyRatesPath = "/eurofxref-daily.xml"
+
+// maxDocumentSize bounds the rate documents read from providers.
+const maxDocumentSize = 10 << 20
+
+type HTTPProvider struct {
+	baseURL string
+	client  *http.Client
+}
+
+func NewHTTPProvider(baseURL string, timeout time.Duration) *HTTPProvider {
+	return &HTTPProvider{
+		baseURL: strings.TrimRight(baseURL, "/"),
+		client:  &http.Client{Timeout: timeout},
+	}
+}
+
+func (p *HTTPProvider) Name() string {
+	return SourceHTTP
+}
+
+func (p *HTTPProvider) FetchRates(ctx context.Context) ([]*Rate, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.baseURL+DailyRatesPath, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("fetch rates: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fetch rates: unexpected status %d", resp.StatusCode)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxDocumentSize))
+	if err != nil {
+		return nil, fmt.Errorf("read rates: %w", err)
+	}
+	return parseRatesDocument(data, SourceHTTP)
+}
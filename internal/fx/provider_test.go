@@ -0,0 +1,113 @@
+package fx
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const ecbDocument = `<?xml version="1.0" encoding="UTF-8"?>
+<gesmes:Envelope xmlns:gesmes="http://www.gesmes.org/xml/2002-08-01" xmlns="http://www.ecb.int/vocabulary/2002-08-01/eurofxref">
+	<gesmes:subject>Reference rates</gesmes:subject>
+	<Cube>
+		<Cube time="2026-03-06">
+			<Cube currency="USD" rate="1.0842"/>
+			<Cube currency="JPY" rate="161.23"/>
+			<Cube currency="BRL" rate="6.1204"/>
+		</Cube>
+	</Cube>
+</gesmes:Envelope>`
+
+func TestParseECBXML(t *testing.T) {
+	rates, err := ParseECBXML(strings.NewReader(ecbDocument), SourceHTTP)
+
+	require.NoError(t, err)
+	require.Len(t, rates, 2)
+	assert.Equal(t, domain.CurrencyEUR, rates[0].BaseCurrency)
+	assert.Equal(t, domain.CurrencyUSD, rates[0].QuoteCurrency)
+	assert.Equal(t, "1.0842", rates[0].Rate.String())
+	assert.Equal(t, "2026-03-06", rates[0].RateDate.Format(DateLayout))
+	assert.Equal(t, domain.CurrencyBRL, rates[1].QuoteCurrency)
+	assert.Equal(t, SourceHTTP, rates[1].Source)
+
+	_, err = ParseECBXML(strings.NewReader("<Envelope><Cube/></Envelope>"), SourceHTTP)
+	assert.ErrorIs(t, err, ErrInvalidRatesFile)
+}
+
+func TestFileProvider(t *testing.T) {
+	t.Run("reads xml and csv drops from a directory", func(t *testing.T) {
+		dir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "eurofxref.xml"), []byte(ecbDocument), 0o600))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "btc.csv"), []byte("date,base,quote,rate\n2026-03-06,BTC,USD,88000\n"), 0o600))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "README.txt"), []byte("ignored"), 0o600))
+
+		rates, err := NewFileProvider(dir).FetchRates(context.Background())
+
+		require.NoError(t, err)
+		require.Len(t, rates, 3)
+		assert.Equal(t, domain.CurrencyBTC, rates[0].BaseCurrency)
+		assert.Equal(t, SourceFile, rates[0].Source)
+	})
+
+	t.Run("single file", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "rates.xml")
+		require.NoError(t, os.WriteFile(path, []byte(ecbDocument), 0o600))
+
+		rates, err := NewFileProvider(path).FetchRates(context.Background())
+
+		require.NoError(t, err)
+		assert.Len(t, rates, 2)
+	})
+
+	t.Run("invalid file fails the whole fetch", func(t *testing.T) {
+		dir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "a.xml"), []byte(ecbDocument), 0o600))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "b.csv"), []byte("date,base,quote,rate\n2026-03-06,BTC,USD,oops\n"), 0o600))
+
+		_, err := NewFileProvider(dir).FetchRates(context.Background())
+
+		assert.ErrorIs(t, err, ErrInvalidRatesFile)
+	})
+
+	t.Run("missing path", func(t *testing.T) {
+		_, err := NewFileProvider(filepath.Join(t.TempDir(), "missing")).FetchRates(context.Background())
+
+		assert.Error(t, err)
+	})
+}
+
+func TestHTTPProvider(t *testing.T) {
+	t.Run("fetches the daily document from the base url", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/stats/eurofxref"+DailyRatesPath, r.URL.Path)
+			w.Header().Set("Content-Type", "text/xml")
+			w.Write([]byte(ecbDocument))
+		}))
+		defer server.Close()
+
+		rates, err := NewHTTPProvider(server.URL+"/stats/eurofxref/", time.Second).FetchRates(context.Background())
+
+		require.NoError(t, err)
+		assert.Len(t, rates, 2)
+	})
+
+	t.Run("unexpected status", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer server.Close()
+
+		_, err := NewHTTPProvider(server.URL, time.Second).FetchRates(context.Background())
+
+		assert.ErrorContains(t, err, "503")
+	})
+}
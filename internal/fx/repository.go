@@ -0,0 +1,14 @@
+package fx
+
+import (
+	"context"
+	"time"
+
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
+)
+
+type RateRepository interface {
+	Upsert(ctx context.Context, rates []*Rate) error
+	FindClosest(ctx context.Context, base domain.Currency, quote domain.Currency, on time.Time) (*Rate, error)
+	List(ctx context.Context, filter RateListFilter) ([]*Rate, int64, error)
+}
@@ -0,0 +1,234 @@
+package fx
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"time"
+
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
+	"github.com/opinedajr/micro-stakes-api/internal/shared/pagination"
+)
+
+// Converter is the part of the rate service other modules depend on.
+type Converter interface {
+	Rate(ctx context.Context, from domain.Currency, to domain.Currency, at time.Time) (domain.Decimal, error)
+	Convert(ctx context.Context, amount domain.Decimal, from domain.Currency, to domain.Currency, at time.Time) (domain.Decimal, error)
+}
+
+type RateService interface {
+	Converter
+	UpsertRate(ctx context.Context, input UpsertRateInput) (*RateOutput, error)
+	ImportRates(ctx context.Context, r io.Reader, source string) (*ImportOutput, error)
+	ListRates(ctx context.Context, input ListRatesInput) (*RatePageOutput, error)
+	ConvertAmount(ctx context.Context, input ConvertInput) (*ConvertOutput, error)
+}
+
+type rateService struct {
+	repo   RateRepository
+	logger *slog.Logger
+}
+
+func NewRateService(repo RateRepository, logger *slog.Logger) RateService {
+	return &rateService{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+func (s *rateService) UpsertRate(ctx context.Context, input UpsertRateInput) (*RateOutput, error) {
+	rateDate, err := time.Parse(DateLayout, input.Date)
+	if err != nil {
+		s.logger.Error("invalid rate date", "error", err, "date", input.Date)
+		return nil, WrapError(ErrValidationFailed, "invalid date format")
+	}
+
+	rate, err := buildRate(rateDate, input.Base, input.Quote, input.Rate, input.Source)
+	if err != nil {
+		s.logger.Error("invalid rate", "error", err, "base", input.Base, "quote", input.Quote, "date", input.Date)
+		return nil, err
+	}
+
+	if err := s.repo.Upsert(ctx, []*Rate{rate}); err != nil {
+		s.logger.Error("failed to upsert rate", "error", err, "base", rate.BaseCurrency, "quote", rate.QuoteCurrency, "date", input.Date)
+		return nil, err
+	}
+
+	s.logger.Info("rate upserted", "base", rate.BaseCurrency, "quote", rate.QuoteCurrency, "date", input.Date, "rate", rate.Rate, "source", rate.Source)
+
+	return toRateOutput(rate), nil
+}
+
+func (s *rateService) ImportRates(ctx context.Context, r io.Reader, source string) (*ImportOutput, error) {
+	if source == "" {
+		source = SourceImport
+	}
+
+	rates, err := ParseRatesCSV(r, source)
+	if err != nil {
+		s.logger.Error("invalid rates file", "error", err, "source", source)
+		return nil, err
+	}
+
+	if err := s.repo.Upsert(ctx, rates); err != nil {
+		s.logger.Error("failed to import rates", "error", err, "source", source, "count", len(rates))
+		return nil, err
+	}
+
+	s.logger.Info("rates imported", "source", source, "count", len(rates))
+
+	return &ImportOutput{
+		Imported: len(rates),
+		Source:   source,
+	}, nil
+}
+
+func (s *rateService) ListRates(ctx context.Context, input ListRatesInput) (*RatePageOutput, error) {
+	params, err := input.Params.Normalize()
+	if err != nil {
+		s.logger.Error("invalid list parameters", "error", err)
+		return nil, WrapError(ErrValidationFailed, err.Error())
+	}
+
+	filter := RateListFilter{
+		Base:   input.Base,
+		Quote:  input.Quote,
+		Limit:  params.Limit + 1,
+		Offset: params.Offset,
+	}
+	if (filter.Base != "" && !filter.Base.IsValid()) || (filter.Quote != "" && !filter.Quote.IsValid()) {
+		s.logger.Error("invalid currency filter", "base", input.Base, "quote", input.Quote)
+		return nil, ErrInvalidCurrency
+	}
+	if input.From != "" {
+		from, err := time.Parse(DateLayout, input.From)
+		if err != nil {
+			return nil, WrapError(ErrValidationFailed, "invalid from date format")
+		}
+		filter.From = &from
+	}
+	if input.To != "" {
+		to, err := time.Parse(DateLayout, input.To)
+		if err != nil {
+			return nil, WrapError(ErrValidationFailed, "invalid to date format")
+		}
+		filter.To = &to
+	}
+	if params.Cursor != "" {
+		cursor, err := pagination.DecodeCursor(params.Cursor, "rate_date", true)
+		if err == nil {
+			var rateDate time.Time
+			rateDate, err = time.Parse(DateLayout, cursor.Value)
+			filter.After = &RateCursor{RateDate: rateDate, ID: cursor.ID}
+		}
+		if err != nil {
+			s.logger.Error("invalid cursor", "error", err)
+			return nil, WrapError(ErrValidationFailed, pagination.ErrInvalidCursor.Error())
+		}
+	}
+
+	rates, total, err := s.repo.List(ctx, filter)
+	if err != nil {
+		s.logger.Error("failed to list rates", "error", err)
+		return nil, err
+	}
+
+	rates, more := pagination.Trim(rates, params.Limit)
+	nextCursor := ""
+	if more {
+		last := rates[len(rates)-1]
+		nextCursor = pagination.EncodeCursor("rate_date", true, last.RateDate.Format(DateLayout), last.ID)
+	}
+
+	outputs := make([]*RateOutput, len(rates))
+	for i, rate := range rates {
+		outputs[i] = toRateOutput(rate)
+	}
+
+	return pagination.NewPage(outputs, total, params, nextCursor), nil
+}
+
+func (s *rateService) ConvertAmount(ctx context.Context, input ConvertInput) (*ConvertOutput, error) {
+	amount, err := domain.ParseDecimal(input.Amount)
+	if err != nil {
+		return nil, WrapError(ErrValidationFailed, "invalid amount")
+	}
+
+	at := time.Now().UTC()
+	if input.At != "" {
+		at, err = time.Parse(DateLayout, input.At)
+		if err != nil {
+			return nil, WrapError(ErrValidationFailed, "invalid at date format")
+		}
+	}
+
+	rate, err := s.Rate(ctx, input.From, input.To, at)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ConvertOutput{
+		Amount:    amount,
+		From:      input.From,
+		To:        input.To,
+		Rate:      rate,
+		Converted: input.To.Round(amount.Mul(rate)),
+		At:        at.Format(DateLayout),
+	}, nil
+}
+
+// Rate returns the price of one unit of from in to, using the closest rate
+// quoted on or before at. A pair stored only in the opposite direction is
+// inverted; when both directions exist the more recent quote wins.
+func (s *rateService) Rate(ctx context.Context, from domain.Currency, to domain.Currency, at time.Time) (domain.Decimal, error) {
+	if !from.IsValid() || !to.IsValid() {
+		return domain.Zero, ErrInvalidCurrency
+	}
+	if from == to {
+		return domain.NewDecimalFromInt(1), nil
+	}
+
+	direct, err := s.repo.FindClosest(ctx, from, to, at)
+	if err != nil && !errors.Is(err, ErrRateNotFound) {
+		s.logger.Error("failed to find rate", "error", err, "from", from, "to", to)
+		return domain.Zero, err
+	}
+	inverse, err := s.repo.FindClosest(ctx, to, from, at)
+	if err != nil && !errors.Is(err, ErrRateNotFound) {
+		s.logger.Error("failed to find rate", "error", err, "from", to, "to", from)
+		return domain.Zero, err
+	}
+
+	switch {
+	case direct != nil && (inverse == nil || !direct.RateDate.Before(inverse.RateDate)):
+		return direct.Rate, nil
+	case inverse != nil:
+		if !inverse.Rate.IsPositive() {
+			return domain.Zero, ErrInvalidRate
+		}
+		return domain.NewDecimalFromInt(1).Div(inverse.Rate).Round(domain.MaxScale), nil
+	default:
+		return domain.Zero, ErrRateNotFound
+	}
+}
+
+// Convert converts amount from one currency to another at the rate in effect
+// at the given time, rounded to the target currency's precision.
+func (s *rateService) Convert(ctx context.Context, amount domain.Decimal, from domain.Currency, to domain.Currency, at time.Time) (domain.Decimal, error) {
+	rate, err := s.Rate(ctx, from, to, at)
+	if err != nil {
+		return domain.Zero, err
+	}
+	return to.Round(amount.Mul(rate)), nil
+}
+
+func toRateOutput(rate *Rate) *RateOutput {
+	return &RateOutput{
+		Date:   rate.RateDate.Format(DateLayout),
+		Base:   rate.BaseCurrency,
+		Quote:  rate.QuoteCurrency,
+		Rate:   rate.Rate,
+		Source: rate.Source,
+	}
+}
@@ -0,0 +1,236 @@
+package fx
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
+	"github.com/opinedajr/micro-stakes-api/internal/shared/pagination"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type MockRateRepository struct {
+	mock.Mock
+}
+
+func (m *MockRateRepository) Upsert(ctx context.Context, rates []*Rate) error {
+	args := m.Called(ctx, rates)
+	return args.Error(0)
+}
+
+func (m *MockRateRepository) FindClosest(ctx context.Context, base domain.Currency, quote domain.Currency, on time.Time) (*Rate, error) {
+	args := m.Called(ctx, base, quote, on)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Rate), args.Error(1)
+}
+
+func (m *MockRateRepository) List(ctx context.Context, filter RateListFilter) ([]*Rate, int64, error) {
+	args := m.Called(ctx, filter)
+	if args.Get(0) == nil {
+		return nil, 0, args.Error(2)
+	}
+	return args.Get(0).([]*Rate), args.Get(1).(int64), args.Error(2)
+}
+
+func TestRate(t *testing.T) {
+	at := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+
+	t.Run("same currency", func(t *testing.T) {
+		mockRepo := new(MockRateRepository)
+		service := NewRateService(mockRepo, slog.Default())
+
+		rate, err := service.Rate(context.Background(), domain.CurrencyUSD, domain.CurrencyUSD, at)
+
+		require.NoError(t, err)
+		assert.Equal(t, "1", rate.String())
+		mockRepo.AssertNotCalled(t, "FindClosest")
+	})
+
+	t.Run("direct rate", func(t *testing.T) {
+		mockRepo := new(MockRateRepository)
+		service := NewRateService(mockRepo, slog.Default())
+		ctx := context.Background()
+
+		mockRepo.On("FindClosest", ctx, domain.CurrencyUSD, domain.CurrencyBRL, at).
+			Return(testRate(domain.CurrencyUSD, domain.CurrencyBRL, "2026-03-09", "5.25"), nil).Once()
+		mockRepo.On("FindClosest", ctx, domain.CurrencyBRL, domain.CurrencyUSD, at).Return(nil, ErrRateNotFound).Once()
+
+		rate, err := service.Rate(ctx, domain.CurrencyUSD, domain.CurrencyBRL, at)
+
+		require.NoError(t, err)
+		assert.Equal(t, "5.25", rate.String())
+	})
+
+	t.Run("newer inverse rate wins", func(t *testing.T) {
+		mockRepo := new(MockRateRepository)
+		service := NewRateService(mockRepo, slog.Default())
+		ctx := context.Background()
+
+		mockRepo.On("FindClosest", ctx, domain.CurrencyBRL, domain.CurrencyUSD, at).
+			Return(testRate(domain.CurrencyBRL, domain.CurrencyUSD, "2026-01-01", "0.19"), nil).Once()
+		mockRepo.On("FindClosest", ctx, domain.CurrencyUSD, domain.CurrencyBRL, at).
+			Return(testRate(domain.CurrencyUSD, domain.CurrencyBRL, "2026-03-01", "5"), nil).Once()
+
+		rate, err := service.Rate(ctx, domain.CurrencyBRL, domain.CurrencyUSD, at)
+
+		require.NoError(t, err)
+		assert.Equal(t, "0.20000000", rate.String())
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		mockRepo := new(MockRateRepository)
+		service := NewRateService(mockRepo, slog.Default())
+		ctx := context.Background()
+
+		mockRepo.On("FindClosest", ctx, mock.Anything, mock.Anything, at).Return(nil, ErrRateNotFound)
+
+		_, err := service.Rate(ctx, domain.CurrencyEUR, domain.CurrencyBTC, at)
+
+		assert.ErrorIs(t, err, ErrRateNotFound)
+	})
+
+	t.Run("invalid currency", func(t *testing.T) {
+		service := NewRateService(new(MockRateRepository), slog.Default())
+
+		_, err := service.Rate(context.Background(), domain.Currency("XYZ"), domain.CurrencyBRL, at)
+
+		assert.ErrorIs(t, err, ErrInvalidCurrency)
+	})
+}
+
+func TestConvert(t *testing.T) {
+	mockRepo := new(MockRateRepository)
+	service := NewRateService(mockRepo, slog.Default())
+	ctx := context.Background()
+	at := time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC)
+
+	mockRepo.On("FindClosest", ctx, domain.CurrencyUSD, domain.CurrencyBRL, at).
+		Return(testRate(domain.CurrencyUSD, domain.CurrencyBRL, "2026-03-09", "5.12345"), nil).Once()
+	mockRepo.On("FindClosest", ctx, domain.CurrencyBRL, domain.CurrencyUSD, at).Return(nil, ErrRateNotFound).Once()
+
+	converted, err := service.Convert(ctx, domain.MustParseDecimal("100"), domain.CurrencyUSD, domain.CurrencyBRL, at)
+
+	require.NoError(t, err)
+	assert.Equal(t, "512.35", converted.String())
+}
+
+func TestUpsertRate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		mockRepo := new(MockRateRepository)
+		service := NewRateService(mockRepo, slog.Default())
+		ctx := context.Background()
+
+		mockRepo.On("Upsert", ctx, mock.MatchedBy(func(rates []*Rate) bool {
+			return len(rates) == 1 &&
+				rates[0].BaseCurrency == domain.CurrencyEUR &&
+				rates[0].RateDate.Format(DateLayout) == "2026-03-01" &&
+				rates[0].Source == SourceManual
+		})).Return(nil).Once()
+
+		output, err := service.UpsertRate(ctx, UpsertRateInput{
+			Date:  "2026-03-01",
+			Base:  domain.CurrencyEUR,
+			Quote: domain.CurrencyUSD,
+			Rate:  domain.MustParseDecimal("1.0842"),
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "2026-03-01", output.Date)
+		assert.Equal(t, "1.0842", output.Rate.String())
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("validation", func(t *testing.T) {
+		service := NewRateService(new(MockRateRepository), slog.Default())
+		ctx := context.Background()
+
+		_, err := service.UpsertRate(ctx, UpsertRateInput{Date: "01/03/2026", Base: domain.CurrencyEUR, Quote: domain.CurrencyUSD, Rate: domain.MustParseDecimal("1")})
+		assert.ErrorIs(t, err, ErrValidationFailed)
+
+		_, err = service.UpsertRate(ctx, UpsertRateInput{Date: "2026-03-01", Base: domain.CurrencyEUR, Quote: domain.CurrencyEUR, Rate: domain.MustParseDecimal("1")})
+		assert.ErrorIs(t, err, ErrSameCurrency)
+
+		_, err = service.UpsertRate(ctx, UpsertRateInput{Date: "2026-03-01", Base: domain.CurrencyEUR, Quote: domain.CurrencyUSD, Rate: domain.MustParseDecimal("-1")})
+		assert.ErrorIs(t, err, ErrInvalidRate)
+
+		_, err = service.UpsertRate(ctx, UpsertRateInput{Date: "2026-03-01", Base: domain.CurrencyEUR, Quote: domain.Currency("GBP"), Rate: domain.MustParseDecimal("1")})
+		assert.ErrorIs(t, err, ErrInvalidCurrency)
+	})
+}
+
+func TestImportRates(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		mockRepo := new(MockRateRepository)
+		service := NewRateService(mockRepo, slog.Default())
+		ctx := context.Background()
+
+		csv := "Date,Base,Quote,Rate,Source\n" +
+			"# weekly close\n" +
+			"2026-03-06,usd,brl,5.12,\n" +
+			"2026-03-06, EUR, USD, 1.09, ecb\n"
+
+		mockRepo.On("Upsert", ctx, mock.MatchedBy(func(rates []*Rate) bool {
+			return len(rates) == 2 &&
+				rates[0].BaseCurrency == domain.CurrencyUSD && rates[0].Source == "broker" &&
+				rates[1].QuoteCurrency == domain.CurrencyUSD && rates[1].Source == "ecb"
+		})).Return(nil).Once()
+
+		output, err := service.ImportRates(ctx, strings.NewReader(csv), "broker")
+
+		require.NoError(t, err)
+		assert.Equal(t, 2, output.Imported)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("invalid row aborts the import", func(t *testing.T) {
+		mockRepo := new(MockRateRepository)
+		service := NewRateService(mockRepo, slog.Default())
+
+		csv := "date,base,quote,rate\n2026-03-06,USD,BRL,5.12\n2026-03-07,USD,BRL,abc\n"
+
+		_, err := service.ImportRates(context.Background(), strings.NewReader(csv), "")
+
+		assert.ErrorIs(t, err, ErrInvalidRatesFile)
+		assert.Contains(t, err.Error(), "line 3")
+		mockRepo.AssertNotCalled(t, "Upsert")
+	})
+
+	t.Run("missing column", func(t *testing.T) {
+		service := NewRateService(new(MockRateRepository), slog.Default())
+
+		_, err := service.ImportRates(context.Background(), strings.NewReader("date,base,rate\n"), "")
+
+		assert.ErrorIs(t, err, ErrInvalidRatesFile)
+	})
+}
+
+func TestListRates(t *testing.T) {
+	mockRepo := new(MockRateRepository)
+	service := NewRateService(mockRepo, slog.Default())
+	ctx := context.Background()
+
+	first := testRate(domain.CurrencyUSD, domain.CurrencyBRL, "2026-03-02", "5.1")
+	first.ID = 2
+	second := testRate(domain.CurrencyUSD, domain.CurrencyBRL, "2026-03-01", "5.0")
+	second.ID = 1
+	mockRepo.On("List", ctx, mock.MatchedBy(func(filter RateListFilter) bool {
+		return filter.Base == domain.CurrencyUSD && filter.Limit == 2 && filter.From != nil
+	})).Return([]*Rate{first, second}, int64(5), nil).Once()
+
+	output, err := service.ListRates(ctx, ListRatesInput{Base: domain.CurrencyUSD, From: "2026-01-01", Params: pagination.Params{Limit: 1}})
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), output.Total)
+	require.Len(t, output.Items, 1)
+	assert.NotEmpty(t, output.NextCursor)
+
+	_, err = service.ListRates(ctx, ListRatesInput{Base: domain.Currency("XYZ")})
+	assert.ErrorIs(t, err, ErrInvalidCurrency)
+}
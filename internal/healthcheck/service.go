@@ -4,18 +4,31 @@ type ServiceInterface interface {
 	Check() []Health
 }
 
-type Service struct{}
+// Checker reports the health of a dependency or background job.
+type Checker interface {
+	Health() Health
+}
 
-func NewHealthCheckService() *Service {
-	return &Service{}
+type Service struct {
+	checkers []Checker
+}
+
+func NewHealthCheckService(checkers ...Checker) *Service {
+	return &Service{
+		checkers: checkers,
+	}
 }
 
 func (s *Service) Check() []Health {
-	return []Health{
+	results := []Health{
 		{
 			ServiceName: ServiceName,
 			Status:      "healthy",
 			Message:     "Service is running",
 		},
 	}
+	for _, checker := range s.checkers {
+		results = append(results, checker.Health())
+	}
+	return results
 }
@@ -37,3 +37,22 @@ func TestHealthCheckService_Check(t *testing.T) {
 		}
 	})
 }
+
+type stubChecker struct{}
+
+func (stubChecker) Health() Health {
+	return Health{ServiceName: "fx-rates", Status: "degraded", Message: "provider down"}
+}
+
+func TestHealthCheckService_CheckWithCheckers(t *testing.T) {
+	service := NewHealthCheckService(stubChecker{})
+	result := service.Check()
+
+	if len(result) != 2 {
+		t.Fatalf("expected 2 health check results, got %d", len(result))
+	}
+
+	if result[1].ServiceName != "fx-rates" || result[1].Status != "degraded" {
+		t.Errorf("expected degraded fx-rates check, got %+v", result[1])
+	}
+}
@@ -0,0 +1,108 @@
+package session
+
+import (
+	"time"
+
+	"github.com/opinedajr/micro-stakes-api/internal/bankroll"
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
+	"github.com/opinedajr/micro-stakes-api/internal/shared/pagination"
+)
+
+type CreateSessionInput struct {
+	GameType   GameType       `json:"game_type" binding:"required"`
+	Stakes     string         `json:"stakes" binding:"required,min=1,max=50"`
+	Venue      string         `json:"venue" binding:"required,min=1,max=100"`
+	StartedAt  string         `json:"started_at" binding:"required"`
+	EndedAt    string         `json:"ended_at" binding:"required"`
+	BuyIn      domain.Decimal `json:"buy_in" binding:"decimal_gte=0"`
+	Rebuys     domain.Decimal `json:"rebuys" binding:"decimal_gte=0"`
+	AddOns     domain.Decimal `json:"add_ons" binding:"decimal_gte=0"`
+	Bounties   domain.Decimal `json:"bounties" binding:"decimal_gte=0"`
+	CashOut    domain.Decimal `json:"cash_out" binding:"decimal_gte=0"`
+	Rake       domain.Decimal `json:"rake" binding:"decimal_gte=0"`
+	StrategyID *uint          `json:"strategy_id"`
+}
+
+type UpdateSessionInput struct {
+	GameType   GameType       `json:"game_type" binding:"required"`
+	Stakes     string         `json:"stakes" binding:"required,min=1,max=50"`
+	Venue      string         `json:"venue" binding:"required,min=1,max=100"`
+	StartedAt  string         `json:"started_at" binding:"required"`
+	EndedAt    string         `json:"ended_at" binding:"required"`
+	BuyIn      domain.Decimal `json:"buy_in" binding:"decimal_gte=0"`
+	Rebuys     domain.Decimal `json:"rebuys" binding:"decimal_gte=0"`
+	AddOns     domain.Decimal `json:"add_ons" binding:"decimal_gte=0"`
+	Bounties   domain.Decimal `json:"bounties" binding:"decimal_gte=0"`
+	CashOut    domain.Decimal `json:"cash_out" binding:"decimal_gte=0"`
+	Rake       domain.Decimal `json:"rake" binding:"decimal_gte=0"`
+	StrategyID *uint          `json:"strategy_id"`
+}
+
+type StartSessionInput struct {
+	BankrollID uint           `json:"bankroll_id" binding:"required"`
+	GameType   GameType       `json:"game_type" binding:"required"`
+	Stakes     string         `json:"stakes" binding:"required,min=1,max=50"`
+	Venue      string         `json:"venue" binding:"required,min=1,max=100"`
+	Table      string         `json:"table" binding:"required,min=1,max=50"`
+	StartedAt  string         `json:"started_at"`
+	BuyIn      domain.Decimal `json:"buy_in" binding:"decimal_gte=0"`
+	StrategyID *uint          `json:"strategy_id"`
+}
+
+type RebuyInput struct {
+	Amount domain.Decimal `json:"amount" binding:"decimal_gt=0"`
+	AddOn  bool           `json:"add_on"`
+}
+
+type StopSessionInput struct {
+	CashOut  domain.Decimal `json:"cash_out" binding:"decimal_gte=0"`
+	Bounties domain.Decimal `json:"bounties" binding:"decimal_gte=0"`
+	Rake     domain.Decimal `json:"rake" binding:"decimal_gte=0"`
+	EndedAt  string         `json:"ended_at"`
+}
+
+type ListSessionsInput struct {
+	pagination.Params
+	GameType   GameType      `form:"game_type"`
+	Venue      string        `form:"venue"`
+	StrategyID *uint         `form:"strategy_id"`
+	Status     SessionStatus `form:"status"`
+	From       string        `form:"from"`
+	To         string        `form:"to"`
+}
+
+type SessionOutput struct {
+	ID         uint           `json:"id"`
+	BankrollID uint           `json:"bankroll_id"`
+	StrategyID *uint          `json:"strategy_id"`
+	GameType   GameType       `json:"game_type"`
+	Stakes     string         `json:"stakes"`
+	Venue      string         `json:"venue"`
+	Table      string         `json:"table,omitempty"`
+	Status     SessionStatus  `json:"status"`
+	StartedAt  time.Time      `json:"started_at"`
+	EndedAt    *time.Time     `json:"ended_at"`
+	Duration   int64          `json:"duration_minutes"`
+	BuyIn      domain.Decimal `json:"buy_in"`
+	Rebuys     domain.Decimal `json:"rebuys"`
+	AddOns     domain.Decimal `json:"add_ons"`
+	Bounties   domain.Decimal `json:"bounties"`
+	CashOut    domain.Decimal `json:"cash_out"`
+	Rake       domain.Decimal `json:"rake"`
+	Profit     domain.Decimal `json:"profit"`
+	AutoClosed bool           `json:"auto_closed"`
+	CreatedAt  time.Time      `json:"created_at"`
+	UpdatedAt  time.Time      `json:"updated_at"`
+	// Warnings lists the soft bankroll rules the session broke when recorded.
+	Warnings []bankroll.RuleBreachOutput `json:"warnings,omitempty"`
+}
+
+type SessionPageOutput = pagination.Page[*SessionOutput]
+
+type ErrorOutput struct {
+	Error   string                     `json:"error"`
+	Code    string                     `json:"code"`
+	Details map[string][]string        `json:"details,omitempty"`
+	Rule    *bankroll.RuleBreachOutput `json:"rule,omitempty"`
+	Lock    *bankroll.LimitLockOutput  `json:"lock,omitempty"`
+}
@@ -0,0 +1,29 @@
+package session
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrSessionNotFound   = errors.New("session not found")
+	ErrValidationFailed  = errors.New("validation failed")
+	ErrDatabaseError     = errors.New("database error")
+	ErrUnauthorized      = errors.New("unauthorized access to session")
+	ErrInvalidGameType   = errors.New("invalid game type")
+	ErrInvalidAmount     = errors.New("session amounts cannot be negative")
+	ErrInvalidTimeRange  = errors.New("session end must not be before its start")
+	ErrBankrollNotFound  = errors.New("bankroll not found")
+	ErrBankrollArchived  = errors.New("bankroll is archived")
+	ErrInsufficientFunds = errors.New("insufficient funds in bankroll")
+	ErrInvalidPrecision  = errors.New("amount exceeds currency precision")
+	ErrStrategyNotFound  = errors.New("strategy not found")
+
+	ErrSessionAlreadyOpen = errors.New("an open session already exists for this table")
+	ErrSessionNotOpen     = errors.New("session is not open")
+	ErrSessionOpen        = errors.New("session is still open")
+)
+
+func WrapError(err error, message string) error {
+	return fmt.Errorf("%s: %w", message, err)
+}
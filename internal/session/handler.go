@@ -0,0 +1,387 @@
+package session
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"log/slog"
+
+	"github.com/gin-gonic/gin"
+	"github.com/opinedajr/micro-stakes-api/internal/bankroll"
+)
+
+type SessionHandler struct {
+	service SessionService
+	logger  *slog.Logger
+}
+
+func NewSessionHandler(service SessionService, logger *slog.Logger) *SessionHandler {
+	return &SessionHandler{
+		service: service,
+		logger:  logger,
+	}
+}
+
+func (h *SessionHandler) CreateSession(c *gin.Context) {
+	var input CreateSessionInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		h.logger.Error("invalid request body", "error", err)
+		c.JSON(http.StatusBadRequest, ErrorOutput{
+			Error:   "Invalid request body",
+			Code:    "VALIDATION_ERROR",
+			Details: nil,
+		})
+		return
+	}
+
+	userID, err := h.getUserID(c)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	bankrollID, err := h.getParamID(c, "bankrollId")
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	output, err := h.service.CreateSession(c.Request.Context(), userID, bankrollID, input)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, output)
+}
+
+func (h *SessionHandler) ListSessions(c *gin.Context) {
+	var input ListSessionsInput
+	if err := c.ShouldBindQuery(&input); err != nil {
+		h.logger.Error("invalid query parameters", "error", err)
+		c.JSON(http.StatusBadRequest, ErrorOutput{
+			Error:   "Invalid query parameters",
+			Code:    "VALIDATION_ERROR",
+			Details: nil,
+		})
+		return
+	}
+
+	userID, err := h.getUserID(c)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	bankrollID, err := h.getParamID(c, "bankrollId")
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	output, err := h.service.ListSessions(c.Request.Context(), userID, bankrollID, input)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
+
+func (h *SessionHandler) GetSession(c *gin.Context) {
+	userID, err := h.getUserID(c)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	sessionID, err := h.getParamID(c, "sessionId")
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	output, err := h.service.GetSession(c.Request.Context(), userID, sessionID)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
+
+func (h *SessionHandler) UpdateSession(c *gin.Context) {
+	var input UpdateSessionInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		h.logger.Error("invalid request body", "error", err)
+		c.JSON(http.StatusBadRequest, ErrorOutput{
+			Error:   "Invalid request body",
+			Code:    "VALIDATION_ERROR",
+			Details: nil,
+		})
+		return
+	}
+
+	userID, err := h.getUserID(c)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	sessionID, err := h.getParamID(c, "sessionId")
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	output, err := h.service.UpdateSession(c.Request.Context(), userID, sessionID, input)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
+
+func (h *SessionHandler) DeleteSession(c *gin.Context) {
+	userID, err := h.getUserID(c)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	sessionID, err := h.getParamID(c, "sessionId")
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	if err := h.service.DeleteSession(c.Request.Context(), userID, sessionID); err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (h *SessionHandler) StartSession(c *gin.Context) {
+	var input StartSessionInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		h.logger.Error("invalid request body", "error", err)
+		c.JSON(http.StatusBadRequest, ErrorOutput{
+			Error:   "Invalid request body",
+			Code:    "VALIDATION_ERROR",
+			Details: nil,
+		})
+		return
+	}
+
+	userID, err := h.getUserID(c)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	output, err := h.service.StartSession(c.Request.Context(), userID, input)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, output)
+}
+
+func (h *SessionHandler) RebuySession(c *gin.Context) {
+	var input RebuyInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		h.logger.Error("invalid request body", "error", err)
+		c.JSON(http.StatusBadRequest, ErrorOutput{
+			Error:   "Invalid request body",
+			Code:    "VALIDATION_ERROR",
+			Details: nil,
+		})
+		return
+	}
+
+	userID, err := h.getUserID(c)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	sessionID, err := h.getParamID(c, "sessionId")
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	output, err := h.service.RebuySession(c.Request.Context(), userID, sessionID, input)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
+
+func (h *SessionHandler) StopSession(c *gin.Context) {
+	var input StopSessionInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		h.logger.Error("invalid request body", "error", err)
+		c.JSON(http.StatusBadRequest, ErrorOutput{
+			Error:   "Invalid request body",
+			Code:    "VALIDATION_ERROR",
+			Details: nil,
+		})
+		return
+	}
+
+	userID, err := h.getUserID(c)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	sessionID, err := h.getParamID(c, "sessionId")
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	output, err := h.service.StopSession(c.Request.Context(), userID, sessionID, input)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
+
+func (h *SessionHandler) handleError(c *gin.Context, err error) {
+	var violation *bankroll.RuleViolation
+	var limitReached *bankroll.LimitReachedError
+	switch {
+	case errors.As(err, &violation):
+		c.JSON(http.StatusUnprocessableEntity, ErrorOutput{
+			Error: "Bankroll rule violated",
+			Code:  "RULE_VIOLATION",
+			Rule:  &violation.Breach,
+		})
+	case errors.As(err, &limitReached):
+		c.JSON(http.StatusUnprocessableEntity, ErrorOutput{
+			Error: "Bankroll stop limit reached",
+			Code:  "LIMIT_REACHED",
+			Lock:  &limitReached.Lock,
+		})
+	case errors.Is(err, ErrSessionNotFound):
+		c.JSON(http.StatusNotFound, ErrorOutput{
+			Error: "Session not found",
+			Code:  "SESSION_NOT_FOUND",
+		})
+	case errors.Is(err, ErrBankrollNotFound):
+		c.JSON(http.StatusNotFound, ErrorOutput{
+			Error: "Bankroll not found",
+			Code:  "BANKROLL_NOT_FOUND",
+		})
+	case errors.Is(err, ErrBankrollArchived):
+		c.JSON(http.StatusConflict, ErrorOutput{
+			Error: "Bankroll is archived",
+			Code:  "BANKROLL_ARCHIVED",
+		})
+	case errors.Is(err, ErrStrategyNotFound):
+		c.JSON(http.StatusNotFound, ErrorOutput{
+			Error: "Strategy not found",
+			Code:  "STRATEGY_NOT_FOUND",
+		})
+	case errors.Is(err, ErrValidationFailed):
+		c.JSON(http.StatusBadRequest, ErrorOutput{
+			Error: err.Error(),
+			Code:  "VALIDATION_ERROR",
+		})
+	case errors.Is(err, ErrDatabaseError):
+		h.logger.Error("database error", "error", err)
+		c.JSON(http.StatusInternalServerError, ErrorOutput{
+			Error: "Database error occurred",
+			Code:  "DATABASE_ERROR",
+		})
+	case errors.Is(err, ErrUnauthorized):
+		c.JSON(http.StatusForbidden, ErrorOutput{
+			Error: "Unauthorized access to session",
+			Code:  "UNAUTHORIZED",
+		})
+	case errors.Is(err, ErrInvalidGameType):
+		c.JSON(http.StatusBadRequest, ErrorOutput{
+			Error: "Invalid game type",
+			Code:  "INVALID_GAME_TYPE",
+		})
+	case errors.Is(err, ErrInvalidAmount):
+		c.JSON(http.StatusBadRequest, ErrorOutput{
+			Error: "Session amounts cannot be negative",
+			Code:  "INVALID_AMOUNT",
+		})
+	case errors.Is(err, ErrInvalidTimeRange):
+		c.JSON(http.StatusBadRequest, ErrorOutput{
+			Error: "Session end must not be before its start",
+			Code:  "INVALID_TIME_RANGE",
+		})
+	case errors.Is(err, ErrInvalidPrecision):
+		c.JSON(http.StatusBadRequest, ErrorOutput{
+			Error: "Amount exceeds currency precision",
+			Code:  "INVALID_PRECISION",
+		})
+	case errors.Is(err, ErrInsufficientFunds):
+		c.JSON(http.StatusUnprocessableEntity, ErrorOutput{
+			Error: "Insufficient funds in bankroll",
+			Code:  "INSUFFICIENT_FUNDS",
+		})
+	case errors.Is(err, ErrSessionAlreadyOpen):
+		c.JSON(http.StatusConflict, ErrorOutput{
+			Error: "An open session already exists for this table",
+			Code:  "SESSION_ALREADY_OPEN",
+		})
+	case errors.Is(err, ErrSessionNotOpen):
+		c.JSON(http.StatusConflict, ErrorOutput{
+			Error: "Session is not open",
+			Code:  "SESSION_NOT_OPEN",
+		})
+	case errors.Is(err, ErrSessionOpen):
+		c.JSON(http.StatusConflict, ErrorOutput{
+			Error: "Session is still open; stop it first",
+			Code:  "SESSION_OPEN",
+		})
+	default:
+		h.logger.Error("unexpected error", "error", err)
+		c.JSON(http.StatusInternalServerError, ErrorOutput{
+			Error: "An unexpected error occurred",
+			Code:  "INTERNAL_ERROR",
+		})
+	}
+}
+
+func (h *SessionHandler) getParamID(c *gin.Context, name string) (uint, error) {
+	id, err := strconv.ParseUint(c.Param(name), 10, 32)
+	if err != nil {
+		return 0, ErrUnauthorized
+	}
+	return uint(id), nil
+}
+
+func (h *SessionHandler) getUserID(c *gin.Context) (uint, error) {
+	userIDStr, exists := c.Get("userID")
+	if !exists {
+		return 0, ErrUnauthorized
+	}
+
+	userID, ok := userIDStr.(string)
+	if !ok {
+		return 0, ErrUnauthorized
+	}
+
+	parsedID, err := strconv.ParseUint(userID, 10, 32)
+	if err != nil {
+		return 0, ErrUnauthorized
+	}
+
+	return uint(parsedID), nil
+}
@@ -0,0 +1,360 @@
+package session
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"log/slog"
+
+	"github.com/gin-gonic/gin"
+	"github.com/opinedajr/micro-stakes-api/internal/bankroll"
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
+	"github.com/opinedajr/micro-stakes-api/internal/shared/pagination"
+	customValidator "github.com/opinedajr/micro-stakes-api/internal/shared/validator"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMain(m *testing.M) {
+	if err := customValidator.RegisterBindingValidators(); err != nil {
+		panic(err)
+	}
+	os.Exit(m.Run())
+}
+
+type MockSessionServiceForHandler struct {
+	mock.Mock
+}
+
+func (m *MockSessionServiceForHandler) CreateSession(ctx context.Context, userID uint, bankrollID uint, input CreateSessionInput) (*SessionOutput, error) {
+	args := m.Called(ctx, userID, bankrollID, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*SessionOutput), args.Error(1)
+}
+
+func (m *MockSessionServiceForHandler) ListSessions(ctx context.Context, userID uint, bankrollID uint, input ListSessionsInput) (*SessionPageOutput, error) {
+	args := m.Called(ctx, userID, bankrollID, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*SessionPageOutput), args.Error(1)
+}
+
+func (m *MockSessionServiceForHandler) GetSession(ctx context.Context, userID uint, sessionID uint) (*SessionOutput, error) {
+	args := m.Called(ctx, userID, sessionID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*SessionOutput), args.Error(1)
+}
+
+func (m *MockSessionServiceForHandler) UpdateSession(ctx context.Context, userID uint, sessionID uint, input UpdateSessionInput) (*SessionOutput, error) {
+	args := m.Called(ctx, userID, sessionID, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*SessionOutput), args.Error(1)
+}
+
+func (m *MockSessionServiceForHandler) DeleteSession(ctx context.Context, userID uint, sessionID uint) error {
+	args := m.Called(ctx, userID, sessionID)
+	return args.Error(0)
+}
+
+func (m *MockSessionServiceForHandler) StartSession(ctx context.Context, userID uint, input StartSessionInput) (*SessionOutput, error) {
+	args := m.Called(ctx, userID, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*SessionOutput), args.Error(1)
+}
+
+func (m *MockSessionServiceForHandler) RebuySession(ctx context.Context, userID uint, sessionID uint, input RebuyInput) (*SessionOutput, error) {
+	args := m.Called(ctx, userID, sessionID, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*SessionOutput), args.Error(1)
+}
+
+func (m *MockSessionServiceForHandler) StopSession(ctx context.Context, userID uint, sessionID uint, input StopSessionInput) (*SessionOutput, error) {
+	args := m.Called(ctx, userID, sessionID, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*SessionOutput), args.Error(1)
+}
+
+func newSessionRequest(t *testing.T, method string, url string, body interface{}) *http.Request {
+	bodyBytes, err := json.Marshal(body)
+	require.NoError(t, err)
+
+	req, err := http.NewRequest(method, url, bytes.NewBuffer(bodyBytes))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+	return req
+}
+
+func TestCreateSessionHandler(t *testing.T) {
+	input := CreateSessionInput{
+		GameType:  GameTypeNLHE,
+		Stakes:    "NL50",
+		Venue:     "PokerStars",
+		StartedAt: "2026-03-01T20:00:00Z",
+		EndedAt:   "2026-03-01T23:00:00Z",
+		BuyIn:     domain.MustParseDecimal("50"),
+		CashOut:   domain.MustParseDecimal("85"),
+	}
+
+	t.Run("success", func(t *testing.T) {
+		mockService := new(MockSessionServiceForHandler)
+		handler := NewSessionHandler(mockService, slog.Default())
+
+		mockService.On("CreateSession", mock.Anything, uint(1), uint(1), mock.AnythingOfType("session.CreateSessionInput")).
+			Return(&SessionOutput{ID: 1, Profit: domain.MustParseDecimal("35.00")}, nil).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = newSessionRequest(t, http.MethodPost, "/bankrolls/1/sessions", input)
+		c.Params = gin.Params{{Key: "bankrollId", Value: "1"}}
+		c.Set("userID", "1")
+
+		handler.CreateSession(c)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		var response SessionOutput
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, "35.00", response.Profit.String())
+	})
+
+	t.Run("validation error - negative buy-in", func(t *testing.T) {
+		mockService := new(MockSessionServiceForHandler)
+		handler := NewSessionHandler(mockService, slog.Default())
+		invalid := input
+		invalid.BuyIn = domain.MustParseDecimal("-5")
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = newSessionRequest(t, http.MethodPost, "/bankrolls/1/sessions", invalid)
+		c.Params = gin.Params{{Key: "bankrollId", Value: "1"}}
+		c.Set("userID", "1")
+
+		handler.CreateSession(c)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		mockService.AssertNotCalled(t, "CreateSession")
+	})
+
+	t.Run("insufficient funds", func(t *testing.T) {
+		mockService := new(MockSessionServiceForHandler)
+		handler := NewSessionHandler(mockService, slog.Default())
+
+		mockService.On("CreateSession", mock.Anything, uint(1), uint(1), mock.AnythingOfType("session.CreateSessionInput")).
+			Return(nil, ErrInsufficientFunds).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = newSessionRequest(t, http.MethodPost, "/bankrolls/1/sessions", input)
+		c.Params = gin.Params{{Key: "bankrollId", Value: "1"}}
+		c.Set("userID", "1")
+
+		handler.CreateSession(c)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	})
+
+	t.Run("rule violation", func(t *testing.T) {
+		mockService := new(MockSessionServiceForHandler)
+		handler := NewSessionHandler(mockService, slog.Default())
+		breach := bankroll.RuleBreachOutput{
+			RuleID:   2,
+			Type:     bankroll.RuleTypeMinBuyIns,
+			Severity: bankroll.RuleSeverityHard,
+			Limit:    domain.MustParseDecimal("40"),
+			Actual:   domain.MustParseDecimal("20.00"),
+		}
+
+		mockService.On("CreateSession", mock.Anything, uint(1), uint(1), mock.AnythingOfType("session.CreateSessionInput")).
+			Return(nil, &bankroll.RuleViolation{Breach: breach}).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = newSessionRequest(t, http.MethodPost, "/bankrolls/1/sessions", input)
+		c.Params = gin.Params{{Key: "bankrollId", Value: "1"}}
+		c.Set("userID", "1")
+
+		handler.CreateSession(c)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+		var response ErrorOutput
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, "RULE_VIOLATION", response.Code)
+		require.NotNil(t, response.Rule)
+		assert.Equal(t, bankroll.RuleTypeMinBuyIns, response.Rule.Type)
+	})
+
+	t.Run("stop limit reached", func(t *testing.T) {
+		mockService := new(MockSessionServiceForHandler)
+		handler := NewSessionHandler(mockService, slog.Default())
+		until := time.Date(2026, 3, 9, 0, 0, 0, 0, time.UTC)
+
+		mockService.On("CreateSession", mock.Anything, uint(1), uint(1), mock.AnythingOfType("session.CreateSessionInput")).
+			Return(nil, &bankroll.LimitReachedError{Lock: bankroll.LimitLockOutput{Locked: true, Reason: bankroll.LimitReasonWeeklyStopWin, LockedUntil: &until}}).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = newSessionRequest(t, http.MethodPost, "/bankrolls/1/sessions", input)
+		c.Params = gin.Params{{Key: "bankrollId", Value: "1"}}
+		c.Set("userID", "1")
+
+		handler.CreateSession(c)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+		var response ErrorOutput
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, "LIMIT_REACHED", response.Code)
+		require.NotNil(t, response.Lock)
+		assert.Equal(t, bankroll.LimitReasonWeeklyStopWin, response.Lock.Reason)
+	})
+}
+
+func TestListSessionsHandler(t *testing.T) {
+	mockService := new(MockSessionServiceForHandler)
+	handler := NewSessionHandler(mockService, slog.Default())
+
+	input := ListSessionsInput{Params: pagination.Params{Limit: 5, Offset: 5}, GameType: GameTypeMTT, Venue: "GG"}
+	mockService.On("ListSessions", mock.Anything, uint(1), uint(1), input).
+		Return(&SessionPageOutput{Items: []*SessionOutput{{ID: 1}}, Total: 6, Limit: 5, Offset: 5}, nil).Once()
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest(http.MethodGet, "/bankrolls/1/sessions?game_type=mtt&venue=GG&limit=5&offset=5", nil)
+	c.Params = gin.Params{{Key: "bankrollId", Value: "1"}}
+	c.Set("userID", "1")
+
+	handler.ListSessions(c)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	var response SessionPageOutput
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Len(t, response.Items, 1)
+	assert.Equal(t, int64(6), response.Total)
+	mockService.AssertExpectations(t)
+}
+
+func TestGetSessionHandler(t *testing.T) {
+	mockService := new(MockSessionServiceForHandler)
+	handler := NewSessionHandler(mockService, slog.Default())
+
+	mockService.On("GetSession", mock.Anything, uint(2), uint(5)).Return(nil, ErrSessionNotFound).Once()
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest(http.MethodGet, "/sessions/5", nil)
+	c.Params = gin.Params{{Key: "sessionId", Value: "5"}}
+	c.Set("userID", "2")
+
+	handler.GetSession(c)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	var response ErrorOutput
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, "SESSION_NOT_FOUND", response.Code)
+}
+
+func TestDeleteSessionHandler(t *testing.T) {
+	mockService := new(MockSessionServiceForHandler)
+	handler := NewSessionHandler(mockService, slog.Default())
+
+	mockService.On("DeleteSession", mock.Anything, uint(1), uint(5)).Return(nil).Once()
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest(http.MethodDelete, "/sessions/5", nil)
+	c.Params = gin.Params{{Key: "sessionId", Value: "5"}}
+	c.Set("userID", "1")
+
+	handler.DeleteSession(c)
+
+	assert.Equal(t, http.StatusNoContent, c.Writer.Status())
+}
+
+func TestStartSessionHandler(t *testing.T) {
+	input := StartSessionInput{
+		BankrollID: 1,
+		GameType:   GameTypeNLHE,
+		Stakes:     "NL50",
+		Venue:      "PokerStars",
+		Table:      "Altair II",
+		BuyIn:      domain.MustParseDecimal("50"),
+	}
+
+	t.Run("success", func(t *testing.T) {
+		mockService := new(MockSessionServiceForHandler)
+		handler := NewSessionHandler(mockService, slog.Default())
+
+		mockService.On("StartSession", mock.Anything, uint(1), mock.AnythingOfType("session.StartSessionInput")).
+			Return(&SessionOutput{ID: 1, Status: SessionStatusOpen, Table: "Altair II"}, nil).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = newSessionRequest(t, http.MethodPost, "/sessions/start", input)
+		c.Set("userID", "1")
+
+		handler.StartSession(c)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		var response SessionOutput
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, SessionStatusOpen, response.Status)
+	})
+
+	t.Run("table already open", func(t *testing.T) {
+		mockService := new(MockSessionServiceForHandler)
+		handler := NewSessionHandler(mockService, slog.Default())
+
+		mockService.On("StartSession", mock.Anything, uint(1), mock.Anything).Return(nil, ErrSessionAlreadyOpen).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = newSessionRequest(t, http.MethodPost, "/sessions/start", input)
+		c.Set("userID", "1")
+
+		handler.StartSession(c)
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+		var response ErrorOutput
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, "SESSION_ALREADY_OPEN", response.Code)
+	})
+}
+
+func TestStopSessionHandler(t *testing.T) {
+	mockService := new(MockSessionServiceForHandler)
+	handler := NewSessionHandler(mockService, slog.Default())
+
+	mockService.On("StopSession", mock.Anything, uint(1), uint(5), mock.AnythingOfType("session.StopSessionInput")).
+		Return(nil, ErrSessionNotOpen).Once()
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = newSessionRequest(t, http.MethodPost, "/sessions/5/stop", StopSessionInput{CashOut: domain.MustParseDecimal("80")})
+	c.Params = gin.Params{{Key: "sessionId", Value: "5"}}
+	c.Set("userID", "1")
+
+	handler.StopSession(c)
+
+	assert.Equal(t, http.StatusConflict, w.Code)
+	var response ErrorOutput
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, "SESSION_NOT_OPEN", response.Code)
+}
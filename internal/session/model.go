@@ -0,0 +1,109 @@
+package session
+
+import (
+	"time"
+
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
+	"gorm.io/gorm"
+)
+
+type GameType string
+
+const (
+	GameTypeNLHE GameType = "nlhe"
+	GameTypePLO  GameType = "plo"
+	GameTypeMTT  GameType = "mtt"
+	GameTypeSNG  GameType = "sng"
+	GameTypeSpin GameType = "spin"
+)
+
+func (g GameType) IsValid() bool {
+	switch g {
+	case GameTypeNLHE, GameTypePLO, GameTypeMTT, GameTypeSNG, GameTypeSpin:
+		return true
+	}
+	return false
+}
+
+type SessionStatus string
+
+const (
+	SessionStatusOpen    SessionStatus = "open"
+	SessionStatusSettled SessionStatus = "settled"
+)
+
+// Session is a poker session played against a bankroll. Profit is derived
+// from the money columns and is what has been posted to the bankroll ledger.
+// While a live session is open its invested amount is held as in play. Rake
+// is what the room took during the session; it is already reflected in the
+// result and only feeds rakeback deals.
+type Session struct {
+	ID         uint          `gorm:"primaryKey;autoIncrement"`
+	UserID     uint          `gorm:"not null;index"`
+	BankrollID uint          `gorm:"not null;index"`
+	StrategyID *uint         `gorm:"index"`
+	GameType   GameType      `gorm:"type:varchar(10);not null"`
+	Stakes     string        `gorm:"type:varchar(50);not null"`
+	Venue      string        `gorm:"type:varchar(100);not null"`
+	Table      string        `gorm:"column:poker_table;type:varchar(50);not null;default:''"`
+	Status     SessionStatus `gorm:"type:varchar(20);not null;index"`
+	StartedAt  time.Time     `gorm:"not null;index"`
+	EndedAt    *time.Time
+	BuyIn      domain.Decimal `gorm:"type:decimal(27,8);not null"`
+	Rebuys     domain.Decimal `gorm:"type:decimal(27,8);not null"`
+	AddOns     domain.Decimal `gorm:"type:decimal(27,8);not null"`
+	Bounties   domain.Decimal `gorm:"type:decimal(27,8);not null"`
+	CashOut    domain.Decimal `gorm:"type:decimal(27,8);not null"`
+	Rake       domain.Decimal `gorm:"type:decimal(27,8);not null;default:0"`
+	Profit     domain.Decimal `gorm:"type:decimal(27,8);not null"`
+	AutoClosed bool           `gorm:"not null;default:false"`
+	CreatedAt  time.Time      `gorm:"autoCreateTime"`
+	UpdatedAt  time.Time      `gorm:"autoUpdateTime"`
+	DeletedAt  gorm.DeletedAt `gorm:"index"`
+}
+
+func (Session) TableName() string {
+	return "poker_sessions"
+}
+
+// Invested is the total amount paid into the game.
+func (s *Session) Invested() domain.Decimal {
+	return domain.SumDecimals(s.BuyIn, s.Rebuys, s.AddOns)
+}
+
+func (s *Session) ComputeProfit() domain.Decimal {
+	return s.CashOut.Add(s.Bounties).Sub(s.Invested())
+}
+
+func (s *Session) IsOpen() bool {
+	return s.Status == SessionStatusOpen
+}
+
+// SessionClose carries the figures used to stop a live session.
+type SessionClose struct {
+	CashOut    domain.Decimal
+	Bounties   domain.Decimal
+	Rake       domain.Decimal
+	EndedAt    time.Time
+	AutoClosed bool
+}
+
+// SessionFilter selects a page of sessions, most recently started first.
+// After, when set, replaces Offset with keyset paging.
+type SessionFilter struct {
+	BankrollID uint
+	GameType   GameType
+	Venue      string
+	StrategyID *uint
+	Status     SessionStatus
+	From       *time.Time
+	To         *time.Time
+	After      *SessionCursor
+	Limit      int
+	Offset     int
+}
+
+type SessionCursor struct {
+	StartedAt time.Time
+	ID        uint
+}
@@ -0,0 +1,333 @@
+package session
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/opinedajr/micro-stakes-api/internal/bankroll"
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
+	"github.com/opinedajr/micro-stakes-api/internal/shared/pagination"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const ReferenceTypeSession = "session"
+
+type postgresSessionRepository struct {
+	db *gorm.DB
+}
+
+func NewPostgresSessionRepository(db *gorm.DB) SessionRepository {
+	return &postgresSessionRepository{
+		db: db,
+	}
+}
+
+func (r *postgresSessionRepository) Create(ctx context.Context, session *Session) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(session).Error; err != nil {
+			return WrapError(ErrDatabaseError, err.Error())
+		}
+		return postResult(tx, session, session.Profit, fmt.Sprintf("Session #%d result", session.ID))
+	})
+}
+
+// Update rewrites a session and posts the change in profit, so the ledger
+// always sums to the current figures of every session.
+func (r *postgresSessionRepository) Update(ctx context.Context, session *Session) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		existing, err := lockSession(tx, session.ID, session.UserID)
+		if err != nil {
+			return err
+		}
+		if existing.IsOpen() {
+			return ErrSessionOpen
+		}
+		previousProfit := existing.Profit
+
+		if err := tx.Model(existing).Updates(map[string]interface{}{
+			"strategy_id": session.StrategyID,
+			"game_type":   session.GameType,
+			"stakes":      session.Stakes,
+			"venue":       session.Venue,
+			"started_at":  session.StartedAt,
+			"ended_at":    session.EndedAt,
+			"buy_in":      session.BuyIn,
+			"rebuys":      session.Rebuys,
+			"add_ons":     session.AddOns,
+			"bounties":    session.Bounties,
+			"cash_out":    session.CashOut,
+			"rake":        session.Rake,
+			"profit":      session.Profit,
+		}).Error; err != nil {
+			return WrapError(ErrDatabaseError, err.Error())
+		}
+
+		session.BankrollID = existing.BankrollID
+		session.Status = existing.Status
+		session.CreatedAt = existing.CreatedAt
+		session.UpdatedAt = existing.UpdatedAt
+
+		delta := session.Profit.Sub(previousProfit)
+		return postResult(tx, session, delta, fmt.Sprintf("Session #%d result adjusted", session.ID))
+	})
+}
+
+func (r *postgresSessionRepository) Delete(ctx context.Context, id uint, userID uint) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		existing, err := lockSession(tx, id, userID)
+		if err != nil {
+			return err
+		}
+		if existing.IsOpen() {
+			return ErrSessionOpen
+		}
+
+		if err := tx.Delete(existing).Error; err != nil {
+			return WrapError(ErrDatabaseError, err.Error())
+		}
+
+		return postResult(tx, existing, existing.Profit.Neg(), fmt.Sprintf("Session #%d deleted", existing.ID))
+	})
+}
+
+func (r *postgresSessionRepository) FindByID(ctx context.Context, id uint, userID uint) (*Session, error) {
+	var session Session
+	err := r.db.WithContext(ctx).Where("id = ? AND user_id = ?", id, userID).First(&session).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, ErrSessionNotFound
+		}
+		return nil, WrapError(ErrDatabaseError, err.Error())
+	}
+	return &session, nil
+}
+
+// List returns a page of the user's sessions matching the filter together
+// with the total number of matches.
+func (r *postgresSessionRepository) List(ctx context.Context, userID uint, filter SessionFilter) ([]*Session, int64, error) {
+	query := r.db.WithContext(ctx).Model(&Session{}).Where("user_id = ?", userID)
+	if filter.BankrollID != 0 {
+		query = query.Where("bankroll_id = ?", filter.BankrollID)
+	}
+	if filter.GameType != "" {
+		query = query.Where("game_type = ?", filter.GameType)
+	}
+	if filter.Venue != "" {
+		query = query.Where(`LOWER(venue) LIKE ? ESCAPE '\'`, pagination.ContainsPattern(filter.Venue))
+	}
+	if filter.StrategyID != nil {
+		query = query.Where("strategy_id = ?", *filter.StrategyID)
+	}
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+	if filter.From != nil {
+		query = query.Where("started_at >= ?", *filter.From)
+	}
+	if filter.To != nil {
+		query = query.Where("started_at < ?", *filter.To)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, WrapError(ErrDatabaseError, err.Error())
+	}
+
+	if filter.After != nil {
+		query = query.Where(pagination.KeysetCondition("started_at", true),
+			filter.After.StartedAt, filter.After.StartedAt, filter.After.ID)
+	} else if filter.Offset > 0 {
+		query = query.Offset(filter.Offset)
+	}
+
+	var sessions []*Session
+	err := query.Order(pagination.OrderClause("started_at", true)).
+		Limit(filter.Limit).
+		Find(&sessions).Error
+	if err != nil {
+		return nil, 0, WrapError(ErrDatabaseError, err.Error())
+	}
+	return sessions, total, nil
+}
+
+// Start opens a live session, moving the buy-in from the balance to the
+// bankroll's in-play amount.
+func (r *postgresSessionRepository) Start(ctx context.Context, session *Session) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var count int64
+		err := tx.Model(&Session{}).
+			Where("user_id = ? AND venue = ? AND poker_table = ? AND status = ?",
+				session.UserID, session.Venue, session.Table, SessionStatusOpen).
+			Count(&count).Error
+		if err != nil {
+			return WrapError(ErrDatabaseError, err.Error())
+		}
+		if count > 0 {
+			return ErrSessionAlreadyOpen
+		}
+
+		if err := tx.Create(session).Error; err != nil {
+			if isOpenTableViolation(err) {
+				return ErrSessionAlreadyOpen
+			}
+			return WrapError(ErrDatabaseError, err.Error())
+		}
+
+		return moveInPlay(tx, session, session.BuyIn, fmt.Sprintf("Session #%d buy-in", session.ID))
+	})
+}
+
+func (r *postgresSessionRepository) Rebuy(ctx context.Context, session *Session, amount domain.Decimal, addOn bool) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		existing, err := lockSession(tx, session.ID, session.UserID)
+		if err != nil {
+			return err
+		}
+		if !existing.IsOpen() {
+			return ErrSessionNotOpen
+		}
+
+		column, description := "rebuys", fmt.Sprintf("Session #%d rebuy", existing.ID)
+		target := &existing.Rebuys
+		if addOn {
+			column, description = "add_ons", fmt.Sprintf("Session #%d add-on", existing.ID)
+			target = &existing.AddOns
+		}
+		value := target.Add(amount)
+
+		if err := tx.Model(existing).Update(column, value).Error; err != nil {
+			return WrapError(ErrDatabaseError, err.Error())
+		}
+		*target = value
+		if err := moveInPlay(tx, existing, amount, description); err != nil {
+			return err
+		}
+
+		*session = *existing
+		return nil
+	})
+}
+
+// Stop settles a live session: the invested amount leaves in play and the
+// cash-out plus bounties are credited to the balance.
+func (r *postgresSessionRepository) Stop(ctx context.Context, session *Session, close SessionClose) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		existing, err := lockSession(tx, session.ID, session.UserID)
+		if err != nil {
+			return err
+		}
+		if !existing.IsOpen() {
+			return ErrSessionNotOpen
+		}
+
+		existing.CashOut = close.CashOut
+		existing.Bounties = close.Bounties
+		existing.Rake = close.Rake
+		profit := existing.ComputeProfit()
+		endedAt := close.EndedAt
+
+		if err := tx.Model(existing).Updates(map[string]interface{}{
+			"status":      SessionStatusSettled,
+			"ended_at":    &endedAt,
+			"cash_out":    close.CashOut,
+			"bounties":    close.Bounties,
+			"rake":        close.Rake,
+			"profit":      profit,
+			"auto_closed": close.AutoClosed,
+		}).Error; err != nil {
+			return WrapError(ErrDatabaseError, err.Error())
+		}
+
+		if err := bankroll.AdjustInPlay(tx, existing.BankrollID, existing.UserID, existing.Invested().Neg()); err != nil {
+			return mapBankrollError(err)
+		}
+		returned := close.CashOut.Add(close.Bounties)
+		if err := postLedger(tx, existing, bankroll.TransactionTypeSessionCashOut, returned,
+			fmt.Sprintf("Session #%d cash-out", existing.ID)); err != nil {
+			return err
+		}
+
+		*session = *existing
+		return nil
+	})
+}
+
+func (r *postgresSessionRepository) ListStale(ctx context.Context, startedBefore time.Time) ([]*Session, error) {
+	var sessions []*Session
+	err := r.db.WithContext(ctx).
+		Where("status = ? AND started_at < ?", SessionStatusOpen, startedBefore).
+		Order("started_at ASC").
+		Find(&sessions).Error
+	if err != nil {
+		return nil, WrapError(ErrDatabaseError, err.Error())
+	}
+	return sessions, nil
+}
+
+func lockSession(tx *gorm.DB, id uint, userID uint) (*Session, error) {
+	var session Session
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ? AND user_id = ?", id, userID).
+		First(&session).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, ErrSessionNotFound
+		}
+		return nil, WrapError(ErrDatabaseError, err.Error())
+	}
+	return &session, nil
+}
+
+func postResult(tx *gorm.DB, session *Session, amount domain.Decimal, description string) error {
+	return postLedger(tx, session, bankroll.TransactionTypeSessionResult, amount, description)
+}
+
+// moveInPlay debits the balance and holds the amount as in play.
+func moveInPlay(tx *gorm.DB, session *Session, amount domain.Decimal, description string) error {
+	if err := postLedger(tx, session, bankroll.TransactionTypeSessionBuyIn, amount.Neg(), description); err != nil {
+		return err
+	}
+	if err := bankroll.AdjustInPlay(tx, session.BankrollID, session.UserID, amount); err != nil {
+		return mapBankrollError(err)
+	}
+	return nil
+}
+
+func postLedger(tx *gorm.DB, session *Session, transactionType bankroll.TransactionType, amount domain.Decimal, description string) error {
+	if amount.IsZero() {
+		return nil
+	}
+
+	sessionID := session.ID
+	transaction := &bankroll.Transaction{
+		BankrollID:    session.BankrollID,
+		Type:          transactionType,
+		Amount:        amount,
+		Description:   description,
+		ReferenceType: ReferenceTypeSession,
+		ReferenceID:   &sessionID,
+	}
+	if err := bankroll.ApplyTransaction(tx, transaction, session.UserID); err != nil {
+		return mapBankrollError(err)
+	}
+	return nil
+}
+
+func mapBankrollError(err error) error {
+	switch {
+	case errors.Is(err, bankroll.ErrBankrollNotFound):
+		return ErrBankrollNotFound
+	case errors.Is(err, bankroll.ErrInsufficientFunds):
+		return ErrInsufficientFunds
+	default:
+		return WrapError(ErrDatabaseError, err.Error())
+	}
+}
+
+func isOpenTableViolation(err error) bool {
+	return strings.Contains(err.Error(), "uq_open_session_per_table")
+}
@@ -0,0 +1,332 @@
+package session
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/opinedajr/micro-stakes-api/internal/bankroll"
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+func setupTestDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+
+	db.AutoMigrate(&bankroll.Bankroll{}, &bankroll.Transaction{}, &bankroll.BankrollGoal{}, &bankroll.GoalMilestone{}, &Session{})
+
+	return db
+}
+
+func createTestBankroll(t *testing.T, db *gorm.DB, userID uint, balance string) *bankroll.Bankroll {
+	startDate, err := time.Parse("2006-01-02", "2026-02-01")
+	require.NoError(t, err)
+
+	br := &bankroll.Bankroll{
+		UserID:               userID,
+		Name:                 "Poker",
+		Currency:             bankroll.CurrencyUSD,
+		InitialBalance:       domain.MustParseDecimal(balance),
+		CurrentBalance:       domain.MustParseDecimal(balance),
+		StartDate:            startDate,
+		CommissionPercentage: domain.Zero,
+	}
+
+	err = bankroll.NewPostgresBankrollRepository(db).Create(context.Background(), br)
+	require.NoError(t, err)
+
+	return br
+}
+
+func newTestSession(br *bankroll.Bankroll, buyIn string, cashOut string) *Session {
+	startedAt := time.Date(2026, 3, 1, 20, 0, 0, 0, time.UTC)
+	endedAt := startedAt.Add(3 * time.Hour)
+	session := &Session{
+		UserID:     br.UserID,
+		BankrollID: br.ID,
+		GameType:   GameTypeNLHE,
+		Stakes:     "NL50",
+		Venue:      "PokerStars",
+		Status:     SessionStatusSettled,
+		StartedAt:  startedAt,
+		EndedAt:    &endedAt,
+		BuyIn:      domain.MustParseDecimal(buyIn),
+		Rebuys:     domain.Zero,
+		AddOns:     domain.Zero,
+		Bounties:   domain.Zero,
+		CashOut:    domain.MustParseDecimal(cashOut),
+	}
+	session.Profit = session.ComputeProfit()
+	return session
+}
+
+func currentBalance(t *testing.T, db *gorm.DB, br *bankroll.Bankroll) float64 {
+	found, err := bankroll.NewPostgresBankrollRepository(db).FindByID(context.Background(), br.ID, br.UserID)
+	require.NoError(t, err)
+	return found.CurrentBalance.Float64()
+}
+
+func TestPostgresSessionRepository_Create(t *testing.T) {
+	t.Run("winning session credits bankroll", func(t *testing.T) {
+		db := setupTestDB(t)
+		repo := NewPostgresSessionRepository(db)
+		br := createTestBankroll(t, db, 1, "1000.00")
+		session := newTestSession(br, "50.00", "135.50")
+
+		err := repo.Create(context.Background(), session)
+
+		require.NoError(t, err)
+		assert.NotZero(t, session.ID)
+		assert.Equal(t, 1085.50, currentBalance(t, db, br))
+
+		var transaction bankroll.Transaction
+		require.NoError(t, db.Where("reference_type = ? AND reference_id = ?", ReferenceTypeSession, session.ID).First(&transaction).Error)
+		assert.Equal(t, bankroll.TransactionTypeSessionResult, transaction.Type)
+		assert.Equal(t, 85.50, transaction.Amount.Float64())
+	})
+
+	t.Run("losing session beyond balance rolls back", func(t *testing.T) {
+		db := setupTestDB(t)
+		repo := NewPostgresSessionRepository(db)
+		br := createTestBankroll(t, db, 1, "20.00")
+		session := newTestSession(br, "50.00", "0")
+
+		err := repo.Create(context.Background(), session)
+
+		assert.ErrorIs(t, err, ErrInsufficientFunds)
+		var count int64
+		db.Model(&Session{}).Count(&count)
+		assert.Zero(t, count)
+		assert.Equal(t, 20.00, currentBalance(t, db, br))
+	})
+
+	t.Run("bankroll of another user", func(t *testing.T) {
+		db := setupTestDB(t)
+		repo := NewPostgresSessionRepository(db)
+		br := createTestBankroll(t, db, 1, "1000.00")
+		session := newTestSession(br, "50.00", "100.00")
+		session.UserID = 2
+
+		err := repo.Create(context.Background(), session)
+
+		assert.ErrorIs(t, err, ErrBankrollNotFound)
+	})
+}
+
+func TestPostgresSessionRepository_Update(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewPostgresSessionRepository(db)
+	ctx := context.Background()
+	br := createTestBankroll(t, db, 1, "1000.00")
+	session := newTestSession(br, "50.00", "150.00")
+	require.NoError(t, repo.Create(ctx, session))
+
+	updated := newTestSession(br, "50.00", "80.00")
+	updated.ID = session.ID
+	require.NoError(t, repo.Update(ctx, updated))
+
+	assert.Equal(t, 1030.00, currentBalance(t, db, br))
+	found, err := repo.FindByID(ctx, session.ID, 1)
+	require.NoError(t, err)
+	assert.Equal(t, 30.00, found.Profit.Float64())
+
+	other := newTestSession(br, "50.00", "80.00")
+	other.ID = session.ID
+	other.UserID = 2
+	assert.ErrorIs(t, repo.Update(ctx, other), ErrSessionNotFound)
+}
+
+func TestPostgresSessionRepository_Delete(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewPostgresSessionRepository(db)
+	ctx := context.Background()
+	br := createTestBankroll(t, db, 1, "1000.00")
+	session := newTestSession(br, "100.00", "40.00")
+	require.NoError(t, repo.Create(ctx, session))
+	assert.Equal(t, 940.00, currentBalance(t, db, br))
+
+	assert.ErrorIs(t, repo.Delete(ctx, session.ID, 2), ErrSessionNotFound)
+	require.NoError(t, repo.Delete(ctx, session.ID, 1))
+
+	assert.Equal(t, 1000.00, currentBalance(t, db, br))
+	_, err := repo.FindByID(ctx, session.ID, 1)
+	assert.ErrorIs(t, err, ErrSessionNotFound)
+}
+
+func TestPostgresSessionRepository_List(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewPostgresSessionRepository(db)
+	ctx := context.Background()
+	br := createTestBankroll(t, db, 1, "1000.00")
+
+	cash := newTestSession(br, "50.00", "60.00")
+	require.NoError(t, repo.Create(ctx, cash))
+
+	mtt := newTestSession(br, "11.00", "0")
+	mtt.GameType = GameTypeMTT
+	mtt.Venue = "GGPoker"
+	mtt.StartedAt = mtt.StartedAt.AddDate(0, 0, 5)
+	mtt.Profit = mtt.ComputeProfit()
+	require.NoError(t, repo.Create(ctx, mtt))
+
+	all, total, err := repo.List(ctx, 1, SessionFilter{BankrollID: br.ID, Limit: 10})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), total)
+	require.Len(t, all, 2)
+	assert.Equal(t, mtt.ID, all[0].ID)
+
+	byType, _, err := repo.List(ctx, 1, SessionFilter{BankrollID: br.ID, GameType: GameTypeMTT, Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, byType, 1)
+	assert.Equal(t, mtt.ID, byType[0].ID)
+
+	byVenue, _, err := repo.List(ctx, 1, SessionFilter{BankrollID: br.ID, Venue: "stars", Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, byVenue, 1)
+	assert.Equal(t, cash.ID, byVenue[0].ID)
+
+	wildcard, _, err := repo.List(ctx, 1, SessionFilter{BankrollID: br.ID, Venue: "%", Limit: 10})
+	require.NoError(t, err)
+	assert.Empty(t, wildcard, "LIKE wildcards in the venue match literally")
+
+	to := cash.StartedAt.AddDate(0, 0, 1)
+	byDate, _, err := repo.List(ctx, 1, SessionFilter{BankrollID: br.ID, To: &to, Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, byDate, 1)
+	assert.Equal(t, cash.ID, byDate[0].ID)
+
+	firstPage, total, err := repo.List(ctx, 1, SessionFilter{BankrollID: br.ID, Limit: 1})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), total)
+	require.Len(t, firstPage, 1)
+	nextPage, _, err := repo.List(ctx, 1, SessionFilter{
+		BankrollID: br.ID,
+		Limit:      1,
+		After:      &SessionCursor{StartedAt: firstPage[0].StartedAt, ID: firstPage[0].ID},
+	})
+	require.NoError(t, err)
+	require.Len(t, nextPage, 1)
+	assert.Equal(t, cash.ID, nextPage[0].ID)
+
+	otherUser, _, err := repo.List(ctx, 2, SessionFilter{BankrollID: br.ID, Limit: 10})
+	require.NoError(t, err)
+	assert.Empty(t, otherUser)
+}
+
+func newOpenSession(br *bankroll.Bankroll, table string, buyIn string) *Session {
+	return &Session{
+		UserID:     br.UserID,
+		BankrollID: br.ID,
+		GameType:   GameTypeNLHE,
+		Stakes:     "NL50",
+		Venue:      "PokerStars",
+		Table:      table,
+		Status:     SessionStatusOpen,
+		StartedAt:  time.Date(2026, 3, 1, 20, 0, 0, 0, time.UTC),
+		BuyIn:      domain.MustParseDecimal(buyIn),
+		Profit:     domain.MustParseDecimal(buyIn).Neg(),
+	}
+}
+
+func inPlay(t *testing.T, db *gorm.DB, br *bankroll.Bankroll) float64 {
+	found, err := bankroll.NewPostgresBankrollRepository(db).FindByID(context.Background(), br.ID, br.UserID)
+	require.NoError(t, err)
+	return found.InPlay.Float64()
+}
+
+func TestPostgresSessionRepository_LiveSession(t *testing.T) {
+	t.Run("start, rebuy and stop move money through in play", func(t *testing.T) {
+		db := setupTestDB(t)
+		repo := NewPostgresSessionRepository(db)
+		ctx := context.Background()
+		br := createTestBankroll(t, db, 1, "1000.00")
+		session := newOpenSession(br, "Altair II", "50.00")
+
+		require.NoError(t, repo.Start(ctx, session))
+		assert.Equal(t, 950.00, currentBalance(t, db, br))
+		assert.Equal(t, 50.00, inPlay(t, db, br))
+
+		require.NoError(t, repo.Rebuy(ctx, session, domain.MustParseDecimal("50.00"), false))
+		require.NoError(t, repo.Rebuy(ctx, session, domain.MustParseDecimal("10.00"), true))
+		assert.Equal(t, 890.00, currentBalance(t, db, br))
+		assert.Equal(t, 110.00, inPlay(t, db, br))
+		assert.Equal(t, 50.00, session.Rebuys.Float64())
+		assert.Equal(t, 10.00, session.AddOns.Float64())
+
+		endedAt := session.StartedAt.Add(2 * time.Hour)
+		err := repo.Stop(ctx, session, SessionClose{CashOut: domain.MustParseDecimal("200.00"), EndedAt: endedAt})
+		require.NoError(t, err)
+
+		assert.Equal(t, 1090.00, currentBalance(t, db, br))
+		assert.Equal(t, 0.00, inPlay(t, db, br))
+		found, err := repo.FindByID(ctx, session.ID, 1)
+		require.NoError(t, err)
+		assert.Equal(t, SessionStatusSettled, found.Status)
+		assert.Equal(t, 90.00, found.Profit.Float64())
+		require.NotNil(t, found.EndedAt)
+
+		assert.ErrorIs(t, repo.Stop(ctx, session, SessionClose{EndedAt: endedAt}), ErrSessionNotOpen)
+		assert.ErrorIs(t, repo.Rebuy(ctx, session, domain.MustParseDecimal("10.00"), false), ErrSessionNotOpen)
+	})
+
+	t.Run("one open session per table", func(t *testing.T) {
+		db := setupTestDB(t)
+		repo := NewPostgresSessionRepository(db)
+		ctx := context.Background()
+		br := createTestBankroll(t, db, 1, "1000.00")
+
+		require.NoError(t, repo.Start(ctx, newOpenSession(br, "Altair II", "50.00")))
+		assert.ErrorIs(t, repo.Start(ctx, newOpenSession(br, "Altair II", "50.00")), ErrSessionAlreadyOpen)
+		assert.NoError(t, repo.Start(ctx, newOpenSession(br, "Vega", "50.00")))
+		assert.Equal(t, 900.00, currentBalance(t, db, br))
+	})
+
+	t.Run("buy-in above balance", func(t *testing.T) {
+		db := setupTestDB(t)
+		repo := NewPostgresSessionRepository(db)
+		br := createTestBankroll(t, db, 1, "30.00")
+
+		err := repo.Start(context.Background(), newOpenSession(br, "Altair II", "50.00"))
+
+		assert.ErrorIs(t, err, ErrInsufficientFunds)
+		assert.Equal(t, 0.00, inPlay(t, db, br))
+	})
+
+	t.Run("open sessions cannot be edited or deleted", func(t *testing.T) {
+		db := setupTestDB(t)
+		repo := NewPostgresSessionRepository(db)
+		ctx := context.Background()
+		br := createTestBankroll(t, db, 1, "1000.00")
+		session := newOpenSession(br, "Altair II", "50.00")
+		require.NoError(t, repo.Start(ctx, session))
+
+		assert.ErrorIs(t, repo.Delete(ctx, session.ID, 1), ErrSessionOpen)
+		updated := newTestSession(br, "50.00", "80.00")
+		updated.ID = session.ID
+		assert.ErrorIs(t, repo.Update(ctx, updated), ErrSessionOpen)
+	})
+}
+
+func TestPostgresSessionRepository_ListStale(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewPostgresSessionRepository(db)
+	ctx := context.Background()
+	br := createTestBankroll(t, db, 1, "1000.00")
+
+	stale := newOpenSession(br, "Altair II", "50.00")
+	require.NoError(t, repo.Start(ctx, stale))
+	fresh := newOpenSession(br, "Vega", "50.00")
+	fresh.StartedAt = stale.StartedAt.Add(10 * time.Hour)
+	require.NoError(t, repo.Start(ctx, fresh))
+	require.NoError(t, repo.Create(ctx, newTestSession(br, "50.00", "80.00")))
+
+	sessions, err := repo.ListStale(ctx, stale.StartedAt.Add(time.Hour))
+
+	require.NoError(t, err)
+	require.Len(t, sessions, 1)
+	assert.Equal(t, stale.ID, sessions[0].ID)
+}
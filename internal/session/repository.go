@@ -0,0 +1,20 @@
+package session
+
+import (
+	"context"
+	"time"
+
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
+)
+
+type SessionRepository interface {
+	Create(ctx context.Context, session *Session) error
+	Update(ctx context.Context, session *Session) error
+	Delete(ctx context.Context, id uint, userID uint) error
+	FindByID(ctx context.Context, id uint, userID uint) (*Session, error)
+	List(ctx context.Context, userID uint, filter SessionFilter) ([]*Session, int64, error)
+	Start(ctx context.Context, session *Session) error
+	Rebuy(ctx context.Context, session *Session, amount domain.Decimal, addOn bool) error
+	Stop(ctx context.Context, session *Session, close SessionClose) error
+	ListStale(ctx context.Context, startedBefore time.Time) ([]*Session, error)
+}
@@ -0,0 +1,537 @@
+package session
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/opinedajr/micro-stakes-api/internal/bankroll"
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
+	"github.com/opinedajr/micro-stakes-api/internal/shared/pagination"
+	"github.com/opinedajr/micro-stakes-api/internal/strategy"
+)
+
+type SessionService interface {
+	CreateSession(ctx context.Context, userID uint, bankrollID uint, input CreateSessionInput) (*SessionOutput, error)
+	ListSessions(ctx context.Context, userID uint, bankrollID uint, input ListSessionsInput) (*SessionPageOutput, error)
+	GetSession(ctx context.Context, userID uint, sessionID uint) (*SessionOutput, error)
+	UpdateSession(ctx context.Context, userID uint, sessionID uint, input UpdateSessionInput) (*SessionOutput, error)
+	DeleteSession(ctx context.Context, userID uint, sessionID uint) error
+	StartSession(ctx context.Context, userID uint, input StartSessionInput) (*SessionOutput, error)
+	RebuySession(ctx context.Context, userID uint, sessionID uint, input RebuyInput) (*SessionOutput, error)
+	StopSession(ctx context.Context, userID uint, sessionID uint, input StopSessionInput) (*SessionOutput, error)
+}
+
+type sessionService struct {
+	repo         SessionRepository
+	bankrollRepo bankroll.BankrollRepository
+	strategyRepo strategy.StrategyRepository
+	rules        bankroll.RuleEvaluator
+	logger       *slog.Logger
+}
+
+func NewSessionService(repo SessionRepository, bankrollRepo bankroll.BankrollRepository, strategyRepo strategy.StrategyRepository, rules bankroll.RuleEvaluator, logger *slog.Logger) SessionService {
+	return &sessionService{
+		repo:         repo,
+		bankrollRepo: bankrollRepo,
+		strategyRepo: strategyRepo,
+		rules:        rules,
+		logger:       logger,
+	}
+}
+
+func (s *sessionService) CreateSession(ctx context.Context, userID uint, bankrollID uint, input CreateSessionInput) (*SessionOutput, error) {
+	session := &Session{
+		UserID:     userID,
+		BankrollID: bankrollID,
+		StrategyID: input.StrategyID,
+		GameType:   input.GameType,
+		Stakes:     input.Stakes,
+		Venue:      input.Venue,
+		Status:     SessionStatusSettled,
+		BuyIn:      input.BuyIn,
+		Rebuys:     input.Rebuys,
+		AddOns:     input.AddOns,
+		Bounties:   input.Bounties,
+		CashOut:    input.CashOut,
+		Rake:       input.Rake,
+	}
+
+	br, err := s.prepare(ctx, session, input.StartedAt, input.EndedAt)
+	if err != nil {
+		return nil, err
+	}
+	warnings, err := s.checkRules(ctx, br, session, session.BuyIn, domain.Zero)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.Create(ctx, session); err != nil {
+		s.logger.Error("failed to create session", "error", err, "user_id", userID, "bankroll_id", bankrollID)
+		return nil, err
+	}
+
+	s.logger.Info("session created", "user_id", userID, "bankroll_id", bankrollID, "session_id", session.ID, "game_type", session.GameType, "profit", session.Profit)
+
+	output := toSessionOutput(session)
+	output.Warnings = warnings
+	return output, nil
+}
+
+func (s *sessionService) ListSessions(ctx context.Context, userID uint, bankrollID uint, input ListSessionsInput) (*SessionPageOutput, error) {
+	params, err := input.Params.Normalize()
+	if err != nil {
+		s.logger.Error("invalid list parameters", "error", err, "user_id", userID)
+		return nil, WrapError(ErrValidationFailed, err.Error())
+	}
+
+	filter := SessionFilter{
+		BankrollID: bankrollID,
+		GameType:   input.GameType,
+		Venue:      input.Venue,
+		StrategyID: input.StrategyID,
+		Status:     input.Status,
+		Limit:      params.Limit + 1,
+		Offset:     params.Offset,
+	}
+	if params.Cursor != "" {
+		cursor, err := pagination.DecodeCursor(params.Cursor, "started_at", true)
+		if err == nil {
+			var startedAt time.Time
+			startedAt, err = time.Parse(time.RFC3339Nano, cursor.Value)
+			filter.After = &SessionCursor{StartedAt: startedAt, ID: cursor.ID}
+		}
+		if err != nil {
+			s.logger.Error("invalid cursor", "error", err, "user_id", userID)
+			return nil, WrapError(ErrValidationFailed, pagination.ErrInvalidCursor.Error())
+		}
+	}
+	if input.From != "" {
+		from, err := time.Parse("2006-01-02", input.From)
+		if err != nil {
+			s.logger.Error("invalid from date format", "from", input.From, "error", err)
+			return nil, WrapError(ErrValidationFailed, "invalid from date format")
+		}
+		filter.From = &from
+	}
+	if input.To != "" {
+		to, err := time.Parse("2006-01-02", input.To)
+		if err != nil {
+			s.logger.Error("invalid to date format", "to", input.To, "error", err)
+			return nil, WrapError(ErrValidationFailed, "invalid to date format")
+		}
+		to = to.AddDate(0, 0, 1)
+		filter.To = &to
+	}
+
+	if _, err := s.findBankroll(ctx, bankrollID, userID); err != nil {
+		return nil, err
+	}
+
+	sessions, total, err := s.repo.List(ctx, userID, filter)
+	if err != nil {
+		s.logger.Error("failed to list sessions", "error", err, "user_id", userID, "bankroll_id", bankrollID)
+		return nil, err
+	}
+
+	sessions, more := pagination.Trim(sessions, params.Limit)
+	nextCursor := ""
+	if more {
+		last := sessions[len(sessions)-1]
+		nextCursor = pagination.EncodeCursor("started_at", true, last.StartedAt.UTC().Format(time.RFC3339Nano), last.ID)
+	}
+
+	outputs := make([]*SessionOutput, len(sessions))
+	for i, session := range sessions {
+		outputs[i] = toSessionOutput(session)
+	}
+
+	s.logger.Info("sessions listed", "user_id", userID, "bankroll_id", bankrollID, "count", len(outputs), "total", total)
+
+	return pagination.NewPage(outputs, total, params, nextCursor), nil
+}
+
+func (s *sessionService) GetSession(ctx context.Context, userID uint, sessionID uint) (*SessionOutput, error) {
+	session, err := s.repo.FindByID(ctx, sessionID, userID)
+	if err != nil {
+		s.logger.Error("failed to get session", "error", err, "user_id", userID, "session_id", sessionID)
+		return nil, err
+	}
+
+	return toSessionOutput(session), nil
+}
+
+func (s *sessionService) UpdateSession(ctx context.Context, userID uint, sessionID uint, input UpdateSessionInput) (*SessionOutput, error) {
+	existing, err := s.repo.FindByID(ctx, sessionID, userID)
+	if err != nil {
+		s.logger.Error("failed to get session", "error", err, "user_id", userID, "session_id", sessionID)
+		return nil, err
+	}
+	if existing.IsOpen() {
+		s.logger.Error("cannot update open session", "user_id", userID, "session_id", sessionID)
+		return nil, ErrSessionOpen
+	}
+
+	session := &Session{
+		ID:         existing.ID,
+		UserID:     userID,
+		BankrollID: existing.BankrollID,
+		StrategyID: input.StrategyID,
+		GameType:   input.GameType,
+		Stakes:     input.Stakes,
+		Venue:      input.Venue,
+		Status:     existing.Status,
+		BuyIn:      input.BuyIn,
+		Rebuys:     input.Rebuys,
+		AddOns:     input.AddOns,
+		Bounties:   input.Bounties,
+		CashOut:    input.CashOut,
+		Rake:       input.Rake,
+	}
+
+	if _, err := s.prepare(ctx, session, input.StartedAt, input.EndedAt); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.Update(ctx, session); err != nil {
+		s.logger.Error("failed to update session", "error", err, "user_id", userID, "session_id", sessionID)
+		return nil, err
+	}
+
+	s.logger.Info("session updated", "user_id", userID, "session_id", sessionID, "profit", session.Profit)
+
+	return toSessionOutput(session), nil
+}
+
+func (s *sessionService) DeleteSession(ctx context.Context, userID uint, sessionID uint) error {
+	session, err := s.repo.FindByID(ctx, sessionID, userID)
+	if err != nil {
+		s.logger.Error("failed to get session", "error", err, "user_id", userID, "session_id", sessionID)
+		return err
+	}
+
+	if _, err := s.findActiveBankroll(ctx, session.BankrollID, userID); err != nil {
+		return err
+	}
+
+	if err := s.repo.Delete(ctx, sessionID, userID); err != nil {
+		s.logger.Error("failed to delete session", "error", err, "user_id", userID, "session_id", sessionID)
+		return err
+	}
+
+	s.logger.Info("session deleted", "user_id", userID, "session_id", sessionID)
+
+	return nil
+}
+
+func (s *sessionService) StartSession(ctx context.Context, userID uint, input StartSessionInput) (*SessionOutput, error) {
+	if !input.GameType.IsValid() {
+		s.logger.Error("invalid game type", "game_type", input.GameType, "user_id", userID)
+		return nil, ErrInvalidGameType
+	}
+	if input.BuyIn.IsNegative() {
+		s.logger.Error("negative session amount", "amount", input.BuyIn, "user_id", userID)
+		return nil, ErrInvalidAmount
+	}
+
+	startedAt := time.Now().UTC()
+	if input.StartedAt != "" {
+		parsed, err := time.Parse(time.RFC3339, input.StartedAt)
+		if err != nil {
+			s.logger.Error("invalid started_at format", "started_at", input.StartedAt, "error", err)
+			return nil, WrapError(ErrValidationFailed, "invalid started_at format")
+		}
+		startedAt = parsed
+	}
+
+	br, err := s.findActiveBankroll(ctx, input.BankrollID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !br.Currency.Fits(input.BuyIn) {
+		s.logger.Error("amount exceeds currency precision", "amount", input.BuyIn, "currency", br.Currency, "user_id", userID)
+		return nil, ErrInvalidPrecision
+	}
+	if err := s.checkStrategy(ctx, input.StrategyID, userID); err != nil {
+		return nil, err
+	}
+
+	session := &Session{
+		UserID:     userID,
+		BankrollID: input.BankrollID,
+		StrategyID: input.StrategyID,
+		GameType:   input.GameType,
+		Stakes:     input.Stakes,
+		Venue:      input.Venue,
+		Table:      input.Table,
+		Status:     SessionStatusOpen,
+		StartedAt:  startedAt,
+		BuyIn:      input.BuyIn,
+	}
+	session.Profit = session.ComputeProfit()
+
+	warnings, err := s.checkRules(ctx, br, session, session.BuyIn, session.BuyIn)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.Start(ctx, session); err != nil {
+		s.logger.Error("failed to start session", "error", err, "user_id", userID, "bankroll_id", input.BankrollID)
+		return nil, err
+	}
+
+	s.logger.Info("session started", "user_id", userID, "bankroll_id", input.BankrollID, "session_id", session.ID, "table", session.Table)
+
+	output := toSessionOutput(session)
+	output.Warnings = warnings
+	return output, nil
+}
+
+func (s *sessionService) RebuySession(ctx context.Context, userID uint, sessionID uint, input RebuyInput) (*SessionOutput, error) {
+	session, err := s.findOpenSession(ctx, sessionID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !input.Amount.IsPositive() {
+		s.logger.Error("invalid rebuy amount", "amount", input.Amount, "user_id", userID)
+		return nil, ErrInvalidAmount
+	}
+	br, err := s.checkPrecision(ctx, session, input.Amount)
+	if err != nil {
+		return nil, err
+	}
+	warnings, err := s.checkRules(ctx, br, session, input.Amount, input.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.Rebuy(ctx, session, input.Amount, input.AddOn); err != nil {
+		s.logger.Error("failed to rebuy session", "error", err, "user_id", userID, "session_id", sessionID)
+		return nil, err
+	}
+
+	s.logger.Info("session rebuy", "user_id", userID, "session_id", sessionID, "amount", input.Amount, "add_on", input.AddOn)
+
+	output := toSessionOutput(session)
+	output.Warnings = warnings
+	return output, nil
+}
+
+func (s *sessionService) StopSession(ctx context.Context, userID uint, sessionID uint, input StopSessionInput) (*SessionOutput, error) {
+	session, err := s.findOpenSession(ctx, sessionID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if input.CashOut.IsNegative() || input.Bounties.IsNegative() || input.Rake.IsNegative() {
+		s.logger.Error("negative session amount", "cash_out", input.CashOut, "bounties", input.Bounties, "rake", input.Rake, "user_id", userID)
+		return nil, ErrInvalidAmount
+	}
+
+	endedAt := time.Now().UTC()
+	if input.EndedAt != "" {
+		parsed, err := time.Parse(time.RFC3339, input.EndedAt)
+		if err != nil {
+			s.logger.Error("invalid ended_at format", "ended_at", input.EndedAt, "error", err)
+			return nil, WrapError(ErrValidationFailed, "invalid ended_at format")
+		}
+		endedAt = parsed
+	}
+	if endedAt.Before(session.StartedAt) {
+		s.logger.Error("invalid session time range", "started_at", session.StartedAt, "ended_at", endedAt)
+		return nil, ErrInvalidTimeRange
+	}
+	if _, err := s.checkPrecision(ctx, session, input.CashOut, input.Bounties, input.Rake); err != nil {
+		return nil, err
+	}
+
+	close := SessionClose{
+		CashOut:  input.CashOut,
+		Bounties: input.Bounties,
+		Rake:     input.Rake,
+		EndedAt:  endedAt,
+	}
+	if err := s.repo.Stop(ctx, session, close); err != nil {
+		s.logger.Error("failed to stop session", "error", err, "user_id", userID, "session_id", sessionID)
+		return nil, err
+	}
+
+	s.logger.Info("session stopped", "user_id", userID, "session_id", sessionID, "profit", session.Profit)
+
+	return toSessionOutput(session), nil
+}
+
+func (s *sessionService) findOpenSession(ctx context.Context, sessionID uint, userID uint) (*Session, error) {
+	session, err := s.repo.FindByID(ctx, sessionID, userID)
+	if err != nil {
+		s.logger.Error("failed to get session", "error", err, "user_id", userID, "session_id", sessionID)
+		return nil, err
+	}
+	if !session.IsOpen() {
+		s.logger.Error("session is not open", "user_id", userID, "session_id", sessionID)
+		return nil, ErrSessionNotOpen
+	}
+	return session, nil
+}
+
+// checkPrecision checks amounts against the session bankroll's currency and
+// returns the bankroll.
+func (s *sessionService) checkPrecision(ctx context.Context, session *Session, amounts ...domain.Decimal) (*bankroll.Bankroll, error) {
+	br, err := s.findActiveBankroll(ctx, session.BankrollID, session.UserID)
+	if err != nil {
+		return nil, err
+	}
+	for _, amount := range amounts {
+		if !br.Currency.Fits(amount) {
+			s.logger.Error("amount exceeds currency precision", "amount", amount, "currency", br.Currency, "user_id", session.UserID)
+			return nil, ErrInvalidPrecision
+		}
+	}
+	return br, nil
+}
+
+// prepare validates a session against its bankroll and fills in the parsed
+// times and derived profit. It returns the session's bankroll.
+func (s *sessionService) prepare(ctx context.Context, session *Session, startedAt string, endedAt string) (*bankroll.Bankroll, error) {
+	if !session.GameType.IsValid() {
+		s.logger.Error("invalid game type", "game_type", session.GameType, "user_id", session.UserID)
+		return nil, ErrInvalidGameType
+	}
+
+	start, err := time.Parse(time.RFC3339, startedAt)
+	if err != nil {
+		s.logger.Error("invalid started_at format", "started_at", startedAt, "error", err)
+		return nil, WrapError(ErrValidationFailed, "invalid started_at format")
+	}
+	end, err := time.Parse(time.RFC3339, endedAt)
+	if err != nil {
+		s.logger.Error("invalid ended_at format", "ended_at", endedAt, "error", err)
+		return nil, WrapError(ErrValidationFailed, "invalid ended_at format")
+	}
+	if end.Before(start) {
+		s.logger.Error("invalid session time range", "started_at", start, "ended_at", end)
+		return nil, ErrInvalidTimeRange
+	}
+	session.StartedAt = start
+	session.EndedAt = &end
+
+	amounts := []domain.Decimal{session.BuyIn, session.Rebuys, session.AddOns, session.Bounties, session.CashOut, session.Rake}
+	for _, amount := range amounts {
+		if amount.IsNegative() {
+			s.logger.Error("negative session amount", "amount", amount, "user_id", session.UserID)
+			return nil, ErrInvalidAmount
+		}
+	}
+
+	br, err := s.findActiveBankroll(ctx, session.BankrollID, session.UserID)
+	if err != nil {
+		return nil, err
+	}
+	for _, amount := range amounts {
+		if !br.Currency.Fits(amount) {
+			s.logger.Error("amount exceeds currency precision", "amount", amount, "currency", br.Currency, "user_id", session.UserID)
+			return nil, ErrInvalidPrecision
+		}
+	}
+
+	if err := s.checkStrategy(ctx, session.StrategyID, session.UserID); err != nil {
+		return nil, err
+	}
+
+	session.Profit = session.ComputeProfit()
+	return br, nil
+}
+
+func (s *sessionService) findBankroll(ctx context.Context, bankrollID uint, userID uint) (*bankroll.Bankroll, error) {
+	br, err := s.bankrollRepo.FindByID(ctx, bankrollID, userID)
+	if err != nil {
+		s.logger.Error("bankroll not found", "error", err, "user_id", userID, "bankroll_id", bankrollID)
+		if errors.Is(err, bankroll.ErrBankrollNotFound) {
+			return nil, ErrBankrollNotFound
+		}
+		return nil, WrapError(ErrDatabaseError, err.Error())
+	}
+	return br, nil
+}
+
+// findActiveBankroll loads a bankroll money is about to move on. Archived
+// bankrolls are read-only.
+func (s *sessionService) findActiveBankroll(ctx context.Context, bankrollID uint, userID uint) (*bankroll.Bankroll, error) {
+	br, err := s.findBankroll(ctx, bankrollID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if br.IsArchived() {
+		s.logger.Error("bankroll is archived", "user_id", userID, "bankroll_id", bankrollID)
+		return nil, ErrBankrollArchived
+	}
+	return br, nil
+}
+
+// checkRules evaluates the bankroll's rules against a buy-in or rebuy of amount
+// into the session. exposure is the part of it left in play once recorded.
+// Soft rules that would be broken are returned as warnings.
+func (s *sessionService) checkRules(ctx context.Context, br *bankroll.Bankroll, session *Session, amount domain.Decimal, exposure domain.Decimal) ([]bankroll.RuleBreachOutput, error) {
+	check := bankroll.RuleCheck{
+		Activity: bankroll.RuleActivitySession,
+		GameType: string(session.GameType),
+		Stakes:   session.Stakes,
+		Amount:   amount,
+		Exposure: exposure,
+		At:       time.Now(),
+		EndedAt:  session.EndedAt,
+	}
+	warnings, err := s.rules.Evaluate(ctx, br, check)
+	if err != nil {
+		if errors.Is(err, bankroll.ErrRuleViolation) || errors.Is(err, bankroll.ErrLimitReached) {
+			return nil, err
+		}
+		return nil, WrapError(ErrDatabaseError, err.Error())
+	}
+	return warnings, nil
+}
+
+func (s *sessionService) checkStrategy(ctx context.Context, strategyID *uint, userID uint) error {
+	if strategyID == nil {
+		return nil
+	}
+	if _, err := s.strategyRepo.FindByID(ctx, *strategyID, userID); err != nil {
+		s.logger.Error("strategy not found", "error", err, "user_id", userID, "strategy_id", *strategyID)
+		if errors.Is(err, strategy.ErrStrategyNotFound) {
+			return ErrStrategyNotFound
+		}
+		return WrapError(ErrDatabaseError, err.Error())
+	}
+	return nil
+}
+
+func toSessionOutput(session *Session) *SessionOutput {
+	output := &SessionOutput{
+		ID:         session.ID,
+		BankrollID: session.BankrollID,
+		StrategyID: session.StrategyID,
+		GameType:   session.GameType,
+		Stakes:     session.Stakes,
+		Venue:      session.Venue,
+		Table:      session.Table,
+		Status:     session.Status,
+		StartedAt:  session.StartedAt,
+		EndedAt:    session.EndedAt,
+		BuyIn:      session.BuyIn,
+		Rebuys:     session.Rebuys,
+		AddOns:     session.AddOns,
+		Bounties:   session.Bounties,
+		CashOut:    session.CashOut,
+		Rake:       session.Rake,
+		Profit:     session.Profit,
+		AutoClosed: session.AutoClosed,
+		CreatedAt:  session.CreatedAt,
+		UpdatedAt:  session.UpdatedAt,
+	}
+	if session.EndedAt != nil {
+		output.Duration = int64(session.EndedAt.Sub(session.StartedAt).Minutes())
+	} else if session.IsOpen() {
+		output.Duration = int64(time.Since(session.StartedAt).Minutes())
+	}
+	return output
+}
@@ -0,0 +1,665 @@
+package session
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/opinedajr/micro-stakes-api/internal/bankroll"
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
+	"github.com/opinedajr/micro-stakes-api/internal/shared/pagination"
+	"github.com/opinedajr/micro-stakes-api/internal/strategy"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"log/slog"
+)
+
+type MockSessionRepository struct {
+	mock.Mock
+}
+
+func (m *MockSessionRepository) Create(ctx context.Context, session *Session) error {
+	args := m.Called(ctx, session)
+	return args.Error(0)
+}
+
+func (m *MockSessionRepository) Update(ctx context.Context, session *Session) error {
+	args := m.Called(ctx, session)
+	return args.Error(0)
+}
+
+func (m *MockSessionRepository) Delete(ctx context.Context, id uint, userID uint) error {
+	args := m.Called(ctx, id, userID)
+	return args.Error(0)
+}
+
+func (m *MockSessionRepository) FindByID(ctx context.Context, id uint, userID uint) (*Session, error) {
+	args := m.Called(ctx, id, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Session), args.Error(1)
+}
+
+func (m *MockSessionRepository) List(ctx context.Context, userID uint, filter SessionFilter) ([]*Session, int64, error) {
+	args := m.Called(ctx, userID, filter)
+	if args.Get(0) == nil {
+		return nil, 0, args.Error(2)
+	}
+	return args.Get(0).([]*Session), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockSessionRepository) Start(ctx context.Context, session *Session) error {
+	args := m.Called(ctx, session)
+	return args.Error(0)
+}
+
+func (m *MockSessionRepository) Rebuy(ctx context.Context, session *Session, amount domain.Decimal, addOn bool) error {
+	args := m.Called(ctx, session, amount, addOn)
+	return args.Error(0)
+}
+
+func (m *MockSessionRepository) Stop(ctx context.Context, session *Session, close SessionClose) error {
+	args := m.Called(ctx, session, close)
+	return args.Error(0)
+}
+
+func (m *MockSessionRepository) ListStale(ctx context.Context, startedBefore time.Time) ([]*Session, error) {
+	args := m.Called(ctx, startedBefore)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*Session), args.Error(1)
+}
+
+type MockBankrollRepository struct {
+	mock.Mock
+}
+
+func (m *MockBankrollRepository) Create(ctx context.Context, br *bankroll.Bankroll) error {
+	args := m.Called(ctx, br)
+	return args.Error(0)
+}
+
+func (m *MockBankrollRepository) Update(ctx context.Context, br *bankroll.Bankroll) error {
+	args := m.Called(ctx, br)
+	return args.Error(0)
+}
+
+func (m *MockBankrollRepository) ListByUserID(ctx context.Context, userID uint) ([]*bankroll.Bankroll, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*bankroll.Bankroll), args.Error(1)
+}
+
+func (m *MockBankrollRepository) List(ctx context.Context, userID uint, filter bankroll.BankrollListFilter) ([]*bankroll.Bankroll, int64, error) {
+	args := m.Called(ctx, userID, filter)
+	if args.Get(0) == nil {
+		return nil, 0, args.Error(2)
+	}
+	return args.Get(0).([]*bankroll.Bankroll), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockBankrollRepository) FindByID(ctx context.Context, id uint, userID uint) (*bankroll.Bankroll, error) {
+	args := m.Called(ctx, id, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*bankroll.Bankroll), args.Error(1)
+}
+
+func (m *MockBankrollRepository) Reset(ctx context.Context, id uint, userID uint, amount domain.Decimal, expectedVersion uint) (*bankroll.BankrollPeriod, error) {
+	args := m.Called(ctx, id, userID, amount, expectedVersion)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*bankroll.BankrollPeriod), args.Error(1)
+}
+
+func (m *MockBankrollRepository) Delete(ctx context.Context, id uint, userID uint) error {
+	args := m.Called(ctx, id, userID)
+	return args.Error(0)
+}
+
+func (m *MockBankrollRepository) Archive(ctx context.Context, id uint, userID uint) error {
+	args := m.Called(ctx, id, userID)
+	return args.Error(0)
+}
+
+func (m *MockBankrollRepository) Restore(ctx context.Context, id uint, userID uint) error {
+	args := m.Called(ctx, id, userID)
+	return args.Error(0)
+}
+
+type MockStrategyRepository struct {
+	mock.Mock
+}
+
+func (m *MockStrategyRepository) Create(ctx context.Context, s *strategy.Strategy) error {
+	args := m.Called(ctx, s)
+	return args.Error(0)
+}
+
+func (m *MockStrategyRepository) Update(ctx context.Context, s *strategy.Strategy) error {
+	args := m.Called(ctx, s)
+	return args.Error(0)
+}
+
+func (m *MockStrategyRepository) Delete(ctx context.Context, id uint, userID uint) error {
+	args := m.Called(ctx, id, userID)
+	return args.Error(0)
+}
+
+func (m *MockStrategyRepository) ListByUserID(ctx context.Context, userID uint) ([]*strategy.Strategy, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*strategy.Strategy), args.Error(1)
+}
+
+func (m *MockStrategyRepository) FindByID(ctx context.Context, id uint, userID uint) (*strategy.Strategy, error) {
+	args := m.Called(ctx, id, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*strategy.Strategy), args.Error(1)
+}
+
+func (m *MockStrategyRepository) Performance(ctx context.Context, id uint, userID uint) (*strategy.Performance, error) {
+	args := m.Called(ctx, id, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*strategy.Performance), args.Error(1)
+}
+
+type MockRuleEvaluator struct {
+	mock.Mock
+}
+
+func (m *MockRuleEvaluator) Evaluate(ctx context.Context, br *bankroll.Bankroll, check bankroll.RuleCheck) ([]bankroll.RuleBreachOutput, error) {
+	args := m.Called(ctx, br, check)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]bankroll.RuleBreachOutput), args.Error(1)
+}
+
+func noRules() *MockRuleEvaluator {
+	rules := new(MockRuleEvaluator)
+	rules.On("Evaluate", mock.Anything, mock.Anything, mock.Anything).Return(nil, nil)
+	return rules
+}
+
+func testBankroll() *bankroll.Bankroll {
+	return &bankroll.Bankroll{
+		ID:             1,
+		UserID:         1,
+		Currency:       bankroll.CurrencyUSD,
+		CurrentBalance: domain.MustParseDecimal("1000.00"),
+	}
+}
+
+func validCreateInput() CreateSessionInput {
+	return CreateSessionInput{
+		GameType:  GameTypeMTT,
+		Stakes:    "$22",
+		Venue:     "GGPoker",
+		StartedAt: "2026-03-01T18:00:00Z",
+		EndedAt:   "2026-03-01T23:30:00Z",
+		BuyIn:     domain.MustParseDecimal("22.00"),
+		Rebuys:    domain.MustParseDecimal("22.00"),
+		AddOns:    domain.MustParseDecimal("11.00"),
+		Bounties:  domain.MustParseDecimal("15.50"),
+		CashOut:   domain.MustParseDecimal("120.00"),
+	}
+}
+
+func newTestService() (SessionService, *MockSessionRepository, *MockBankrollRepository, *MockStrategyRepository) {
+	mockRepo := new(MockSessionRepository)
+	mockBankrollRepo := new(MockBankrollRepository)
+	mockStrategyRepo := new(MockStrategyRepository)
+	return NewSessionService(mockRepo, mockBankrollRepo, mockStrategyRepo, noRules(), slog.Default()), mockRepo, mockBankrollRepo, mockStrategyRepo
+}
+
+func TestCreateSession(t *testing.T) {
+	t.Run("success - computes profit and duration", func(t *testing.T) {
+		service, mockRepo, mockBankrollRepo, _ := newTestService()
+		ctx := context.Background()
+
+		mockBankrollRepo.On("FindByID", ctx, uint(1), uint(1)).Return(testBankroll(), nil).Once()
+		mockRepo.On("Create", ctx, mock.MatchedBy(func(session *Session) bool {
+			return session.Profit.Float64() == 80.50 && session.Status == SessionStatusSettled && session.EndedAt != nil
+		})).Return(nil).Once()
+
+		output, err := service.CreateSession(ctx, 1, 1, validCreateInput())
+
+		assert.NoError(t, err)
+		assert.Equal(t, 80.50, output.Profit.Float64())
+		assert.Equal(t, int64(330), output.Duration)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("success - soft rule returned as warning", func(t *testing.T) {
+		mockRepo := new(MockSessionRepository)
+		mockBankrollRepo := new(MockBankrollRepository)
+		mockRules := new(MockRuleEvaluator)
+		service := NewSessionService(mockRepo, mockBankrollRepo, new(MockStrategyRepository), mockRules, slog.Default())
+		ctx := context.Background()
+		warning := bankroll.RuleBreachOutput{RuleID: 3, Type: bankroll.RuleTypeMinBuyIns, Severity: bankroll.RuleSeveritySoft}
+
+		mockBankrollRepo.On("FindByID", ctx, uint(1), uint(1)).Return(testBankroll(), nil).Once()
+		mockRules.On("Evaluate", ctx, mock.Anything, mock.MatchedBy(func(check bankroll.RuleCheck) bool {
+			return check.Activity == bankroll.RuleActivitySession && check.GameType == "mtt" &&
+				check.Stakes == "$22" && check.Amount.Float64() == 22.00 && check.Exposure.IsZero() &&
+				check.EndedAt != nil && check.EndedAt.Equal(time.Date(2026, 3, 1, 23, 30, 0, 0, time.UTC))
+		})).Return([]bankroll.RuleBreachOutput{warning}, nil).Once()
+		mockRepo.On("Create", ctx, mock.Anything).Return(nil).Once()
+
+		output, err := service.CreateSession(ctx, 1, 1, validCreateInput())
+
+		assert.NoError(t, err)
+		assert.Equal(t, []bankroll.RuleBreachOutput{warning}, output.Warnings)
+		mockRules.AssertExpectations(t)
+	})
+
+	t.Run("validation error - game type", func(t *testing.T) {
+		service, mockRepo, _, _ := newTestService()
+		input := validCreateInput()
+		input.GameType = "stud"
+
+		_, err := service.CreateSession(context.Background(), 1, 1, input)
+
+		assert.ErrorIs(t, err, ErrInvalidGameType)
+		mockRepo.AssertNotCalled(t, "Create")
+	})
+
+	t.Run("validation error - end before start", func(t *testing.T) {
+		service, _, _, _ := newTestService()
+		input := validCreateInput()
+		input.EndedAt = "2026-03-01T17:00:00Z"
+
+		_, err := service.CreateSession(context.Background(), 1, 1, input)
+
+		assert.ErrorIs(t, err, ErrInvalidTimeRange)
+	})
+
+	t.Run("validation error - bad timestamp", func(t *testing.T) {
+		service, _, _, _ := newTestService()
+		input := validCreateInput()
+		input.StartedAt = "2026-03-01"
+
+		_, err := service.CreateSession(context.Background(), 1, 1, input)
+
+		assert.ErrorIs(t, err, ErrValidationFailed)
+	})
+
+	t.Run("validation error - negative amount", func(t *testing.T) {
+		service, _, _, _ := newTestService()
+		input := validCreateInput()
+		input.Rebuys = domain.MustParseDecimal("-1")
+
+		_, err := service.CreateSession(context.Background(), 1, 1, input)
+
+		assert.ErrorIs(t, err, ErrInvalidAmount)
+	})
+
+	t.Run("error - precision", func(t *testing.T) {
+		service, _, mockBankrollRepo, _ := newTestService()
+		input := validCreateInput()
+		input.CashOut = domain.MustParseDecimal("120.001")
+
+		mockBankrollRepo.On("FindByID", mock.Anything, uint(1), uint(1)).Return(testBankroll(), nil).Once()
+
+		_, err := service.CreateSession(context.Background(), 1, 1, input)
+
+		assert.ErrorIs(t, err, ErrInvalidPrecision)
+	})
+
+	t.Run("error - bankroll not owned", func(t *testing.T) {
+		service, _, mockBankrollRepo, _ := newTestService()
+
+		mockBankrollRepo.On("FindByID", mock.Anything, uint(1), uint(2)).Return(nil, bankroll.ErrBankrollNotFound).Once()
+
+		_, err := service.CreateSession(context.Background(), 2, 1, validCreateInput())
+
+		assert.ErrorIs(t, err, ErrBankrollNotFound)
+	})
+
+	t.Run("error - strategy not owned", func(t *testing.T) {
+		service, _, mockBankrollRepo, mockStrategyRepo := newTestService()
+		input := validCreateInput()
+		strategyID := uint(9)
+		input.StrategyID = &strategyID
+
+		mockBankrollRepo.On("FindByID", mock.Anything, uint(1), uint(1)).Return(testBankroll(), nil).Once()
+		mockStrategyRepo.On("FindByID", mock.Anything, uint(9), uint(1)).Return(nil, strategy.ErrStrategyNotFound).Once()
+
+		_, err := service.CreateSession(context.Background(), 1, 1, input)
+
+		assert.ErrorIs(t, err, ErrStrategyNotFound)
+	})
+}
+
+func TestListSessions(t *testing.T) {
+	t.Run("success - parses date filters", func(t *testing.T) {
+		service, mockRepo, mockBankrollRepo, _ := newTestService()
+		ctx := context.Background()
+
+		mockBankrollRepo.On("FindByID", ctx, uint(1), uint(1)).Return(testBankroll(), nil).Once()
+		mockRepo.On("List", ctx, uint(1), mock.MatchedBy(func(filter SessionFilter) bool {
+			return filter.BankrollID == 1 && filter.GameType == GameTypeNLHE &&
+				filter.From != nil && filter.From.Format("2006-01-02") == "2026-03-01" &&
+				filter.To != nil && filter.To.Format("2006-01-02") == "2026-04-01" &&
+				filter.Limit == pagination.DefaultLimit+1
+		})).Return([]*Session{{ID: 1}}, int64(1), nil).Once()
+
+		page, err := service.ListSessions(ctx, 1, 1, ListSessionsInput{GameType: GameTypeNLHE, From: "2026-03-01", To: "2026-03-31"})
+
+		assert.NoError(t, err)
+		assert.Len(t, page.Items, 1)
+		assert.Empty(t, page.NextCursor)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("success - returns cursor when more sessions follow", func(t *testing.T) {
+		service, mockRepo, mockBankrollRepo, _ := newTestService()
+		ctx := context.Background()
+		startedAt := time.Date(2026, 3, 2, 20, 0, 0, 0, time.UTC)
+
+		mockBankrollRepo.On("FindByID", ctx, uint(1), uint(1)).Return(testBankroll(), nil).Once()
+		mockRepo.On("List", ctx, uint(1), SessionFilter{BankrollID: 1, Limit: 2}).Return([]*Session{
+			{ID: 7, StartedAt: startedAt},
+			{ID: 6, StartedAt: startedAt.Add(-time.Hour)},
+		}, int64(4), nil).Once()
+
+		page, err := service.ListSessions(ctx, 1, 1, ListSessionsInput{Params: pagination.Params{Limit: 1}})
+
+		assert.NoError(t, err)
+		require.Len(t, page.Items, 1)
+		assert.Equal(t, int64(4), page.Total)
+		assert.Equal(t, pagination.EncodeCursor("started_at", true, startedAt.Format(time.RFC3339Nano), 7), page.NextCursor)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("validation error - bad pagination", func(t *testing.T) {
+		service, _, _, _ := newTestService()
+
+		_, err := service.ListSessions(context.Background(), 1, 1, ListSessionsInput{Params: pagination.Params{Limit: 101}})
+		assert.ErrorIs(t, err, ErrValidationFailed)
+
+		_, err = service.ListSessions(context.Background(), 1, 1, ListSessionsInput{Params: pagination.Params{Cursor: "garbage"}})
+		assert.ErrorIs(t, err, ErrValidationFailed)
+	})
+
+	t.Run("validation error - bad date", func(t *testing.T) {
+		service, _, mockBankrollRepo, _ := newTestService()
+
+		mockBankrollRepo.On("FindByID", mock.Anything, uint(1), uint(1)).Return(testBankroll(), nil).Once()
+
+		_, err := service.ListSessions(context.Background(), 1, 1, ListSessionsInput{From: "03/01/2026"})
+
+		assert.ErrorIs(t, err, ErrValidationFailed)
+	})
+}
+
+func TestUpdateSession(t *testing.T) {
+	t.Run("success - keeps bankroll", func(t *testing.T) {
+		service, mockRepo, mockBankrollRepo, _ := newTestService()
+		ctx := context.Background()
+		input := validCreateInput()
+
+		mockRepo.On("FindByID", ctx, uint(5), uint(1)).Return(&Session{ID: 5, UserID: 1, BankrollID: 1, Status: SessionStatusSettled}, nil).Once()
+		mockBankrollRepo.On("FindByID", ctx, uint(1), uint(1)).Return(testBankroll(), nil).Once()
+		mockRepo.On("Update", ctx, mock.MatchedBy(func(session *Session) bool {
+			return session.ID == 5 && session.BankrollID == 1 && session.Profit.Float64() == 80.50
+		})).Return(nil).Once()
+
+		output, err := service.UpdateSession(ctx, 1, 5, UpdateSessionInput(input))
+
+		assert.NoError(t, err)
+		assert.Equal(t, uint(5), output.ID)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		service, mockRepo, _, _ := newTestService()
+
+		mockRepo.On("FindByID", mock.Anything, uint(5), uint(2)).Return(nil, ErrSessionNotFound).Once()
+
+		_, err := service.UpdateSession(context.Background(), 2, 5, UpdateSessionInput(validCreateInput()))
+
+		assert.ErrorIs(t, err, ErrSessionNotFound)
+	})
+
+	t.Run("open session", func(t *testing.T) {
+		service, mockRepo, _, _ := newTestService()
+
+		mockRepo.On("FindByID", mock.Anything, uint(5), uint(1)).Return(&Session{ID: 5, UserID: 1, Status: SessionStatusOpen}, nil).Once()
+
+		_, err := service.UpdateSession(context.Background(), 1, 5, UpdateSessionInput(validCreateInput()))
+
+		assert.ErrorIs(t, err, ErrSessionOpen)
+		mockRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+}
+
+func TestDeleteSession(t *testing.T) {
+	t.Run("repository error", func(t *testing.T) {
+		service, mockRepo, mockBankrollRepo, _ := newTestService()
+
+		mockRepo.On("FindByID", mock.Anything, uint(5), uint(1)).Return(&Session{ID: 5, UserID: 1, BankrollID: 1, Status: SessionStatusSettled}, nil).Once()
+		mockBankrollRepo.On("FindByID", mock.Anything, uint(1), uint(1)).Return(testBankroll(), nil).Once()
+		mockRepo.On("Delete", mock.Anything, uint(5), uint(1)).Return(ErrInsufficientFunds).Once()
+
+		err := service.DeleteSession(context.Background(), 1, 5)
+
+		assert.ErrorIs(t, err, ErrInsufficientFunds)
+	})
+
+	t.Run("archived bankroll", func(t *testing.T) {
+		service, mockRepo, mockBankrollRepo, _ := newTestService()
+		archived := testBankroll()
+		archivedAt := time.Now()
+		archived.ArchivedAt = &archivedAt
+
+		mockRepo.On("FindByID", mock.Anything, uint(5), uint(1)).Return(&Session{ID: 5, UserID: 1, BankrollID: 1, Status: SessionStatusSettled}, nil).Once()
+		mockBankrollRepo.On("FindByID", mock.Anything, uint(1), uint(1)).Return(archived, nil).Once()
+
+		err := service.DeleteSession(context.Background(), 1, 5)
+
+		assert.ErrorIs(t, err, ErrBankrollArchived)
+		mockRepo.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func validStartInput() StartSessionInput {
+	return StartSessionInput{
+		BankrollID: 1,
+		GameType:   GameTypeNLHE,
+		Stakes:     "NL50",
+		Venue:      "PokerStars",
+		Table:      "Altair II",
+		StartedAt:  "2026-03-01T20:00:00Z",
+		BuyIn:      domain.MustParseDecimal("50.00"),
+	}
+}
+
+func openSession() *Session {
+	return &Session{
+		ID:         5,
+		UserID:     1,
+		BankrollID: 1,
+		Status:     SessionStatusOpen,
+		StartedAt:  time.Date(2026, 3, 1, 20, 0, 0, 0, time.UTC),
+		BuyIn:      domain.MustParseDecimal("50.00"),
+	}
+}
+
+func TestStartSession(t *testing.T) {
+	t.Run("success - opens session", func(t *testing.T) {
+		service, mockRepo, mockBankrollRepo, _ := newTestService()
+		ctx := context.Background()
+
+		mockBankrollRepo.On("FindByID", ctx, uint(1), uint(1)).Return(testBankroll(), nil).Once()
+		mockRepo.On("Start", ctx, mock.MatchedBy(func(session *Session) bool {
+			return session.Status == SessionStatusOpen && session.Table == "Altair II" &&
+				session.EndedAt == nil && session.Profit.Float64() == -50.00
+		})).Return(nil).Once()
+
+		output, err := service.StartSession(ctx, 1, validStartInput())
+
+		assert.NoError(t, err)
+		assert.Equal(t, SessionStatusOpen, output.Status)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("error - hard rule violated", func(t *testing.T) {
+		mockRepo := new(MockSessionRepository)
+		mockBankrollRepo := new(MockBankrollRepository)
+		mockRules := new(MockRuleEvaluator)
+		service := NewSessionService(mockRepo, mockBankrollRepo, new(MockStrategyRepository), mockRules, slog.Default())
+		violation := &bankroll.RuleViolation{Breach: bankroll.RuleBreachOutput{RuleID: 3, Type: bankroll.RuleTypeMaxOpenExposure}}
+
+		mockBankrollRepo.On("FindByID", mock.Anything, uint(1), uint(1)).Return(testBankroll(), nil).Once()
+		mockRules.On("Evaluate", mock.Anything, mock.Anything, mock.MatchedBy(func(check bankroll.RuleCheck) bool {
+			return check.Exposure.Float64() == 50.00
+		})).Return(nil, violation).Once()
+
+		output, err := service.StartSession(context.Background(), 1, validStartInput())
+
+		assert.Nil(t, output)
+		assert.ErrorIs(t, err, bankroll.ErrRuleViolation)
+		mockRepo.AssertNotCalled(t, "Start", mock.Anything, mock.Anything)
+	})
+
+	t.Run("table already open", func(t *testing.T) {
+		service, mockRepo, mockBankrollRepo, _ := newTestService()
+
+		mockBankrollRepo.On("FindByID", mock.Anything, uint(1), uint(1)).Return(testBankroll(), nil).Once()
+		mockRepo.On("Start", mock.Anything, mock.Anything).Return(ErrSessionAlreadyOpen).Once()
+
+		_, err := service.StartSession(context.Background(), 1, validStartInput())
+
+		assert.ErrorIs(t, err, ErrSessionAlreadyOpen)
+	})
+
+	t.Run("archived bankroll", func(t *testing.T) {
+		service, mockRepo, mockBankrollRepo, _ := newTestService()
+		archived := testBankroll()
+		archivedAt := time.Now()
+		archived.ArchivedAt = &archivedAt
+
+		mockBankrollRepo.On("FindByID", mock.Anything, uint(1), uint(1)).Return(archived, nil).Once()
+
+		_, err := service.StartSession(context.Background(), 1, validStartInput())
+
+		assert.ErrorIs(t, err, ErrBankrollArchived)
+		mockRepo.AssertNotCalled(t, "Start", mock.Anything, mock.Anything)
+	})
+
+	t.Run("invalid started_at", func(t *testing.T) {
+		service, _, _, _ := newTestService()
+		input := validStartInput()
+		input.StartedAt = "yesterday"
+
+		_, err := service.StartSession(context.Background(), 1, input)
+
+		assert.ErrorIs(t, err, ErrValidationFailed)
+	})
+}
+
+func TestRebuySession(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		service, mockRepo, mockBankrollRepo, _ := newTestService()
+		ctx := context.Background()
+		amount := domain.MustParseDecimal("50.00")
+
+		mockRepo.On("FindByID", ctx, uint(5), uint(1)).Return(openSession(), nil).Once()
+		mockBankrollRepo.On("FindByID", ctx, uint(1), uint(1)).Return(testBankroll(), nil).Once()
+		mockRepo.On("Rebuy", ctx, mock.AnythingOfType("*session.Session"), amount, false).Return(nil).Once()
+
+		_, err := service.RebuySession(ctx, 1, 5, RebuyInput{Amount: amount})
+
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("error - hard rule violated", func(t *testing.T) {
+		mockRepo := new(MockSessionRepository)
+		mockBankrollRepo := new(MockBankrollRepository)
+		mockRules := new(MockRuleEvaluator)
+		service := NewSessionService(mockRepo, mockBankrollRepo, new(MockStrategyRepository), mockRules, slog.Default())
+		violation := &bankroll.RuleViolation{Breach: bankroll.RuleBreachOutput{RuleID: 3, Type: bankroll.RuleTypeMaxOpenExposure}}
+
+		mockRepo.On("FindByID", mock.Anything, uint(5), uint(1)).Return(openSession(), nil).Once()
+		mockBankrollRepo.On("FindByID", mock.Anything, uint(1), uint(1)).Return(testBankroll(), nil).Once()
+		mockRules.On("Evaluate", mock.Anything, mock.Anything, mock.MatchedBy(func(check bankroll.RuleCheck) bool {
+			return check.Amount.Float64() == 75.00 && check.Exposure.Float64() == 75.00 && check.EndedAt == nil
+		})).Return(nil, violation).Once()
+
+		output, err := service.RebuySession(context.Background(), 1, 5, RebuyInput{Amount: domain.MustParseDecimal("75.00")})
+
+		assert.Nil(t, output)
+		assert.ErrorIs(t, err, bankroll.ErrRuleViolation)
+		mockRepo.AssertNotCalled(t, "Rebuy", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("settled session", func(t *testing.T) {
+		service, mockRepo, _, _ := newTestService()
+
+		mockRepo.On("FindByID", mock.Anything, uint(5), uint(1)).Return(&Session{ID: 5, UserID: 1, Status: SessionStatusSettled}, nil).Once()
+
+		_, err := service.RebuySession(context.Background(), 1, 5, RebuyInput{Amount: domain.MustParseDecimal("50.00")})
+
+		assert.ErrorIs(t, err, ErrSessionNotOpen)
+	})
+}
+
+func TestStopSession(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		service, mockRepo, mockBankrollRepo, _ := newTestService()
+		ctx := context.Background()
+
+		mockRepo.On("FindByID", ctx, uint(5), uint(1)).Return(openSession(), nil).Once()
+		mockBankrollRepo.On("FindByID", ctx, uint(1), uint(1)).Return(testBankroll(), nil).Once()
+		mockRepo.On("Stop", ctx, mock.AnythingOfType("*session.Session"), mock.MatchedBy(func(close SessionClose) bool {
+			return close.CashOut.Float64() == 120.00 && close.Rake.Float64() == 6.50 && !close.AutoClosed &&
+				close.EndedAt.Equal(time.Date(2026, 3, 1, 23, 0, 0, 0, time.UTC))
+		})).Return(nil).Once()
+
+		_, err := service.StopSession(ctx, 1, 5, StopSessionInput{
+			CashOut: domain.MustParseDecimal("120.00"),
+			Rake:    domain.MustParseDecimal("6.50"),
+			EndedAt: "2026-03-01T23:00:00Z",
+		})
+
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("end before start", func(t *testing.T) {
+		service, mockRepo, _, _ := newTestService()
+
+		mockRepo.On("FindByID", mock.Anything, uint(5), uint(1)).Return(openSession(), nil).Once()
+
+		_, err := service.StopSession(context.Background(), 1, 5, StopSessionInput{EndedAt: "2026-03-01T19:00:00Z"})
+
+		assert.ErrorIs(t, err, ErrInvalidTimeRange)
+	})
+
+	t.Run("negative rake", func(t *testing.T) {
+		service, mockRepo, _, _ := newTestService()
+
+		mockRepo.On("FindByID", mock.Anything, uint(5), uint(1)).Return(openSession(), nil).Once()
+
+		_, err := service.StopSession(context.Background(), 1, 5, StopSessionInput{Rake: domain.MustParseDecimal("-1.00")})
+
+		assert.ErrorIs(t, err, ErrInvalidAmount)
+		mockRepo.AssertNotCalled(t, "Stop", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
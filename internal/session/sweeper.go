@@ -0,0 +1,69 @@
+package session
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+)
+
+// Sweeper closes live sessions that have been open longer than the stale
+// timeout. They are settled at break-even so the in-play amount returns to
+// the balance untouched and the player can correct the figures afterwards.
+type Sweeper struct {
+	repo         SessionRepository
+	staleTimeout time.Duration
+	interval     time.Duration
+	logger       *slog.Logger
+}
+
+func NewSweeper(repo SessionRepository, staleTimeout time.Duration, interval time.Duration, logger *slog.Logger) *Sweeper {
+	return &Sweeper{
+		repo:         repo,
+		staleTimeout: staleTimeout,
+		interval:     interval,
+		logger:       logger,
+	}
+}
+
+func (s *Sweeper) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.Sweep(ctx, time.Now().UTC())
+		}
+	}
+}
+
+// Sweep auto-closes every session started before now minus the stale
+// timeout and returns how many were closed.
+func (s *Sweeper) Sweep(ctx context.Context, now time.Time) int {
+	sessions, err := s.repo.ListStale(ctx, now.Add(-s.staleTimeout))
+	if err != nil {
+		s.logger.Error("failed to list stale sessions", "error", err)
+		return 0
+	}
+
+	closed := 0
+	for _, session := range sessions {
+		close := SessionClose{
+			CashOut:    session.Invested(),
+			EndedAt:    now,
+			AutoClosed: true,
+		}
+		if err := s.repo.Stop(ctx, session, close); err != nil {
+			if !errors.Is(err, ErrSessionNotOpen) {
+				s.logger.Error("failed to auto-close session", "error", err, "session_id", session.ID, "user_id", session.UserID)
+			}
+			continue
+		}
+		closed++
+		s.logger.Info("stale session auto-closed", "session_id", session.ID, "user_id", session.UserID, "started_at", session.StartedAt)
+	}
+	return closed
+}
@@ -0,0 +1,47 @@
+package session
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSweeper_Sweep(t *testing.T) {
+	t.Run("closes stale sessions at break-even", func(t *testing.T) {
+		mockRepo := new(MockSessionRepository)
+		sweeper := NewSweeper(mockRepo, 12*time.Hour, time.Minute, slog.Default())
+		ctx := context.Background()
+		now := time.Date(2026, 3, 2, 12, 0, 0, 0, time.UTC)
+		stale := openSession()
+		stale.Rebuys = domain.MustParseDecimal("25.00")
+
+		mockRepo.On("ListStale", ctx, now.Add(-12*time.Hour)).Return([]*Session{stale}, nil).Once()
+		mockRepo.On("Stop", ctx, stale, SessionClose{
+			CashOut:    domain.MustParseDecimal("75.00"),
+			EndedAt:    now,
+			AutoClosed: true,
+		}).Return(nil).Once()
+
+		closed := sweeper.Sweep(ctx, now)
+
+		assert.Equal(t, 1, closed)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("skips sessions stopped meanwhile", func(t *testing.T) {
+		mockRepo := new(MockSessionRepository)
+		sweeper := NewSweeper(mockRepo, 12*time.Hour, time.Minute, slog.Default())
+
+		mockRepo.On("ListStale", mock.Anything, mock.Anything).Return([]*Session{openSession()}, nil).Once()
+		mockRepo.On("Stop", mock.Anything, mock.Anything, mock.Anything).Return(ErrSessionNotOpen).Once()
+
+		closed := sweeper.Sweep(context.Background(), time.Now())
+
+		assert.Equal(t, 0, closed)
+	})
+}
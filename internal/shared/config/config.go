@@ -8,10 +8,15 @@ import (
 )
 
 type Config struct {
-	Server   ServerConfig
-	Database DatabaseConfig
-	Keycloak KeycloakConfig
-	Logging  LoggingConfig
+	Server      ServerConfig
+	Database    DatabaseConfig
+	Keycloak    KeycloakConfig
+	Logging     LoggingConfig
+	Sessions    SessionConfig
+	Idempotency IdempotencyConfig
+	FX          FXConfig
+	Currencies  CurrencyConfig
+	Rakeback    RakebackConfig
 }
 
 type ServerConfig struct {
@@ -34,6 +39,7 @@ type KeycloakConfig struct {
 	AdminUser     string        `env:"KEYCLOAK_ADMIN_USER,required"`
 	AdminPassword string        `env:"KEYCLOAK_ADMIN_PASSWORD,required"`
 	AdminRealm    string        `env:"KEYCLOAK_ADMIN_REALM,required"`
+	AdminRole     string        `env:"KEYCLOAK_ADMIN_ROLE" envDefault:"admin"`
 	Timeout       time.Duration `env:"KEYCLOAK_TIMEOUT" envDefault:"10s"`
 }
 
@@ -41,6 +47,32 @@ type LoggingConfig struct {
 	Level string `env:"LOG_LEVEL" envDefault:"error"`
 }
 
+type SessionConfig struct {
+	StaleTimeout  time.Duration `env:"SESSION_STALE_TIMEOUT" envDefault:"12h"`
+	SweepInterval time.Duration `env:"SESSION_SWEEP_INTERVAL" envDefault:"5m"`
+}
+
+type IdempotencyConfig struct {
+	TTL           time.Duration `env:"IDEMPOTENCY_KEY_TTL" envDefault:"24h"`
+	PendingLease  time.Duration `env:"IDEMPOTENCY_PENDING_LEASE" envDefault:"1m"`
+	PurgeInterval time.Duration `env:"IDEMPOTENCY_PURGE_INTERVAL" envDefault:"1h"`
+}
+
+type FXConfig struct {
+	ProviderURL   string        `env:"FX_PROVIDER_URL" envDefault:"https://www.ecb.europa.eu/stats/eurofxref"`
+	ProviderDir   string        `env:"FX_PROVIDER_DIR"`
+	FetchInterval time.Duration `env:"FX_FETCH_INTERVAL" envDefault:"6h"`
+	FetchTimeout  time.Duration `env:"FX_FETCH_TIMEOUT" envDefault:"30s"`
+}
+
+type CurrencyConfig struct {
+	RefreshInterval time.Duration `env:"CURRENCY_REFRESH_INTERVAL" envDefault:"5m"`
+}
+
+type RakebackConfig struct {
+	PayoutInterval time.Duration `env:"RAKEBACK_PAYOUT_INTERVAL" envDefault:"1h"`
+}
+
 func Load() (*Config, error) {
 	_ = godotenv.Load()
 	cfg := &Config{}
@@ -3,6 +3,7 @@ package config
 import (
 	"os"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -102,6 +103,17 @@ func TestConfig_Load_Success(t *testing.T) {
 				assert.NotNil(t, cfg)
 				assert.Equal(t, "3003", cfg.Server.Port)
 				assert.Equal(t, "error", cfg.Logging.Level)
+				assert.Equal(t, 12*time.Hour, cfg.Sessions.StaleTimeout)
+				assert.Equal(t, 5*time.Minute, cfg.Sessions.SweepInterval)
+				assert.Equal(t, 24*time.Hour, cfg.Idempotency.TTL)
+				assert.Equal(t, time.Minute, cfg.Idempotency.PendingLease)
+				assert.Equal(t, time.Hour, cfg.Idempotency.PurgeInterval)
+				assert.Equal(t, "admin", cfg.Keycloak.AdminRole)
+				assert.Equal(t, "https://www.ecb.europa.eu/stats/eurofxref", cfg.FX.ProviderURL)
+				assert.Empty(t, cfg.FX.ProviderDir)
+				assert.Equal(t, 6*time.Hour, cfg.FX.FetchInterval)
+				assert.Equal(t, 5*time.Minute, cfg.Currencies.RefreshInterval)
+				assert.Equal(t, time.Hour, cfg.Rakeback.PayoutInterval)
 			},
 		},
 	}
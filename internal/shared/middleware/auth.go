@@ -92,11 +92,47 @@ func AuthMiddleware(cfg config.KeycloakConfig, service auth.AuthService, logger
 
 		c.Set("userID", strconv.FormatUint(uint64(user.ID), 10))
 		c.Set("email", user.Email)
+		c.Set("roles", realmRoles(claims))
 
 		c.Next()
 	}
 }
 
+// RequireRole rejects requests whose token does not carry the given realm
+// role. It must run after AuthMiddleware.
+func RequireRole(role string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		roles, _ := c.Get("roles")
+		granted, _ := roles.([]string)
+		for _, r := range granted {
+			if r == role {
+				c.Next()
+				return
+			}
+		}
+		c.JSON(http.StatusForbidden, gin.H{"error": "Insufficient permissions", "code": "FORBIDDEN"})
+		c.Abort()
+	}
+}
+
+func realmRoles(claims jwt.MapClaims) []string {
+	access, ok := claims["realm_access"].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	values, ok := access["roles"].([]interface{})
+	if !ok {
+		return nil
+	}
+	roles := make([]string, 0, len(values))
+	for _, value := range values {
+		if role, ok := value.(string); ok {
+			roles = append(roles, role)
+		}
+	}
+	return roles
+}
+
 func fetchPublicKey(cfg config.KeycloakConfig, token *jwt.Token) (*rsa.PublicKey, error) {
 	jwksURL := fmt.Sprintf("%s/realms/%s/protocol/openid-connect/certs", cfg.URL, cfg.Realm)
 
@@ -303,6 +303,66 @@ func TestAuthMiddleware_UserIDAndEmailInContext(t *testing.T) {
 	assert.Equal(t, http.StatusOK, w.Code)
 }
 
+func TestRequireRole(t *testing.T) {
+	privateKey, publicKey := generateTestKeyPair(t)
+
+	mockServer := httptest.NewServer(createMockJWKSHandler(t, publicKey))
+	defer mockServer.Close()
+
+	cfg := config.KeycloakConfig{
+		URL:   mockServer.URL,
+		Realm: "test-realm",
+	}
+
+	router := gin.New()
+	router.Use(AuthMiddleware(cfg, &mockAuthService{}, slog.Default()))
+	router.GET("/admin", RequireRole("admin"), func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"status": "ok"})
+	})
+
+	tests := []struct {
+		name         string
+		claims       jwt.MapClaims
+		expectedCode int
+	}{
+		{
+			name: "role granted",
+			claims: jwt.MapClaims{
+				"sub":          "user-123",
+				"realm_access": map[string]interface{}{"roles": []string{"user", "admin"}},
+			},
+			expectedCode: http.StatusOK,
+		},
+		{
+			name: "role missing",
+			claims: jwt.MapClaims{
+				"sub":          "user-123",
+				"realm_access": map[string]interface{}{"roles": []string{"user"}},
+			},
+			expectedCode: http.StatusForbidden,
+		},
+		{
+			name:         "no realm access claim",
+			claims:       jwt.MapClaims{"sub": "user-123"},
+			expectedCode: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			token := createTestToken(t, privateKey, tt.claims, 1*time.Hour)
+
+			req := httptest.NewRequest(http.MethodGet, "/admin", nil)
+			req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+		})
+	}
+}
+
 func TestFetchPublicKey(t *testing.T) {
 	_, publicKey := generateTestKeyPair(t)
 
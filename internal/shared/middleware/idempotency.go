@@ -0,0 +1,237 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	IdempotencyKeyHeader     = "Idempotency-Key"
+	IdempotentReplayedHeader = "Idempotent-Replayed"
+	maxIdempotencyKeyLength  = 255
+	// idempotencyWriteTimeout bounds storing or releasing a key once the
+	// handler has run, when the client may already be gone.
+	idempotencyWriteTimeout = 5 * time.Second
+)
+
+// replayedHeaders are the response headers stored with the response and sent
+// again on replay.
+var replayedHeaders = []string{"Content-Type", "ETag", "Location"}
+
+// Idempotency replays the stored response when a request is retried with the
+// same Idempotency-Key. Keys are scoped to the authenticated user, so it must
+// run after AuthMiddleware. Requests without the header pass through.
+// Server errors and panics are not stored, so a failed request can be retried
+// with the same key. A pending key is held for lease and the lease is renewed
+// while its request runs, so only a reservation abandoned by a crashed
+// process expires and frees up; completed responses are kept for ttl.
+func Idempotency(repo IdempotencyRepository, ttl time.Duration, lease time.Duration, logger *slog.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		key := c.GetHeader(IdempotencyKeyHeader)
+		if key == "" {
+			c.Next()
+			return
+		}
+
+		if len(key) > maxIdempotencyKeyLength {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Idempotency key is too long", "code": "INVALID_IDEMPOTENCY_KEY"})
+			c.Abort()
+			return
+		}
+
+		userID, ok := idempotencyUserID(c)
+		if !ok {
+			c.Next()
+			return
+		}
+
+		body, err := io.ReadAll(c.Request.Body)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body", "code": "VALIDATION_ERROR"})
+			c.Abort()
+			return
+		}
+		c.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+		now := time.Now()
+		record := &IdempotencyKey{
+			UserID:      userID,
+			Key:         key,
+			RequestHash: requestHash(c.Request.Method, c.Request.URL.Path, body),
+			ExpiresAt:   now.Add(lease),
+		}
+
+		existing, err := repo.Reserve(c.Request.Context(), record, now)
+		if err != nil {
+			logger.Error("failed to reserve idempotency key", "error", err, "user_id", userID)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "An unexpected error occurred", "code": "INTERNAL_ERROR"})
+			c.Abort()
+			return
+		}
+
+		if existing != nil {
+			replay(c, existing, record.RequestHash)
+			return
+		}
+
+		stopRenewing := renewLease(repo, record.ID, lease, logger, userID)
+
+		// The client may have disconnected by the time the handler returns,
+		// which is when a retry is most likely, so the key is settled on a
+		// context that outlives the request.
+		release := func() {
+			ctx, cancel := detachedContext(c)
+			defer cancel()
+			if err := repo.Release(ctx, record.ID); err != nil {
+				logger.Error("failed to release idempotency key", "error", err, "user_id", userID)
+			}
+		}
+		defer func() {
+			if r := recover(); r != nil {
+				stopRenewing()
+				release()
+				panic(r)
+			}
+		}()
+
+		recorder := &responseRecorder{ResponseWriter: c.Writer}
+		c.Writer = recorder
+		c.Next()
+		stopRenewing()
+
+		status := recorder.Status()
+		if status >= http.StatusInternalServerError {
+			release()
+			return
+		}
+
+		headers := make(map[string]string)
+		for _, name := range replayedHeaders {
+			if value := recorder.Header().Get(name); value != "" {
+				headers[name] = value
+			}
+		}
+		encoded, _ := json.Marshal(headers)
+
+		record.StatusCode = status
+		record.ResponseHeaders = string(encoded)
+		record.ResponseBody = recorder.body.String()
+		record.ExpiresAt = time.Now().Add(ttl)
+		ctx, cancel := detachedContext(c)
+		defer cancel()
+		if err := repo.Complete(ctx, record); err != nil {
+			logger.Error("failed to store idempotent response", "error", err, "user_id", userID)
+		}
+	}
+}
+
+// renewLease keeps extending a pending key's lease until the returned
+// function is called, so a slow request is never mistaken for an abandoned
+// one. Renewing stops early once the key is lost.
+func renewLease(repo IdempotencyRepository, id uint, lease time.Duration, logger *slog.Logger, userID uint) func() {
+	done := make(chan struct{})
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		ticker := time.NewTicker(lease / 3)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				ctx, cancel := context.WithTimeout(context.Background(), idempotencyWriteTimeout)
+				err := repo.Renew(ctx, id, time.Now().Add(lease))
+				cancel()
+				if err != nil {
+					logger.Error("failed to renew idempotency key lease", "error", err, "user_id", userID)
+					if errors.Is(err, ErrIdempotencyKeyLost) {
+						return
+					}
+				}
+			}
+		}
+	}()
+	return func() {
+		close(done)
+		<-stopped
+	}
+}
+
+func detachedContext(c *gin.Context) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.WithoutCancel(c.Request.Context()), idempotencyWriteTimeout)
+}
+
+func replay(c *gin.Context, existing *IdempotencyKey, hash string) {
+	if existing.RequestHash != hash {
+		c.JSON(http.StatusUnprocessableEntity, gin.H{"error": "Idempotency key was used with a different request", "code": "IDEMPOTENCY_KEY_MISMATCH"})
+		c.Abort()
+		return
+	}
+
+	if !existing.Completed() {
+		c.JSON(http.StatusConflict, gin.H{"error": "A request with this idempotency key is still in progress", "code": "IDEMPOTENCY_KEY_IN_PROGRESS"})
+		c.Abort()
+		return
+	}
+
+	var headers map[string]string
+	_ = json.Unmarshal([]byte(existing.ResponseHeaders), &headers)
+	for name, value := range headers {
+		c.Header(name, value)
+	}
+	c.Header(IdempotentReplayedHeader, "true")
+
+	c.Status(existing.StatusCode)
+	_, _ = c.Writer.WriteString(existing.ResponseBody)
+	c.Abort()
+}
+
+func requestHash(method string, path string, body []byte) string {
+	hash := sha256.New()
+	hash.Write([]byte(method + " " + path + "\n"))
+	hash.Write(body)
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+func idempotencyUserID(c *gin.Context) (uint, bool) {
+	value, exists := c.Get("userID")
+	if !exists {
+		return 0, false
+	}
+	userID, ok := value.(string)
+	if !ok {
+		return 0, false
+	}
+	parsed, err := strconv.ParseUint(userID, 10, 32)
+	if err != nil {
+		return 0, false
+	}
+	return uint(parsed), true
+}
+
+type responseRecorder struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+func (w *responseRecorder) Write(data []byte) (int, error) {
+	w.body.Write(data)
+	return w.ResponseWriter.Write(data)
+}
+
+func (w *responseRecorder) WriteString(data string) (int, error) {
+	w.body.WriteString(data)
+	return w.ResponseWriter.WriteString(data)
+}
@@ -0,0 +1,44 @@
+package middleware
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+// IdempotencyJanitor periodically deletes expired idempotency keys. Expired
+// keys are already ignored on lookup; this only keeps the table small.
+type IdempotencyJanitor struct {
+	repo     IdempotencyRepository
+	interval time.Duration
+	logger   *slog.Logger
+}
+
+func NewIdempotencyJanitor(repo IdempotencyRepository, interval time.Duration, logger *slog.Logger) *IdempotencyJanitor {
+	return &IdempotencyJanitor{
+		repo:     repo,
+		interval: interval,
+		logger:   logger,
+	}
+}
+
+func (j *IdempotencyJanitor) Run(ctx context.Context) {
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			deleted, err := j.repo.DeleteExpired(ctx, time.Now())
+			if err != nil {
+				j.logger.Error("failed to delete expired idempotency keys", "error", err)
+				continue
+			}
+			if deleted > 0 {
+				j.logger.Info("expired idempotency keys deleted", "count", deleted)
+			}
+		}
+	}
+}
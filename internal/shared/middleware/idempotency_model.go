@@ -0,0 +1,29 @@
+package middleware
+
+import (
+	"time"
+)
+
+// IdempotencyKey remembers the outcome of a request sent with an
+// Idempotency-Key header. StatusCode stays zero while the original request is
+// still being processed; until then ExpiresAt is a short lease rather than the
+// full retention period.
+type IdempotencyKey struct {
+	ID              uint      `gorm:"primaryKey;autoIncrement"`
+	UserID          uint      `gorm:"not null;uniqueIndex:uq_idempotency_key_per_user"`
+	Key             string    `gorm:"column:idempotency_key;type:varchar(255);not null;uniqueIndex:uq_idempotency_key_per_user"`
+	RequestHash     string    `gorm:"type:varchar(64);not null"`
+	StatusCode      int       `gorm:"not null;default:0"`
+	ResponseHeaders string    `gorm:"type:text"`
+	ResponseBody    string    `gorm:"type:text"`
+	CreatedAt       time.Time `gorm:"autoCreateTime"`
+	ExpiresAt       time.Time `gorm:"not null;index"`
+}
+
+func (IdempotencyKey) TableName() string {
+	return "idempotency_keys"
+}
+
+func (k *IdempotencyKey) Completed() bool {
+	return k.StatusCode != 0
+}
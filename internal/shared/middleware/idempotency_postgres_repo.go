@@ -0,0 +1,88 @@
+package middleware
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type postgresIdempotencyRepository struct {
+	db *gorm.DB
+}
+
+func NewPostgresIdempotencyRepository(db *gorm.DB) IdempotencyRepository {
+	return &postgresIdempotencyRepository{
+		db: db,
+	}
+}
+
+func (r *postgresIdempotencyRepository) Reserve(ctx context.Context, key *IdempotencyKey, now time.Time) (*IdempotencyKey, error) {
+	var existing *IdempotencyKey
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Where("user_id = ? AND idempotency_key = ? AND expires_at <= ?", key.UserID, key.Key, now).
+			Delete(&IdempotencyKey{}).Error
+		if err != nil {
+			return err
+		}
+
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(key)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected > 0 {
+			return nil
+		}
+
+		var found IdempotencyKey
+		if err := tx.Where("user_id = ? AND idempotency_key = ?", key.UserID, key.Key).First(&found).Error; err != nil {
+			return err
+		}
+		existing = &found
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return existing, nil
+}
+
+func (r *postgresIdempotencyRepository) Renew(ctx context.Context, id uint, expiresAt time.Time) error {
+	return pendingUpdate(r.db.WithContext(ctx), id, map[string]interface{}{
+		"expires_at": expiresAt,
+	})
+}
+
+func (r *postgresIdempotencyRepository) Complete(ctx context.Context, key *IdempotencyKey) error {
+	return pendingUpdate(r.db.WithContext(ctx), key.ID, map[string]interface{}{
+		"status_code":      key.StatusCode,
+		"response_headers": key.ResponseHeaders,
+		"response_body":    key.ResponseBody,
+		"expires_at":       key.ExpiresAt,
+	})
+}
+
+// pendingUpdate updates a key only while it is still pending, so a request
+// whose key was reclaimed cannot overwrite the new owner's row.
+func pendingUpdate(db *gorm.DB, id uint, updates map[string]interface{}) error {
+	result := db.Model(&IdempotencyKey{}).
+		Where("id = ? AND status_code = 0", id).
+		Updates(updates)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrIdempotencyKeyLost
+	}
+	return nil
+}
+
+func (r *postgresIdempotencyRepository) Release(ctx context.Context, id uint) error {
+	return r.db.WithContext(ctx).Delete(&IdempotencyKey{}, id).Error
+}
+
+func (r *postgresIdempotencyRepository) DeleteExpired(ctx context.Context, now time.Time) (int64, error) {
+	result := r.db.WithContext(ctx).Where("expires_at <= ?", now).Delete(&IdempotencyKey{})
+	return result.RowsAffected, result.Error
+}
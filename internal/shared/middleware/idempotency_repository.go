@@ -0,0 +1,25 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// ErrIdempotencyKeyLost is returned when a pending key is no longer held by
+// the request that reserved it, because its lease ran out and another request
+// reclaimed it.
+var ErrIdempotencyKeyLost = errors.New("idempotency key is no longer held")
+
+type IdempotencyRepository interface {
+	// Reserve stores a pending key. When a live key already exists for the
+	// user it is returned instead and nothing is stored.
+	Reserve(ctx context.Context, key *IdempotencyKey, now time.Time) (*IdempotencyKey, error)
+	// Renew extends the lease of a pending key.
+	Renew(ctx context.Context, id uint, expiresAt time.Time) error
+	// Complete stores the response and the key's new expiry. Both fail with
+	// ErrIdempotencyKeyLost when the key is no longer pending.
+	Complete(ctx context.Context, key *IdempotencyKey) error
+	Release(ctx context.Context, id uint) error
+	DeleteExpired(ctx context.Context, now time.Time) (int64, error)
+}
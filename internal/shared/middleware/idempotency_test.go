@@ -0,0 +1,328 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+func setupIdempotencyRepo(t *testing.T) IdempotencyRepository {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&IdempotencyKey{}))
+	// Every connection to :memory: is a new database, and leases are renewed
+	// from another goroutine.
+	sqlDB, err := db.DB()
+	require.NoError(t, err)
+	sqlDB.SetMaxOpenConns(1)
+	return NewPostgresIdempotencyRepository(db)
+}
+
+const testLease = time.Minute
+
+func setupIdempotentRouter(repo IdempotencyRepository, ttl time.Duration, calls *int, status int) *gin.Engine {
+	return newIdempotentRouter(repo, ttl, func(c *gin.Context) {
+		*calls++
+		c.Header("ETag", `"1"`)
+		c.JSON(status, gin.H{"call": *calls})
+	})
+}
+
+func newIdempotentRouter(repo IdempotencyRepository, ttl time.Duration, handler gin.HandlerFunc) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.Use(gin.Recovery())
+	r.POST("/bankrolls",
+		func(c *gin.Context) {
+			c.Set("userID", c.GetHeader("X-Test-User"))
+			c.Next()
+		},
+		Idempotency(repo, ttl, testLease, slog.Default()),
+		handler,
+	)
+	return r
+}
+
+func sendIdempotent(r *gin.Engine, user string, key string, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/bankrolls", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Test-User", user)
+	if key != "" {
+		req.Header.Set(IdempotencyKeyHeader, key)
+	}
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	return w
+}
+
+func TestIdempotency(t *testing.T) {
+	t.Run("retry replays the original response", func(t *testing.T) {
+		calls := 0
+		r := setupIdempotentRouter(setupIdempotencyRepo(t), time.Hour, &calls, http.StatusCreated)
+
+		first := sendIdempotent(r, "1", "abc", `{"name":"Main"}`)
+		second := sendIdempotent(r, "1", "abc", `{"name":"Main"}`)
+
+		assert.Equal(t, 1, calls)
+		assert.Equal(t, http.StatusCreated, first.Code)
+		assert.Equal(t, http.StatusCreated, second.Code)
+		assert.JSONEq(t, first.Body.String(), second.Body.String())
+		assert.Equal(t, "true", second.Header().Get(IdempotentReplayedHeader))
+		assert.Equal(t, `"1"`, second.Header().Get("ETag"))
+		assert.Contains(t, second.Header().Get("Content-Type"), "application/json")
+		assert.Empty(t, first.Header().Get(IdempotentReplayedHeader))
+	})
+
+	t.Run("different payload is rejected", func(t *testing.T) {
+		calls := 0
+		r := setupIdempotentRouter(setupIdempotencyRepo(t), time.Hour, &calls, http.StatusCreated)
+
+		sendIdempotent(r, "1", "abc", `{"name":"Main"}`)
+		w := sendIdempotent(r, "1", "abc", `{"name":"Other"}`)
+
+		assert.Equal(t, 1, calls)
+		assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+		assert.Contains(t, w.Body.String(), "IDEMPOTENCY_KEY_MISMATCH")
+	})
+
+	t.Run("keys are scoped per user", func(t *testing.T) {
+		calls := 0
+		r := setupIdempotentRouter(setupIdempotencyRepo(t), time.Hour, &calls, http.StatusCreated)
+
+		sendIdempotent(r, "1", "abc", `{"name":"Main"}`)
+		w := sendIdempotent(r, "2", "abc", `{"name":"Main"}`)
+
+		assert.Equal(t, 2, calls)
+		assert.Empty(t, w.Header().Get(IdempotentReplayedHeader))
+	})
+
+	t.Run("expired key runs the request again", func(t *testing.T) {
+		calls := 0
+		r := setupIdempotentRouter(setupIdempotencyRepo(t), -time.Second, &calls, http.StatusCreated)
+
+		sendIdempotent(r, "1", "abc", `{"name":"Main"}`)
+		sendIdempotent(r, "1", "abc", `{"name":"Other"}`)
+
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("server errors are not stored", func(t *testing.T) {
+		calls := 0
+		r := setupIdempotentRouter(setupIdempotencyRepo(t), time.Hour, &calls, http.StatusInternalServerError)
+
+		sendIdempotent(r, "1", "abc", `{"name":"Main"}`)
+		sendIdempotent(r, "1", "abc", `{"name":"Main"}`)
+
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("request in progress", func(t *testing.T) {
+		repo := setupIdempotencyRepo(t)
+		calls := 0
+		r := setupIdempotentRouter(repo, time.Hour, &calls, http.StatusCreated)
+
+		body := `{"name":"Main"}`
+		_, err := repo.Reserve(context.Background(), &IdempotencyKey{
+			UserID:      1,
+			Key:         "abc",
+			RequestHash: requestHash(http.MethodPost, "/bankrolls", []byte(body)),
+			ExpiresAt:   time.Now().Add(time.Hour),
+		}, time.Now())
+		require.NoError(t, err)
+
+		w := sendIdempotent(r, "1", "abc", body)
+
+		assert.Equal(t, 0, calls)
+		assert.Equal(t, http.StatusConflict, w.Code)
+		assert.Contains(t, w.Body.String(), "IDEMPOTENCY_KEY_IN_PROGRESS")
+	})
+
+	t.Run("abandoned reservation frees up after its lease", func(t *testing.T) {
+		repo := setupIdempotencyRepo(t)
+		calls := 0
+		r := setupIdempotentRouter(repo, time.Hour, &calls, http.StatusCreated)
+
+		body := `{"name":"Main"}`
+		_, err := repo.Reserve(context.Background(), &IdempotencyKey{
+			UserID:      1,
+			Key:         "abc",
+			RequestHash: requestHash(http.MethodPost, "/bankrolls", []byte(body)),
+			ExpiresAt:   time.Now().Add(-time.Second),
+		}, time.Now().Add(-testLease))
+		require.NoError(t, err)
+
+		w := sendIdempotent(r, "1", "abc", body)
+
+		assert.Equal(t, 1, calls)
+		assert.Equal(t, http.StatusCreated, w.Code)
+	})
+
+	t.Run("lease is renewed while the request runs", func(t *testing.T) {
+		repo := setupIdempotencyRepo(t)
+		lease := 30 * time.Millisecond
+		body := `{"name":"Main"}`
+		probe := &IdempotencyKey{UserID: 1, Key: "abc", RequestHash: "probe", ExpiresAt: time.Now().Add(lease)}
+		var pending *IdempotencyKey
+		gin.SetMode(gin.TestMode)
+		r := gin.New()
+		r.POST("/bankrolls",
+			func(c *gin.Context) {
+				c.Set("userID", "1")
+				c.Next()
+			},
+			Idempotency(repo, time.Hour, lease, slog.Default()),
+			func(c *gin.Context) {
+				time.Sleep(4 * lease)
+				var err error
+				pending, err = repo.Reserve(c.Request.Context(), probe, time.Now())
+				require.NoError(t, err)
+				c.JSON(http.StatusCreated, gin.H{})
+			},
+		)
+
+		w := sendIdempotent(r, "1", "abc", body)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		require.NotNil(t, pending, "a slow request keeps its key")
+		assert.False(t, pending.Completed())
+
+		completed, err := repo.Reserve(context.Background(), probe, time.Now())
+		require.NoError(t, err)
+		require.NotNil(t, completed)
+		assert.True(t, completed.Completed())
+	})
+
+	t.Run("pending keys hold for the lease and responses for the ttl", func(t *testing.T) {
+		repo := setupIdempotencyRepo(t)
+		body := `{"name":"Main"}`
+		probe := &IdempotencyKey{UserID: 1, Key: "abc", RequestHash: "probe"}
+		var pending *IdempotencyKey
+		r := newIdempotentRouter(repo, time.Hour, func(c *gin.Context) {
+			var err error
+			pending, err = repo.Reserve(c.Request.Context(), probe, time.Now())
+			require.NoError(t, err)
+			c.JSON(http.StatusCreated, gin.H{})
+		})
+
+		sendIdempotent(r, "1", "abc", body)
+
+		require.NotNil(t, pending)
+		assert.False(t, pending.Completed())
+		assert.WithinDuration(t, time.Now().Add(testLease), pending.ExpiresAt, 5*time.Second)
+
+		completed, err := repo.Reserve(context.Background(), probe, time.Now())
+		require.NoError(t, err)
+		require.NotNil(t, completed)
+		assert.True(t, completed.Completed())
+		assert.WithinDuration(t, time.Now().Add(time.Hour), completed.ExpiresAt, 5*time.Second)
+	})
+
+	t.Run("response is stored after the client disconnects", func(t *testing.T) {
+		calls := 0
+		ctx, cancel := context.WithCancel(context.Background())
+		r := newIdempotentRouter(setupIdempotencyRepo(t), time.Hour, func(c *gin.Context) {
+			calls++
+			c.JSON(http.StatusCreated, gin.H{"call": calls})
+			cancel()
+		})
+
+		req := httptest.NewRequest(http.MethodPost, "/bankrolls", bytes.NewBufferString(`{"name":"Main"}`)).WithContext(ctx)
+		req.Header.Set("X-Test-User", "1")
+		req.Header.Set(IdempotencyKeyHeader, "abc")
+		r.ServeHTTP(httptest.NewRecorder(), req)
+
+		w := sendIdempotent(r, "1", "abc", `{"name":"Main"}`)
+
+		assert.Equal(t, 1, calls)
+		assert.Equal(t, http.StatusCreated, w.Code)
+		assert.Equal(t, "true", w.Header().Get(IdempotentReplayedHeader))
+	})
+
+	t.Run("handler panic releases the key", func(t *testing.T) {
+		calls := 0
+		r := newIdempotentRouter(setupIdempotencyRepo(t), time.Hour, func(c *gin.Context) {
+			calls++
+			if calls == 1 {
+				panic("boom")
+			}
+			c.JSON(http.StatusCreated, gin.H{"call": calls})
+		})
+
+		first := sendIdempotent(r, "1", "abc", `{"name":"Main"}`)
+		second := sendIdempotent(r, "1", "abc", `{"name":"Main"}`)
+
+		assert.Equal(t, http.StatusInternalServerError, first.Code)
+		assert.Equal(t, 2, calls)
+		assert.Equal(t, http.StatusCreated, second.Code)
+	})
+
+	t.Run("requests without a key pass through", func(t *testing.T) {
+		calls := 0
+		r := setupIdempotentRouter(setupIdempotencyRepo(t), time.Hour, &calls, http.StatusCreated)
+
+		sendIdempotent(r, "1", "", `{"name":"Main"}`)
+		sendIdempotent(r, "1", "", `{"name":"Main"}`)
+
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("key too long", func(t *testing.T) {
+		calls := 0
+		r := setupIdempotentRouter(setupIdempotencyRepo(t), time.Hour, &calls, http.StatusCreated)
+
+		w := sendIdempotent(r, "1", string(bytes.Repeat([]byte("k"), 256)), `{}`)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Equal(t, 0, calls)
+	})
+}
+
+func TestPostgresIdempotencyRepository_DeleteExpired(t *testing.T) {
+	repo := setupIdempotencyRepo(t)
+	ctx := context.Background()
+	now := time.Now()
+
+	_, err := repo.Reserve(ctx, &IdempotencyKey{UserID: 1, Key: "old", RequestHash: "h", ExpiresAt: now.Add(-time.Minute)}, now.Add(-time.Hour))
+	require.NoError(t, err)
+	_, err = repo.Reserve(ctx, &IdempotencyKey{UserID: 1, Key: "new", RequestHash: "h", ExpiresAt: now.Add(time.Hour)}, now)
+	require.NoError(t, err)
+
+	deleted, err := repo.DeleteExpired(ctx, now)
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), deleted)
+}
+
+func TestPostgresIdempotencyRepository_LostKey(t *testing.T) {
+	repo := setupIdempotencyRepo(t)
+	ctx := context.Background()
+	now := time.Now()
+
+	abandoned := &IdempotencyKey{UserID: 1, Key: "abc", RequestHash: "h", ExpiresAt: now.Add(-time.Second)}
+	_, err := repo.Reserve(ctx, abandoned, now.Add(-time.Minute))
+	require.NoError(t, err)
+	reclaimed := &IdempotencyKey{UserID: 1, Key: "abc", RequestHash: "h", ExpiresAt: now.Add(time.Minute)}
+	existing, err := repo.Reserve(ctx, reclaimed, now)
+	require.NoError(t, err)
+	require.Nil(t, existing)
+
+	assert.ErrorIs(t, repo.Renew(ctx, abandoned.ID, now.Add(time.Minute)), ErrIdempotencyKeyLost)
+	abandoned.StatusCode = http.StatusCreated
+	assert.ErrorIs(t, repo.Complete(ctx, abandoned), ErrIdempotencyKeyLost)
+
+	require.NoError(t, repo.Renew(ctx, reclaimed.ID, now.Add(time.Minute)))
+	reclaimed.StatusCode = http.StatusCreated
+	reclaimed.ExpiresAt = now.Add(time.Hour)
+	require.NoError(t, repo.Complete(ctx, reclaimed))
+	assert.ErrorIs(t, repo.Renew(ctx, reclaimed.ID, now.Add(time.Minute)), ErrIdempotencyKeyLost, "a completed key has no lease")
+}
@@ -0,0 +1,140 @@
+package pagination
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const (
+	DefaultLimit = 20
+	MaxLimit     = 100
+)
+
+var (
+	ErrInvalidLimit     = errors.New("limit must be between 1 and 100")
+	ErrInvalidOffset    = errors.New("offset cannot be negative")
+	ErrInvalidCursor    = errors.New("invalid cursor")
+	ErrCursorWithOffset = errors.New("cursor and offset cannot be combined")
+	ErrCursorMismatch   = errors.New("cursor was issued for a different sort")
+)
+
+// Params are the paging options accepted by list endpoints. A request pages
+// either by offset or by the opaque cursor returned with the previous page.
+type Params struct {
+	Limit  int    `form:"limit"`
+	Offset int    `form:"offset"`
+	Cursor string `form:"cursor"`
+}
+
+// Normalize validates the params and applies the default limit.
+func (p Params) Normalize() (Params, error) {
+	if p.Limit == 0 {
+		p.Limit = DefaultLimit
+	}
+	if p.Limit < 1 || p.Limit > MaxLimit {
+		return p, ErrInvalidLimit
+	}
+	if p.Offset < 0 {
+		return p, ErrInvalidOffset
+	}
+	if p.Cursor != "" && p.Offset > 0 {
+		return p, ErrCursorWithOffset
+	}
+	return p, nil
+}
+
+// Cursor marks the last row of a page: the value of the sort column and the
+// row ID, which breaks ties between equal values. Sort and Descending record
+// the ordering the cursor was issued for, so it cannot be replayed against
+// another column.
+type Cursor struct {
+	Sort       string `json:"s"`
+	Descending bool   `json:"d,omitempty"`
+	Value      string `json:"v"`
+	ID         uint   `json:"id"`
+}
+
+func EncodeCursor(sort string, descending bool, value string, id uint) string {
+	data, _ := json.Marshal(Cursor{Sort: sort, Descending: descending, Value: value, ID: id})
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// DecodeCursor parses a cursor and checks that it was issued for the
+// requested sort column and direction.
+func DecodeCursor(encoded string, sort string, descending bool) (Cursor, error) {
+	var cursor Cursor
+	data, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return cursor, ErrInvalidCursor
+	}
+	if err := json.Unmarshal(data, &cursor); err != nil || cursor.ID == 0 {
+		return cursor, ErrInvalidCursor
+	}
+	if cursor.Sort != sort || cursor.Descending != descending {
+		return cursor, ErrCursorMismatch
+	}
+	return cursor, nil
+}
+
+// KeysetCondition returns a WHERE clause selecting the rows that sort after a
+// cursor when ordering by column and then id. Its arguments are the cursor
+// value twice followed by the cursor ID. column must not come from user input.
+func KeysetCondition(column string, descending bool) string {
+	op := ">"
+	if descending {
+		op = "<"
+	}
+	return fmt.Sprintf("(%[1]s %[2]s ? OR (%[1]s = ? AND id %[2]s ?))", column, op)
+}
+
+// OrderClause returns the ORDER BY clause matching KeysetCondition.
+func OrderClause(column string, descending bool) string {
+	direction := "ASC"
+	if descending {
+		direction = "DESC"
+	}
+	return fmt.Sprintf("%[1]s %[2]s, id %[2]s", column, direction)
+}
+
+// likeEscaper makes LIKE wildcards in user input match literally.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
+// ContainsPattern builds a case-insensitive substring pattern for
+// "LOWER(column) LIKE ? ESCAPE '\'" filters.
+func ContainsPattern(s string) string {
+	return "%" + likeEscaper.Replace(strings.ToLower(s)) + "%"
+}
+
+// Trim cuts a result fetched with limit+1 rows down to limit and reports
+// whether more rows follow.
+func Trim[T any](items []T, limit int) ([]T, bool) {
+	if len(items) > limit {
+		return items[:limit], true
+	}
+	return items, false
+}
+
+// Page is the envelope returned by paginated list endpoints.
+type Page[T any] struct {
+	Items      []T    `json:"items"`
+	Total      int64  `json:"total"`
+	Limit      int    `json:"limit"`
+	Offset     int    `json:"offset"`
+	NextCursor string `json:"next_cursor,omitempty"`
+}
+
+func NewPage[T any](items []T, total int64, params Params, nextCursor string) *Page[T] {
+	if items == nil {
+		items = []T{}
+	}
+	return &Page[T]{
+		Items:      items,
+		Total:      total,
+		Limit:      params.Limit,
+		Offset:     params.Offset,
+		NextCursor: nextCursor,
+	}
+}
@@ -0,0 +1,80 @@
+package pagination
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParams_Normalize(t *testing.T) {
+	tests := []struct {
+		name    string
+		params  Params
+		limit   int
+		wantErr error
+	}{
+		{name: "default limit", params: Params{}, limit: DefaultLimit},
+		{name: "explicit limit", params: Params{Limit: 50, Offset: 10}, limit: 50},
+		{name: "limit above max", params: Params{Limit: 101}, wantErr: ErrInvalidLimit},
+		{name: "negative limit", params: Params{Limit: -1}, wantErr: ErrInvalidLimit},
+		{name: "negative offset", params: Params{Offset: -5}, wantErr: ErrInvalidOffset},
+		{name: "cursor with offset", params: Params{Offset: 5, Cursor: "abc"}, wantErr: ErrCursorWithOffset},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params, err := tt.params.Normalize()
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.limit, params.Limit)
+		})
+	}
+}
+
+func TestCursor(t *testing.T) {
+	encoded := EncodeCursor("name", false, "Main Bankroll", 42)
+
+	cursor, err := DecodeCursor(encoded, "name", false)
+
+	require.NoError(t, err)
+	assert.Equal(t, Cursor{Sort: "name", Value: "Main Bankroll", ID: 42}, cursor)
+
+	_, err = DecodeCursor("not-a-cursor!", "name", false)
+	assert.ErrorIs(t, err, ErrInvalidCursor)
+	_, err = DecodeCursor(EncodeCursor("name", false, "x", 0), "name", false)
+	assert.ErrorIs(t, err, ErrInvalidCursor)
+	_, err = DecodeCursor(encoded, "current_balance", true)
+	assert.ErrorIs(t, err, ErrCursorMismatch)
+	_, err = DecodeCursor(encoded, "name", true)
+	assert.ErrorIs(t, err, ErrCursorMismatch, "the direction is part of the cursor")
+}
+
+func TestKeysetCondition(t *testing.T) {
+	assert.Equal(t, "(name > ? OR (name = ? AND id > ?))", KeysetCondition("name", false))
+	assert.Equal(t, "(created_at < ? OR (created_at = ? AND id < ?))", KeysetCondition("created_at", true))
+	assert.Equal(t, "current_balance DESC, id DESC", OrderClause("current_balance", true))
+}
+
+func TestContainsPattern(t *testing.T) {
+	assert.Equal(t, "%stars%", ContainsPattern("Stars"))
+	assert.Equal(t, `%100\%\_off\\%`, ContainsPattern(`100%_OFF\`))
+}
+
+func TestTrimAndPage(t *testing.T) {
+	items, more := Trim([]int{1, 2, 3}, 2)
+	assert.Equal(t, []int{1, 2}, items)
+	assert.True(t, more)
+
+	items, more = Trim([]int{1}, 2)
+	assert.Equal(t, []int{1}, items)
+	assert.False(t, more)
+
+	page := NewPage[int](nil, 0, Params{Limit: 20}, "")
+	assert.NotNil(t, page.Items)
+	assert.Equal(t, 20, page.Limit)
+}
@@ -1,9 +1,12 @@
 package validator
 
 import (
+	"errors"
 	"regexp"
 
+	"github.com/gin-gonic/gin/binding"
 	"github.com/go-playground/validator/v10"
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
 )
 
 func RegisterCustomValidators(v *validator.Validate) error {
@@ -13,9 +16,28 @@ func RegisterCustomValidators(v *validator.Validate) error {
 	if err := v.RegisterValidation("currency", validateCurrency); err != nil {
 		return err
 	}
+	decimalRules := map[string]validator.Func{
+		"decimal_required": validateDecimalRequired,
+		"decimal_gt":       decimalBound(func(cmp int) bool { return cmp > 0 }),
+		"decimal_gte":      decimalBound(func(cmp int) bool { return cmp >= 0 }),
+		"decimal_lte":      decimalBound(func(cmp int) bool { return cmp <= 0 }),
+	}
+	for tag, fn := range decimalRules {
+		if err := v.RegisterValidation(tag, fn); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
+func RegisterBindingValidators() error {
+	v, ok := binding.Validator.Engine().(*validator.Validate)
+	if !ok {
+		return errors.New("unsupported binding validator engine")
+	}
+	return RegisterCustomValidators(v)
+}
+
 func validatePassword(fl validator.FieldLevel) bool {
 	password := fl.Field().String()
 
@@ -31,12 +53,29 @@ func validatePassword(fl validator.FieldLevel) bool {
 }
 
 func validateCurrency(fl validator.FieldLevel) bool {
-	currency := fl.Field().String()
-	validCurrencies := map[string]bool{
-		"BRL": true,
-		"USD": true,
-		"EUR": true,
-		"BTC": true,
+	return domain.Currency(fl.Field().String()).IsValid()
+}
+
+// validateDecimalRequired rejects a missing domain.Decimal, which decodes to
+// zero.
+func validateDecimalRequired(fl validator.FieldLevel) bool {
+	d, ok := fl.Field().Interface().(domain.Decimal)
+	return ok && !d.IsZero()
+}
+
+// decimalBound compares a domain.Decimal with the tag's parameter exactly,
+// so bounds never go through float64. accept gets the sign of the field
+// minus the parameter.
+func decimalBound(accept func(cmp int) bool) validator.Func {
+	return func(fl validator.FieldLevel) bool {
+		d, ok := fl.Field().Interface().(domain.Decimal)
+		if !ok {
+			return false
+		}
+		bound, err := domain.ParseDecimal(fl.Param())
+		if err != nil {
+			return false
+		}
+		return accept(d.Cmp(bound))
 	}
-	return validCurrencies[currency]
 }
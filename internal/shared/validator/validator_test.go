@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/go-playground/validator/v10"
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -157,3 +158,71 @@ func TestRegisterCustomValidators(t *testing.T) {
 		})
 	}
 }
+
+type DecimalTest struct {
+	Amount domain.Decimal `validate:"decimal_required,decimal_gte=0,decimal_lte=100"`
+	Stake  domain.Decimal `validate:"decimal_gt=0.000000000000000001"`
+}
+
+func TestValidateDecimal(t *testing.T) {
+	v := validator.New()
+	err := RegisterCustomValidators(v)
+	assert.NoError(t, err)
+
+	tiny := domain.MustParseDecimal("0.000000000000000001")
+	tests := []struct {
+		name      string
+		amount    domain.Decimal
+		stake     domain.Decimal
+		expectErr bool
+	}{
+		{
+			name:      "success - within range",
+			amount:    domain.MustParseDecimal("99.99"),
+			stake:     domain.MustParseDecimal("0.000000000000000002"),
+			expectErr: false,
+		},
+		{
+			name:      "success - exactly the maximum",
+			amount:    domain.MustParseDecimal("100"),
+			stake:     domain.MustParseDecimal("1"),
+			expectErr: false,
+		},
+		{
+			name:      "error - negative",
+			amount:    domain.MustParseDecimal("-0.01"),
+			stake:     domain.MustParseDecimal("1"),
+			expectErr: true,
+		},
+		{
+			name:      "error - above maximum by less than float precision",
+			amount:    domain.MustParseDecimal("100.0000000000000000001"),
+			stake:     domain.MustParseDecimal("1"),
+			expectErr: true,
+		},
+		{
+			name:      "error - equal to an exclusive bound",
+			amount:    domain.MustParseDecimal("1"),
+			stake:     tiny,
+			expectErr: true,
+		},
+		{
+			name:      "error - missing",
+			amount:    domain.Zero,
+			stake:     domain.MustParseDecimal("1"),
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.Struct(DecimalTest{Amount: tt.amount, Stake: tt.stake})
+
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package strategy
+
+import (
+	"time"
+
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
+)
+
+type CreateStrategyInput struct {
+	Name          string      `json:"name" binding:"required,min=1,max=100"`
+	Description   string      `json:"description" binding:"max=2000"`
+	StakingPlan   StakingPlan `json:"staking_plan" binding:"required"`
+	TargetMarkets []string    `json:"target_markets" binding:"max=20,dive,min=1,max=50"`
+	Tags          []string    `json:"tags" binding:"max=20,dive,min=1,max=50"`
+}
+
+type UpdateStrategyInput struct {
+	Name          string      `json:"name" binding:"required,min=1,max=100"`
+	Description   string      `json:"description" binding:"max=2000"`
+	StakingPlan   StakingPlan `json:"staking_plan" binding:"required"`
+	TargetMarkets []string    `json:"target_markets" binding:"max=20,dive,min=1,max=50"`
+	Tags          []string    `json:"tags" binding:"max=20,dive,min=1,max=50"`
+}
+
+type StrategyOutput struct {
+	ID            uint        `json:"id"`
+	Name          string      `json:"name"`
+	Description   string      `json:"description"`
+	StakingPlan   StakingPlan `json:"staking_plan"`
+	TargetMarkets []string    `json:"target_markets"`
+	Tags          []string    `json:"tags"`
+	CreatedAt     time.Time   `json:"created_at"`
+	UpdatedAt     time.Time   `json:"updated_at"`
+}
+
+type PerformanceOutput struct {
+	StrategyID          uint                        `json:"strategy_id"`
+	BetCount            int64                       `json:"bet_count"`
+	SettledCount        int64                       `json:"settled_count"`
+	SessionCount        int64                       `json:"session_count"`
+	ResultCount         int64                       `json:"result_count"`
+	StrikeRate          domain.Decimal              `json:"strike_rate"`
+	AverageOdds         domain.Decimal              `json:"average_odds"`
+	LongestLosingStreak int64                       `json:"longest_losing_streak"`
+	ByCurrency          []CurrencyPerformanceOutput `json:"by_currency"`
+}
+
+type CurrencyPerformanceOutput struct {
+	Currency domain.Currency `json:"currency"`
+	Turnover domain.Decimal  `json:"turnover"`
+	Profit   domain.Decimal  `json:"profit"`
+	ROI      domain.Decimal  `json:"roi"`
+}
+
+type ErrorOutput struct {
+	Error   string              `json:"error"`
+	Code    string              `json:"code"`
+	Details map[string][]string `json:"details,omitempty"`
+}
@@ -0,0 +1,19 @@
+package strategy
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrStrategyNotFound   = errors.New("strategy not found")
+	ErrStrategyNameExists = errors.New("strategy name already exists for user")
+	ErrValidationFailed   = errors.New("validation failed")
+	ErrDatabaseError      = errors.New("database error")
+	ErrUnauthorized       = errors.New("unauthorized access to strategy")
+	ErrInvalidStakingPlan = errors.New("invalid staking plan")
+)
+
+func WrapError(err error, message string) error {
+	return fmt.Errorf("%s: %w", message, err)
+}
@@ -0,0 +1,233 @@
+package strategy
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"log/slog"
+
+	"github.com/gin-gonic/gin"
+)
+
+type StrategyHandler struct {
+	service StrategyService
+	logger  *slog.Logger
+}
+
+func NewStrategyHandler(service StrategyService, logger *slog.Logger) *StrategyHandler {
+	return &StrategyHandler{
+		service: service,
+		logger:  logger,
+	}
+}
+
+func (h *StrategyHandler) CreateStrategy(c *gin.Context) {
+	var input CreateStrategyInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		h.logger.Error("invalid request body", "error", err)
+		c.JSON(http.StatusBadRequest, ErrorOutput{
+			Error:   "Invalid request body",
+			Code:    "VALIDATION_ERROR",
+			Details: nil,
+		})
+		return
+	}
+
+	userID, err := h.getUserID(c)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	output, err := h.service.CreateStrategy(c.Request.Context(), userID, input)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, output)
+}
+
+func (h *StrategyHandler) ListStrategies(c *gin.Context) {
+	userID, err := h.getUserID(c)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	outputs, err := h.service.ListStrategies(c.Request.Context(), userID)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, outputs)
+}
+
+func (h *StrategyHandler) GetStrategy(c *gin.Context) {
+	userID, err := h.getUserID(c)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	strategyID, err := h.getStrategyID(c)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	output, err := h.service.GetStrategy(c.Request.Context(), userID, strategyID)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
+
+func (h *StrategyHandler) UpdateStrategy(c *gin.Context) {
+	var input UpdateStrategyInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		h.logger.Error("invalid request body", "error", err)
+		c.JSON(http.StatusBadRequest, ErrorOutput{
+			Error:   "Invalid request body",
+			Code:    "VALIDATION_ERROR",
+			Details: nil,
+		})
+		return
+	}
+
+	userID, err := h.getUserID(c)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	strategyID, err := h.getStrategyID(c)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	output, err := h.service.UpdateStrategy(c.Request.Context(), userID, strategyID, input)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
+
+func (h *StrategyHandler) DeleteStrategy(c *gin.Context) {
+	userID, err := h.getUserID(c)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	strategyID, err := h.getStrategyID(c)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	if err := h.service.DeleteStrategy(c.Request.Context(), userID, strategyID); err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (h *StrategyHandler) GetPerformance(c *gin.Context) {
+	userID, err := h.getUserID(c)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	strategyID, err := h.getStrategyID(c)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	output, err := h.service.GetPerformance(c.Request.Context(), userID, strategyID)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
+
+func (h *StrategyHandler) handleError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, ErrStrategyNotFound):
+		c.JSON(http.StatusNotFound, ErrorOutput{
+			Error: "Strategy not found",
+			Code:  "STRATEGY_NOT_FOUND",
+		})
+	case errors.Is(err, ErrStrategyNameExists):
+		c.JSON(http.StatusConflict, ErrorOutput{
+			Error: "Strategy name already exists",
+			Code:  "STRATEGY_NAME_EXISTS",
+		})
+	case errors.Is(err, ErrValidationFailed):
+		c.JSON(http.StatusBadRequest, ErrorOutput{
+			Error: err.Error(),
+			Code:  "VALIDATION_ERROR",
+		})
+	case errors.Is(err, ErrInvalidStakingPlan):
+		c.JSON(http.StatusBadRequest, ErrorOutput{
+			Error: "Invalid staking plan",
+			Code:  "INVALID_STAKING_PLAN",
+		})
+	case errors.Is(err, ErrDatabaseError):
+		h.logger.Error("database error", "error", err)
+		c.JSON(http.StatusInternalServerError, ErrorOutput{
+			Error: "Database error occurred",
+			Code:  "DATABASE_ERROR",
+		})
+	case errors.Is(err, ErrUnauthorized):
+		c.JSON(http.StatusForbidden, ErrorOutput{
+			Error: "Unauthorized access to strategy",
+			Code:  "UNAUTHORIZED",
+		})
+	default:
+		h.logger.Error("unexpected error", "error", err)
+		c.JSON(http.StatusInternalServerError, ErrorOutput{
+			Error: "An unexpected error occurred",
+			Code:  "INTERNAL_ERROR",
+		})
+	}
+}
+
+func (h *StrategyHandler) getStrategyID(c *gin.Context) (uint, error) {
+	strategyID, err := strconv.ParseUint(c.Param("strategyId"), 10, 32)
+	if err != nil {
+		return 0, ErrUnauthorized
+	}
+	return uint(strategyID), nil
+}
+
+func (h *StrategyHandler) getUserID(c *gin.Context) (uint, error) {
+	userIDStr, exists := c.Get("userID")
+	if !exists {
+		return 0, ErrUnauthorized
+	}
+
+	userID, ok := userIDStr.(string)
+	if !ok {
+		return 0, ErrUnauthorized
+	}
+
+	parsedID, err := strconv.ParseUint(userID, 10, 32)
+	if err != nil {
+		return 0, ErrUnauthorized
+	}
+
+	return uint(parsedID), nil
+}
@@ -0,0 +1,197 @@
+package strategy
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"log/slog"
+
+	"github.com/gin-gonic/gin"
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type MockStrategyServiceForHandler struct {
+	mock.Mock
+}
+
+func (m *MockStrategyServiceForHandler) CreateStrategy(ctx context.Context, userID uint, input CreateStrategyInput) (*StrategyOutput, error) {
+	args := m.Called(ctx, userID, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*StrategyOutput), args.Error(1)
+}
+
+func (m *MockStrategyServiceForHandler) ListStrategies(ctx context.Context, userID uint) ([]*StrategyOutput, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*StrategyOutput), args.Error(1)
+}
+
+func (m *MockStrategyServiceForHandler) GetStrategy(ctx context.Context, userID uint, strategyID uint) (*StrategyOutput, error) {
+	args := m.Called(ctx, userID, strategyID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*StrategyOutput), args.Error(1)
+}
+
+func (m *MockStrategyServiceForHandler) UpdateStrategy(ctx context.Context, userID uint, strategyID uint, input UpdateStrategyInput) (*StrategyOutput, error) {
+	args := m.Called(ctx, userID, strategyID, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*StrategyOutput), args.Error(1)
+}
+
+func (m *MockStrategyServiceForHandler) DeleteStrategy(ctx context.Context, userID uint, strategyID uint) error {
+	args := m.Called(ctx, userID, strategyID)
+	return args.Error(0)
+}
+
+func (m *MockStrategyServiceForHandler) GetPerformance(ctx context.Context, userID uint, strategyID uint) (*PerformanceOutput, error) {
+	args := m.Called(ctx, userID, strategyID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*PerformanceOutput), args.Error(1)
+}
+
+func newStrategyRequest(t *testing.T, method string, url string, body interface{}) *http.Request {
+	bodyBytes, err := json.Marshal(body)
+	require.NoError(t, err)
+
+	req, err := http.NewRequest(method, url, bytes.NewBuffer(bodyBytes))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+	return req
+}
+
+func TestCreateStrategyHandler(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		mockService := new(MockStrategyServiceForHandler)
+		handler := NewStrategyHandler(mockService, slog.Default())
+		input := CreateStrategyInput{Name: "Lay the draw", StakingPlan: StakingPlanFlat, Tags: []string{"football"}}
+
+		mockService.On("CreateStrategy", mock.Anything, uint(1), input).
+			Return(&StrategyOutput{ID: 1, Name: "Lay the draw", StakingPlan: StakingPlanFlat}, nil).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = newStrategyRequest(t, http.MethodPost, "/strategies", input)
+		c.Set("userID", "1")
+
+		handler.CreateStrategy(c)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("validation error - empty tag", func(t *testing.T) {
+		mockService := new(MockStrategyServiceForHandler)
+		handler := NewStrategyHandler(mockService, slog.Default())
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = newStrategyRequest(t, http.MethodPost, "/strategies", CreateStrategyInput{Name: "x", StakingPlan: StakingPlanFlat, Tags: []string{""}})
+		c.Set("userID", "1")
+
+		handler.CreateStrategy(c)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		mockService.AssertNotCalled(t, "CreateStrategy")
+	})
+
+	t.Run("name exists", func(t *testing.T) {
+		mockService := new(MockStrategyServiceForHandler)
+		handler := NewStrategyHandler(mockService, slog.Default())
+		input := CreateStrategyInput{Name: "Lay the draw", StakingPlan: StakingPlanFlat}
+
+		mockService.On("CreateStrategy", mock.Anything, uint(1), input).Return(nil, ErrStrategyNameExists).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = newStrategyRequest(t, http.MethodPost, "/strategies", input)
+		c.Set("userID", "1")
+
+		handler.CreateStrategy(c)
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+}
+
+func TestDeleteStrategyHandler(t *testing.T) {
+	mockService := new(MockStrategyServiceForHandler)
+	handler := NewStrategyHandler(mockService, slog.Default())
+
+	mockService.On("DeleteStrategy", mock.Anything, uint(1), uint(3)).Return(nil).Once()
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest(http.MethodDelete, "/strategies/3", nil)
+	c.Params = gin.Params{{Key: "strategyId", Value: "3"}}
+	c.Set("userID", "1")
+
+	handler.DeleteStrategy(c)
+
+	assert.Equal(t, http.StatusNoContent, c.Writer.Status())
+}
+
+func TestGetPerformanceHandler(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		mockService := new(MockStrategyServiceForHandler)
+		handler := NewStrategyHandler(mockService, slog.Default())
+
+		mockService.On("GetPerformance", mock.Anything, uint(1), uint(3)).Return(&PerformanceOutput{
+			StrategyID:          3,
+			BetCount:            10,
+			StrikeRate:          domain.MustParseDecimal("40.00"),
+			LongestLosingStreak: 4,
+			ByCurrency:          []CurrencyPerformanceOutput{{Currency: domain.CurrencyBRL, ROI: domain.MustParseDecimal("5.25")}},
+		}, nil).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest(http.MethodGet, "/strategies/3/performance", nil)
+		c.Params = gin.Params{{Key: "strategyId", Value: "3"}}
+		c.Set("userID", "1")
+
+		handler.GetPerformance(c)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		var response PerformanceOutput
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, int64(10), response.BetCount)
+		assert.Equal(t, "40.00", response.StrikeRate.String())
+		assert.Equal(t, int64(4), response.LongestLosingStreak)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		mockService := new(MockStrategyServiceForHandler)
+		handler := NewStrategyHandler(mockService, slog.Default())
+
+		mockService.On("GetPerformance", mock.Anything, uint(1), uint(3)).Return(nil, ErrStrategyNotFound).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest(http.MethodGet, "/strategies/3/performance", nil)
+		c.Params = gin.Params{{Key: "strategyId", Value: "3"}}
+		c.Set("userID", "1")
+
+		handler.GetPerformance(c)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		var response ErrorOutput
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, "STRATEGY_NOT_FOUND", response.Code)
+	})
+}
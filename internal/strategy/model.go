@@ -0,0 +1,103 @@
+package strategy
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
+	"gorm.io/gorm"
+)
+
+type StakingPlan string
+
+const (
+	StakingPlanFlat        StakingPlan = "flat"
+	StakingPlanPercentage  StakingPlan = "percentage"
+	StakingPlanKelly       StakingPlan = "kelly"
+	StakingPlanProgressive StakingPlan = "progressive"
+	StakingPlanCustom      StakingPlan = "custom"
+)
+
+func (p StakingPlan) IsValid() bool {
+	switch p {
+	case StakingPlanFlat, StakingPlanPercentage, StakingPlanKelly, StakingPlanProgressive, StakingPlanCustom:
+		return true
+	}
+	return false
+}
+
+// StringList is stored as a JSON array so it works on both jsonb (Postgres)
+// and text (SQLite) columns.
+type StringList []string
+
+func (l StringList) Value() (driver.Value, error) {
+	if l == nil {
+		return "[]", nil
+	}
+	data, err := json.Marshal([]string(l))
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
+
+func (l *StringList) Scan(value interface{}) error {
+	var data []byte
+	switch v := value.(type) {
+	case nil:
+		*l = StringList{}
+		return nil
+	case []byte:
+		data = v
+	case string:
+		data = []byte(v)
+	default:
+		return fmt.Errorf("cannot scan %T into StringList", value)
+	}
+	var items []string
+	if err := json.Unmarshal(data, &items); err != nil {
+		return err
+	}
+	*l = items
+	return nil
+}
+
+type Strategy struct {
+	ID            uint           `gorm:"primaryKey;autoIncrement"`
+	UserID        uint           `gorm:"not null;index"`
+	Name          string         `gorm:"type:varchar(100);not null"`
+	Description   string         `gorm:"type:text"`
+	StakingPlan   StakingPlan    `gorm:"type:varchar(20);not null"`
+	TargetMarkets StringList     `gorm:"type:jsonb;not null"`
+	Tags          StringList     `gorm:"type:jsonb;not null"`
+	CreatedAt     time.Time      `gorm:"autoCreateTime"`
+	UpdatedAt     time.Time      `gorm:"autoUpdateTime"`
+	DeletedAt     gorm.DeletedAt `gorm:"index"`
+}
+
+func (Strategy) TableName() string {
+	return "strategies"
+}
+
+// Performance holds the figures for the bets and poker sessions linked to a
+// strategy. Money figures are split per currency since bankrolls may differ.
+type Performance struct {
+	StrategyID          uint
+	BetCount            int64
+	SettledCount        int64
+	SessionCount        int64
+	ResultCount         int64
+	StrikeRate          domain.Decimal
+	AverageOdds         domain.Decimal
+	LongestLosingStreak int64
+	ByCurrency          []CurrencyPerformance
+}
+
+type CurrencyPerformance struct {
+	Currency domain.Currency
+	Turnover domain.Decimal
+	Profit   domain.Decimal
+	ROI      domain.Decimal
+}
@@ -0,0 +1,238 @@
+package strategy
+
+import (
+	"context"
+
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
+	"gorm.io/gorm"
+)
+
+const postgresSummaryQuery = `
+SELECT
+    COUNT(*) AS bet_count,
+    COUNT(*) FILTER (WHERE b.status <> 'open') AS settled_count,
+    AVG(b.odds) FILTER (WHERE b.status NOT IN ('open', 'void')) AS average_odds
+FROM bets b
+WHERE b.strategy_id = ? AND b.user_id = ? AND b.deleted_at IS NULL`
+
+// SQLite only gained aggregate FILTER in 3.30 and returns NULL for SUM over no
+// rows, so the test path spells the same aggregates out with CASE.
+const sqliteSummaryQuery = `
+SELECT
+    COUNT(*) AS bet_count,
+    COALESCE(SUM(CASE WHEN b.status <> 'open' THEN 1 ELSE 0 END), 0) AS settled_count,
+    AVG(CASE WHEN b.status NOT IN ('open', 'void') THEN b.odds END) AS average_odds
+FROM bets b
+WHERE b.strategy_id = ? AND b.user_id = ? AND b.deleted_at IS NULL`
+
+// resultsCTE lists every decided outcome linked to the strategy: settled,
+// non-void bets and settled poker sessions.
+const resultsCTE = `
+WITH results AS (
+    SELECT b.id AS id, 'bet' AS kind, b.bankroll_id AS bankroll_id, b.stake AS turnover,
+        b.net_profit AS profit, b.settled_at AS settled_at
+    FROM bets b
+    WHERE b.strategy_id = ? AND b.user_id = ? AND b.deleted_at IS NULL
+        AND b.status NOT IN ('open', 'void')
+    UNION ALL
+    SELECT s.id, 'session', s.bankroll_id, s.buy_in + s.rebuys + s.add_ons,
+        s.profit, s.ended_at
+    FROM poker_sessions s
+    WHERE s.strategy_id = ? AND s.user_id = ? AND s.deleted_at IS NULL
+        AND s.status = 'settled'
+)`
+
+const strikeRateQuery = resultsCTE + `
+SELECT
+    COUNT(*) AS result_count,
+    COUNT(CASE WHEN r.profit > 0 THEN 1 END) * 100.0 / NULLIF(COUNT(*), 0) AS strike_rate
+FROM results r`
+
+const currencyPerformanceQuery = resultsCTE + `
+SELECT
+    br.currency AS currency,
+    COALESCE(SUM(r.turnover), 0) AS turnover,
+    COALESCE(SUM(r.profit), 0) AS profit,
+    COALESCE(SUM(r.profit) * 100.0 / NULLIF(SUM(r.turnover), 0), 0) AS roi
+FROM results r
+JOIN bankrolls br ON br.id = r.bankroll_id
+GROUP BY br.currency
+ORDER BY br.currency`
+
+// Gaps-and-islands: consecutive losses share the same difference between the
+// overall row number and the row number within the win/loss partition.
+const longestLosingStreakQuery = resultsCTE + `
+SELECT COALESCE(MAX(streak), 0) AS longest_losing_streak
+FROM (
+    SELECT COUNT(*) AS streak
+    FROM (
+        SELECT
+            CASE WHEN r.profit < 0 THEN 1 ELSE 0 END AS is_loss,
+            ROW_NUMBER() OVER (ORDER BY r.settled_at, r.kind, r.id)
+                - ROW_NUMBER() OVER (PARTITION BY CASE WHEN r.profit < 0 THEN 1 ELSE 0 END ORDER BY r.settled_at, r.kind, r.id) AS grp
+        FROM results r
+    ) runs
+    WHERE runs.is_loss = 1
+    GROUP BY runs.grp
+) streaks`
+
+type postgresStrategyRepository struct {
+	db *gorm.DB
+}
+
+func NewPostgresStrategyRepository(db *gorm.DB) StrategyRepository {
+	return &postgresStrategyRepository{
+		db: db,
+	}
+}
+
+func (r *postgresStrategyRepository) Create(ctx context.Context, strategy *Strategy) error {
+	var existingStrategy Strategy
+	err := r.db.WithContext(ctx).
+		Where("user_id = ? AND name = ?", strategy.UserID, strategy.Name).
+		First(&existingStrategy).Error
+
+	if err == nil {
+		return ErrStrategyNameExists
+	}
+
+	if err != gorm.ErrRecordNotFound {
+		return WrapError(ErrDatabaseError, err.Error())
+	}
+
+	if err := r.db.WithContext(ctx).Create(strategy).Error; err != nil {
+		return WrapError(ErrDatabaseError, err.Error())
+	}
+	return nil
+}
+
+func (r *postgresStrategyRepository) Update(ctx context.Context, strategy *Strategy) error {
+	var existingStrategy Strategy
+	err := r.db.WithContext(ctx).
+		Where("id = ? AND user_id = ?", strategy.ID, strategy.UserID).
+		First(&existingStrategy).Error
+
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return ErrStrategyNotFound
+		}
+		return WrapError(ErrDatabaseError, err.Error())
+	}
+
+	var otherStrategy Strategy
+	err = r.db.WithContext(ctx).
+		Where("user_id = ? AND name = ? AND id != ?", strategy.UserID, strategy.Name, strategy.ID).
+		First(&otherStrategy).Error
+
+	if err == nil {
+		return ErrStrategyNameExists
+	}
+
+	if err != gorm.ErrRecordNotFound {
+		return WrapError(ErrDatabaseError, err.Error())
+	}
+
+	if err := r.db.WithContext(ctx).Model(&existingStrategy).Updates(map[string]interface{}{
+		"name":           strategy.Name,
+		"description":    strategy.Description,
+		"staking_plan":   strategy.StakingPlan,
+		"target_markets": strategy.TargetMarkets,
+		"tags":           strategy.Tags,
+	}).Error; err != nil {
+		return WrapError(ErrDatabaseError, err.Error())
+	}
+	strategy.CreatedAt = existingStrategy.CreatedAt
+	strategy.UpdatedAt = existingStrategy.UpdatedAt
+	return nil
+}
+
+func (r *postgresStrategyRepository) Delete(ctx context.Context, id uint, userID uint) error {
+	result := r.db.WithContext(ctx).
+		Where("id = ? AND user_id = ?", id, userID).
+		Delete(&Strategy{})
+	if result.Error != nil {
+		return WrapError(ErrDatabaseError, result.Error.Error())
+	}
+	if result.RowsAffected == 0 {
+		return ErrStrategyNotFound
+	}
+	return nil
+}
+
+func (r *postgresStrategyRepository) ListByUserID(ctx context.Context, userID uint) ([]*Strategy, error) {
+	var strategies []*Strategy
+	err := r.db.WithContext(ctx).Where("user_id = ?", userID).Order("name ASC").Find(&strategies).Error
+	if err != nil {
+		return nil, WrapError(ErrDatabaseError, err.Error())
+	}
+	return strategies, nil
+}
+
+func (r *postgresStrategyRepository) FindByID(ctx context.Context, id uint, userID uint) (*Strategy, error) {
+	var strategy Strategy
+	err := r.db.WithContext(ctx).Where("id = ? AND user_id = ?", id, userID).First(&strategy).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, ErrStrategyNotFound
+		}
+		return nil, WrapError(ErrDatabaseError, err.Error())
+	}
+	return &strategy, nil
+}
+
+func (r *postgresStrategyRepository) Performance(ctx context.Context, id uint, userID uint) (*Performance, error) {
+	db := r.db.WithContext(ctx)
+
+	summaryQuery := postgresSummaryQuery
+	if r.db.Dialector.Name() == "sqlite" {
+		summaryQuery = sqliteSummaryQuery
+	}
+
+	var summary struct {
+		BetCount     int64
+		SettledCount int64
+		AverageOdds  domain.Decimal
+	}
+	if err := db.Raw(summaryQuery, id, userID).Scan(&summary).Error; err != nil {
+		return nil, WrapError(ErrDatabaseError, err.Error())
+	}
+
+	var sessionCount int64
+	if err := db.Table("poker_sessions").
+		Where("strategy_id = ? AND user_id = ? AND deleted_at IS NULL", id, userID).
+		Count(&sessionCount).Error; err != nil {
+		return nil, WrapError(ErrDatabaseError, err.Error())
+	}
+
+	var results struct {
+		ResultCount int64
+		StrikeRate  domain.Decimal
+	}
+	if err := db.Raw(strikeRateQuery, id, userID, id, userID).Scan(&results).Error; err != nil {
+		return nil, WrapError(ErrDatabaseError, err.Error())
+	}
+
+	var streak struct {
+		LongestLosingStreak int64
+	}
+	if err := db.Raw(longestLosingStreakQuery, id, userID, id, userID).Scan(&streak).Error; err != nil {
+		return nil, WrapError(ErrDatabaseError, err.Error())
+	}
+
+	var byCurrency []CurrencyPerformance
+	if err := db.Raw(currencyPerformanceQuery, id, userID, id, userID).Scan(&byCurrency).Error; err != nil {
+		return nil, WrapError(ErrDatabaseError, err.Error())
+	}
+
+	return &Performance{
+		StrategyID:          id,
+		BetCount:            summary.BetCount,
+		SettledCount:        summary.SettledCount,
+		SessionCount:        sessionCount,
+		ResultCount:         results.ResultCount,
+		StrikeRate:          results.StrikeRate,
+		AverageOdds:         summary.AverageOdds,
+		LongestLosingStreak: streak.LongestLosingStreak,
+		ByCurrency:          byCurrency,
+	}, nil
+}
@@ -0,0 +1,224 @@
+package strategy
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/opinedajr/micro-stakes-api/internal/bankroll"
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// testBet and testSession mirror the columns read by the performance queries;
+// the bet and session packages import this one and cannot be used here.
+type testBet struct {
+	ID         uint `gorm:"primaryKey;autoIncrement"`
+	UserID     uint
+	BankrollID uint
+	StrategyID *uint
+	Status     string
+	Odds       domain.Decimal `gorm:"type:decimal(10,3)"`
+	Stake      domain.Decimal `gorm:"type:decimal(27,8)"`
+	NetProfit  domain.Decimal `gorm:"type:decimal(27,8)"`
+	SettledAt  *time.Time
+	DeletedAt  gorm.DeletedAt
+}
+
+func (testBet) TableName() string {
+	return "bets"
+}
+
+type testSession struct {
+	ID         uint `gorm:"primaryKey;autoIncrement"`
+	UserID     uint
+	BankrollID uint
+	StrategyID *uint
+	Status     string
+	BuyIn      domain.Decimal `gorm:"type:decimal(27,8)"`
+	Rebuys     domain.Decimal `gorm:"type:decimal(27,8)"`
+	AddOns     domain.Decimal `gorm:"type:decimal(27,8)"`
+	Profit     domain.Decimal `gorm:"type:decimal(27,8)"`
+	EndedAt    *time.Time
+	DeletedAt  gorm.DeletedAt
+}
+
+func (testSession) TableName() string {
+	return "poker_sessions"
+}
+
+func setupTestDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+
+	db.AutoMigrate(&Strategy{}, &bankroll.Bankroll{}, &testBet{}, &testSession{})
+
+	return db
+}
+
+func createTestStrategy(t *testing.T, db *gorm.DB, userID uint, name string) *Strategy {
+	strategy := &Strategy{
+		UserID:        userID,
+		Name:          name,
+		StakingPlan:   StakingPlanFlat,
+		TargetMarkets: StringList{"Match Odds"},
+		Tags:          StringList{"football"},
+	}
+	require.NoError(t, NewPostgresStrategyRepository(db).Create(context.Background(), strategy))
+	return strategy
+}
+
+func TestPostgresStrategyRepository_Create(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		db := setupTestDB(t)
+		repo := NewPostgresStrategyRepository(db)
+
+		strategy := createTestStrategy(t, db, 1, "Lay the draw")
+
+		found, err := repo.FindByID(context.Background(), strategy.ID, 1)
+		require.NoError(t, err)
+		assert.Equal(t, "Lay the draw", found.Name)
+		assert.Equal(t, StringList{"Match Odds"}, found.TargetMarkets)
+		assert.Equal(t, StringList{"football"}, found.Tags)
+	})
+
+	t.Run("duplicate name", func(t *testing.T) {
+		db := setupTestDB(t)
+		repo := NewPostgresStrategyRepository(db)
+		createTestStrategy(t, db, 1, "Lay the draw")
+
+		err := repo.Create(context.Background(), &Strategy{UserID: 1, Name: "Lay the draw", StakingPlan: StakingPlanFlat})
+
+		assert.ErrorIs(t, err, ErrStrategyNameExists)
+	})
+}
+
+func TestPostgresStrategyRepository_Update(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewPostgresStrategyRepository(db)
+	strategy := createTestStrategy(t, db, 1, "Lay the draw")
+	createTestStrategy(t, db, 1, "Over 2.5")
+
+	strategy.Name = "Over 2.5"
+	assert.ErrorIs(t, repo.Update(context.Background(), strategy), ErrStrategyNameExists)
+
+	strategy.Name = "Lay the draw v2"
+	strategy.Tags = StringList{"football", "in-play"}
+	require.NoError(t, repo.Update(context.Background(), strategy))
+
+	found, err := repo.FindByID(context.Background(), strategy.ID, 1)
+	require.NoError(t, err)
+	assert.Equal(t, "Lay the draw v2", found.Name)
+	assert.Equal(t, StringList{"football", "in-play"}, found.Tags)
+
+	other := &Strategy{ID: strategy.ID, UserID: 2, Name: "x", StakingPlan: StakingPlanFlat}
+	assert.ErrorIs(t, repo.Update(context.Background(), other), ErrStrategyNotFound)
+}
+
+func TestPostgresStrategyRepository_Delete(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewPostgresStrategyRepository(db)
+	strategy := createTestStrategy(t, db, 1, "Lay the draw")
+
+	assert.ErrorIs(t, repo.Delete(context.Background(), strategy.ID, 2), ErrStrategyNotFound)
+	require.NoError(t, repo.Delete(context.Background(), strategy.ID, 1))
+
+	_, err := repo.FindByID(context.Background(), strategy.ID, 1)
+	assert.ErrorIs(t, err, ErrStrategyNotFound)
+
+	createTestStrategy(t, db, 1, "Lay the draw")
+}
+
+func TestPostgresStrategyRepository_Performance(t *testing.T) {
+	t.Run("aggregates settled bets", func(t *testing.T) {
+		db := setupTestDB(t)
+		repo := NewPostgresStrategyRepository(db)
+		strategy := createTestStrategy(t, db, 1, "Lay the draw")
+		otherStrategy := createTestStrategy(t, db, 1, "Over 2.5")
+
+		brl := &bankroll.Bankroll{UserID: 1, Name: "BRL", Currency: bankroll.CurrencyBRL, StartDate: time.Now()}
+		usd := &bankroll.Bankroll{UserID: 1, Name: "USD", Currency: bankroll.CurrencyUSD, StartDate: time.Now()}
+		require.NoError(t, db.Create(brl).Error)
+		require.NoError(t, db.Create(usd).Error)
+
+		base := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+		bets := []struct {
+			bankrollID uint
+			strategyID *uint
+			status     string
+			odds       string
+			stake      string
+			netProfit  string
+		}{
+			{brl.ID, &strategy.ID, "won", "2.000", "100", "95"},
+			{brl.ID, &strategy.ID, "lost", "3.000", "100", "-100"},
+			{brl.ID, &strategy.ID, "lost", "2.500", "100", "-100"},
+			{usd.ID, &strategy.ID, "lost", "1.500", "50", "-50"},
+			{usd.ID, &strategy.ID, "won", "2.000", "50", "47.5"},
+			{brl.ID, &strategy.ID, "void", "9.000", "100", "0"},
+			{brl.ID, &strategy.ID, "open", "4.000", "100", "0"},
+			{brl.ID, &otherStrategy.ID, "lost", "2.000", "100", "-100"},
+		}
+		for i, b := range bets {
+			settledAt := base.Add(time.Duration(i) * time.Hour)
+			require.NoError(t, db.Create(&testBet{
+				UserID:     1,
+				BankrollID: b.bankrollID,
+				StrategyID: b.strategyID,
+				Status:     b.status,
+				Odds:       domain.MustParseDecimal(b.odds),
+				Stake:      domain.MustParseDecimal(b.stake),
+				NetProfit:  domain.MustParseDecimal(b.netProfit),
+				SettledAt:  &settledAt,
+			}).Error)
+		}
+
+		endedAt := base.Add(10 * time.Hour)
+		require.NoError(t, db.Create(&testSession{
+			UserID:     1,
+			BankrollID: brl.ID,
+			StrategyID: &strategy.ID,
+			Status:     "settled",
+			BuyIn:      domain.MustParseDecimal("50"),
+			Rebuys:     domain.MustParseDecimal("50"),
+			AddOns:     domain.Zero,
+			Profit:     domain.MustParseDecimal("-20"),
+			EndedAt:    &endedAt,
+		}).Error)
+
+		performance, err := repo.Performance(context.Background(), strategy.ID, 1)
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(7), performance.BetCount)
+		assert.Equal(t, int64(6), performance.SettledCount)
+		assert.Equal(t, int64(1), performance.SessionCount)
+		assert.Equal(t, int64(6), performance.ResultCount)
+		assert.Equal(t, 2.2, performance.AverageOdds.Round(3).Float64())
+		assert.Equal(t, 33.33, performance.StrikeRate.Round(2).Float64())
+		assert.Equal(t, int64(3), performance.LongestLosingStreak)
+		require.Len(t, performance.ByCurrency, 2)
+		assert.Equal(t, bankroll.CurrencyBRL, performance.ByCurrency[0].Currency)
+		assert.Equal(t, 400.0, performance.ByCurrency[0].Turnover.Float64())
+		assert.Equal(t, -125.0, performance.ByCurrency[0].Profit.Float64())
+		assert.Equal(t, -31.25, performance.ByCurrency[0].ROI.Round(2).Float64())
+		assert.Equal(t, bankroll.CurrencyUSD, performance.ByCurrency[1].Currency)
+		assert.Equal(t, -2.5, performance.ByCurrency[1].Profit.Float64())
+	})
+
+	t.Run("no bets", func(t *testing.T) {
+		db := setupTestDB(t)
+		repo := NewPostgresStrategyRepository(db)
+		strategy := createTestStrategy(t, db, 1, "Lay the draw")
+
+		performance, err := repo.Performance(context.Background(), strategy.ID, 1)
+
+		require.NoError(t, err)
+		assert.Zero(t, performance.BetCount)
+		assert.True(t, performance.StrikeRate.IsZero())
+		assert.Zero(t, performance.LongestLosingStreak)
+		assert.Empty(t, performance.ByCurrency)
+	})
+}
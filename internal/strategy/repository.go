@@ -0,0 +1,14 @@
+package strategy
+
+import (
+	"context"
+)
+
+type StrategyRepository interface {
+	Create(ctx context.Context, strategy *Strategy) error
+	Update(ctx context.Context, strategy *Strategy) error
+	Delete(ctx context.Context, id uint, userID uint) error
+	ListByUserID(ctx context.Context, userID uint) ([]*Strategy, error)
+	FindByID(ctx context.Context, id uint, userID uint) (*Strategy, error)
+	Performance(ctx context.Context, id uint, userID uint) (*Performance, error)
+}
@@ -0,0 +1,186 @@
+package strategy
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+)
+
+type StrategyService interface {
+	CreateStrategy(ctx context.Context, userID uint, input CreateStrategyInput) (*StrategyOutput, error)
+	ListStrategies(ctx context.Context, userID uint) ([]*StrategyOutput, error)
+	GetStrategy(ctx context.Context, userID uint, strategyID uint) (*StrategyOutput, error)
+	UpdateStrategy(ctx context.Context, userID uint, strategyID uint, input UpdateStrategyInput) (*StrategyOutput, error)
+	DeleteStrategy(ctx context.Context, userID uint, strategyID uint) error
+	GetPerformance(ctx context.Context, userID uint, strategyID uint) (*PerformanceOutput, error)
+}
+
+type strategyService struct {
+	repo   StrategyRepository
+	logger *slog.Logger
+}
+
+func NewStrategyService(repo StrategyRepository, logger *slog.Logger) StrategyService {
+	return &strategyService{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+func (s *strategyService) CreateStrategy(ctx context.Context, userID uint, input CreateStrategyInput) (*StrategyOutput, error) {
+	if !input.StakingPlan.IsValid() {
+		s.logger.Error("invalid staking plan", "staking_plan", input.StakingPlan, "user_id", userID)
+		return nil, ErrInvalidStakingPlan
+	}
+
+	strategy := &Strategy{
+		UserID:        userID,
+		Name:          strings.TrimSpace(input.Name),
+		Description:   input.Description,
+		StakingPlan:   input.StakingPlan,
+		TargetMarkets: normalizeList(input.TargetMarkets),
+		Tags:          normalizeList(input.Tags),
+	}
+
+	if err := s.repo.Create(ctx, strategy); err != nil {
+		s.logger.Error("failed to create strategy", "error", err, "user_id", userID, "name", strategy.Name)
+		return nil, err
+	}
+
+	s.logger.Info("strategy created", "user_id", userID, "strategy_id", strategy.ID, "name", strategy.Name)
+
+	return toStrategyOutput(strategy), nil
+}
+
+func (s *strategyService) ListStrategies(ctx context.Context, userID uint) ([]*StrategyOutput, error) {
+	strategies, err := s.repo.ListByUserID(ctx, userID)
+	if err != nil {
+		s.logger.Error("failed to list strategies", "error", err, "user_id", userID)
+		return nil, err
+	}
+
+	outputs := make([]*StrategyOutput, len(strategies))
+	for i, strategy := range strategies {
+		outputs[i] = toStrategyOutput(strategy)
+	}
+
+	s.logger.Info("strategies listed", "user_id", userID, "count", len(outputs))
+
+	return outputs, nil
+}
+
+func (s *strategyService) GetStrategy(ctx context.Context, userID uint, strategyID uint) (*StrategyOutput, error) {
+	strategy, err := s.repo.FindByID(ctx, strategyID, userID)
+	if err != nil {
+		s.logger.Error("failed to get strategy", "error", err, "user_id", userID, "strategy_id", strategyID)
+		return nil, err
+	}
+
+	return toStrategyOutput(strategy), nil
+}
+
+func (s *strategyService) UpdateStrategy(ctx context.Context, userID uint, strategyID uint, input UpdateStrategyInput) (*StrategyOutput, error) {
+	if !input.StakingPlan.IsValid() {
+		s.logger.Error("invalid staking plan", "staking_plan", input.StakingPlan, "user_id", userID)
+		return nil, ErrInvalidStakingPlan
+	}
+
+	strategy := &Strategy{
+		ID:            strategyID,
+		UserID:        userID,
+		Name:          strings.TrimSpace(input.Name),
+		Description:   input.Description,
+		StakingPlan:   input.StakingPlan,
+		TargetMarkets: normalizeList(input.TargetMarkets),
+		Tags:          normalizeList(input.Tags),
+	}
+
+	if err := s.repo.Update(ctx, strategy); err != nil {
+		s.logger.Error("failed to update strategy", "error", err, "user_id", userID, "strategy_id", strategyID)
+		return nil, err
+	}
+
+	s.logger.Info("strategy updated", "user_id", userID, "strategy_id", strategyID)
+
+	return toStrategyOutput(strategy), nil
+}
+
+func (s *strategyService) DeleteStrategy(ctx context.Context, userID uint, strategyID uint) error {
+	if err := s.repo.Delete(ctx, strategyID, userID); err != nil {
+		s.logger.Error("failed to delete strategy", "error", err, "user_id", userID, "strategy_id", strategyID)
+		return err
+	}
+
+	s.logger.Info("strategy deleted", "user_id", userID, "strategy_id", strategyID)
+
+	return nil
+}
+
+func (s *strategyService) GetPerformance(ctx context.Context, userID uint, strategyID uint) (*PerformanceOutput, error) {
+	if _, err := s.repo.FindByID(ctx, strategyID, userID); err != nil {
+		s.logger.Error("strategy not found", "error", err, "user_id", userID, "strategy_id", strategyID)
+		return nil, err
+	}
+
+	performance, err := s.repo.Performance(ctx, strategyID, userID)
+	if err != nil {
+		s.logger.Error("failed to compute strategy performance", "error", err, "user_id", userID, "strategy_id", strategyID)
+		return nil, err
+	}
+
+	output := &PerformanceOutput{
+		StrategyID:          performance.StrategyID,
+		BetCount:            performance.BetCount,
+		SettledCount:        performance.SettledCount,
+		SessionCount:        performance.SessionCount,
+		ResultCount:         performance.ResultCount,
+		StrikeRate:          performance.StrikeRate.Round(2),
+		AverageOdds:         performance.AverageOdds.Round(3),
+		LongestLosingStreak: performance.LongestLosingStreak,
+		ByCurrency:          make([]CurrencyPerformanceOutput, len(performance.ByCurrency)),
+	}
+	for i, c := range performance.ByCurrency {
+		output.ByCurrency[i] = CurrencyPerformanceOutput{
+			Currency: c.Currency,
+			Turnover: c.Currency.Round(c.Turnover),
+			Profit:   c.Currency.Round(c.Profit),
+			ROI:      c.ROI.Round(2),
+		}
+	}
+
+	return output, nil
+}
+
+func normalizeList(items []string) StringList {
+	seen := make(map[string]bool, len(items))
+	result := StringList{}
+	for _, item := range items {
+		item = strings.TrimSpace(item)
+		if item == "" || seen[strings.ToLower(item)] {
+			continue
+		}
+		seen[strings.ToLower(item)] = true
+		result = append(result, item)
+	}
+	return result
+}
+
+func toStrategyOutput(strategy *Strategy) *StrategyOutput {
+	return &StrategyOutput{
+		ID:            strategy.ID,
+		Name:          strategy.Name,
+		Description:   strategy.Description,
+		StakingPlan:   strategy.StakingPlan,
+		TargetMarkets: nonNilList(strategy.TargetMarkets),
+		Tags:          nonNilList(strategy.Tags),
+		CreatedAt:     strategy.CreatedAt,
+		UpdatedAt:     strategy.UpdatedAt,
+	}
+}
+
+func nonNilList(items StringList) []string {
+	if items == nil {
+		return []string{}
+	}
+	return items
+}
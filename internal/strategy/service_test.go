@@ -0,0 +1,172 @@
+package strategy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/opinedajr/micro-stakes-api/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"log/slog"
+)
+
+type MockStrategyRepository struct {
+	mock.Mock
+}
+
+func (m *MockStrategyRepository) Create(ctx context.Context, strategy *Strategy) error {
+	args := m.Called(ctx, strategy)
+	return args.Error(0)
+}
+
+func (m *MockStrategyRepository) Update(ctx context.Context, strategy *Strategy) error {
+	args := m.Called(ctx, strategy)
+	return args.Error(0)
+}
+
+func (m *MockStrategyRepository) Delete(ctx context.Context, id uint, userID uint) error {
+	args := m.Called(ctx, id, userID)
+	return args.Error(0)
+}
+
+func (m *MockStrategyRepository) ListByUserID(ctx context.Context, userID uint) ([]*Strategy, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*Strategy), args.Error(1)
+}
+
+func (m *MockStrategyRepository) FindByID(ctx context.Context, id uint, userID uint) (*Strategy, error) {
+	args := m.Called(ctx, id, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Strategy), args.Error(1)
+}
+
+func (m *MockStrategyRepository) Performance(ctx context.Context, id uint, userID uint) (*Performance, error) {
+	args := m.Called(ctx, id, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Performance), args.Error(1)
+}
+
+func TestCreateStrategy(t *testing.T) {
+	t.Run("success - normalizes lists", func(t *testing.T) {
+		mockRepo := new(MockStrategyRepository)
+		service := NewStrategyService(mockRepo, slog.Default())
+		ctx := context.Background()
+
+		mockRepo.On("Create", ctx, mock.MatchedBy(func(strategy *Strategy) bool {
+			return strategy.UserID == 1 && strategy.Name == "Lay the draw" &&
+				len(strategy.Tags) == 2 && strategy.Tags[0] == "football" && strategy.Tags[1] == "in-play"
+		})).Return(nil).Once()
+
+		output, err := service.CreateStrategy(ctx, 1, CreateStrategyInput{
+			Name:        " Lay the draw ",
+			StakingPlan: StakingPlanFlat,
+			Tags:        []string{"football", " in-play", "Football"},
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "Lay the draw", output.Name)
+		assert.Equal(t, []string{}, output.TargetMarkets)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("validation error - staking plan", func(t *testing.T) {
+		mockRepo := new(MockStrategyRepository)
+		service := NewStrategyService(mockRepo, slog.Default())
+
+		output, err := service.CreateStrategy(context.Background(), 1, CreateStrategyInput{Name: "x", StakingPlan: "martingale"})
+
+		assert.Nil(t, output)
+		assert.ErrorIs(t, err, ErrInvalidStakingPlan)
+		mockRepo.AssertNotCalled(t, "Create")
+	})
+
+	t.Run("name exists", func(t *testing.T) {
+		mockRepo := new(MockStrategyRepository)
+		service := NewStrategyService(mockRepo, slog.Default())
+
+		mockRepo.On("Create", mock.Anything, mock.Anything).Return(ErrStrategyNameExists).Once()
+
+		_, err := service.CreateStrategy(context.Background(), 1, CreateStrategyInput{Name: "x", StakingPlan: StakingPlanKelly})
+
+		assert.ErrorIs(t, err, ErrStrategyNameExists)
+	})
+}
+
+func TestUpdateStrategy(t *testing.T) {
+	mockRepo := new(MockStrategyRepository)
+	service := NewStrategyService(mockRepo, slog.Default())
+
+	mockRepo.On("Update", mock.Anything, mock.MatchedBy(func(strategy *Strategy) bool {
+		return strategy.ID == 3 && strategy.UserID == 1 && strategy.StakingPlan == StakingPlanPercentage
+	})).Return(nil).Once()
+
+	output, err := service.UpdateStrategy(context.Background(), 1, 3, UpdateStrategyInput{Name: "Value", StakingPlan: StakingPlanPercentage})
+
+	assert.NoError(t, err)
+	assert.Equal(t, uint(3), output.ID)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestDeleteStrategy(t *testing.T) {
+	mockRepo := new(MockStrategyRepository)
+	service := NewStrategyService(mockRepo, slog.Default())
+
+	mockRepo.On("Delete", mock.Anything, uint(3), uint(2)).Return(ErrStrategyNotFound).Once()
+
+	err := service.DeleteStrategy(context.Background(), 2, 3)
+
+	assert.ErrorIs(t, err, ErrStrategyNotFound)
+}
+
+func TestGetPerformance(t *testing.T) {
+	t.Run("success - rounds figures", func(t *testing.T) {
+		mockRepo := new(MockStrategyRepository)
+		service := NewStrategyService(mockRepo, slog.Default())
+		ctx := context.Background()
+
+		mockRepo.On("FindByID", ctx, uint(3), uint(1)).Return(&Strategy{ID: 3, UserID: 1}, nil).Once()
+		mockRepo.On("Performance", ctx, uint(3), uint(1)).Return(&Performance{
+			StrategyID:          3,
+			BetCount:            3,
+			SettledCount:        3,
+			StrikeRate:          domain.MustParseDecimal("33.333333"),
+			AverageOdds:         domain.MustParseDecimal("2.16666666"),
+			LongestLosingStreak: 2,
+			ByCurrency: []CurrencyPerformance{{
+				Currency: domain.CurrencyBRL,
+				Turnover: domain.MustParseDecimal("300"),
+				Profit:   domain.MustParseDecimal("-105.004"),
+				ROI:      domain.MustParseDecimal("-35.00133333"),
+			}},
+		}, nil).Once()
+
+		output, err := service.GetPerformance(ctx, 1, 3)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "33.33", output.StrikeRate.String())
+		assert.Equal(t, "2.167", output.AverageOdds.String())
+		assert.Equal(t, int64(2), output.LongestLosingStreak)
+		assert.Equal(t, "-105.00", output.ByCurrency[0].Profit.String())
+		assert.Equal(t, "-35.00", output.ByCurrency[0].ROI.String())
+	})
+
+	t.Run("strategy not found", func(t *testing.T) {
+		mockRepo := new(MockStrategyRepository)
+		service := NewStrategyService(mockRepo, slog.Default())
+
+		mockRepo.On("FindByID", mock.Anything, uint(3), uint(2)).Return(nil, ErrStrategyNotFound).Once()
+
+		output, err := service.GetPerformance(context.Background(), 2, 3)
+
+		assert.Nil(t, output)
+		assert.ErrorIs(t, err, ErrStrategyNotFound)
+		mockRepo.AssertNotCalled(t, "Performance", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
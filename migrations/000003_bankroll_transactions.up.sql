@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS bankroll_transactions (
+    id BIGSERIAL PRIMARY KEY,
+    bankroll_id BIGINT NOT NULL,
+    type VARCHAR(20) NOT NULL,
+    amount NUMERIC(19, 4) NOT NULL,
+    balance_after NUMERIC(19, 4) NOT NULL,
+    description VARCHAR(255),
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT fk_bankroll FOREIGN KEY (bankroll_id) REFERENCES bankrolls(id),
+    CONSTRAINT ck_transaction_type CHECK (type IN ('deposit', 'withdrawal', 'adjustment')),
+    CONSTRAINT ck_transaction_amount_nonzero CHECK (amount <> 0),
+    CONSTRAINT ck_balance_after_nonnegative CHECK (balance_after >= 0)
+);
+
+CREATE INDEX IF NOT EXISTS idx_bankroll_transactions_bankroll_id ON bankroll_transactions(bankroll_id);
+CREATE INDEX IF NOT EXISTS idx_bankroll_transactions_created_at ON bankroll_transactions(created_at);
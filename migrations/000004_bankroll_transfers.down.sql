@@ -0,0 +1,19 @@
+-- Dropping transfer ledger rows would leave current_balance and every later
+-- balance_after out of step with the ledger, so rolling back is refused once
+-- any transfer has been made.
+DO $$
+BEGIN
+    IF EXISTS (SELECT 1 FROM bankroll_transactions WHERE transfer_id IS NOT NULL) THEN
+        RAISE EXCEPTION 'cannot roll back bankroll transfers: transfer ledger entries exist';
+    END IF;
+END
+$$;
+
+DROP INDEX IF EXISTS idx_bankroll_transactions_transfer_id;
+ALTER TABLE bankroll_transactions DROP CONSTRAINT IF EXISTS ck_transaction_type;
+ALTER TABLE bankroll_transactions ADD CONSTRAINT ck_transaction_type CHECK (type IN ('deposit', 'withdrawal', 'adjustment'));
+ALTER TABLE bankroll_transactions DROP CONSTRAINT IF EXISTS fk_transaction_transfer;
+ALTER TABLE bankroll_transactions DROP COLUMN IF EXISTS transfer_id;
+
+DROP TABLE IF EXISTS bankroll_transfers;
+DROP TABLE IF EXISTS exchange_rates;
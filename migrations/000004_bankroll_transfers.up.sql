@@ -0,0 +1,39 @@
+CREATE TABLE IF NOT EXISTS exchange_rates (
+    id BIGSERIAL PRIMARY KEY,
+    base_currency currency_type NOT NULL,
+    quote_currency currency_type NOT NULL,
+    rate NUMERIC(19, 8) NOT NULL,
+    rate_date DATE NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT uq_exchange_rate_per_day UNIQUE (base_currency, quote_currency, rate_date),
+    CONSTRAINT ck_exchange_rate_positive CHECK (rate > 0)
+);
+
+CREATE TABLE IF NOT EXISTS bankroll_transfers (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    from_bankroll_id BIGINT NOT NULL,
+    to_bankroll_id BIGINT NOT NULL,
+    amount NUMERIC(19, 4) NOT NULL,
+    converted_amount NUMERIC(19, 4) NOT NULL,
+    exchange_rate NUMERIC(19, 8) NOT NULL,
+    description VARCHAR(255),
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT fk_transfer_user FOREIGN KEY (user_id) REFERENCES users(id),
+    CONSTRAINT fk_transfer_from_bankroll FOREIGN KEY (from_bankroll_id) REFERENCES bankrolls(id),
+    CONSTRAINT fk_transfer_to_bankroll FOREIGN KEY (to_bankroll_id) REFERENCES bankrolls(id),
+    CONSTRAINT ck_transfer_distinct_bankrolls CHECK (from_bankroll_id <> to_bankroll_id),
+    CONSTRAINT ck_transfer_amount_positive CHECK (amount > 0 AND converted_amount > 0),
+    CONSTRAINT ck_transfer_rate_positive CHECK (exchange_rate > 0)
+);
+
+CREATE INDEX IF NOT EXISTS idx_bankroll_transfers_user_id ON bankroll_transfers(user_id);
+CREATE INDEX IF NOT EXISTS idx_bankroll_transfers_from_bankroll_id ON bankroll_transfers(from_bankroll_id);
+CREATE INDEX IF NOT EXISTS idx_bankroll_transfers_to_bankroll_id ON bankroll_transfers(to_bankroll_id);
+
+ALTER TABLE bankroll_transactions ADD COLUMN transfer_id BIGINT;
+ALTER TABLE bankroll_transactions ADD CONSTRAINT fk_transaction_transfer FOREIGN KEY (transfer_id) REFERENCES bankroll_transfers(id);
+ALTER TABLE bankroll_transactions DROP CONSTRAINT IF EXISTS ck_transaction_type;
+ALTER TABLE bankroll_transactions ADD CONSTRAINT ck_transaction_type CHECK (type IN ('deposit', 'withdrawal', 'adjustment', 'transfer_in', 'transfer_out'));
+
+CREATE INDEX IF NOT EXISTS idx_bankroll_transactions_transfer_id ON bankroll_transactions(transfer_id);
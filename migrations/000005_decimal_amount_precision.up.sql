@@ -0,0 +1,11 @@
+ALTER TABLE bankrolls
+    ALTER COLUMN initial_balance TYPE NUMERIC(27, 8),
+    ALTER COLUMN current_balance TYPE NUMERIC(27, 8);
+
+ALTER TABLE bankroll_transactions
+    ALTER COLUMN amount TYPE NUMERIC(27, 8),
+    ALTER COLUMN balance_after TYPE NUMERIC(27, 8);
+
+ALTER TABLE bankroll_transfers
+    ALTER COLUMN amount TYPE NUMERIC(27, 8),
+    ALTER COLUMN converted_amount TYPE NUMERIC(27, 8);
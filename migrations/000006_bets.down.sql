@@ -0,0 +1,9 @@
+DROP INDEX IF EXISTS idx_bankroll_transactions_reference;
+
+DELETE FROM bankroll_transactions WHERE type = 'bet_settlement';
+ALTER TABLE bankroll_transactions DROP CONSTRAINT IF EXISTS ck_transaction_type;
+ALTER TABLE bankroll_transactions ADD CONSTRAINT ck_transaction_type CHECK (type IN ('deposit', 'withdrawal', 'adjustment', 'transfer_in', 'transfer_out'));
+ALTER TABLE bankroll_transactions DROP COLUMN IF EXISTS reference_id;
+ALTER TABLE bankroll_transactions DROP COLUMN IF EXISTS reference_type;
+
+DROP TABLE IF EXISTS bets;
@@ -0,0 +1,40 @@
+CREATE TABLE IF NOT EXISTS bets (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    bankroll_id BIGINT NOT NULL,
+    type VARCHAR(10) NOT NULL,
+    event VARCHAR(200) NOT NULL,
+    market VARCHAR(100) NOT NULL,
+    selection VARCHAR(100) NOT NULL,
+    odds NUMERIC(10, 3) NOT NULL,
+    stake NUMERIC(27, 8) NOT NULL,
+    liability NUMERIC(27, 8) NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'open',
+    gross_profit NUMERIC(27, 8) NOT NULL DEFAULT 0,
+    commission NUMERIC(27, 8) NOT NULL DEFAULT 0,
+    net_profit NUMERIC(27, 8) NOT NULL DEFAULT 0,
+    placed_at TIMESTAMPTZ NOT NULL,
+    settled_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMPTZ,
+    CONSTRAINT fk_bet_user FOREIGN KEY (user_id) REFERENCES users(id),
+    CONSTRAINT fk_bet_bankroll FOREIGN KEY (bankroll_id) REFERENCES bankrolls(id),
+    CONSTRAINT ck_bet_type CHECK (type IN ('back', 'lay')),
+    CONSTRAINT ck_bet_status CHECK (status IN ('open', 'won', 'lost', 'void', 'cashed_out')),
+    CONSTRAINT ck_bet_odds CHECK (odds > 1),
+    CONSTRAINT ck_bet_stake_positive CHECK (stake > 0 AND liability > 0)
+);
+
+CREATE INDEX IF NOT EXISTS idx_bets_user_id ON bets(user_id);
+CREATE INDEX IF NOT EXISTS idx_bets_bankroll_id ON bets(bankroll_id);
+CREATE INDEX IF NOT EXISTS idx_bets_status ON bets(status);
+CREATE INDEX IF NOT EXISTS idx_bets_market ON bets(bankroll_id, event, market);
+CREATE INDEX IF NOT EXISTS idx_bets_deleted_at ON bets(deleted_at);
+
+ALTER TABLE bankroll_transactions ADD COLUMN reference_type VARCHAR(30);
+ALTER TABLE bankroll_transactions ADD COLUMN reference_id BIGINT;
+ALTER TABLE bankroll_transactions DROP CONSTRAINT IF EXISTS ck_transaction_type;
+ALTER TABLE bankroll_transactions ADD CONSTRAINT ck_transaction_type CHECK (type IN ('deposit', 'withdrawal', 'adjustment', 'transfer_in', 'transfer_out', 'bet_settlement'));
+
+CREATE INDEX IF NOT EXISTS idx_bankroll_transactions_reference ON bankroll_transactions(reference_type, reference_id);
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_bets_strategy_id;
+ALTER TABLE bets DROP CONSTRAINT IF EXISTS fk_bet_strategy;
+ALTER TABLE bets DROP COLUMN IF EXISTS strategy_id;
+
+DROP TABLE IF EXISTS strategies;
@@ -0,0 +1,24 @@
+CREATE TABLE IF NOT EXISTS strategies (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    description TEXT,
+    staking_plan VARCHAR(20) NOT NULL,
+    target_markets JSONB NOT NULL DEFAULT '[]',
+    tags JSONB NOT NULL DEFAULT '[]',
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMPTZ,
+    CONSTRAINT fk_strategy_user FOREIGN KEY (user_id) REFERENCES users(id),
+    CONSTRAINT ck_strategy_staking_plan CHECK (staking_plan IN ('flat', 'percentage', 'kelly', 'progressive', 'custom'))
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_strategy_name_per_user ON strategies(user_id, name) WHERE deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_strategies_user_id ON strategies(user_id);
+CREATE INDEX IF NOT EXISTS idx_strategies_deleted_at ON strategies(deleted_at);
+CREATE INDEX IF NOT EXISTS idx_strategies_tags ON strategies USING GIN (tags);
+
+ALTER TABLE bets ADD COLUMN strategy_id BIGINT;
+ALTER TABLE bets ADD CONSTRAINT fk_bet_strategy FOREIGN KEY (strategy_id) REFERENCES strategies(id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_bets_strategy_id ON bets(strategy_id);
@@ -0,0 +1,5 @@
+DELETE FROM bankroll_transactions WHERE type = 'session_result';
+ALTER TABLE bankroll_transactions DROP CONSTRAINT IF EXISTS ck_transaction_type;
+ALTER TABLE bankroll_transactions ADD CONSTRAINT ck_transaction_type CHECK (type IN ('deposit', 'withdrawal', 'adjustment', 'transfer_in', 'transfer_out', 'bet_settlement'));
+
+DROP TABLE IF EXISTS poker_sessions;
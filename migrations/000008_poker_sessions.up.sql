@@ -0,0 +1,38 @@
+CREATE TABLE IF NOT EXISTS poker_sessions (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    bankroll_id BIGINT NOT NULL,
+    strategy_id BIGINT,
+    game_type VARCHAR(10) NOT NULL,
+    stakes VARCHAR(50) NOT NULL,
+    venue VARCHAR(100) NOT NULL,
+    status VARCHAR(20) NOT NULL,
+    started_at TIMESTAMPTZ NOT NULL,
+    ended_at TIMESTAMPTZ,
+    buy_in NUMERIC(27, 8) NOT NULL DEFAULT 0,
+    rebuys NUMERIC(27, 8) NOT NULL DEFAULT 0,
+    add_ons NUMERIC(27, 8) NOT NULL DEFAULT 0,
+    bounties NUMERIC(27, 8) NOT NULL DEFAULT 0,
+    cash_out NUMERIC(27, 8) NOT NULL DEFAULT 0,
+    profit NUMERIC(27, 8) NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMPTZ,
+    CONSTRAINT fk_session_user FOREIGN KEY (user_id) REFERENCES users(id),
+    CONSTRAINT fk_session_bankroll FOREIGN KEY (bankroll_id) REFERENCES bankrolls(id),
+    CONSTRAINT fk_session_strategy FOREIGN KEY (strategy_id) REFERENCES strategies(id) ON DELETE SET NULL,
+    CONSTRAINT ck_session_game_type CHECK (game_type IN ('nlhe', 'plo', 'mtt', 'sng', 'spin')),
+    CONSTRAINT ck_session_status CHECK (status IN ('settled')),
+    CONSTRAINT ck_session_amounts_nonnegative CHECK (buy_in >= 0 AND rebuys >= 0 AND add_ons >= 0 AND bounties >= 0 AND cash_out >= 0),
+    CONSTRAINT ck_session_time_range CHECK (ended_at IS NULL OR ended_at >= started_at)
+);
+
+CREATE INDEX IF NOT EXISTS idx_poker_sessions_user_id ON poker_sessions(user_id);
+CREATE INDEX IF NOT EXISTS idx_poker_sessions_bankroll_id ON poker_sessions(bankroll_id);
+CREATE INDEX IF NOT EXISTS idx_poker_sessions_strategy_id ON poker_sessions(strategy_id);
+CREATE INDEX IF NOT EXISTS idx_poker_sessions_started_at ON poker_sessions(started_at);
+CREATE INDEX IF NOT EXISTS idx_poker_sessions_status ON poker_sessions(status);
+CREATE INDEX IF NOT EXISTS idx_poker_sessions_deleted_at ON poker_sessions(deleted_at);
+
+ALTER TABLE bankroll_transactions DROP CONSTRAINT IF EXISTS ck_transaction_type;
+ALTER TABLE bankroll_transactions ADD CONSTRAINT ck_transaction_type CHECK (type IN ('deposit', 'withdrawal', 'adjustment', 'transfer_in', 'transfer_out', 'bet_settlement', 'session_result'));
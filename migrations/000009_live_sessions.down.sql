@@ -0,0 +1,13 @@
+ALTER TABLE bankroll_transactions DROP CONSTRAINT IF EXISTS ck_transaction_type;
+ALTER TABLE bankroll_transactions ADD CONSTRAINT ck_transaction_type CHECK (type IN ('deposit', 'withdrawal', 'adjustment', 'transfer_in', 'transfer_out', 'bet_settlement', 'session_result'));
+
+DROP INDEX IF EXISTS uq_open_session_per_table;
+
+ALTER TABLE poker_sessions DROP CONSTRAINT IF EXISTS ck_session_status;
+ALTER TABLE poker_sessions ADD CONSTRAINT ck_session_status CHECK (status IN ('settled'));
+
+ALTER TABLE poker_sessions DROP COLUMN IF EXISTS auto_closed;
+ALTER TABLE poker_sessions DROP COLUMN IF EXISTS poker_table;
+
+ALTER TABLE bankrolls DROP CONSTRAINT IF EXISTS ck_in_play_nonnegative;
+ALTER TABLE bankrolls DROP COLUMN IF EXISTS in_play;
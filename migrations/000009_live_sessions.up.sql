@@ -0,0 +1,15 @@
+ALTER TABLE bankrolls ADD COLUMN IF NOT EXISTS in_play NUMERIC(27, 8) NOT NULL DEFAULT 0;
+ALTER TABLE bankrolls ADD CONSTRAINT ck_in_play_nonnegative CHECK (in_play >= 0);
+
+ALTER TABLE poker_sessions ADD COLUMN IF NOT EXISTS poker_table VARCHAR(50) NOT NULL DEFAULT '';
+ALTER TABLE poker_sessions ADD COLUMN IF NOT EXISTS auto_closed BOOLEAN NOT NULL DEFAULT FALSE;
+
+ALTER TABLE poker_sessions DROP CONSTRAINT IF EXISTS ck_session_status;
+ALTER TABLE poker_sessions ADD CONSTRAINT ck_session_status CHECK (status IN ('open', 'settled'));
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_open_session_per_table
+    ON poker_sessions(user_id, venue, poker_table)
+    WHERE status = 'open' AND deleted_at IS NULL;
+
+ALTER TABLE bankroll_transactions DROP CONSTRAINT IF EXISTS ck_transaction_type;
+ALTER TABLE bankroll_transactions ADD CONSTRAINT ck_transaction_type CHECK (type IN ('deposit', 'withdrawal', 'adjustment', 'transfer_in', 'transfer_out', 'bet_settlement', 'session_result', 'session_buy_in', 'session_cash_out'));
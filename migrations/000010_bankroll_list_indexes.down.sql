@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_bankrolls_user_current_balance;
+DROP INDEX IF EXISTS idx_bankrolls_user_name;
+DROP INDEX IF EXISTS idx_bankrolls_user_created_at;
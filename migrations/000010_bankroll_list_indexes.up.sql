@@ -0,0 +1,3 @@
+CREATE INDEX IF NOT EXISTS idx_bankrolls_user_created_at ON bankrolls(user_id, created_at, id);
+CREATE INDEX IF NOT EXISTS idx_bankrolls_user_name ON bankrolls(user_id, name, id);
+CREATE INDEX IF NOT EXISTS idx_bankrolls_user_current_balance ON bankrolls(user_id, current_balance, id);
@@ -0,0 +1,16 @@
+-- The restored constraint also covers soft-deleted bankrolls, which may share
+-- a name with a live bankroll or with each other. Those are renamed after
+-- their id first so the constraint can be added back.
+UPDATE bankrolls b
+SET name = LEFT(b.name, 79) || ' #' || b.id
+WHERE b.deleted_at IS NOT NULL
+  AND EXISTS (
+      SELECT 1 FROM bankrolls other
+      WHERE other.user_id = b.user_id AND other.name = b.name AND other.id <> b.id
+  );
+
+DROP INDEX IF EXISTS uq_bankroll_name_per_user;
+ALTER TABLE bankrolls ADD CONSTRAINT uq_bankroll_name_per_user UNIQUE (user_id, name);
+
+DROP INDEX IF EXISTS idx_bankrolls_archived_at;
+ALTER TABLE bankrolls DROP COLUMN IF EXISTS archived_at;
@@ -0,0 +1,7 @@
+ALTER TABLE bankrolls ADD COLUMN IF NOT EXISTS archived_at TIMESTAMPTZ;
+CREATE INDEX IF NOT EXISTS idx_bankrolls_archived_at ON bankrolls(archived_at);
+
+ALTER TABLE bankrolls DROP CONSTRAINT IF EXISTS uq_bankroll_name_per_user;
+CREATE UNIQUE INDEX IF NOT EXISTS uq_bankroll_name_per_user
+    ON bankrolls(user_id, name)
+    WHERE deleted_at IS NULL;
@@ -0,0 +1,5 @@
+UPDATE bankroll_transactions SET type = 'adjustment' WHERE type = 'reset';
+ALTER TABLE bankroll_transactions DROP CONSTRAINT IF EXISTS ck_transaction_type;
+ALTER TABLE bankroll_transactions ADD CONSTRAINT ck_transaction_type CHECK (type IN ('deposit', 'withdrawal', 'adjustment', 'transfer_in', 'transfer_out', 'bet_settlement', 'session_result', 'session_buy_in', 'session_cash_out'));
+
+DROP TABLE IF EXISTS bankroll_periods;
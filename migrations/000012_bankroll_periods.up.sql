@@ -0,0 +1,22 @@
+CREATE TABLE IF NOT EXISTS bankroll_periods (
+    id BIGSERIAL PRIMARY KEY,
+    bankroll_id BIGINT NOT NULL,
+    user_id BIGINT NOT NULL,
+    start_date DATE NOT NULL,
+    end_date DATE NOT NULL,
+    started_at TIMESTAMPTZ NOT NULL,
+    ended_at TIMESTAMPTZ NOT NULL,
+    initial_balance NUMERIC(27, 8) NOT NULL,
+    final_balance NUMERIC(27, 8) NOT NULL,
+    profit NUMERIC(27, 8) NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT fk_period_bankroll FOREIGN KEY (bankroll_id) REFERENCES bankrolls(id),
+    CONSTRAINT fk_period_user FOREIGN KEY (user_id) REFERENCES users(id),
+    CONSTRAINT ck_period_range CHECK (ended_at >= started_at)
+);
+
+CREATE INDEX IF NOT EXISTS idx_bankroll_periods_bankroll_id ON bankroll_periods(bankroll_id, created_at, id);
+CREATE INDEX IF NOT EXISTS idx_bankroll_periods_user_id ON bankroll_periods(user_id);
+
+ALTER TABLE bankroll_transactions DROP CONSTRAINT IF EXISTS ck_transaction_type;
+ALTER TABLE bankroll_transactions ADD CONSTRAINT ck_transaction_type CHECK (type IN ('deposit', 'withdrawal', 'adjustment', 'transfer_in', 'transfer_out', 'bet_settlement', 'session_result', 'session_buy_in', 'session_cash_out', 'reset'));
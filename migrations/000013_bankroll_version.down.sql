@@ -0,0 +1,2 @@
+ALTER TABLE bankrolls DROP CONSTRAINT IF EXISTS ck_version_positive;
+ALTER TABLE bankrolls DROP COLUMN IF EXISTS version;
@@ -0,0 +1,2 @@
+ALTER TABLE bankrolls ADD COLUMN IF NOT EXISTS version BIGINT NOT NULL DEFAULT 1;
+ALTER TABLE bankrolls ADD CONSTRAINT ck_version_positive CHECK (version > 0);
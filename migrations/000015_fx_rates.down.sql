@@ -0,0 +1,3 @@
+ALTER TABLE exchange_rates DROP CONSTRAINT IF EXISTS ck_exchange_rate_distinct_currencies;
+ALTER TABLE exchange_rates DROP COLUMN IF EXISTS updated_at;
+ALTER TABLE exchange_rates DROP COLUMN IF EXISTS source;
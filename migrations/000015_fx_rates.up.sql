@@ -0,0 +1,3 @@
+ALTER TABLE exchange_rates ADD COLUMN IF NOT EXISTS source VARCHAR(50) NOT NULL DEFAULT 'manual';
+ALTER TABLE exchange_rates ADD COLUMN IF NOT EXISTS updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP;
+ALTER TABLE exchange_rates ADD CONSTRAINT ck_exchange_rate_distinct_currencies CHECK (base_currency <> quote_currency);
@@ -0,0 +1,26 @@
+-- The currency_type enum only knows the four original currencies. A bankroll
+-- in any other currency has no value to fall back to, so rolling back is
+-- refused while one exists. Exchange rates for those currencies are dropped.
+DO $$
+BEGIN
+    IF EXISTS (SELECT 1 FROM bankrolls WHERE currency NOT IN ('BRL', 'USD', 'EUR', 'BTC')) THEN
+        RAISE EXCEPTION 'cannot roll back currencies: bankrolls use currencies outside BRL, USD, EUR and BTC';
+    END IF;
+END
+$$;
+
+DELETE FROM exchange_rates
+WHERE base_currency NOT IN ('BRL', 'USD', 'EUR', 'BTC')
+   OR quote_currency NOT IN ('BRL', 'USD', 'EUR', 'BTC');
+
+ALTER TABLE exchange_rates DROP CONSTRAINT IF EXISTS fk_exchange_rate_quote_currency;
+ALTER TABLE exchange_rates DROP CONSTRAINT IF EXISTS fk_exchange_rate_base_currency;
+ALTER TABLE bankrolls DROP CONSTRAINT IF EXISTS fk_bankroll_currency;
+
+CREATE TYPE currency_type AS ENUM ('BRL', 'USD', 'EUR', 'BTC');
+
+ALTER TABLE exchange_rates ALTER COLUMN quote_currency TYPE currency_type USING quote_currency::currency_type;
+ALTER TABLE exchange_rates ALTER COLUMN base_currency TYPE currency_type USING base_currency::currency_type;
+ALTER TABLE bankrolls ALTER COLUMN currency TYPE currency_type USING currency::currency_type;
+
+DROP TABLE IF EXISTS currencies;
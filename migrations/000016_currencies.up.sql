@@ -0,0 +1,28 @@
+CREATE TABLE IF NOT EXISTS currencies (
+    code VARCHAR(10) PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    decimals SMALLINT NOT NULL,
+    symbol VARCHAR(10) NOT NULL DEFAULT '',
+    is_crypto BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT ck_currency_code_format CHECK (code ~ '^[A-Z0-9]{2,10}$'),
+    CONSTRAINT ck_currency_decimals CHECK (decimals BETWEEN 0 AND 8)
+);
+
+INSERT INTO currencies (code, name, decimals, symbol, is_crypto) VALUES
+    ('BRL', 'Brazilian Real', 2, 'R$', FALSE),
+    ('USD', 'US Dollar', 2, '$', FALSE),
+    ('EUR', 'Euro', 2, '€', FALSE),
+    ('BTC', 'Bitcoin', 8, '₿', TRUE)
+ON CONFLICT (code) DO NOTHING;
+
+ALTER TABLE bankrolls ALTER COLUMN currency TYPE VARCHAR(10) USING currency::text;
+ALTER TABLE exchange_rates ALTER COLUMN base_currency TYPE VARCHAR(10) USING base_currency::text;
+ALTER TABLE exchange_rates ALTER COLUMN quote_currency TYPE VARCHAR(10) USING quote_currency::text;
+
+ALTER TABLE bankrolls ADD CONSTRAINT fk_bankroll_currency FOREIGN KEY (currency) REFERENCES currencies(code);
+ALTER TABLE exchange_rates ADD CONSTRAINT fk_exchange_rate_base_currency FOREIGN KEY (base_currency) REFERENCES currencies(code);
+ALTER TABLE exchange_rates ADD CONSTRAINT fk_exchange_rate_quote_currency FOREIGN KEY (quote_currency) REFERENCES currencies(code);
+
+DROP TYPE IF EXISTS currency_type;
@@ -0,0 +1,20 @@
+CREATE TABLE IF NOT EXISTS bankroll_rules (
+    id BIGSERIAL PRIMARY KEY,
+    bankroll_id BIGINT NOT NULL,
+    user_id BIGINT NOT NULL,
+    type VARCHAR(30) NOT NULL,
+    value NUMERIC(27, 8) NOT NULL,
+    severity VARCHAR(10) NOT NULL DEFAULT 'hard',
+    game_type VARCHAR(10),
+    stakes VARCHAR(50),
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT fk_rule_bankroll FOREIGN KEY (bankroll_id) REFERENCES bankrolls(id),
+    CONSTRAINT fk_rule_user FOREIGN KEY (user_id) REFERENCES users(id),
+    CONSTRAINT ck_rule_type CHECK (type IN ('max_buy_in_percent', 'min_buy_ins', 'max_daily_loss', 'max_open_exposure')),
+    CONSTRAINT ck_rule_severity CHECK (severity IN ('hard', 'soft')),
+    CONSTRAINT ck_rule_value_positive CHECK (value > 0)
+);
+
+CREATE INDEX IF NOT EXISTS idx_bankroll_rules_bankroll_id ON bankroll_rules(bankroll_id);
+CREATE INDEX IF NOT EXISTS idx_bankroll_rules_user_id ON bankroll_rules(user_id);
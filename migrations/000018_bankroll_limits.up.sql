@@ -0,0 +1,20 @@
+CREATE TABLE IF NOT EXISTS bankroll_limits (
+    id BIGSERIAL PRIMARY KEY,
+    bankroll_id BIGINT NOT NULL UNIQUE,
+    user_id BIGINT NOT NULL,
+    timezone VARCHAR(64) NOT NULL DEFAULT 'UTC',
+    daily_stop_loss NUMERIC(27, 8),
+    daily_stop_win NUMERIC(27, 8),
+    weekly_stop_loss NUMERIC(27, 8),
+    weekly_stop_win NUMERIC(27, 8),
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT fk_limit_bankroll FOREIGN KEY (bankroll_id) REFERENCES bankrolls(id),
+    CONSTRAINT fk_limit_user FOREIGN KEY (user_id) REFERENCES users(id),
+    CONSTRAINT ck_limit_daily_stop_loss_positive CHECK (daily_stop_loss > 0),
+    CONSTRAINT ck_limit_daily_stop_win_positive CHECK (daily_stop_win > 0),
+    CONSTRAINT ck_limit_weekly_stop_loss_positive CHECK (weekly_stop_loss > 0),
+    CONSTRAINT ck_limit_weekly_stop_win_positive CHECK (weekly_stop_win > 0)
+);
+
+CREATE INDEX IF NOT EXISTS idx_bankroll_limits_user_id ON bankroll_limits(user_id);
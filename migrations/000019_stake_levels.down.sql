@@ -0,0 +1 @@
+DROP TABLE IF EXISTS stake_levels;
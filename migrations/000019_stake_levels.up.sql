@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS stake_levels (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    game_type VARCHAR(10) NOT NULL,
+    stakes VARCHAR(50) NOT NULL,
+    buy_in NUMERIC(27, 8) NOT NULL,
+    position INTEGER NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT fk_stake_level_user FOREIGN KEY (user_id) REFERENCES users(id),
+    CONSTRAINT ck_stake_level_game_type CHECK (game_type IN ('nlhe', 'plo', 'mtt', 'sng', 'spin')),
+    CONSTRAINT ck_stake_level_buy_in_positive CHECK (buy_in > 0),
+    CONSTRAINT uq_stake_level_stakes UNIQUE (user_id, game_type, stakes),
+    CONSTRAINT uq_stake_level_position UNIQUE (user_id, game_type, position)
+);
+
+CREATE INDEX IF NOT EXISTS idx_stake_levels_user_game ON stake_levels(user_id, game_type);
@@ -0,0 +1,27 @@
+CREATE TABLE IF NOT EXISTS bankroll_stats (
+    id BIGSERIAL PRIMARY KEY,
+    bankroll_id BIGINT NOT NULL UNIQUE,
+    user_id BIGINT NOT NULL,
+    last_transaction_id BIGINT NOT NULL DEFAULT 0,
+    results INTEGER NOT NULL DEFAULT 0,
+    net_result NUMERIC(27, 8) NOT NULL DEFAULT 0,
+    peak NUMERIC(27, 8) NOT NULL DEFAULT 0,
+    peak_balance NUMERIC(27, 8) NOT NULL DEFAULT 0,
+    peak_at TIMESTAMPTZ NOT NULL,
+    downswing_results INTEGER NOT NULL DEFAULT 0,
+    max_drawdown NUMERIC(27, 8) NOT NULL DEFAULT 0,
+    max_drawdown_percent NUMERIC(10, 2) NOT NULL DEFAULT 0,
+    longest_downswing_results INTEGER NOT NULL DEFAULT 0,
+    longest_downswing_days INTEGER NOT NULL DEFAULT 0,
+    current_streak INTEGER NOT NULL DEFAULT 0,
+    longest_winning_streak INTEGER NOT NULL DEFAULT 0,
+    longest_losing_streak INTEGER NOT NULL DEFAULT 0,
+    last_recovery_days INTEGER,
+    longest_recovery_days INTEGER,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT fk_stats_bankroll FOREIGN KEY (bankroll_id) REFERENCES bankrolls(id),
+    CONSTRAINT fk_stats_user FOREIGN KEY (user_id) REFERENCES users(id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_bankroll_stats_user_id ON bankroll_stats(user_id);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS bankroll_goal_milestones;
+DROP TABLE IF EXISTS bankroll_goals;
@@ -0,0 +1,41 @@
+CREATE TABLE IF NOT EXISTS bankroll_goals (
+    id BIGSERIAL PRIMARY KEY,
+    bankroll_id BIGINT NOT NULL,
+    user_id BIGINT NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    type VARCHAR(20) NOT NULL,
+    target NUMERIC(27, 8) NOT NULL,
+    target_percent NUMERIC(10, 2),
+    deadline DATE,
+    start_balance NUMERIC(27, 8) NOT NULL,
+    accrued NUMERIC(27, 8) NOT NULL DEFAULT 0,
+    reached_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT fk_goal_bankroll FOREIGN KEY (bankroll_id) REFERENCES bankrolls(id),
+    CONSTRAINT fk_goal_user FOREIGN KEY (user_id) REFERENCES users(id),
+    CONSTRAINT ck_goal_type CHECK (type IN ('balance', 'profit', 'cash_out')),
+    CONSTRAINT ck_goal_target_positive CHECK (target > 0)
+);
+
+CREATE INDEX IF NOT EXISTS idx_bankroll_goals_bankroll_id ON bankroll_goals(bankroll_id);
+CREATE INDEX IF NOT EXISTS idx_bankroll_goals_user_id ON bankroll_goals(user_id);
+
+CREATE TABLE IF NOT EXISTS bankroll_goal_milestones (
+    id BIGSERIAL PRIMARY KEY,
+    goal_id BIGINT NOT NULL,
+    bankroll_id BIGINT NOT NULL,
+    user_id BIGINT NOT NULL,
+    goal_name VARCHAR(100) NOT NULL,
+    goal_type VARCHAR(20) NOT NULL,
+    target NUMERIC(27, 8) NOT NULL,
+    progress NUMERIC(27, 8) NOT NULL,
+    transaction_id BIGINT NOT NULL,
+    reached_at TIMESTAMPTZ NOT NULL,
+    CONSTRAINT fk_milestone_bankroll FOREIGN KEY (bankroll_id) REFERENCES bankrolls(id),
+    CONSTRAINT fk_milestone_user FOREIGN KEY (user_id) REFERENCES users(id),
+    CONSTRAINT fk_milestone_transaction FOREIGN KEY (transaction_id) REFERENCES bankroll_transactions(id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_bankroll_goal_milestones_bankroll_id ON bankroll_goal_milestones(bankroll_id);
+CREATE INDEX IF NOT EXISTS idx_bankroll_goal_milestones_goal_id ON bankroll_goal_milestones(goal_id);
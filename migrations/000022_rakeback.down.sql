@@ -0,0 +1,9 @@
+ALTER TABLE bankroll_transactions DROP CONSTRAINT IF EXISTS ck_transaction_type;
+UPDATE bankroll_transactions SET type = 'adjustment' WHERE type = 'rakeback';
+ALTER TABLE bankroll_transactions ADD CONSTRAINT ck_transaction_type CHECK (type IN ('deposit', 'withdrawal', 'adjustment', 'transfer_in', 'transfer_out', 'bet_settlement', 'session_result', 'session_buy_in', 'session_cash_out', 'reset'));
+
+DROP TABLE IF EXISTS rakeback_payouts;
+DROP TABLE IF EXISTS rakeback_deals;
+
+ALTER TABLE poker_sessions DROP CONSTRAINT IF EXISTS ck_session_rake_nonnegative;
+ALTER TABLE poker_sessions DROP COLUMN IF EXISTS rake;
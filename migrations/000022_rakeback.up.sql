@@ -0,0 +1,50 @@
+ALTER TABLE poker_sessions ADD COLUMN IF NOT EXISTS rake NUMERIC(27, 8) NOT NULL DEFAULT 0;
+ALTER TABLE poker_sessions ADD CONSTRAINT ck_session_rake_nonnegative CHECK (rake >= 0);
+
+CREATE TABLE IF NOT EXISTS rakeback_deals (
+    id BIGSERIAL PRIMARY KEY,
+    bankroll_id BIGINT NOT NULL,
+    user_id BIGINT NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    source VARCHAR(20) NOT NULL,
+    percentage NUMERIC(5, 2) NOT NULL,
+    period VARCHAR(10) NOT NULL,
+    venue VARCHAR(100) NOT NULL DEFAULT '',
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    paid_through TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT fk_rakeback_deal_bankroll FOREIGN KEY (bankroll_id) REFERENCES bankrolls(id),
+    CONSTRAINT fk_rakeback_deal_user FOREIGN KEY (user_id) REFERENCES users(id),
+    CONSTRAINT ck_rakeback_deal_source CHECK (source IN ('rake', 'commission')),
+    CONSTRAINT ck_rakeback_deal_period CHECK (period IN ('weekly', 'monthly')),
+    CONSTRAINT ck_rakeback_deal_percentage CHECK (percentage > 0 AND percentage <= 100)
+);
+
+CREATE INDEX IF NOT EXISTS idx_rakeback_deals_bankroll_id ON rakeback_deals(bankroll_id);
+CREATE INDEX IF NOT EXISTS idx_rakeback_deals_user_id ON rakeback_deals(user_id);
+CREATE INDEX IF NOT EXISTS idx_rakeback_deals_due ON rakeback_deals(paid_through) WHERE active;
+
+CREATE TABLE IF NOT EXISTS rakeback_payouts (
+    id BIGSERIAL PRIMARY KEY,
+    deal_id BIGINT NOT NULL,
+    bankroll_id BIGINT NOT NULL,
+    user_id BIGINT NOT NULL,
+    period_start TIMESTAMPTZ NOT NULL,
+    period_end TIMESTAMPTZ NOT NULL,
+    basis NUMERIC(27, 8) NOT NULL,
+    percentage NUMERIC(5, 2) NOT NULL,
+    amount NUMERIC(27, 8) NOT NULL,
+    transaction_id BIGINT,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT fk_rakeback_payout_bankroll FOREIGN KEY (bankroll_id) REFERENCES bankrolls(id),
+    CONSTRAINT fk_rakeback_payout_user FOREIGN KEY (user_id) REFERENCES users(id),
+    CONSTRAINT fk_rakeback_payout_transaction FOREIGN KEY (transaction_id) REFERENCES bankroll_transactions(id),
+    CONSTRAINT ck_rakeback_payout_period CHECK (period_end > period_start)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_rakeback_payouts_deal_period ON rakeback_payouts(deal_id, period_start);
+CREATE INDEX IF NOT EXISTS idx_rakeback_payouts_bankroll_id ON rakeback_payouts(bankroll_id, period_start);
+
+ALTER TABLE bankroll_transactions DROP CONSTRAINT IF EXISTS ck_transaction_type;
+ALTER TABLE bankroll_transactions ADD CONSTRAINT ck_transaction_type CHECK (type IN ('deposit', 'withdrawal', 'adjustment', 'transfer_in', 'transfer_out', 'bet_settlement', 'session_result', 'session_buy_in', 'session_cash_out', 'reset', 'rakeback'));
@@ -0,0 +1,2 @@
+ALTER TABLE stake_levels DROP CONSTRAINT IF EXISTS fk_stake_level_currency;
+ALTER TABLE stake_levels DROP COLUMN IF EXISTS currency;
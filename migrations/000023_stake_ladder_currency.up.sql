@@ -0,0 +1,2 @@
+ALTER TABLE stake_levels ADD COLUMN IF NOT EXISTS currency VARCHAR(10) NOT NULL DEFAULT 'USD';
+ALTER TABLE stake_levels ADD CONSTRAINT fk_stake_level_currency FOREIGN KEY (currency) REFERENCES currencies(code);
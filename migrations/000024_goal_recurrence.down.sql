@@ -0,0 +1,4 @@
+ALTER TABLE bankroll_goals DROP CONSTRAINT IF EXISTS ck_goal_recurrence_period;
+ALTER TABLE bankroll_goals DROP CONSTRAINT IF EXISTS ck_goal_recurrence;
+ALTER TABLE bankroll_goals DROP COLUMN IF EXISTS period_start;
+ALTER TABLE bankroll_goals DROP COLUMN IF EXISTS recurrence;
@@ -0,0 +1,4 @@
+ALTER TABLE bankroll_goals ADD COLUMN IF NOT EXISTS recurrence VARCHAR(10) NOT NULL DEFAULT '';
+ALTER TABLE bankroll_goals ADD COLUMN IF NOT EXISTS period_start TIMESTAMPTZ;
+ALTER TABLE bankroll_goals ADD CONSTRAINT ck_goal_recurrence CHECK (recurrence IN ('', 'weekly', 'monthly'));
+ALTER TABLE bankroll_goals ADD CONSTRAINT ck_goal_recurrence_period CHECK (recurrence = '' OR period_start IS NOT NULL);
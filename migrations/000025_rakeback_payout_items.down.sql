@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS rakeback_payout_items;
+
+ALTER TABLE rakeback_deals DROP COLUMN IF EXISTS accrues_from;
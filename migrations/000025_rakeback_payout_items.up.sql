@@ -0,0 +1,16 @@
+ALTER TABLE rakeback_deals ADD COLUMN IF NOT EXISTS accrues_from TIMESTAMPTZ;
+UPDATE rakeback_deals SET accrues_from = paid_through WHERE accrues_from IS NULL;
+ALTER TABLE rakeback_deals ALTER COLUMN accrues_from SET NOT NULL;
+
+CREATE TABLE IF NOT EXISTS rakeback_payout_items (
+    id BIGSERIAL PRIMARY KEY,
+    payout_id BIGINT NOT NULL,
+    deal_id BIGINT NOT NULL,
+    source_id BIGINT NOT NULL,
+    amount NUMERIC(27, 8) NOT NULL,
+    CONSTRAINT fk_rakeback_payout_item_payout FOREIGN KEY (payout_id) REFERENCES rakeback_payouts(id),
+    CONSTRAINT ck_rakeback_payout_item_amount CHECK (amount <> 0)
+);
+
+CREATE INDEX IF NOT EXISTS idx_rakeback_payout_items_payout_id ON rakeback_payout_items(payout_id);
+CREATE INDEX IF NOT EXISTS idx_rakeback_payout_items_deal_source ON rakeback_payout_items(deal_id, source_id);
@@ -0,0 +1,8 @@
+ALTER TABLE bankroll_limits ADD COLUMN IF NOT EXISTS timezone VARCHAR(64) NOT NULL DEFAULT 'UTC';
+
+UPDATE bankroll_limits l
+SET timezone = b.timezone
+FROM bankrolls b
+WHERE b.id = l.bankroll_id;
+
+ALTER TABLE bankrolls DROP COLUMN IF EXISTS timezone;
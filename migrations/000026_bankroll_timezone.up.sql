@@ -0,0 +1,8 @@
+ALTER TABLE bankrolls ADD COLUMN IF NOT EXISTS timezone VARCHAR(64) NOT NULL DEFAULT 'UTC';
+
+UPDATE bankrolls b
+SET timezone = l.timezone
+FROM bankroll_limits l
+WHERE l.bankroll_id = b.id;
+
+ALTER TABLE bankroll_limits DROP COLUMN IF EXISTS timezone;
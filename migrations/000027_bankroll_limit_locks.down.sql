@@ -0,0 +1 @@
+DROP TABLE IF EXISTS bankroll_limit_locks;
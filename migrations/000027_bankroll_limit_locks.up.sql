@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS bankroll_limit_locks (
+    id BIGSERIAL PRIMARY KEY,
+    bankroll_id BIGINT NOT NULL UNIQUE,
+    user_id BIGINT NOT NULL,
+    reason VARCHAR(30) NOT NULL,
+    threshold NUMERIC(27, 8) NOT NULL,
+    result NUMERIC(27, 8) NOT NULL,
+    locked_until TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT fk_limit_lock_bankroll FOREIGN KEY (bankroll_id) REFERENCES bankrolls(id),
+    CONSTRAINT fk_limit_lock_user FOREIGN KEY (user_id) REFERENCES users(id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_bankroll_limit_locks_user_id ON bankroll_limit_locks(user_id);
@@ -0,0 +1,4 @@
+ALTER TABLE bankroll_stats DROP COLUMN IF EXISTS last_result_at;
+ALTER TABLE bankroll_stats DROP COLUMN IF EXISTS series_start;
+
+DELETE FROM bankroll_stats;
@@ -0,0 +1,6 @@
+ALTER TABLE bankroll_stats ADD COLUMN IF NOT EXISTS series_start TIMESTAMPTZ;
+ALTER TABLE bankroll_stats ADD COLUMN IF NOT EXISTS last_result_at TIMESTAMPTZ;
+
+-- Stored stats were folded in ledger order and across resets. They are only
+-- a cache of the ledger, so they are dropped and rebuilt on the next request.
+DELETE FROM bankroll_stats;